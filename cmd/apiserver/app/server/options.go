@@ -17,8 +17,10 @@ limitations under the License.
 package server
 
 import (
+	"fmt"
 	"os"
 
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	"github.com/spf13/pflag"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	genericserveroptions "k8s.io/apiserver/pkg/server/options"
@@ -59,6 +61,12 @@ type ServiceCatalogServerOptions struct {
 	ServeOpenAPISpec bool
 	// KubeconfigPath, if specified, is used over the in-cluster service account token.
 	KubeconfigPath string
+	// MaxParametersSize is the maximum size, in bytes, of the serialized
+	// parameters of ServiceInstances and ServiceBindings
+	MaxParametersSize int
+	// ReservedParameterKeys are the parameter names ServiceInstances and
+	// ServiceBindings may not set
+	ReservedParameterKeys []string
 }
 
 // NewServiceCatalogServerOptions creates a new instances of
@@ -73,6 +81,7 @@ func NewServiceCatalogServerOptions() *ServiceCatalogServerOptions {
 		AuditOptions:            genericserveroptions.NewAuditOptions(),
 		EtcdOptions:             NewEtcdOptions(),
 		StandaloneMode:          standaloneMode(),
+		MaxParametersSize:       scv.DefaultMaxParametersSize,
 	}
 	// register all admission plugins
 	registerAllAdmissionPlugins(opts.AdmissionOptions.Plugins)
@@ -112,6 +121,18 @@ func (s *ServiceCatalogServerOptions) AddFlags(flags *pflag.FlagSet) {
 		"",
 		"Path to kubeconfig to use over the in-cluster service account token",
 	)
+	flags.IntVar(
+		&s.MaxParametersSize,
+		"max-parameters-size",
+		s.MaxParametersSize,
+		"The maximum size, in bytes, of the serialized spec.parameters of ServiceInstances and ServiceBindings",
+	)
+	flags.StringSliceVar(
+		&s.ReservedParameterKeys,
+		"reserved-parameter-keys",
+		nil,
+		"Parameter names that ServiceInstances and ServiceBindings may not set, such as names that brokers could confuse with OSB context fields (e.g. platform,instance_id)",
+	)
 
 	s.GenericServerRunOptions.AddUniversalFlags(flags)
 	s.AdmissionOptions.AddFlags(flags)
@@ -132,6 +153,9 @@ func (s *ServiceCatalogServerOptions) Validate() error {
 	errors = append(errors, s.SecureServingOptions.Validate()...)
 	errors = append(errors, s.AuthenticationOptions.Validate()...)
 	errors = append(errors, s.AuthorizationOptions.Validate()...)
+	if s.MaxParametersSize <= 0 {
+		errors = append(errors, fmt.Errorf("--max-parameters-size must be greater than 0"))
+	}
 	// etcd options
 	etcdErrs := s.EtcdOptions.Validate()
 	if len(etcdErrs) > 0 {
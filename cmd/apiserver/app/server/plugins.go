@@ -24,7 +24,12 @@ import (
 
 	// Admission controllers
 	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/broker/authsarcheck"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/parameterkeys"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/servicebindings/bindableplan"
 	siclifecycle "github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/servicebindings/lifecycle"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/servicebindings/secretcopies"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/serviceinstances/allowedbrokers"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/serviceinstances/requiredparameters"
 	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/serviceplan/changevalidator"
 	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/serviceplan/defaultserviceplan"
 )
@@ -35,4 +40,9 @@ func registerAllAdmissionPlugins(plugins *admission.Plugins) {
 	siclifecycle.Register(plugins)
 	changevalidator.Register(plugins)
 	authsarcheck.Register(plugins)
+	allowedbrokers.Register(plugins)
+	requiredparameters.Register(plugins)
+	bindableplan.Register(plugins)
+	parameterkeys.Register(plugins)
+	secretcopies.Register(plugins)
 }
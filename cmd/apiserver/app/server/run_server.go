@@ -21,6 +21,7 @@ import (
 	"net/http"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	"k8s.io/apiserver/pkg/server/healthz"
 	genericapiserverstorage "k8s.io/apiserver/pkg/server/storage"
 	"k8s.io/apiserver/pkg/storage/etcd3/preflight"
@@ -86,7 +87,11 @@ func runEtcdServer(opts *ServiceCatalogServerOptions, stopCh <-chan struct{}) er
 	}
 
 	// // Set the finalized generic and storage configs
-	config := apiserver.NewEtcdConfig(genericConfig, 0 /* deleteCollectionWorkers */, storageFactory)
+	parametersLimits := scv.ParametersLimits{
+		MaxSize:      opts.MaxParametersSize,
+		ReservedKeys: opts.ReservedParameterKeys,
+	}
+	config := apiserver.NewEtcdConfig(genericConfig, 0 /* deleteCollectionWorkers */, storageFactory, parametersLimits)
 
 	// Fill in defaults not already set in the config
 	completed := config.Complete()
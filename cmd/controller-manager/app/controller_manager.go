@@ -27,6 +27,7 @@ import (
 	"strconv"
 	"time"
 
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 
@@ -40,6 +41,7 @@ import (
 	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
 	"github.com/kubernetes-incubator/service-catalog/pkg/metrics/osbclientproxy"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -95,6 +97,34 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		klog.Warning("program option --port is obsolete and ignored, specify --secure-port instead")
 	}
 
+	if _, err := labels.Parse(controllerManagerOptions.Selector); err != nil {
+		return fmt.Errorf("invalid --selector %q: %v", controllerManagerOptions.Selector, err)
+	}
+
+	if controllerManagerOptions.BrokerContextPlatform == "" {
+		return fmt.Errorf("--broker-context-platform must not be empty")
+	}
+
+	if _, err := controller.ParseBrokerContextAnnotations(controllerManagerOptions.BrokerContextAnnotations); err != nil {
+		return fmt.Errorf("invalid --broker-context-annotations %q: %v", controllerManagerOptions.BrokerContextAnnotations, err)
+	}
+
+	if controllerManagerOptions.OperationPollingInitialInterval <= 0 {
+		return fmt.Errorf("--operation-polling-initial-interval must be positive")
+	}
+
+	if _, err := controller.ParseNamespaceAnnotationParameters(controllerManagerOptions.NamespaceAnnotationParameters); err != nil {
+		return fmt.Errorf("invalid --namespace-annotation-parameters %q: %v", controllerManagerOptions.NamespaceAnnotationParameters, err)
+	}
+
+	if _, err := controller.ParseParametersFromURLAllowedHosts(controllerManagerOptions.ParametersFromURLAllowedHosts); err != nil {
+		return fmt.Errorf("invalid --parameters-from-url-allowed-hosts %q: %v", controllerManagerOptions.ParametersFromURLAllowedHosts, err)
+	}
+
+	if _, err := controller.LoadCatalogRewriteRules(controllerManagerOptions.CatalogRewriteRulesFile); err != nil {
+		return fmt.Errorf("invalid --catalog-rewrite-rules-file %q: %v", controllerManagerOptions.CatalogRewriteRulesFile, err)
+	}
+
 	// Build the K8s kubeconfig / client / clientBuilder
 	klog.V(4).Info("Building k8s kubeconfig")
 
@@ -154,7 +184,7 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 
 	apiextensionsClient, err := apiextensionsclientset.NewForConfig(serviceCatalogKubeconfig)
 	if err != nil {
-		return fmt.Errorf("failed to create apiextension clientset", err)
+		return fmt.Errorf("failed to create apiextension clientset: %v", err)
 	}
 	readinessProbe, err := probe.NewReadinessCRDProbe(apiextensionsClient)
 	if err != nil {
@@ -250,6 +280,8 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 
 	klog.V(5).Infof("Using namespace %v for leader election lock", controllerManagerOptions.LeaderElectionNamespace)
 
+	identity := id + "-external-service-catalog-controller"
+
 	// Lock required for leader election
 	rl, err := resourcelock.New(
 		controllerManagerOptions.LeaderElection.ResourceLock,
@@ -257,7 +289,7 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		"service-catalog-controller-manager",
 		leaderElectionClient.CoreV1(),
 		resourcelock.ResourceLockConfig{
-			Identity:      id + "-external-service-catalog-controller",
+			Identity:      identity,
 			EventRecorder: recorder,
 		})
 	if err != nil {
@@ -270,16 +302,29 @@ func Run(controllerManagerOptions *options.ControllerManagerServer) error {
 		LeaseDuration: controllerManagerOptions.LeaderElection.LeaseDuration.Duration,
 		RenewDeadline: controllerManagerOptions.LeaderElection.RenewDeadline.Duration,
 		RetryPeriod:   controllerManagerOptions.LeaderElection.RetryPeriod.Duration,
-		Callbacks: leaderelection.LeaderCallbacks{
-			OnStartedLeading: run,
-			OnStoppedLeading: func() {
-				klog.Fatalf("leaderelection lost")
-			},
-		},
+		Callbacks:     leaderCallbacks(identity, run),
 	})
 	panic("unreachable")
 }
 
+// leaderCallbacks returns the leader election callbacks that run the
+// controllers once leadership is acquired and report the leadership of this
+// replica through the LeaderElectionMasterStatus metric.
+func leaderCallbacks(identity string, run func(context.Context)) leaderelection.LeaderCallbacks {
+	metrics.LeaderElectionMasterStatus.WithLabelValues(identity).Set(0)
+	return leaderelection.LeaderCallbacks{
+		OnStartedLeading: func(ctx context.Context) {
+			klog.Infof("%v acquired the leader election lock", identity)
+			metrics.LeaderElectionMasterStatus.WithLabelValues(identity).Set(1)
+			run(ctx)
+		},
+		OnStoppedLeading: func() {
+			metrics.LeaderElectionMasterStatus.WithLabelValues(identity).Set(0)
+			klog.Fatalf("leaderelection lost")
+		},
+	}
+}
+
 // StartControllers starts all the controllers in the service-catalog
 // controller manager.
 func StartControllers(s *options.ControllerManagerServer,
@@ -308,9 +353,12 @@ func StartControllers(s *options.ControllerManagerServer,
 	if err != nil {
 		klog.Fatal(err)
 	}
-	klog.V(5).Infof("Creating shared informers; resync interval: %v", s.ResyncInterval)
+	klog.V(5).Infof("Creating shared informers; resync interval: %v, selector: %q", s.ResyncInterval, s.Selector)
 
-	// Build the informer factory for service-catalog resources
+	// Build the informer factory for service-catalog resources. The informers
+	// are not filtered by the selector, which is applied by the controller
+	// when deciding what to reconcile, so that the objects of other shards
+	// can still be looked up.
 	informerFactory := servicecataloginformers.NewSharedInformerFactory(
 		serviceCatalogClientBuilder.ClientOrDie("shared-informers"),
 		s.ResyncInterval,
@@ -318,6 +366,39 @@ func StartControllers(s *options.ControllerManagerServer,
 	// All shared informers are v1beta1 API level
 	serviceCatalogSharedInformers := informerFactory.Servicecatalog().V1beta1()
 
+	// Build the informer factory for the Secrets and Namespaces watched by
+	// the controller. The Secret informer is only started when Secrets are
+	// watched, and the Namespace informer when namespace annotations are read.
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(coreClient, s.ResyncInterval)
+
+	namespaceAnnotationParameters, err := controller.ParseNamespaceAnnotationParameters(s.NamespaceAnnotationParameters)
+	if err != nil {
+		return err
+	}
+
+	parametersFromURLAllowedHosts, err := controller.ParseParametersFromURLAllowedHosts(s.ParametersFromURLAllowedHosts)
+	if err != nil {
+		return err
+	}
+
+	catalogRewriteRules, err := controller.LoadCatalogRewriteRules(s.CatalogRewriteRulesFile)
+	if err != nil {
+		return err
+	}
+
+	brokerContextAnnotations, err := controller.ParseBrokerContextAnnotations(s.BrokerContextAnnotations)
+	if err != nil {
+		return err
+	}
+
+	var selector labels.Selector
+	if s.Selector != "" {
+		selector, err = labels.Parse(s.Selector)
+		if err != nil {
+			return err
+		}
+	}
+
 	klog.V(5).Infof("Creating controller; broker relist interval: %v", s.ServiceBrokerRelistInterval)
 	serviceCatalogController, err := controller.NewController(
 		coreClient,
@@ -330,6 +411,8 @@ func StartControllers(s *options.ControllerManagerServer,
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		kubeInformerFactory.Core().V1().Secrets(),
+		kubeInformerFactory.Core().V1().Namespaces(),
 		osbclientproxy.NewClient,
 		s.ServiceBrokerRelistInterval,
 		s.OSBAPIPreferredVersion,
@@ -338,6 +421,30 @@ func StartControllers(s *options.ControllerManagerServer,
 		s.OperationPollingMaximumBackoffDuration,
 		s.ClusterIDConfigMapName,
 		s.ClusterIDConfigMapNamespace,
+		controller.Options{
+			OperationPollingInitialInterval:               s.OperationPollingInitialInterval,
+			AllowBrokerInsecureSkipTLSVerify:              s.AllowBrokerInsecureSkipTLSVerify,
+			BrokerContextNamespacePrefix:                  s.BrokerContextNamespacePrefix,
+			BrokerContextPlatform:                         s.BrokerContextPlatform,
+			BrokerContextAnnotations:                      brokerContextAnnotations,
+			NamespaceAnnotationParameters:                 namespaceAnnotationParameters,
+			ParametersFromURLAllowedHosts:                 parametersFromURLAllowedHosts,
+			OriginatingIdentityNamespaceAnnotation:        s.OriginatingIdentityNamespaceAnnotation,
+			CatalogRewriteRules:                           catalogRewriteRules,
+			MaxCatalogSize:                                s.MaxCatalogSize,
+			SkipMalformedCatalogEntries:                   s.SkipMalformedCatalogEntries,
+			MaxOrphanMitigationAttempts:                   s.MaxOrphanMitigationAttempts,
+			ResumeOrphanMitigationOnBrokerRecovery:        s.ResumeOrphanMitigationOnBrokerRecovery,
+			RefetchBindingCredentialsOnInstancePlanChange: s.RefetchBindingCredentialsOnInstancePlanChange,
+			RevalidateInstancesOnPlanSchemaChange:         s.RevalidateInstancesOnPlanSchemaChange,
+			ResendParametersOnDefaultsChange:              s.ResendParametersOnDefaultsChange,
+			AllowBindToNonBindablePlans:                   s.AllowBindToNonBindablePlans,
+			ReconcileInstancesOnlyOnChange:                s.ReconcileInstancesOnlyOnChange,
+			StuckOperationWarningInterval:                 s.StuckOperationWarningInterval,
+			HealBindingSecretsOnStartup:                   s.HealBindingSecretsOnStartup,
+			WatchSecrets:                                  s.WatchSecrets,
+			Selector:                                      selector,
+		},
 	)
 	if err != nil {
 		return err
@@ -345,12 +452,14 @@ func StartControllers(s *options.ControllerManagerServer,
 
 	klog.V(1).Info("Starting shared informers")
 	informerFactory.Start(stop)
+	kubeInformerFactory.Start(stop)
 
 	klog.V(5).Info("Waiting for caches to sync")
 	informerFactory.WaitForCacheSync(stop)
+	kubeInformerFactory.WaitForCacheSync(stop)
 
 	klog.V(5).Info("Running controller")
-	go serviceCatalogController.Run(s.ConcurrentSyncs, stop)
+	go serviceCatalogController.Run(s.Workers(), stop)
 
 	select {}
 }
@@ -0,0 +1,59 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+)
+
+func leaderElectionMasterStatus(t *testing.T, identity string) float64 {
+	m := &dto.Metric{}
+	if err := metrics.LeaderElectionMasterStatus.WithLabelValues(identity).Write(m); err != nil {
+		t.Fatalf("unexpected error reading metric: %v", err)
+	}
+	return m.GetGauge().GetValue()
+}
+
+func TestLeaderCallbacksReportLeadership(t *testing.T) {
+	const identity = "replica-a"
+
+	ran := false
+	callbacks := leaderCallbacks(identity, func(ctx context.Context) {
+		ran = true
+		if e, a := 1.0, leaderElectionMasterStatus(t, identity); e != a {
+			t.Errorf("unexpected leader status while running controllers; expected %v, got %v", e, a)
+		}
+	})
+
+	if e, a := 0.0, leaderElectionMasterStatus(t, identity); e != a {
+		t.Fatalf("unexpected leader status before acquiring leadership; expected %v, got %v", e, a)
+	}
+
+	callbacks.OnStartedLeading(context.TODO())
+
+	if !ran {
+		t.Fatal("expected the controllers to be run after acquiring leadership")
+	}
+	if e, a := 1.0, leaderElectionMasterStatus(t, identity); e != a {
+		t.Fatalf("unexpected leader status after acquiring leadership; expected %v, got %v", e, a)
+	}
+}
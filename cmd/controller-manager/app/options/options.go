@@ -60,6 +60,7 @@ const (
 	defaultLeaderElectionNamespace                = "kube-system"
 	defaultReconciliationRetryDuration            = 7 * 24 * time.Hour
 	defaultOperationPollingMaximumBackoffDuration = 20 * time.Minute
+	defaultOperationPollingInitialInterval        = 1 * time.Second
 )
 
 var defaultOSBAPIPreferredVersion = osb.LatestAPIVersion().HeaderValue()
@@ -85,6 +86,7 @@ func NewControllerManagerServer() *ControllerManagerServer {
 			EnableContentionProfiling:              false,
 			ReconciliationRetryDuration:            defaultReconciliationRetryDuration,
 			OperationPollingMaximumBackoffDuration: defaultOperationPollingMaximumBackoffDuration,
+			OperationPollingInitialInterval:        defaultOperationPollingInitialInterval,
 			SecureServingOptions:                   genericoptions.NewSecureServingOptions(),
 		},
 	}
@@ -101,6 +103,10 @@ func (s *ControllerManagerServer) AddFlags(fs *pflag.FlagSet) {
 	fs.MarkDeprecated("address", "see --bind-address instead")
 	fs.Int32Var(&s.Port, "port", 0, "DEPRECATED: see --secure-port instead")
 	fs.IntVar(&s.ConcurrentSyncs, "concurrent-syncs", defaultConcurrentSyncs, "Number of concurrent syncs")
+	fs.IntVar(&s.ConcurrentInstanceSyncs, "concurrent-instance-syncs", s.ConcurrentInstanceSyncs, "Number of concurrent service instance syncs. If 0, --concurrent-syncs is used")
+	fs.IntVar(&s.ConcurrentBindingSyncs, "concurrent-binding-syncs", s.ConcurrentBindingSyncs, "Number of concurrent service binding syncs. If 0, --concurrent-syncs is used")
+	fs.IntVar(&s.ConcurrentBrokerSyncs, "concurrent-broker-syncs", s.ConcurrentBrokerSyncs, "Number of concurrent broker, class and plan syncs. If 0, --concurrent-syncs is used")
+	fs.StringVar(&s.Selector, "selector", s.Selector, "Label selector restricting the brokers, instances and bindings reconciled by this controller, used to shard reconciliation across several controllers. Each shard needs its own --leader-election-namespace")
 	fs.MarkDeprecated("port", "see --secure-port instead")
 	fs.StringVar(&s.ContentType, "api-content-type", s.ContentType, "Content type of requests sent to API servers")
 	fs.StringVar(&s.K8sAPIServerURL, "k8s-api-server-url", "", "The URL for the k8s API server")
@@ -119,8 +125,45 @@ func (s *ControllerManagerServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.LeaderElectionNamespace, "leader-election-namespace", s.LeaderElectionNamespace, "Namespace to use for leader election lock")
 	fs.DurationVar(&s.ReconciliationRetryDuration, "reconciliation-retry-duration", s.ReconciliationRetryDuration, "The maximum amount of time to retry reconciliations on a resource before failing")
 	fs.DurationVar(&s.OperationPollingMaximumBackoffDuration, "operation-polling-maximum-backoff-duration", s.OperationPollingMaximumBackoffDuration, "The maximum amount of time to back-off while polling an OSB API operation")
+	fs.DurationVar(&s.OperationPollingInitialInterval, "operation-polling-initial-interval", s.OperationPollingInitialInterval, "The interval of the first poll of an OSB API operation; the interval doubles while the operation stays in the same state, up to the maximum back-off duration")
+	fs.BoolVar(&s.AllowBrokerInsecureSkipTLSVerify, "allow-broker-insecure-skip-tls-verify", s.AllowBrokerInsecureSkipTLSVerify, "Honor insecureSkipTLSVerify on brokers, skipping verification of their TLS certificates. This is dangerous and only intended for development clusters")
+	fs.StringVar(&s.BrokerContextNamespacePrefix, "broker-context-namespace-prefix", s.BrokerContextNamespacePrefix, "A prefix added to the namespace sent to brokers in the OSB context, so that brokers serving several clusters can tell apart namespaces with the same name")
+	fs.StringVar(&s.BrokerContextPlatform, "broker-context-platform", controller.ContextProfilePlatformKubernetes, "The platform sent to brokers in the OSB context, for platforms built on top of the service catalog which identify themselves to brokers")
+	fs.StringVar(&s.BrokerContextAnnotations, "broker-context-annotations", s.BrokerContextAnnotations, "A comma separated list of annotations of instances which are copied into the OSB context of their provision and update requests, under the annotation key")
+	fs.BoolVar(&s.HealBindingSecretsOnStartup, "heal-binding-secrets-on-startup", s.HealBindingSecretsOnStartup, "On startup, fetch the bindings of brokers advertising bindings_retrievable one at a time and rewrite the Secrets which no longer match the credentials the brokers return, such as Secrets modified while the controller was down")
+	fs.BoolVar(&s.WatchSecrets, "watch-secrets", s.WatchSecrets, "Watch Secrets, to write the Secrets of bindings again when they are deleted and to send the parameters of instances to the brokers again when the Secrets they are read from change. Requires permission to list and watch Secrets")
+	fs.BoolVar(&s.SkipMalformedCatalogEntries, "skip-malformed-catalog-entries", s.SkipMalformedCatalogEntries, "Skip the classes and plans of a broker catalog which have no plans or are rejected as invalid, listing them in the MalformedCatalogEntries condition of the broker, and synchronize the rest of the catalog. Otherwise a single malformed entry fails the whole relist")
+	fs.Int64Var(&s.MaxOrphanMitigationAttempts, "max-orphan-mitigation-attempts", s.MaxOrphanMitigationAttempts, "The maximum number of deprovision requests sent to mitigate an orphaned instance before it requires manual intervention; 0 means no limit")
+	fs.BoolVar(&s.ResumeOrphanMitigationOnBrokerRecovery, "resume-orphan-mitigation-on-broker-recovery", s.ResumeOrphanMitigationOnBrokerRecovery, "Resume the failed orphan mitigations of a broker, such as the ones which ran out of retries while the broker was down, on every successful relist of its catalog. Orphan mitigations stopped by --max-orphan-mitigation-attempts are not resumed")
+	fs.Int64Var(&s.MaxCatalogSize, "max-catalog-size", s.MaxCatalogSize, "The maximum number of classes and plans a single broker may publish; the catalog of a broker publishing more is rejected. 0 means no limit")
+	fs.BoolVar(&s.RefetchBindingCredentialsOnInstancePlanChange, "refetch-binding-credentials-on-instance-plan-change", s.RefetchBindingCredentialsOnInstancePlanChange, "Send the bind requests of the bindings of an instance again, with the same binding IDs, after its plan changes, and write the credentials the brokers return. Brokers treating the repeated requests as idempotent return the credentials of the existing bindings rather than new ones. Otherwise the bindings are only marked as having stale credentials")
+	fs.BoolVar(&s.RevalidateInstancesOnPlanSchemaChange, "revalidate-instances-on-plan-schema-change", s.RevalidateInstancesOnPlanSchemaChange, "Check the parameters of the instances of a plan against its new parameter schema when a broker relist changes it, and flag the instances which do not comply with the NonCompliantParameters condition. The instances themselves are not modified")
+	fs.BoolVar(&s.ResendParametersOnDefaultsChange, "resend-parameters-on-defaults-change", s.ResendParametersOnDefaultsChange, "Send the parameters of a provisioned instance to the broker again when the default provisioning parameters it was provisioned with change, with the parameters left at their old defaults set to the new ones. Otherwise the instances are only flagged with the DefaultParametersChanged condition")
+	fs.BoolVar(&s.AllowBindToNonBindablePlans, "allow-bind-to-non-bindable-plans", s.AllowBindToNonBindablePlans, "Send the bind requests of the bindings of instances whose plan is not bindable instead of rejecting them. Existing bindings of a plan which becomes non-bindable are flagged with the PlanNotBindable condition either way")
+	fs.StringVar(&s.CatalogRewriteRulesFile, "catalog-rewrite-rules-file", s.CatalogRewriteRulesFile, "Path of a YAML file of per broker rules dropping or renaming the classes and plans the brokers advertise, applied before the catalog restrictions of the brokers")
+	fs.BoolVar(&s.ReconcileInstancesOnlyOnChange, "reconcile-instances-only-on-change", s.ReconcileInstancesOnlyOnChange, "Skip the reconciliation of instances on resync when their spec has been observed and no operation is in progress, to reduce the load on brokers. Changes outside of an instance, such as to its context, are then only acted upon with the next change of the instance")
+	fs.DurationVar(&s.StuckOperationWarningInterval, "stuck-operation-warning-interval", s.StuckOperationWarningInterval, "The age past which an ongoing operation of an instance or binding is reminded of with a Warning event, repeated at most once per interval. 0 disables the reminders")
+	fs.StringVar(&s.NamespaceAnnotationParameters, "namespace-annotation-parameters", s.NamespaceAnnotationParameters, "A comma separated list of annotation=parameter pairs. The value of each annotation on the namespace of an instance is used as the default of the given provisioning parameter of the instance")
+	fs.StringVar(&s.ParametersFromURLAllowedHosts, "parameters-from-url-allowed-hosts", s.ParametersFromURLAllowedHosts, "A comma separated list of the hosts parameters may be fetched from with urlRef. Parameters are fetched from no host if empty. Requires the ParametersFromURL feature")
+	fs.StringVar(&s.OriginatingIdentityNamespaceAnnotation, "originating-identity-namespace-annotation", s.OriginatingIdentityNamespaceAnnotation, "An annotation of namespaces whose value is sent as the username of the originating identity of the broker requests of the instances and bindings in the namespace, in place of the user who made the change. Users allowed to update namespaces can choose the identity brokers see. Requires the OriginatingIdentity feature")
 	s.SecureServingOptions.AddFlags(fs)
 	utilfeature.DefaultFeatureGate.AddFlag(fs)
 	fs.StringVar(&s.ClusterIDConfigMapName, "cluster-id-configmap-name", controller.DefaultClusterIDConfigMapName, "k8s name for clusterid configmap")
 	fs.StringVar(&s.ClusterIDConfigMapNamespace, "cluster-id-configmap-namespace", controller.DefaultClusterIDConfigMapNamespace, "k8s namespace for clusterid configmap")
 }
+
+// Workers returns the number of workers to run for each type of resource.
+func (s *ControllerManagerServer) Workers() controller.Workers {
+	return controller.Workers{
+		Brokers:   concurrentSyncsOrDefault(s.ConcurrentBrokerSyncs, s.ConcurrentSyncs),
+		Instances: concurrentSyncsOrDefault(s.ConcurrentInstanceSyncs, s.ConcurrentSyncs),
+		Bindings:  concurrentSyncsOrDefault(s.ConcurrentBindingSyncs, s.ConcurrentSyncs),
+	}
+}
+
+func concurrentSyncsOrDefault(concurrentSyncs, fallback int) int {
+	if concurrentSyncs > 0 {
+		return concurrentSyncs
+	}
+	return fallback
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/controller"
+)
+
+func TestWorkers(t *testing.T) {
+	cases := []struct {
+		name     string
+		args     []string
+		expected controller.Workers
+	}{
+		{
+			name:     "defaults",
+			expected: controller.Workers{Brokers: 5, Instances: 5, Bindings: 5},
+		},
+		{
+			name:     "concurrent syncs",
+			args:     []string{"--concurrent-syncs=2"},
+			expected: controller.Workers{Brokers: 2, Instances: 2, Bindings: 2},
+		},
+		{
+			name: "per resource syncs",
+			args: []string{
+				"--concurrent-broker-syncs=1",
+				"--concurrent-instance-syncs=10",
+				"--concurrent-binding-syncs=3",
+			},
+			expected: controller.Workers{Brokers: 1, Instances: 10, Bindings: 3},
+		},
+		{
+			name: "per resource syncs fall back to concurrent syncs",
+			args: []string{
+				"--concurrent-syncs=4",
+				"--concurrent-instance-syncs=8",
+			},
+			expected: controller.Workers{Brokers: 4, Instances: 8, Bindings: 4},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewControllerManagerServer()
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			s.AddFlags(fs)
+			if err := fs.Parse(tc.args); err != nil {
+				t.Fatalf("unexpected error parsing flags: %v", err)
+			}
+			if actual := s.Workers(); actual != tc.expected {
+				t.Errorf("unexpected workers: expected %+v, got %+v", tc.expected, actual)
+			}
+		})
+	}
+}
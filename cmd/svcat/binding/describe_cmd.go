@@ -26,18 +26,25 @@ import (
 
 type describeCmd struct {
 	*command.Namespaced
+	*command.Redacted
 	name        string
 	showSecrets bool
 }
 
 // NewDescribeCmd builds a "svcat describe binding" command
 func NewDescribeCmd(cxt *command.Context) *cobra.Command {
-	describeCmd := &describeCmd{Namespaced: command.NewNamespaced(cxt)}
+	describeCmd := &describeCmd{
+		Namespaced: command.NewNamespaced(cxt),
+		Redacted:   command.NewRedacted(),
+	}
 	cmd := &cobra.Command{
 		Use:     "binding NAME",
 		Aliases: []string{"bindings", "bnd"},
 		Short:   "Show details of a specific binding",
-		Example: command.NormalizeExamples(`svcat describe binding wordpress-mysql-binding`),
+		Example: command.NormalizeExamples(`
+  svcat describe binding wordpress-mysql-binding
+  svcat describe binding wordpress-mysql-binding --redact
+`),
 		PreRunE: command.PreRunE(describeCmd),
 		RunE:    command.RunE(describeCmd),
 	}
@@ -48,6 +55,7 @@ func NewDescribeCmd(cxt *command.Context) *cobra.Command {
 		false,
 		"Output the decoded secret values. By default only the length of the secret is displayed",
 	)
+	describeCmd.AddRedactFlag(cmd.Flags(), "Mask the values of sensitive parameters and all secret values")
 	return cmd
 }
 
@@ -57,6 +65,10 @@ func (c *describeCmd) Validate(args []string) error {
 	}
 	c.name = args[0]
 
+	if c.Redact() && c.showSecrets {
+		return fmt.Errorf("--redact and --show-secrets cannot be used together")
+	}
+
 	return nil
 }
 
@@ -69,10 +81,18 @@ func (c *describeCmd) describe() error {
 	if err != nil {
 		return err
 	}
+	if c.Redact() {
+		binding = output.RedactBinding(binding, c.RedactKeys)
+	}
 
 	output.WriteBindingDetails(c.Output, binding)
 
 	secret, err := c.App.RetrieveSecretByBinding(binding)
+	if c.Redact() {
+		// Print the masked values rather than their length.
+		output.WriteAssociatedSecret(c.Output, output.RedactSecret(secret), err, true)
+		return nil
+	}
 	output.WriteAssociatedSecret(c.Output, secret, err, c.showSecrets)
 
 	return nil
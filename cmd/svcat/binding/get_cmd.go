@@ -17,6 +17,8 @@ limitations under the License.
 package binding
 
 import (
+	"fmt"
+
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
 	"github.com/spf13/cobra"
@@ -25,7 +27,8 @@ import (
 type getCmd struct {
 	*command.Namespaced
 	*command.Formatted
-	name string
+	name         string
+	secretFilter string
 }
 
 // NewGetCmd builds a "svcat get bindings" command
@@ -41,6 +44,7 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 		Example: command.NormalizeExamples(`
   svcat get bindings
   svcat get bindings --all-namespaces
+  svcat get bindings --all-namespaces --secret wordpress-mysql-binding
   svcat get binding wordpress-mysql-binding
   svcat get binding -n ci concourse-postgres-binding
 `),
@@ -50,12 +54,22 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 
 	getCmd.AddNamespaceFlags(cmd.Flags(), true)
 	getCmd.AddOutputFlags(cmd.Flags())
+	cmd.Flags().StringVar(
+		&getCmd.secretFilter,
+		"secret",
+		"",
+		"If present, list only the bindings which write their credentials to the secret with this name",
+	)
 	return cmd
 }
 
 func (c *getCmd) Validate(args []string) error {
 	if len(args) > 0 {
 		c.name = args[0]
+
+		if c.secretFilter != "" {
+			return fmt.Errorf("secret filter is not supported when specifying binding name")
+		}
 	}
 
 	return nil
@@ -70,7 +84,7 @@ func (c *getCmd) Run() error {
 }
 
 func (c *getCmd) getAll() error {
-	bindings, err := c.App.RetrieveBindings(c.Namespace)
+	bindings, err := c.App.RetrieveBindings(c.Namespace, c.secretFilter)
 	if err != nil {
 		return err
 	}
@@ -17,8 +17,11 @@ limitations under the License.
 package broker
 
 import (
+	"fmt"
+
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 	"github.com/spf13/cobra"
 )
@@ -27,7 +30,11 @@ type getCmd struct {
 	*command.Namespaced
 	*command.Formatted
 	*command.Scoped
-	name string
+	name          string
+	unhealthy     bool
+	exportCatalog bool
+	classesOnly   bool
+	plansOnly     bool
 }
 
 // NewGetCmd builds a "svcat get brokers" command
@@ -45,11 +52,38 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
   svcat get brokers
   svcat get brokers --scope=cluster
   svcat get brokers --scope=all
+  svcat get brokers --unhealthy
   svcat get broker minibroker
+  svcat get broker minibroker --export-catalog
+  svcat get broker minibroker --export-catalog --plans-only
 `),
 		PreRunE: command.PreRunE(getCmd),
 		RunE:    command.RunE(getCmd),
 	}
+	cmd.Flags().BoolVar(
+		&getCmd.unhealthy,
+		"unhealthy",
+		false,
+		"List only the brokers whose Ready condition is False, with the reason and time of the transition",
+	)
+	cmd.Flags().BoolVar(
+		&getCmd.exportCatalog,
+		"export-catalog",
+		false,
+		"Print the classes and plans of the broker, including their schemas, as a List in YAML, or JSON with --output json",
+	)
+	cmd.Flags().BoolVar(
+		&getCmd.classesOnly,
+		"classes-only",
+		false,
+		"Export only the classes of the broker. Requires --export-catalog",
+	)
+	cmd.Flags().BoolVar(
+		&getCmd.plansOnly,
+		"plans-only",
+		false,
+		"Export only the plans of the broker. Requires --export-catalog",
+	)
 	getCmd.AddOutputFlags(cmd.Flags())
 	getCmd.AddScopedFlags(cmd.Flags(), true)
 	getCmd.AddNamespaceFlags(cmd.Flags(), true)
@@ -61,6 +95,22 @@ func (c *getCmd) Validate(args []string) error {
 		c.name = args[0]
 	}
 
+	if c.unhealthy && c.name != "" {
+		return fmt.Errorf("--unhealthy cannot be used with a broker name")
+	}
+
+	if c.exportCatalog && c.name == "" {
+		return fmt.Errorf("--export-catalog requires a broker name")
+	}
+
+	if (c.classesOnly || c.plansOnly) && !c.exportCatalog {
+		return fmt.Errorf("--classes-only and --plans-only require --export-catalog")
+	}
+
+	if c.classesOnly && c.plansOnly {
+		return fmt.Errorf("--classes-only and --plans-only cannot be used together")
+	}
+
 	return nil
 }
 
@@ -69,6 +119,10 @@ func (c *getCmd) Run() error {
 		return c.getAll()
 	}
 
+	if c.exportCatalog {
+		return c.exportBrokerCatalog()
+	}
+
 	return c.get()
 }
 
@@ -82,10 +136,29 @@ func (c *getCmd) getAll() error {
 		return err
 	}
 
+	if c.unhealthy {
+		output.WriteUnhealthyBrokerList(c.Output, c.OutputFormat, unhealthyBrokers(brokers)...)
+		return nil
+	}
+
 	output.WriteBrokerList(c.Output, c.OutputFormat, brokers...)
 	return nil
 }
 
+// unhealthyBrokers returns the brokers whose Ready condition is False.
+func unhealthyBrokers(brokers []servicecatalog.Broker) []servicecatalog.Broker {
+	var unhealthy []servicecatalog.Broker
+	for _, broker := range brokers {
+		for _, cond := range broker.GetStatus().Conditions {
+			if cond.Type == v1beta1.ServiceBrokerConditionReady && cond.Status == v1beta1.ConditionFalse {
+				unhealthy = append(unhealthy, broker)
+				break
+			}
+		}
+	}
+	return unhealthy
+}
+
 func (c *getCmd) get() error {
 	broker, err := c.App.RetrieveBroker(c.name)
 	if err != nil {
@@ -95,3 +168,47 @@ func (c *getCmd) get() error {
 	output.WriteBroker(c.Output, c.OutputFormat, *broker)
 	return nil
 }
+
+// exportBrokerCatalog prints the classes and plans offered by the broker.
+func (c *getCmd) exportBrokerCatalog() error {
+	if _, err := c.App.RetrieveBroker(c.name); err != nil {
+		return err
+	}
+
+	opts := servicecatalog.ScopeOptions{
+		Scope: servicecatalog.ClusterScope,
+	}
+	allClasses, err := c.App.RetrieveClasses(opts)
+	if err != nil {
+		return err
+	}
+
+	var classes []servicecatalog.Class
+	classIDs := make(map[string]bool)
+	for _, class := range allClasses {
+		if class.GetServiceBrokerName() == c.name {
+			classes = append(classes, class)
+			classIDs[class.GetName()] = true
+		}
+	}
+
+	var plans []servicecatalog.Plan
+	if !c.classesOnly {
+		allPlans, err := c.App.RetrievePlans("", opts)
+		if err != nil {
+			return err
+		}
+		for _, plan := range allPlans {
+			if classIDs[plan.GetClassID()] {
+				plans = append(plans, plan)
+			}
+		}
+	}
+
+	if c.plansOnly {
+		classes = nil
+	}
+
+	output.WriteBrokerCatalog(c.Output, c.OutputFormat, classes, plans)
+	return nil
+}
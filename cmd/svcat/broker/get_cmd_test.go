@@ -18,8 +18,11 @@ package broker
 
 import (
 	"bytes"
+	"encoding/json"
+	"time"
 
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/test"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat"
@@ -154,5 +157,165 @@ var _ = Describe("Get Broker Command", func() {
 			Expect(output).To(ContainSubstring("global-broker"))
 			Expect(output).To(ContainSubstring("minibroker"))
 		})
+		Context("with --unhealthy", func() {
+			var (
+				outputBuffer *bytes.Buffer
+				cmd          getCmd
+			)
+
+			BeforeEach(func() {
+				outputBuffer = &bytes.Buffer{}
+
+				healthy := &v1beta1.ClusterServiceBroker{ObjectMeta: v1.ObjectMeta{Name: "global-broker"}}
+				healthy.Status.Conditions = []v1beta1.ServiceBrokerCondition{
+					{Type: v1beta1.ServiceBrokerConditionReady, Status: v1beta1.ConditionTrue, Reason: "FetchedCatalog"},
+				}
+				unhealthy := &v1beta1.ServiceBroker{ObjectMeta: v1.ObjectMeta{Name: "minibroker", Namespace: "default"}}
+				unhealthy.Status.Conditions = []v1beta1.ServiceBrokerCondition{
+					{
+						Type:               v1beta1.ServiceBrokerConditionReady,
+						Status:             v1beta1.ConditionFalse,
+						Reason:             "ErrorFetchingCatalog",
+						Message:            "connection refused",
+						LastTransitionTime: v1.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC),
+					},
+				}
+
+				fakeApp, _ := svcat.NewApp(nil, nil, "default")
+				fakeSDK := new(servicecatalogfakes.FakeSvcatClient)
+				fakeSDK.RetrieveBrokersReturns([]servicecatalog.Broker{healthy, unhealthy}, nil)
+				fakeApp.SvcatClient = fakeSDK
+				cmd = getCmd{
+					Namespaced: &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
+					Scoped:     command.NewScoped(),
+					Formatted:  command.NewFormatted(),
+					unhealthy:  true,
+				}
+				cmd.Scope = servicecatalog.AllScope
+			})
+
+			It("lists only the brokers whose Ready condition is False", func() {
+				err := cmd.Run()
+
+				Expect(err).NotTo(HaveOccurred())
+				output := outputBuffer.String()
+				Expect(output).NotTo(ContainSubstring("global-broker"))
+				Expect(output).To(ContainSubstring("minibroker"))
+				Expect(output).To(ContainSubstring("ErrorFetchingCatalog"))
+				Expect(output).To(ContainSubstring("2019-01-02 03:04:05 +0000 UTC"))
+			})
+
+			It("prints the reason and transition time as JSON", func() {
+				cmd.OutputFormat = output.FormatJSON
+
+				err := cmd.Run()
+
+				Expect(err).NotTo(HaveOccurred())
+				var health []map[string]string
+				Expect(json.Unmarshal(outputBuffer.Bytes(), &health)).To(Succeed())
+				Expect(health).To(Equal([]map[string]string{{
+					"name":               "minibroker",
+					"namespace":          "default",
+					"reason":             "ErrorFetchingCatalog",
+					"message":            "connection refused",
+					"lastTransitionTime": "2019-01-02T03:04:05Z",
+				}}))
+			})
+
+			It("rejects a broker name", func() {
+				err := cmd.Validate([]string{"minibroker"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("with --export-catalog", func() {
+			var (
+				outputBuffer *bytes.Buffer
+				fakeSDK      *servicecatalogfakes.FakeSvcatClient
+				cmd          getCmd
+			)
+
+			BeforeEach(func() {
+				outputBuffer = &bytes.Buffer{}
+
+				class := &v1beta1.ClusterServiceClass{ObjectMeta: v1.ObjectMeta{Name: "mysql-id"}}
+				class.Spec.ClusterServiceBrokerName = "minibroker"
+				class.Spec.ExternalName = "mysql"
+				otherClass := &v1beta1.ClusterServiceClass{ObjectMeta: v1.ObjectMeta{Name: "redis-id"}}
+				otherClass.Spec.ClusterServiceBrokerName = "ups-broker"
+				otherClass.Spec.ExternalName = "redis"
+
+				plan := &v1beta1.ClusterServicePlan{ObjectMeta: v1.ObjectMeta{Name: "mysql-small-id"}}
+				plan.Spec.ClusterServiceClassRef.Name = "mysql-id"
+				plan.Spec.ExternalName = "small"
+				otherPlan := &v1beta1.ClusterServicePlan{ObjectMeta: v1.ObjectMeta{Name: "redis-small-id"}}
+				otherPlan.Spec.ClusterServiceClassRef.Name = "redis-id"
+				otherPlan.Spec.ExternalName = "tiny"
+
+				fakeApp, _ := svcat.NewApp(nil, nil, "default")
+				fakeSDK = new(servicecatalogfakes.FakeSvcatClient)
+				fakeSDK.RetrieveBrokerReturns(&v1beta1.ClusterServiceBroker{ObjectMeta: v1.ObjectMeta{Name: "minibroker"}}, nil)
+				fakeSDK.RetrieveClassesReturns([]servicecatalog.Class{class, otherClass}, nil)
+				fakeSDK.RetrievePlansReturns([]servicecatalog.Plan{plan, otherPlan}, nil)
+				fakeApp.SvcatClient = fakeSDK
+				cmd = getCmd{
+					Namespaced:    &command.Namespaced{Context: svcattest.NewContext(outputBuffer, fakeApp)},
+					Scoped:        command.NewScoped(),
+					Formatted:     command.NewFormatted(),
+					name:          "minibroker",
+					exportCatalog: true,
+				}
+			})
+
+			It("prints the classes and plans of the broker as a List", func() {
+				err := cmd.Run()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeSDK.RetrieveClassesArgsForCall(0)).To(Equal(servicecatalog.ScopeOptions{Scope: servicecatalog.ClusterScope}))
+				output := outputBuffer.String()
+				Expect(output).To(ContainSubstring("kind: List"))
+				Expect(output).To(ContainSubstring("kind: ClusterServiceClass"))
+				Expect(output).To(ContainSubstring("externalName: mysql"))
+				Expect(output).To(ContainSubstring("kind: ClusterServicePlan"))
+				Expect(output).To(ContainSubstring("externalName: small"))
+				Expect(output).NotTo(ContainSubstring("redis"))
+			})
+
+			It("prints only the classes with --classes-only", func() {
+				cmd.classesOnly = true
+
+				err := cmd.Run()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeSDK.RetrievePlansCallCount()).To(Equal(0))
+				output := outputBuffer.String()
+				Expect(output).To(ContainSubstring("kind: ClusterServiceClass"))
+				Expect(output).NotTo(ContainSubstring("kind: ClusterServicePlan"))
+			})
+
+			It("prints only the plans with --plans-only", func() {
+				cmd.plansOnly = true
+
+				err := cmd.Run()
+
+				Expect(err).NotTo(HaveOccurred())
+				output := outputBuffer.String()
+				Expect(output).NotTo(ContainSubstring("kind: ClusterServiceClass"))
+				Expect(output).To(ContainSubstring("kind: ClusterServicePlan"))
+				Expect(output).To(ContainSubstring("externalName: small"))
+			})
+
+			It("requires a broker name", func() {
+				cmd.name = ""
+				err := cmd.Validate([]string{})
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("rejects --classes-only together with --plans-only", func() {
+				cmd.classesOnly = true
+				cmd.plansOnly = true
+				err := cmd.Validate([]string{"minibroker"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 })
@@ -0,0 +1,50 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"strings"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	"github.com/spf13/pflag"
+)
+
+// Redacted adds support to a command for the --redact flag.
+type Redacted struct {
+	// RedactKeys are the sensitive keys whose values are masked. Redaction
+	// is disabled when it is empty.
+	RedactKeys []string
+}
+
+// NewRedacted initializes a new command that supports redaction.
+func NewRedacted() *Redacted {
+	return &Redacted{}
+}
+
+// AddRedactFlag adds the redaction related flag.
+//   --redact
+func (c *Redacted) AddRedactFlag(flags *pflag.FlagSet, description string) {
+	flags.StringSliceVar(&c.RedactKeys, "redact", nil, description+
+		". Parameters whose name contains one of the given comma separated keys, ignoring case, are masked. Without a value, masks "+
+		strings.Join(output.DefaultRedactedKeys, ","))
+	flags.Lookup("redact").NoOptDefVal = strings.Join(output.DefaultRedactedKeys, ",")
+}
+
+// Redact returns whether the output should be redacted.
+func (c *Redacted) Redact() bool {
+	return c != nil && len(c.RedactKeys) > 0
+}
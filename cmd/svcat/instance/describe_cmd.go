@@ -26,23 +26,38 @@ import (
 
 type describeCmd struct {
 	*command.Namespaced
-	name string
+	*command.Redacted
+	name      string
+	showDrift bool
 }
 
 // NewDescribeCmd builds a "svcat describe instance" command
 func NewDescribeCmd(cxt *command.Context) *cobra.Command {
-	describeCmd := &describeCmd{Namespaced: command.NewNamespaced(cxt)}
+	describeCmd := &describeCmd{
+		Namespaced: command.NewNamespaced(cxt),
+		Redacted:   command.NewRedacted(),
+	}
 	cmd := &cobra.Command{
 		Use:     "instance NAME",
 		Aliases: []string{"instances", "inst"},
 		Short:   "Show details of a specific instance",
 		Example: command.NormalizeExamples(`
   svcat describe instance wordpress-mysql-instance
+  svcat describe instance wordpress-mysql-instance --show-drift
+  svcat describe instance wordpress-mysql-instance --redact
+  svcat describe instance wordpress-mysql-instance --redact=password,apiKey
 `),
 		PreRunE: command.PreRunE(describeCmd),
 		RunE:    command.RunE(describeCmd),
 	}
 	describeCmd.AddNamespaceFlags(cmd.Flags(), false)
+	cmd.Flags().BoolVar(
+		&describeCmd.showDrift,
+		"show-drift",
+		false,
+		"Show the difference between the desired parameters and the parameters last sent to the broker",
+	)
+	describeCmd.AddRedactFlag(cmd.Flags(), "Mask the values of sensitive parameters")
 	return cmd
 }
 
@@ -64,8 +79,14 @@ func (c *describeCmd) describe() error {
 	if err != nil {
 		return err
 	}
+	if c.Redact() {
+		instance = output.RedactInstance(instance, c.RedactKeys)
+	}
 
 	output.WriteInstanceDetails(c.Output, instance)
+	if c.showDrift {
+		output.WriteInstanceParametersDrift(c.Output, instance)
+	}
 
 	bindings, err := c.App.RetrieveBindingsByInstance(instance)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
 	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
 	"github.com/spf13/cobra"
 )
 
@@ -29,7 +30,8 @@ type getCmd struct {
 	*command.Formatted
 	*command.PlanFiltered
 	*command.ClassFiltered
-	name string
+	name   string
+	export bool
 }
 
 // NewGetCmd builds a "svcat get instances" command
@@ -51,6 +53,7 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
   svcat get instances --all-namespaces
   svcat get instance wordpress-mysql-instance
   svcat get instance -n ci concourse-postgres-instance
+  svcat get instance wordpress-mysql-instance --export > wordpress-mysql.yaml
 `),
 		PreRunE: command.PreRunE(getCmd),
 		RunE:    command.RunE(getCmd),
@@ -59,6 +62,12 @@ func NewGetCmd(cxt *command.Context) *cobra.Command {
 	getCmd.AddOutputFlags(cmd.Flags())
 	getCmd.AddClassFlag(cmd)
 	getCmd.AddPlanFlag(cmd)
+	cmd.Flags().BoolVar(
+		&getCmd.export,
+		"export",
+		false,
+		"Print the instance and its bindings, including their external IDs, as a List in YAML that can be imported with 'svcat import instance'",
+	)
 
 	return cmd
 }
@@ -76,6 +85,10 @@ func (c *getCmd) Validate(args []string) error {
 		}
 	}
 
+	if c.export && c.name == "" {
+		return fmt.Errorf("--export requires an instance name")
+	}
+
 	return nil
 }
 
@@ -84,6 +97,10 @@ func (c *getCmd) Run() error {
 		return c.getAll()
 	}
 
+	if c.export {
+		return c.exportInstance()
+	}
+
 	return c.get()
 }
 
@@ -107,3 +124,18 @@ func (c *getCmd) get() error {
 
 	return nil
 }
+
+// exportInstance prints the instance and its bindings as a portable bundle.
+func (c *getCmd) exportInstance() error {
+	bundle, err := c.App.ExportInstance(c.Namespace, c.name)
+	if err != nil {
+		return err
+	}
+
+	data, err := servicecatalog.EncodeInstanceBundle(bundle)
+	if err != nil {
+		return err
+	}
+	_, err = c.Output.Write(data)
+	return err
+}
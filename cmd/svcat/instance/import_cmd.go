@@ -0,0 +1,89 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/output"
+	servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+	"github.com/spf13/cobra"
+)
+
+type importInstanceCmd struct {
+	*command.Namespaced
+	filename string
+}
+
+// NewImportCmd builds a "svcat import instance" command.
+func NewImportCmd(cxt *command.Context) *cobra.Command {
+	importInstanceCmd := &importInstanceCmd{Namespaced: command.NewNamespaced(cxt)}
+	cmd := &cobra.Command{
+		Use:   "instance",
+		Short: "Create an instance and its bindings from a bundle exported with 'svcat get instance --export'",
+		Long: `Import instance creates the instance and bindings of a bundle in the namespace.
+They keep the external IDs of the bundle, so the broker receives provision and bind
+requests for its existing instance and bindings, and brokers handling those requests
+idempotently hand back the existing resources.`,
+		Example: command.NormalizeExamples(`
+  svcat import instance -f wordpress-mysql.yaml
+  svcat import instance -f wordpress-mysql.yaml --namespace restored
+`),
+		PreRunE: command.PreRunE(importInstanceCmd),
+		RunE:    command.RunE(importInstanceCmd),
+	}
+	cmd.Flags().StringVarP(
+		&importInstanceCmd.filename,
+		"file",
+		"f",
+		"",
+		"The bundle to import",
+	)
+	importInstanceCmd.AddNamespaceFlags(cmd.Flags(), false)
+
+	return cmd
+}
+
+func (c *importInstanceCmd) Validate(args []string) error {
+	if c.filename == "" {
+		return fmt.Errorf("a bundle is required, specify it with --file")
+	}
+
+	return nil
+}
+
+func (c *importInstanceCmd) Run() error {
+	data, err := ioutil.ReadFile(c.filename)
+	if err != nil {
+		return err
+	}
+	bundle, err := servicecatalog.DecodeInstanceBundle(data)
+	if err != nil {
+		return err
+	}
+
+	imported, err := c.App.ImportInstance(c.Namespace, bundle)
+	if err != nil {
+		return err
+	}
+
+	output.WriteInstanceDetails(c.Output, &imported.Instance)
+	output.WriteAssociatedBindings(c.Output, imported.Bindings)
+	return nil
+}
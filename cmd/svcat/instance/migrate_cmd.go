@@ -0,0 +1,149 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"fmt"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	"github.com/spf13/cobra"
+)
+
+// MigrateCmd contains the information needed to move the instances of a plan
+// to another plan.
+type MigrateCmd struct {
+	*command.Namespaced
+	Class    string
+	FromPlan string
+	ToPlan   string
+	Selector string
+	DryRun   bool
+}
+
+// NewMigrateCmd builds a "svcat migrate instances" command.
+func NewMigrateCmd(cxt *command.Context) *cobra.Command {
+	migrateCmd := &MigrateCmd{Namespaced: command.NewNamespaced(cxt)}
+	cmd := &cobra.Command{
+		Use:   "instances",
+		Short: "Move the instances of a plan to another plan",
+		Long: `Migrate instances changes the plan of every instance of --class on --from-plan
+to --to-plan, after which service catalog sends the brokers update requests for the
+plan changes. The class and plans are matched and set in the form the instances
+specify them, usually by external name. An instance which is no longer on
+--from-plan when it is migrated, or fails to migrate, is reported and the
+remaining instances are still migrated.`,
+		Example: command.NormalizeExamples(`
+  svcat migrate instances --class mysqldb --from-plan default --to-plan premium
+  svcat migrate instances --class mysqldb --from-plan default --to-plan premium --selector app=wordpress
+  svcat migrate instances --class mysqldb --from-plan default --to-plan premium --all-namespaces --dry-run
+`),
+		PreRunE: command.PreRunE(migrateCmd),
+		RunE:    command.RunE(migrateCmd),
+	}
+	cmd.Flags().StringVar(
+		&migrateCmd.Class,
+		"class",
+		"",
+		"The class of the instances to move",
+	)
+	cmd.Flags().StringVar(
+		&migrateCmd.FromPlan,
+		"from-plan",
+		"",
+		"The plan to move the instances from",
+	)
+	cmd.Flags().StringVar(
+		&migrateCmd.ToPlan,
+		"to-plan",
+		"",
+		"The plan to move the instances to",
+	)
+	cmd.Flags().StringVarP(
+		&migrateCmd.Selector,
+		"selector",
+		"l",
+		"",
+		"Only migrate the instances matching this label selector",
+	)
+	cmd.Flags().BoolVar(
+		&migrateCmd.DryRun,
+		"dry-run",
+		false,
+		"Only list the instances which would be migrated",
+	)
+	migrateCmd.AddNamespaceFlags(cmd.Flags(), true)
+
+	return cmd
+}
+
+// Validate checks that the required arguments have been provided.
+func (c *MigrateCmd) Validate(args []string) error {
+	if c.Class == "" {
+		return fmt.Errorf("the class of the instances is required, specify it with --class")
+	}
+	if c.FromPlan == "" {
+		return fmt.Errorf("a plan to migrate from is required, specify it with --from-plan")
+	}
+	if c.ToPlan == "" {
+		return fmt.Errorf("a plan to migrate to is required, specify it with --to-plan")
+	}
+	if c.FromPlan == c.ToPlan {
+		return fmt.Errorf("--from-plan and --to-plan must be different")
+	}
+
+	return nil
+}
+
+// Run migrates the instances.
+func (c *MigrateCmd) Run() error {
+	return c.Migrate()
+}
+
+// Migrate moves the matching instances to the new plan one by one, reporting
+// the progress. Failures are reported per instance and do not stop the
+// migration of the remaining instances; an error summarizing them is returned
+// at the end.
+func (c *MigrateCmd) Migrate() error {
+	instances, err := c.App.RetrieveInstancesOnPlan(c.Namespace, c.Class, c.FromPlan, c.Selector)
+	if err != nil {
+		return err
+	}
+	if len(instances) == 0 {
+		fmt.Fprintf(c.Output, "No instances found on plan %q of class %q\n", c.FromPlan, c.Class)
+		return nil
+	}
+
+	failed := 0
+	for i, instance := range instances {
+		progress := fmt.Sprintf("[%d/%d]", i+1, len(instances))
+		if c.DryRun {
+			fmt.Fprintf(c.Output, "%s Would migrate instance %s/%s to plan %q\n", progress, instance.Namespace, instance.Name, c.ToPlan)
+			continue
+		}
+		if _, err := c.App.MigrateInstance(instance.Namespace, instance.Name, c.Class, c.FromPlan, c.ToPlan); err != nil {
+			failed++
+			fmt.Fprintf(c.Output, "%s Failed to migrate instance %s/%s: %v\n", progress, instance.Namespace, instance.Name, err)
+			continue
+		}
+		fmt.Fprintf(c.Output, "%s Migrated instance %s/%s to plan %q\n", progress, instance.Namespace, instance.Name, c.ToPlan)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to migrate %d of %d instances", failed, len(instances))
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance_test
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/command"
+	. "github.com/kubernetes-incubator/service-catalog/cmd/svcat/instance"
+	"github.com/kubernetes-incubator/service-catalog/cmd/svcat/test"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat"
+	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog/service-catalogfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Migrate Command", func() {
+	var (
+		outputBuffer *bytes.Buffer
+		fakeSDK      *servicecatalogfakes.FakeSvcatClient
+		cmd          *MigrateCmd
+	)
+
+	BeforeEach(func() {
+		outputBuffer = &bytes.Buffer{}
+		fakeApp, _ := svcat.NewApp(nil, nil, "default")
+		fakeSDK = new(servicecatalogfakes.FakeSvcatClient)
+		fakeSDK.RetrieveInstancesOnPlanReturns([]v1beta1.ServiceInstance{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "wordpress-mysql"}},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "drupal-mysql"}},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "staging", Name: "ghost-mysql"}},
+		}, nil)
+		fakeApp.SvcatClient = fakeSDK
+		cxt := svcattest.NewContext(outputBuffer, fakeApp)
+		cmd = &MigrateCmd{
+			Namespaced: command.NewNamespaced(cxt),
+			Class:      "mysqldb",
+			FromPlan:   "default",
+			ToPlan:     "premium",
+			Selector:   "app=cms",
+		}
+		cmd.Namespace = "default"
+	})
+
+	Describe("NewMigrateCmd", func() {
+		It("Builds and returns a cobra command with the correct flags", func() {
+			cobraCmd := NewMigrateCmd(&command.Context{})
+			Expect(cobraCmd.Use).To(Equal("instances"))
+			for _, flag := range []string{"class", "from-plan", "to-plan", "selector", "dry-run", "namespace", "all-namespaces"} {
+				Expect(cobraCmd.Flags().Lookup(flag)).NotTo(BeNil(), flag)
+			}
+		})
+	})
+	Describe("Validate", func() {
+		It("errors if a class is not provided", func() {
+			cmd.Class = ""
+			err := cmd.Validate([]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--class"))
+		})
+		It("errors if a plan to migrate from is not provided", func() {
+			cmd.FromPlan = ""
+			err := cmd.Validate([]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--from-plan"))
+		})
+		It("errors if a plan to migrate to is not provided", func() {
+			cmd.ToPlan = ""
+			err := cmd.Validate([]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--to-plan"))
+		})
+		It("errors if both plans are the same", func() {
+			cmd.ToPlan = cmd.FromPlan
+			err := cmd.Validate([]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be different"))
+		})
+	})
+	Describe("Migrate", func() {
+		It("Retrieves the instances with the passed in class, plan, namespace and selector", func() {
+			err := cmd.Migrate()
+
+			Expect(err).NotTo(HaveOccurred())
+			ns, class, plan, selector := fakeSDK.RetrieveInstancesOnPlanArgsForCall(0)
+			Expect(ns).To(Equal("default"))
+			Expect(class).To(Equal("mysqldb"))
+			Expect(plan).To(Equal("default"))
+			Expect(selector).To(Equal("app=cms"))
+			Expect(fakeSDK.MigrateInstanceCallCount()).To(Equal(3))
+			ns, name, class, fromPlan, toPlan := fakeSDK.MigrateInstanceArgsForCall(2)
+			Expect(ns).To(Equal("staging"))
+			Expect(name).To(Equal("ghost-mysql"))
+			Expect(class).To(Equal("mysqldb"))
+			Expect(fromPlan).To(Equal("default"))
+			Expect(toPlan).To(Equal("premium"))
+			Expect(outputBuffer.String()).To(ContainSubstring("[3/3] Migrated instance staging/ghost-mysql to plan \"premium\""))
+		})
+		It("Only prints the instances in dry-run mode", func() {
+			cmd.DryRun = true
+			err := cmd.Migrate()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeSDK.MigrateInstanceCallCount()).To(Equal(0))
+			output := outputBuffer.String()
+			Expect(output).To(ContainSubstring("[1/3] Would migrate instance default/wordpress-mysql to plan \"premium\""))
+			Expect(output).To(ContainSubstring("[2/3] Would migrate instance default/drupal-mysql to plan \"premium\""))
+			Expect(output).To(ContainSubstring("[3/3] Would migrate instance staging/ghost-mysql to plan \"premium\""))
+		})
+		It("Continues migrating after an instance fails and reports the failures", func() {
+			fakeSDK.MigrateInstanceReturnsOnCall(1, nil, errors.New("conflict"))
+			err := cmd.Migrate()
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("failed to migrate 1 of 3 instances"))
+			Expect(fakeSDK.MigrateInstanceCallCount()).To(Equal(3))
+			output := outputBuffer.String()
+			Expect(output).To(ContainSubstring("[1/3] Migrated instance default/wordpress-mysql"))
+			Expect(output).To(ContainSubstring("[2/3] Failed to migrate instance default/drupal-mysql: conflict"))
+			Expect(output).To(ContainSubstring("[3/3] Migrated instance staging/ghost-mysql"))
+		})
+		It("Reports when no instances are on the plan", func() {
+			fakeSDK.RetrieveInstancesOnPlanReturns(nil, nil)
+			err := cmd.Migrate()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeSDK.MigrateInstanceCallCount()).To(Equal(0))
+			Expect(outputBuffer.String()).To(ContainSubstring("No instances found on plan \"default\" of class \"mysqldb\""))
+		})
+	})
+})
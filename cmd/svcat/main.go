@@ -132,6 +132,8 @@ func buildRootCommand(cxt *command.Context) *cobra.Command {
 		cmd.AddCommand(newInstallCmd(cxt))
 	}
 	cmd.AddCommand(newTouchCmd(cxt))
+	cmd.AddCommand(newImportCmd(cxt))
+	cmd.AddCommand(newMigrateCmd(cxt))
 	cmd.AddCommand(versions.NewVersionCmd(cxt))
 	cmd.AddCommand(newCompletionCmd(cxt))
 
@@ -206,6 +208,24 @@ func newTouchCmd(cxt *command.Context) *cobra.Command {
 	return cmd
 }
 
+func newImportCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Create resources from a bundle exported by svcat",
+	}
+	cmd.AddCommand(instance.NewImportCmd(cxt))
+	return cmd
+}
+
+func newMigrateCmd(cxt *command.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Move resources between plans",
+	}
+	cmd.AddCommand(instance.NewMigrateCmd(cxt))
+	return cmd
+}
+
 func newCompletionCmd(ctx *command.Context) *cobra.Command {
 	return completion.NewCompletionCmd(ctx)
 }
@@ -90,13 +90,45 @@ func WriteBindingDetails(w io.Writer, binding *v1beta1.ServiceBinding) {
 		{"Name:", binding.Name},
 		{"Namespace:", binding.Namespace},
 		{"Status:", getBindingStatusFull(binding.Status)},
+		{"Observed Generation:", formatObservedGeneration(binding.Generation, binding.Status.ObservedGeneration)},
 		{"Secret:", binding.Spec.SecretName},
 		{"Instance:", binding.Spec.InstanceRef.Name},
 	})
+	appendLastOperation(binding.Status.LastOperation, t)
+	if binding.Status.RouteServiceURL != nil {
+		t.Append([]string{"Route Service URL:", *binding.Status.RouteServiceURL})
+	}
 	t.Render()
 
 	writeParameters(w, binding.Spec.Parameters)
 	writeParametersFrom(w, binding.Spec.ParametersFrom)
+	writeBindingVolumeMounts(w, binding.Status.VolumeMounts)
+}
+
+// writeBindingVolumeMounts prints the volume mounts returned by the broker
+// for a binding.
+func writeBindingVolumeMounts(w io.Writer, volumeMounts []v1beta1.ServiceBindingVolumeMount) {
+	if len(volumeMounts) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "\nVolume Mounts:")
+	t := NewListTable(w)
+	t.SetHeader([]string{
+		"Driver",
+		"Container Dir",
+		"Mode",
+		"Volume ID",
+	})
+	for _, vm := range volumeMounts {
+		t.Append([]string{
+			vm.Driver,
+			vm.ContainerDir,
+			string(vm.Mode),
+			vm.Device.VolumeID,
+		})
+	}
+	t.Render()
 }
 
 // WriteAssociatedBindings prints a list of bindings associated with an instance.
@@ -0,0 +1,64 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestWriteBindingVolumeMounts(t *testing.T) {
+	var sb strings.Builder
+	writeBindingVolumeMounts(&sb, nil)
+	if sb.Len() != 0 {
+		t.Fatalf("expected no output without volume mounts; got %q", sb.String())
+	}
+
+	writeBindingVolumeMounts(&sb, []v1beta1.ServiceBindingVolumeMount{
+		{
+			Driver:       "cephdriver",
+			ContainerDir: "/data/images",
+			Mode:         v1beta1.ServiceBindingVolumeMountModeReadOnly,
+			DeviceType:   v1beta1.ServiceBindingVolumeDeviceTypeShared,
+			Device: v1beta1.ServiceBindingVolumeDevice{
+				VolumeID: "bc2c1eab-05b9-482d-b0cf-750ee07de311",
+			},
+		},
+	})
+	for _, expected := range []string{"Volume Mounts:", "cephdriver", "/data/images", "bc2c1eab-05b9-482d-b0cf-750ee07de311"} {
+		if !strings.Contains(sb.String(), expected) {
+			t.Errorf("expected output to contain %q; got %q", expected, sb.String())
+		}
+	}
+}
+
+func TestWriteBindingDetailsRouteServiceURL(t *testing.T) {
+	routeServiceURL := "https://route.example.com/proxy"
+	binding := &v1beta1.ServiceBinding{
+		Status: v1beta1.ServiceBindingStatus{
+			RouteServiceURL: &routeServiceURL,
+		},
+	}
+
+	var sb strings.Builder
+	WriteBindingDetails(&sb, binding)
+	if !strings.Contains(sb.String(), "Route Service URL:     "+routeServiceURL) {
+		t.Errorf("expected output to contain the route service URL; got %q", sb.String())
+	}
+}
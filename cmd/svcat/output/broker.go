@@ -21,8 +21,19 @@ import (
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// brokerHealth summarizes the Ready condition of a broker.
+type brokerHealth struct {
+	Name               string  `json:"name"`
+	Namespace          string  `json:"namespace,omitempty"`
+	Reason             string  `json:"reason"`
+	Message            string  `json:"message"`
+	LastTransitionTime v1.Time `json:"lastTransitionTime"`
+}
+
 func getBrokerStatusCondition(status v1beta1.CommonServiceBrokerStatus) v1beta1.ServiceBrokerCondition {
 	if len(status.Conditions) > 0 {
 		return status.Conditions[len(status.Conditions)-1]
@@ -71,6 +82,60 @@ func WriteBrokerList(w io.Writer, outputFormat string, brokers ...servicecatalog
 	}
 }
 
+func getBrokerHealth(brokers []servicecatalog.Broker) []brokerHealth {
+	health := make([]brokerHealth, 0, len(brokers))
+	for _, broker := range brokers {
+		h := brokerHealth{
+			Name:      broker.GetName(),
+			Namespace: broker.GetNamespace(),
+		}
+		for _, cond := range broker.GetStatus().Conditions {
+			if cond.Type == v1beta1.ServiceBrokerConditionReady {
+				h.Reason = cond.Reason
+				h.Message = cond.Message
+				h.LastTransitionTime = cond.LastTransitionTime
+				break
+			}
+		}
+		health = append(health, h)
+	}
+	return health
+}
+
+func writeUnhealthyBrokerListTable(w io.Writer, health []brokerHealth) {
+	t := NewListTable(w)
+	t.SetHeader([]string{
+		"Name",
+		"Namespace",
+		"Reason",
+		"Last Transition",
+	})
+	for _, h := range health {
+		t.Append([]string{
+			h.Name,
+			h.Namespace,
+			h.Reason,
+			h.LastTransitionTime.UTC().String(),
+		})
+	}
+	t.Render()
+}
+
+// WriteUnhealthyBrokerList prints the reason and time of the last transition
+// of the Ready condition of each of the given brokers, in the specified
+// output format.
+func WriteUnhealthyBrokerList(w io.Writer, outputFormat string, brokers ...servicecatalog.Broker) {
+	health := getBrokerHealth(brokers)
+	switch outputFormat {
+	case FormatJSON:
+		writeJSON(w, health)
+	case FormatYAML:
+		writeYAML(w, health, 0)
+	case FormatTable:
+		writeUnhealthyBrokerListTable(w, health)
+	}
+}
+
 // WriteBroker prints a broker in the specified output format.
 func WriteBroker(w io.Writer, outputFormat string, broker v1beta1.ClusterServiceBroker) {
 	switch outputFormat {
@@ -83,6 +148,59 @@ func WriteBroker(w io.Writer, outputFormat string, broker v1beta1.ClusterService
 	}
 }
 
+// catalogList is a List of the classes and plans of a broker, in the same
+// shape as the one printed by kubectl.
+type catalogList struct {
+	APIVersion string           `json:"apiVersion"`
+	Kind       string           `json:"kind"`
+	Items      []runtime.Object `json:"items"`
+}
+
+// withCatalogTypeMeta returns a copy of a class or plan with its kind and
+// API version set, as they are not filled in on objects read from the API.
+func withCatalogTypeMeta(obj runtime.Object) runtime.Object {
+	obj = obj.DeepCopyObject()
+	var kind string
+	switch obj.(type) {
+	case *v1beta1.ClusterServiceClass:
+		kind = "ClusterServiceClass"
+	case *v1beta1.ServiceClass:
+		kind = "ServiceClass"
+	case *v1beta1.ClusterServicePlan:
+		kind = "ClusterServicePlan"
+	case *v1beta1.ServicePlan:
+		kind = "ServicePlan"
+	}
+	obj.GetObjectKind().SetGroupVersionKind(v1beta1.SchemeGroupVersion.WithKind(kind))
+	return obj
+}
+
+// WriteBrokerCatalog prints the given classes and plans of a broker as a
+// single List, in YAML unless JSON is requested.
+func WriteBrokerCatalog(w io.Writer, outputFormat string, classes []servicecatalog.Class, plans []servicecatalog.Plan) {
+	list := catalogList{
+		APIVersion: "v1",
+		Kind:       "List",
+		Items:      []runtime.Object{},
+	}
+	for _, class := range classes {
+		if obj, ok := class.(runtime.Object); ok {
+			list.Items = append(list.Items, withCatalogTypeMeta(obj))
+		}
+	}
+	for _, plan := range plans {
+		if obj, ok := plan.(runtime.Object); ok {
+			list.Items = append(list.Items, withCatalogTypeMeta(obj))
+		}
+	}
+
+	if outputFormat == FormatJSON {
+		writeJSON(w, list)
+		return
+	}
+	writeYAML(w, list, 0)
+}
+
 // WriteBrokerDetails prints details for a single broker.
 func WriteBrokerDetails(w io.Writer, broker servicecatalog.Broker) {
 	t := NewDetailsTable(w)
@@ -92,6 +210,11 @@ func WriteBrokerDetails(w io.Writer, broker servicecatalog.Broker) {
 		{"URL:", broker.GetURL()},
 		{"Status:", getBrokerStatusFull(broker.GetStatus())},
 	})
+	for _, cond := range broker.GetStatus().Conditions {
+		if cond.Type == v1beta1.ServiceBrokerConditionDeprecated && cond.Status == v1beta1.ConditionTrue {
+			t.Append([]string{"Deprecation:", cond.Message})
+		}
+	}
 
 	t.Render()
 }
@@ -17,13 +17,21 @@ limitations under the License.
 package output
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"reflect"
+	"sort"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/olekukonko/tablewriter"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// redactedParameterValue is the value the controller records instead of
+// parameters sourced from secrets.
+const redactedParameterValue = "<redacted>"
+
 func getInstanceStatusCondition(status v1beta1.ServiceInstanceStatus) v1beta1.ServiceInstanceCondition {
 	if len(status.Conditions) > 0 {
 		return status.Conditions[len(status.Conditions)-1]
@@ -50,6 +58,26 @@ func appendInstanceDashboardURL(status v1beta1.ServiceInstanceStatus, table *tab
 	}
 }
 
+// getInstanceClassName returns the external name of the class of the
+// instance resolved by the controller, or the class specified by the user
+// until it is resolved.
+func getInstanceClassName(instance v1beta1.ServiceInstance) string {
+	if instance.Status.ResolvedClassExternalName != "" {
+		return instance.Status.ResolvedClassExternalName
+	}
+	return instance.Spec.GetSpecifiedClusterServiceClass()
+}
+
+// getInstancePlanName returns the external name of the plan of the instance
+// resolved by the controller, or the plan specified by the user until it is
+// resolved.
+func getInstancePlanName(instance v1beta1.ServiceInstance) string {
+	if instance.Status.ResolvedPlanExternalName != "" {
+		return instance.Status.ResolvedPlanExternalName
+	}
+	return instance.Spec.GetSpecifiedClusterServicePlan()
+}
+
 func writeInstanceListTable(w io.Writer, instanceList *v1beta1.ServiceInstanceList) {
 	t := NewListTable(w)
 	t.SetHeader([]string{
@@ -64,8 +92,8 @@ func writeInstanceListTable(w io.Writer, instanceList *v1beta1.ServiceInstanceLi
 		t.Append([]string{
 			instance.Name,
 			instance.Namespace,
-			instance.Spec.GetSpecifiedClusterServiceClass(),
-			instance.Spec.GetSpecifiedClusterServicePlan(),
+			getInstanceClassName(instance),
+			getInstancePlanName(instance),
 			getInstanceStatusShort(instance.Status),
 		})
 	}
@@ -143,14 +171,110 @@ func WriteInstanceDetails(w io.Writer, instance *v1beta1.ServiceInstance) {
 		{"Name:", instance.Name},
 		{"Namespace:", instance.Namespace},
 		{"Status:", getInstanceStatusFull(instance.Status)},
+		{"Observed Generation:", formatObservedGeneration(instance.Generation, instance.Status.ObservedGeneration)},
 	})
 	appendInstanceDashboardURL(instance.Status, t)
+	appendLastOperation(instance.Status.LastOperation, t)
 	t.AppendBulk([][]string{
-		{"Class:", instance.Spec.GetSpecifiedClusterServiceClass()},
-		{"Plan:", instance.Spec.GetSpecifiedClusterServicePlan()},
+		{"Class:", getInstanceClassName(*instance)},
+		{"Plan:", getInstancePlanName(*instance)},
 	})
 	t.Render()
 
 	writeParameters(w, instance.Spec.Parameters)
 	writeParametersFrom(w, instance.Spec.ParametersFrom)
 }
+
+// WriteInstanceParametersDrift prints the difference between the desired
+// parameters of an instance and the parameters last sent to the broker. Values
+// are compared as typed JSON values, so that the string "1" differs from the
+// number 1. Parameters sent to the broker which the instance does not set,
+// such as defaults of the plan or parameters sourced from secrets, are not
+// compared.
+func WriteInstanceParametersDrift(w io.Writer, instance *v1beta1.ServiceInstance) {
+	fmt.Fprintln(w, "\nParameters Drift:")
+	if instance.Status.ExternalProperties == nil {
+		fmt.Fprintln(w, "  The instance has not been provisioned yet")
+		return
+	}
+
+	desired, err := flattenParameters(instance.Spec.Parameters)
+	if err != nil {
+		fmt.Fprintf(w, "  Unable to read the desired parameters: %v\n", err)
+		return
+	}
+	provisioned, err := flattenParameters(instance.Status.ExternalProperties.Parameters)
+	if err != nil {
+		fmt.Fprintf(w, "  Unable to read the provisioned parameters: %v\n", err)
+		return
+	}
+
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	drift := false
+	for _, key := range keys {
+		desiredValue := desired[key]
+		provisionedValue, inProvisioned := provisioned[key]
+		switch {
+		case !inProvisioned:
+			fmt.Fprintf(w, "  + %s: %s\n", key, formatParameterValue(desiredValue))
+			drift = true
+		case !reflect.DeepEqual(desiredValue, provisionedValue):
+			fmt.Fprintf(w, "  - %s: %s\n", key, formatParameterValue(provisionedValue))
+			fmt.Fprintf(w, "  + %s: %s\n", key, formatParameterValue(desiredValue))
+			drift = true
+		}
+	}
+	if !drift {
+		fmt.Fprintln(w, "  No drift detected")
+	}
+	for key, value := range provisioned {
+		if _, found := desired[key]; !found && value == redactedParameterValue {
+			fmt.Fprintln(w, "  Parameters sourced from secrets are not compared")
+			break
+		}
+	}
+}
+
+// flattenParameters returns the leaf values of the given parameters keyed by
+// their dot separated path. The values are kept as decoded from JSON.
+func flattenParameters(parameters *runtime.RawExtension) (map[string]interface{}, error) {
+	flattened := make(map[string]interface{})
+	if parameters == nil || len(parameters.Raw) == 0 {
+		return flattened, nil
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal(parameters.Raw, &params); err != nil {
+		return nil, err
+	}
+	flattenParameterValues(flattened, "", params)
+	return flattened, nil
+}
+
+func flattenParameterValues(flattened map[string]interface{}, prefix string, params map[string]interface{}) {
+	for key, value := range params {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if v, ok := value.(map[string]interface{}); ok {
+			flattenParameterValues(flattened, path, v)
+			continue
+		}
+		flattened[path] = value
+	}
+}
+
+// formatParameterValue returns the JSON form of a parameter value, which tells
+// a string apart from a number or boolean of the same text.
+func formatParameterValue(value interface{}) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/olekukonko/tablewriter"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func Test_appendInstanceDashboardURL(t *testing.T) {
@@ -53,3 +54,161 @@ func Test_appendInstanceDashboardURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_getInstanceClassAndPlanName(t *testing.T) {
+	tests := []struct {
+		name          string
+		instance      v1beta1.ServiceInstance
+		expectedClass string
+		expectedPlan  string
+	}{
+		{
+			name: "unresolved",
+			instance: v1beta1.ServiceInstance{
+				Spec: v1beta1.ServiceInstanceSpec{
+					PlanReference: v1beta1.PlanReference{
+						ClusterServiceClassExternalName: "mysql",
+						ClusterServicePlanExternalName:  "small",
+					},
+				},
+			},
+			expectedClass: "mysql",
+			expectedPlan:  "small",
+		},
+		{
+			name: "resolved",
+			instance: v1beta1.ServiceInstance{
+				Spec: v1beta1.ServiceInstanceSpec{
+					PlanReference: v1beta1.PlanReference{
+						ClusterServiceClassName: "4f6e6cf6-ffdd-425f-a2c7-3c9258ad2468",
+						ClusterServicePlanName:  "86064792-7ea2-467b-af93-ac9694d96d52",
+					},
+				},
+				Status: v1beta1.ServiceInstanceStatus{
+					ResolvedClassExternalName: "mysql",
+					ResolvedPlanExternalName:  "small",
+				},
+			},
+			expectedClass: "mysql",
+			expectedPlan:  "small",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if e, a := tt.expectedClass, getInstanceClassName(tt.instance); e != a {
+				t.Fatalf("unexpected class; expected %v, got %v", e, a)
+			}
+			if e, a := tt.expectedPlan, getInstancePlanName(tt.instance); e != a {
+				t.Fatalf("unexpected plan; expected %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestWriteInstanceParametersDrift(t *testing.T) {
+	tests := []struct {
+		name                    string
+		parameters              string
+		externalProperties      *v1beta1.ServiceInstancePropertiesState
+		expectedOutputContent   []string
+		unexpectedOutputContent []string
+	}{
+		{
+			name:                  "never provisioned",
+			parameters:            `{"a":"b"}`,
+			expectedOutputContent: []string{"The instance has not been provisioned yet"},
+		},
+		{
+			name:       "no drift",
+			parameters: `{"a":"b","c":{"d":1}}`,
+			externalProperties: &v1beta1.ServiceInstancePropertiesState{
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"a":"b","c":{"d":1}}`)},
+			},
+			expectedOutputContent: []string{"No drift detected"},
+		},
+		{
+			name:       "changed and added parameters",
+			parameters: `{"a":"new","c":{"d":2},"e":true}`,
+			externalProperties: &v1beta1.ServiceInstancePropertiesState{
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"a":"old","c":{"d":1}}`)},
+			},
+			expectedOutputContent: []string{
+				"  - a: \"old\"\n  + a: \"new\"\n",
+				"  - c.d: 1\n  + c.d: 2\n",
+				"  + e: true\n",
+			},
+		},
+		{
+			name:       "values of different types",
+			parameters: `{"size":"1","enabled":"true","tags":["a","b"]}`,
+			externalProperties: &v1beta1.ServiceInstancePropertiesState{
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"size":1,"enabled":true,"tags":["a","b"]}`)},
+			},
+			expectedOutputContent: []string{
+				"  - enabled: true\n  + enabled: \"true\"\n",
+				"  - size: 1\n  + size: \"1\"\n",
+			},
+			unexpectedOutputContent: []string{"tags"},
+		},
+		{
+			name:       "parameters the instance does not set",
+			parameters: `{"a":"b","c":{"d":1}}`,
+			externalProperties: &v1beta1.ServiceInstancePropertiesState{
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"a":"b","c":{"d":1,"e":"default"},"f":"default"}`)},
+			},
+			expectedOutputContent:   []string{"No drift detected"},
+			unexpectedOutputContent: []string{"c.e", "f:"},
+		},
+		{
+			name:       "parameters from secrets",
+			parameters: `{"a":"b"}`,
+			externalProperties: &v1beta1.ServiceInstancePropertiesState{
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"a":"b","secret":"<redacted>"}`)},
+			},
+			expectedOutputContent: []string{"No drift detected", "Parameters sourced from secrets are not compared"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &v1beta1.ServiceInstance{
+				Spec: v1beta1.ServiceInstanceSpec{
+					Parameters: &runtime.RawExtension{Raw: []byte(tt.parameters)},
+				},
+				Status: v1beta1.ServiceInstanceStatus{
+					ExternalProperties: tt.externalProperties,
+				},
+			}
+			var stringBuilder strings.Builder
+			WriteInstanceParametersDrift(&stringBuilder, instance)
+			for _, content := range tt.expectedOutputContent {
+				if !strings.Contains(stringBuilder.String(), content) {
+					t.Fatalf("%v failed; expected output to contain %q; got %v", tt.name, content, stringBuilder.String())
+				}
+			}
+			for _, content := range tt.unexpectedOutputContent {
+				if strings.Contains(stringBuilder.String(), content) {
+					t.Fatalf("%v failed; expected output not to contain %q; got %v", tt.name, content, stringBuilder.String())
+				}
+			}
+		})
+	}
+}
+
+func Test_formatObservedGeneration(t *testing.T) {
+	tests := []struct {
+		name               string
+		generation         int64
+		observedGeneration int64
+		expectedString     string
+	}{
+		{"up to date", 2, 2, "2 (up to date)"},
+		{"lagging", 3, 1, "1 (2 behind generation 3)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actualString := formatObservedGeneration(tt.generation, tt.observedGeneration); actualString != tt.expectedString {
+				t.Fatalf("%v failed; expected %v; got %v", tt.name, tt.expectedString, actualString)
+			}
+		})
+	}
+}
@@ -19,9 +19,12 @@ package output
 import (
 	"fmt"
 	"io"
+	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/olekukonko/tablewriter"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -58,6 +61,46 @@ func formatStatusFull(condition string, conditionStatus v1beta1.ConditionStatus,
 	return fmt.Sprintf("%s - %s @ %s", status, message, timestamp.UTC())
 }
 
+func formatObservedGeneration(generation, observedGeneration int64) string {
+	if lag := generation - observedGeneration; lag > 0 {
+		return fmt.Sprintf("%d (%d behind generation %d)", observedGeneration, lag, generation)
+	}
+	return fmt.Sprintf("%d (up to date)", observedGeneration)
+}
+
+// jwtPattern matches operation keys which look like a JSON Web Token.
+var jwtPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+$`)
+
+// sensitiveOperationWords are words which, when found in an operation key,
+// suggest that the broker embedded a credential into it.
+var sensitiveOperationWords = []string{"password", "passwd", "secret", "token", "credential"}
+
+// formatOperation returns the operation key a broker returned for an
+// asynchronous operation, redacting it if it looks like it holds a credential.
+func formatOperation(operation string) string {
+	if jwtPattern.MatchString(operation) {
+		return redactedParameterValue
+	}
+	if u, err := url.Parse(operation); err == nil && u.User != nil {
+		return redactedParameterValue
+	}
+	lower := strings.ToLower(operation)
+	for _, word := range sensitiveOperationWords {
+		if strings.Contains(lower, word) {
+			return redactedParameterValue
+		}
+	}
+	return operation
+}
+
+// appendLastOperation adds the operation key of the asynchronous operation in
+// progress, if any, to a details table.
+func appendLastOperation(lastOperation *string, table *tablewriter.Table) {
+	if lastOperation != nil {
+		table.Append([]string{"Broker Operation:", formatOperation(*lastOperation)})
+	}
+}
+
 // WriteDeletedResourceName prints the name of a deleted resource
 func WriteDeletedResourceName(w io.Writer, resourceName string) {
 	fmt.Fprintf(w, "deleted %s\n", resourceName)
@@ -21,6 +21,7 @@ import (
 	"io"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
@@ -148,10 +149,33 @@ func WritePlanDetails(w io.Writer, plan servicecatalog.Plan, class *v1beta1.Clus
 		{"Free:", strconv.FormatBool(plan.GetFree())},
 		{"Class:", class.Spec.ExternalName},
 	})
+	if costs := plan.GetCosts(); len(costs) > 0 {
+		t.Append([]string{"Costs:", formatPlanCosts(costs)})
+	}
 
 	t.Render()
 }
 
+// formatPlanCosts formats the costs of a plan on a single line, for example
+// "9 EUR, 10 USD MONTHLY; 0.02 USD PER 1GB".
+func formatPlanCosts(costs []v1beta1.ServicePlanCost) string {
+	formatted := make([]string, 0, len(costs))
+	for _, cost := range costs {
+		currencies := make([]string, 0, len(cost.Amount))
+		for currency := range cost.Amount {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+
+		amounts := make([]string, 0, len(currencies))
+		for _, currency := range currencies {
+			amounts = append(amounts, fmt.Sprintf("%s %s", cost.Amount[currency], strings.ToUpper(currency)))
+		}
+		formatted = append(formatted, fmt.Sprintf("%s %s", strings.Join(amounts, ", "), cost.Unit))
+	}
+	return strings.Join(formatted, "; ")
+}
+
 // WriteDefaultProvisionParameters prints the default provision parameters for a single plan.
 func WriteDefaultProvisionParameters(w io.Writer, plan servicecatalog.Plan) {
 	defaultProvisionParameters := plan.GetDefaultProvisionParameters()
@@ -0,0 +1,46 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+)
+
+func TestWritePlanDetailsCosts(t *testing.T) {
+	class := &v1beta1.ClusterServiceClass{}
+	plan := &v1beta1.ClusterServicePlan{}
+
+	var sb strings.Builder
+	WritePlanDetails(&sb, plan, class)
+	if strings.Contains(sb.String(), "Costs:") {
+		t.Fatalf("expected no costs without costs reported by the broker; got %q", sb.String())
+	}
+
+	plan.Status.Costs = []v1beta1.ServicePlanCost{
+		{Amount: map[string]string{"usd": "10", "eur": "9"}, Unit: "MONTHLY"},
+		{Amount: map[string]string{"usd": "0.02"}, Unit: "PER 1GB"},
+	}
+	sb.Reset()
+	WritePlanDetails(&sb, plan, class)
+	expected := "9 EUR, 10 USD MONTHLY; 0.02 USD PER 1GB"
+	if !strings.Contains(sb.String(), expected) {
+		t.Errorf("expected output to contain %q; got %q", expected, sb.String())
+	}
+}
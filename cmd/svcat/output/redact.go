@@ -0,0 +1,116 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DefaultRedactedKeys are the sensitive keys masked when redaction is
+// requested without a list of keys.
+var DefaultRedactedKeys = []string{"password", "secret", "token", "key", "credential"}
+
+// RedactInstance returns a copy of the instance whose desired, in progress
+// and provisioned parameters have the values of sensitive keys masked.
+func RedactInstance(instance *v1beta1.ServiceInstance, sensitiveKeys []string) *v1beta1.ServiceInstance {
+	redacted := instance.DeepCopy()
+	redacted.Spec.Parameters = redactParameters(redacted.Spec.Parameters, sensitiveKeys)
+	if props := redacted.Status.InProgressProperties; props != nil {
+		props.Parameters = redactParameters(props.Parameters, sensitiveKeys)
+	}
+	if props := redacted.Status.ExternalProperties; props != nil {
+		props.Parameters = redactParameters(props.Parameters, sensitiveKeys)
+	}
+	return redacted
+}
+
+// RedactBinding returns a copy of the binding whose desired, in progress and
+// bound parameters have the values of sensitive keys masked.
+func RedactBinding(binding *v1beta1.ServiceBinding, sensitiveKeys []string) *v1beta1.ServiceBinding {
+	redacted := binding.DeepCopy()
+	redacted.Spec.Parameters = redactParameters(redacted.Spec.Parameters, sensitiveKeys)
+	if props := redacted.Status.InProgressProperties; props != nil {
+		props.Parameters = redactParameters(props.Parameters, sensitiveKeys)
+	}
+	if props := redacted.Status.ExternalProperties; props != nil {
+		props.Parameters = redactParameters(props.Parameters, sensitiveKeys)
+	}
+	return redacted
+}
+
+// RedactSecret returns a copy of the secret with all of its values masked.
+func RedactSecret(secret *v1.Secret) *v1.Secret {
+	if secret == nil {
+		return nil
+	}
+	redacted := secret.DeepCopy()
+	for key := range redacted.Data {
+		redacted.Data[key] = []byte(redactedParameterValue)
+	}
+	return redacted
+}
+
+// redactParameters masks the values of the parameters, at any depth, whose
+// name contains one of the sensitive keys, ignoring case. Parameters that are
+// not a JSON object are masked entirely, since their keys cannot be checked.
+func redactParameters(parameters *runtime.RawExtension, sensitiveKeys []string) *runtime.RawExtension {
+	if parameters == nil || len(parameters.Raw) == 0 {
+		return parameters
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal(parameters.Raw, &params); err != nil {
+		return &runtime.RawExtension{Raw: []byte(redactedParameterValue)}
+	}
+	raw, err := json.Marshal(redactParameterValues(params, sensitiveKeys))
+	if err != nil {
+		return &runtime.RawExtension{Raw: []byte(redactedParameterValue)}
+	}
+	return &runtime.RawExtension{Raw: raw}
+}
+
+func redactParameterValues(value interface{}, sensitiveKeys []string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if isSensitiveKey(key, sensitiveKeys) {
+				v[key] = redactedParameterValue
+			} else {
+				v[key] = redactParameterValues(nested, sensitiveKeys)
+			}
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = redactParameterValues(nested, sensitiveKeys)
+		}
+	}
+	return value
+}
+
+func isSensitiveKey(key string, sensitiveKeys []string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if sensitive != "" && strings.Contains(key, strings.ToLower(sensitive)) {
+			return true
+		}
+	}
+	return false
+}
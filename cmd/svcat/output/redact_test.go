@@ -0,0 +1,133 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// jsonEqual compares the decoded documents, since the encoder escapes the
+// angle brackets of the redacted values.
+func jsonEqual(expected, actual []byte) bool {
+	if string(expected) == string(actual) {
+		return true
+	}
+	var e, a interface{}
+	if err := json.Unmarshal(expected, &e); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(actual, &a); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(e, a)
+}
+
+func TestRedactParameters(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters *runtime.RawExtension
+		expected   *runtime.RawExtension
+	}{
+		{
+			name:       "no parameters",
+			parameters: nil,
+			expected:   nil,
+		},
+		{
+			name:       "top level keys, ignoring case",
+			parameters: &runtime.RawExtension{Raw: []byte(`{"adminPassword":"hunter2","size":"10Gi"}`)},
+			expected:   &runtime.RawExtension{Raw: []byte(`{"adminPassword":"<redacted>","size":"10Gi"}`)},
+		},
+		{
+			name:       "nested keys and arrays",
+			parameters: &runtime.RawExtension{Raw: []byte(`{"users":[{"name":"admin","token":"abc"}],"tls":{"privateKey":"xyz"}}`)},
+			expected:   &runtime.RawExtension{Raw: []byte(`{"tls":{"privateKey":"<redacted>"},"users":[{"name":"admin","token":"<redacted>"}]}`)},
+		},
+		{
+			name:       "whole object under a sensitive key",
+			parameters: &runtime.RawExtension{Raw: []byte(`{"credentials":{"user":"admin"}}`)},
+			expected:   &runtime.RawExtension{Raw: []byte(`{"credentials":"<redacted>"}`)},
+		},
+		{
+			name:       "not a JSON object",
+			parameters: &runtime.RawExtension{Raw: []byte(`password=hunter2`)},
+			expected:   &runtime.RawExtension{Raw: []byte(`<redacted>`)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := redactParameters(tc.parameters, DefaultRedactedKeys)
+			if tc.expected == nil {
+				if actual != nil {
+					t.Fatalf("expected no parameters, got %s", actual.Raw)
+				}
+				return
+			}
+			if !jsonEqual(tc.expected.Raw, actual.Raw) {
+				t.Fatalf("unexpected redacted parameters; expected %s, got %s", tc.expected.Raw, actual.Raw)
+			}
+		})
+	}
+}
+
+func TestRedactInstance(t *testing.T) {
+	instance := &v1beta1.ServiceInstance{
+		Spec: v1beta1.ServiceInstanceSpec{
+			Parameters: &runtime.RawExtension{Raw: []byte(`{"secret":"a"}`)},
+		},
+		Status: v1beta1.ServiceInstanceStatus{
+			ExternalProperties: &v1beta1.ServiceInstancePropertiesState{
+				Parameters: &runtime.RawExtension{Raw: []byte(`{"secret":"b"}`)},
+			},
+		},
+	}
+
+	redacted := RedactInstance(instance, []string{"SECRET"})
+
+	expected := []byte(`{"secret":"<redacted>"}`)
+	if a := redacted.Spec.Parameters.Raw; !jsonEqual(expected, a) {
+		t.Fatalf("unexpected spec parameters; expected %s, got %s", expected, a)
+	}
+	if a := redacted.Status.ExternalProperties.Parameters.Raw; !jsonEqual(expected, a) {
+		t.Fatalf("unexpected external parameters; expected %s, got %s", expected, a)
+	}
+	if a := string(instance.Spec.Parameters.Raw); a != `{"secret":"a"}` {
+		t.Fatalf("the original instance was modified: %v", a)
+	}
+}
+
+func TestRedactSecret(t *testing.T) {
+	secret := &v1.Secret{Data: map[string][]byte{"username": []byte("admin")}}
+
+	redacted := RedactSecret(secret)
+
+	if e, a := redactedParameterValue, string(redacted.Data["username"]); e != a {
+		t.Fatalf("unexpected secret value; expected %v, got %v", e, a)
+	}
+	if a := string(secret.Data["username"]); a != "admin" {
+		t.Fatalf("the original secret was modified: %v", a)
+	}
+	if RedactSecret(nil) != nil {
+		t.Fatal("expected no secret when redacting a nil secret")
+	}
+}
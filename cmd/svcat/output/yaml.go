@@ -68,12 +68,23 @@ func writeParametersFrom(w io.Writer, parametersFrom []v1beta1.ParametersFromSou
 
 	headerPrinted := false
 	for _, p := range parametersFrom {
-		if p.SecretKeyRef != nil {
-			if !headerPrinted {
-				fmt.Fprintln(w, "\nParameters From:")
-				headerPrinted = true
-			}
-			fmt.Fprintf(w, "  Secret: %s.%s\n", p.SecretKeyRef.Name, p.SecretKeyRef.Key)
+		var source string
+		switch {
+		case p.SecretKeyRef != nil:
+			source = fmt.Sprintf("Secret: %s.%s", p.SecretKeyRef.Name, p.SecretKeyRef.Key)
+		case p.URLRef != nil:
+			source = fmt.Sprintf("URL: %s", p.URLRef.URL)
+		default:
+			continue
+		}
+		if !headerPrinted {
+			fmt.Fprintln(w, "\nParameters From:")
+			headerPrinted = true
+		}
+		if p.ParameterName != "" {
+			fmt.Fprintf(w, "  %s (as parameter %q)\n", source, p.ParameterName)
+		} else {
+			fmt.Fprintf(w, "  %s\n", source)
 		}
 	}
 }
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	_ "github.com/kubernetes-incubator/service-catalog/internal/test"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -45,3 +46,30 @@ func TestWriteParameters(t *testing.T) {
 		}
 	}
 }
+
+func TestWriteParametersFrom(t *testing.T) {
+	testcases := []struct {
+		name           string                         // Test name
+		parametersFrom []v1beta1.ParametersFromSource // Parameter sources tested
+		output         string                         // Expected output
+	}{
+		{"No sources", nil, ""},
+		{"JSON object", []v1beta1.ParametersFromSource{
+			{SecretKeyRef: &v1beta1.SecretKeyReference{Name: "creds", Key: "params"}},
+		}, "\nParameters From:\n  Secret: creds.params\n"},
+		{"Scalar", []v1beta1.ParametersFromSource{
+			{SecretKeyRef: &v1beta1.SecretKeyReference{Name: "creds", Key: "password"}, ParameterName: "adminPassword"},
+		}, "\nParameters From:\n  Secret: creds.password (as parameter \"adminPassword\")\n"},
+		{"URL", []v1beta1.ParametersFromSource{
+			{URLRef: &v1beta1.URLReference{URL: "https://example.com/params.json"}},
+		}, "\nParameters From:\n  URL: https://example.com/params.json\n"},
+	}
+
+	for _, tc := range testcases {
+		output := &bytes.Buffer{}
+		writeParametersFrom(output, tc.parametersFrom)
+		if tc.output != output.String() {
+			t.Errorf("%v: Output mismatch: expected \"%v\", actual \"%v\"", tc.name, tc.output, output.String())
+		}
+	}
+}
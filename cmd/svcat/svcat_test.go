@@ -159,6 +159,7 @@ func TestCommandValidation(t *testing.T) {
 		{"describe plan requires name", "describe plan", "a plan name or Kubernetes name is required"},
 		{"describe instance requires name", "describe instance", "an instance name is required"},
 		{"describe binding requires name", "describe binding", "a binding name is required"},
+		{"get binding does not accept --secret with name", "get binding name --secret secret", "secret filter is not supported when specifying binding name"},
 		{"bind requires arg", "bind", "an instance name is required"},
 		{"unbind requires arg", "unbind", "an instance or binding name is required"},
 		{"sync requires names", "sync broker", "a broker name is required"},
@@ -255,6 +256,8 @@ func TestCommandOutput(t *testing.T) {
 		{name: "get instance (json)", cmd: "get instance ups-instance -n test-ns -o json", golden: "output/get-instance.json"},
 		{name: "get instance (yaml)", cmd: "get instance ups-instance -n test-ns -o yaml", golden: "output/get-instance.yaml"},
 		{name: "describe instance", cmd: "describe instance ups-instance -n test-ns", golden: "output/describe-instance.txt"},
+		{name: "describe instance with drift", cmd: "describe instance ups-instance -n test-ns --show-drift", golden: "output/describe-instance-show-drift.txt"},
+		{name: "describe instance redacted", cmd: "describe instance ups-instance -n test-ns --show-drift --redact=param1,ps2", golden: "output/describe-instance-redacted.txt"},
 		{name: "bind instance", cmd: "bind ups-instance --name ups-binding -n test-ns", golden: "output/bind-instance.txt"},
 		{name: "bind instance and wait", cmd: "bind ups-instance --name ups-binding -n test-ns --wait", golden: "output/bind-instance-and-wait.txt"},
 		{name: "unbind instance", cmd: "unbind ups-instance -n test-ns", golden: "output/unbind-instance.txt"},
@@ -266,11 +269,14 @@ func TestCommandOutput(t *testing.T) {
 		{name: "list all bindings in a namespace (json)", cmd: "get bindings -n test-ns -o json", golden: "output/get-bindings.json"},
 		{name: "list all bindings in a namespace (yaml)", cmd: "get bindings -n test-ns -o yaml", golden: "output/get-bindings.yaml"},
 		{name: "list all bindings", cmd: "get bindings --all-namespaces", golden: "output/get-bindings-all-namespaces.txt"},
+		{name: "list all bindings filtered by existing secret", cmd: "get bindings --all-namespaces --secret ups-binding", golden: "output/get-bindings-all-namespaces-by-secret.txt"},
+		{name: "list all bindings filtered by not existing secret", cmd: "get bindings --all-namespaces --secret wrong", golden: "output/get-bindings-all-namespaces-by-wrong-secret.txt"},
 		{name: "get binding", cmd: "get binding ups-binding -n test-ns", golden: "output/get-binding.txt"},
 		{name: "get binding (json)", cmd: "get binding ups-binding -n test-ns -o json", golden: "output/get-binding.json"},
 		{name: "get binding (yaml)", cmd: "get binding ups-binding -n test-ns -o yaml", golden: "output/get-binding.yaml"},
 		{name: "describe binding", cmd: "describe binding ups-binding -n test-ns", golden: "output/describe-binding.txt"},
 		{name: "describe binding and decode secret", cmd: "describe binding ups-binding -n test-ns --show-secrets", golden: "output/describe-binding-show-secrets.txt"},
+		{name: "describe binding redacted", cmd: "describe binding ups-binding -n test-ns --redact=param1,ps2", golden: "output/describe-binding-redacted.txt"},
 		{name: "delete binding", cmd: "unbind --name ups-binding -n test-ns", golden: "output/delete-binding.txt"},
 		{name: "delete binding and wait", cmd: "unbind --name ups-binding -n test-ns --wait", golden: "output/delete-binding-and-wait.txt"},
 
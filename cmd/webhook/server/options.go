@@ -19,6 +19,7 @@ package server
 import (
 	"fmt"
 
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	"github.com/spf13/pflag"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	genericserveroptions "k8s.io/apiserver/pkg/server/options"
@@ -36,6 +37,10 @@ type WebhookServerOptions struct {
 	SecureServingOptions  *genericserveroptions.SecureServingOptions
 	ReleaseName           string
 	HealthzServerBindPort int
+	MaxParametersSize     int
+	ReservedParameterKeys []string
+	AllowedBrokersConfig  string
+	ParameterKeysConfig   string
 }
 
 // NewWebhookServerOptions creates a new WebhookServerOptions with a default settings.
@@ -54,6 +59,10 @@ func NewWebhookServerOptions() *WebhookServerOptions {
 // AddFlags adds flags for a WebhookServerOptions to the specified FlagSet.
 func (s *WebhookServerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&s.HealthzServerBindPort, "healthz-server-bind-port", defaultHealthzServerPort, "The port on which to serve HTTP  /healthz endpoint")
+	fs.IntVar(&s.MaxParametersSize, "max-parameters-size", validation.DefaultMaxParametersSize, "The maximum size, in bytes, of the serialized spec.parameters of ServiceInstances and ServiceBindings")
+	fs.StringVar(&s.AllowedBrokersConfig, "allowed-brokers-config", "", "Path to the ServiceInstanceAllowedBrokers policy file, which maps namespaces to the brokers their ServiceInstances may be provisioned from")
+	fs.StringVar(&s.ParameterKeysConfig, "parameter-keys-config", "", "Path to the ParameterKeyFormat policy file, which sets the pattern the parameter keys of ServiceInstances and ServiceBindings must match")
+	fs.StringSliceVar(&s.ReservedParameterKeys, "reserved-parameter-keys", nil, "Parameter names that ServiceInstances and ServiceBindings may not set, such as names that brokers could confuse with OSB context fields (e.g. platform,instance_id)")
 
 	s.SecureServingOptions.AddFlags(fs)
 	utilfeature.DefaultFeatureGate.AddFlag(fs)
@@ -68,6 +77,9 @@ func (s *WebhookServerOptions) Validate() error {
 	if s.SecureServingOptions.BindPort == s.HealthzServerBindPort {
 		errors = append(errors, fmt.Errorf("validation erorr: --secure-port and --healthz-server-bind-port MUST have different values"))
 	}
+	if s.MaxParametersSize <= 0 {
+		errors = append(errors, fmt.Errorf("validation error: --max-parameters-size MUST be greater than 0"))
+	}
 
 	return utilerrors.NewAggregate(errors)
 }
@@ -19,8 +19,11 @@ package server
 import (
 	"fmt"
 	"net/http"
+	"os"
+	"regexp"
 
 	scTypes "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	apivalidation "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	csbmutation "github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/clusterservicebroker/mutation"
 	cscmutation "github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/clusterserviceclass/mutation"
 	cspmutation "github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/clusterserviceplan/mutation"
@@ -40,7 +43,10 @@ import (
 	sivalidation "github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
 	spvalidation "github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/serviceplan/validation"
 
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
 	"github.com/kubernetes-incubator/service-catalog/pkg/probe"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/parameterkeys"
+	"github.com/kubernetes-incubator/service-catalog/plugin/pkg/admission/serviceinstances/allowedbrokers"
 	"github.com/pkg/errors"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apiserver/pkg/server/healthz"
@@ -66,6 +72,29 @@ func RunServer(opts *WebhookServerOptions, stopCh <-chan struct{}) error {
 }
 
 func run(opts *WebhookServerOptions, stopCh <-chan struct{}) error {
+	parametersLimits := apivalidation.ParametersLimits{
+		MaxSize:      opts.MaxParametersSize,
+		ReservedKeys: opts.ReservedParameterKeys,
+	}
+
+	allowedBrokers := &allowedbrokers.Policy{}
+	if err := readPolicy(allowedbrokers.PluginName, opts.AllowedBrokersConfig, allowedBrokers); err != nil {
+		return err
+	}
+
+	parameterKeys := &parameterkeys.Policy{}
+	if err := readPolicy(parameterkeys.PluginName, opts.ParameterKeysConfig, parameterKeys); err != nil {
+		return err
+	}
+	var parameterKeyPattern *regexp.Regexp
+	if parameterKeys.Pattern != "" {
+		pattern, err := regexp.Compile(parameterKeys.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid %s pattern %q: %v", parameterkeys.PluginName, parameterKeys.Pattern, err)
+		}
+		parameterKeyPattern = pattern
+	}
+
 	cfg := config.GetConfigOrDie()
 	mgr, err := manager.New(cfg, manager.Options{})
 	if err != nil {
@@ -100,13 +129,14 @@ func run(opts *WebhookServerOptions, stopCh <-chan struct{}) error {
 		"/validating-clusterserviceclasses":        cscvalidation.NewAdmissionHandler(),
 		"/validating-clusterserviceplans":          cspvalidation.NewAdmissionHandler(),
 
-		"/validating-servicebindings":        sbvalidation.NewAdmissionHandler(),
-		"/validating-servicebindings/status": &sbvalidation.StatusUpdateValidationHandler{},
-		"/validating-servicebrokers":         sbrvalidation.NewAdmissionHandler(),
-		"/validating-servicebrokers/status":  &sbrvalidation.StatusUpdateHandler{},
-		"/validating-serviceclasses":         scvalidation.NewAdmissionHandler(),
-		"/validating-serviceplans":           spvalidation.NewAdmissionHandler(),
-		"/validating-serviceinstances":       sivalidation.NewAdmissionHandler(),
+		"/validating-servicebindings":         sbvalidation.NewAdmissionHandler(parametersLimits, parameterKeyPattern),
+		"/validating-servicebindings/status":  &sbvalidation.StatusUpdateValidationHandler{},
+		"/validating-servicebrokers":          sbrvalidation.NewAdmissionHandler(),
+		"/validating-servicebrokers/status":   &sbrvalidation.StatusUpdateHandler{},
+		"/validating-serviceclasses":          scvalidation.NewAdmissionHandler(),
+		"/validating-serviceplans":            spvalidation.NewAdmissionHandler(),
+		"/validating-serviceinstances":        sivalidation.NewAdmissionHandler(parametersLimits, allowedBrokers.Namespaces, parameterKeyPattern),
+		"/validating-serviceinstances/status": &sivalidation.StatusUpdateValidationHandler{},
 	}
 
 	for path, handler := range webhooks {
@@ -152,3 +182,18 @@ func run(opts *WebhookServerOptions, stopCh <-chan struct{}) error {
 
 	return nil
 }
+
+// readPolicy parses the policy file at path the same way the admission
+// plugin named pluginName reads its configuration. An empty path leaves
+// policy unchanged.
+func readPolicy(pluginName, path string, policy interface{}) error {
+	if path == "" {
+		return nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "while opening %s configuration", pluginName)
+	}
+	defer f.Close()
+	return scadmission.ReadPolicy(pluginName, f, policy)
+}
@@ -82,6 +82,25 @@ type ControllerManagerConfiguration struct {
 	// SC operations, but more CPU (and network) load.
 	ConcurrentSyncs int
 
+	// ConcurrentInstanceSyncs is the number of service instances that are
+	// allowed to sync concurrently. If zero, ConcurrentSyncs is used.
+	ConcurrentInstanceSyncs int
+
+	// ConcurrentBindingSyncs is the number of service bindings that are
+	// allowed to sync concurrently. If zero, ConcurrentSyncs is used.
+	ConcurrentBindingSyncs int
+
+	// ConcurrentBrokerSyncs is the number of brokers, classes and plans, per
+	// resource type, that are allowed to sync concurrently. If zero,
+	// ConcurrentSyncs is used.
+	ConcurrentBrokerSyncs int
+
+	// Selector is a label selector restricting the brokers, instances and
+	// bindings reconciled by the controller. It allows running several
+	// controllers, each reconciling a different subset of the objects. An
+	// empty selector matches all objects.
+	Selector string
+
 	// leaderElection defines the configuration of leader election client.
 	LeaderElection componentconfig.LeaderElectionConfiguration
 
@@ -103,6 +122,132 @@ type ControllerManagerConfiguration struct {
 	// backoff for polling OSB API operations will use.
 	OperationPollingMaximumBackoffDuration time.Duration
 
+	// OperationPollingInitialInterval is the interval of the first poll of an
+	// OSB API operation. The interval doubles with every poll finding the
+	// operation in the same state, up to OperationPollingMaximumBackoffDuration.
+	OperationPollingInitialInterval time.Duration
+
+	// AllowBrokerInsecureSkipTLSVerify allows brokers to disable TLS
+	// certificate verification through spec.insecureSkipTLSVerify. Without it
+	// the field is ignored and certificates are always verified. This is
+	// dangerous and only intended for development clusters.
+	AllowBrokerInsecureSkipTLSVerify bool
+
+	// BrokerContextNamespacePrefix is prepended to the namespace sent to
+	// brokers in the OSB context, so that brokers shared by several clusters
+	// can tell apart namespaces with the same name.
+	BrokerContextNamespacePrefix string
+
+	// BrokerContextPlatform is the platform sent to brokers in the OSB
+	// context, "kubernetes" by default, for platforms built on top of the
+	// service catalog which identify themselves to brokers.
+	BrokerContextPlatform string
+
+	// BrokerContextAnnotations is a comma separated list of annotations of
+	// instances which are copied into the OSB context of their provision and
+	// update requests, under the annotation key.
+	BrokerContextAnnotations string
+
+	// HealBindingSecretsOnStartup makes the controller fetch the bindings of
+	// brokers advertising bindings_retrievable on startup and rewrite the
+	// Secrets which no longer match the credentials the brokers return, such
+	// as Secrets modified while the controller was down. The bindings are
+	// fetched one at a time to spread the load on the brokers.
+	HealBindingSecretsOnStartup bool
+
+	// WatchSecrets makes the controller watch Secrets: the Secrets of
+	// bindings are written again when deleted, and the changes of the Secrets
+	// the parameters of instances are read from are sent to the brokers. It
+	// requires permission to list and watch Secrets.
+	WatchSecrets bool
+
+	// SkipMalformedCatalogEntries makes the controller skip the classes and
+	// plans of a catalog which have no plans or which the API server rejects
+	// as invalid, and synchronize the rest of the catalog, instead of failing
+	// the whole relist. The skipped entries are listed in the
+	// MalformedCatalogEntries condition of the broker.
+	SkipMalformedCatalogEntries bool
+
+	// MaxOrphanMitigationAttempts is the number of deprovision requests sent
+	// to mitigate an orphaned instance before giving up and leaving the
+	// instance for manual intervention. Zero means no limit.
+	MaxOrphanMitigationAttempts int64
+
+	// ResumeOrphanMitigationOnBrokerRecovery makes the controller resume the
+	// failed orphan mitigations of a broker, typically the ones which ran out
+	// of retries while the broker was down, on every successful relist of the
+	// broker. Orphan mitigations stopped after
+	// MaxOrphanMitigationAttempts are not resumed.
+	ResumeOrphanMitigationOnBrokerRecovery bool
+
+	// MaxCatalogSize is the maximum number of classes and plans a single
+	// broker may publish. The catalog of a broker publishing more is
+	// rejected. Zero means no limit.
+	MaxCatalogSize int64
+
+	// RefetchBindingCredentialsOnInstancePlanChange makes the controller send
+	// the bind requests of the bindings of an instance again once the plan of
+	// the instance has been changed, and write the credentials the broker
+	// returns. The requests keep the binding IDs, so brokers treating them as
+	// idempotent return the credentials of the existing bindings rather than
+	// new ones. Otherwise the bindings are only marked as having stale
+	// credentials.
+	RefetchBindingCredentialsOnInstancePlanChange bool
+
+	// RevalidateInstancesOnPlanSchemaChange makes the controller check the
+	// parameters of the instances of a plan against its new instance create
+	// schema when a relist of the broker changes it, and flag the instances
+	// which do not comply with the NonCompliantParameters condition.
+	RevalidateInstancesOnPlanSchemaChange bool
+
+	// ResendParametersOnDefaultsChange makes the controller send the
+	// parameters of a provisioned instance to the broker again once the
+	// default provisioning parameters it was provisioned with change, with
+	// the parameters left at their old defaults set to the new ones.
+	// Otherwise the instances are only flagged with the
+	// DefaultParametersChanged condition.
+	ResendParametersOnDefaultsChange bool
+
+	// AllowBindToNonBindablePlans makes the controller send the bind
+	// requests of the bindings of instances whose plan is not bindable,
+	// instead of rejecting them. Existing bindings of a plan which becomes
+	// non-bindable are flagged with the PlanNotBindable condition either way.
+	AllowBindToNonBindablePlans bool
+
+	// ReconcileInstancesOnlyOnChange makes the controller skip the
+	// reconciliation of instances on informer resyncs when their spec has
+	// been observed and no operation is in progress. Changes outside of the
+	// instance, such as to its context, are then only acted upon with the
+	// next change of the instance.
+	ReconcileInstancesOnlyOnChange bool
+
+	// StuckOperationWarningInterval is the age past which an ongoing
+	// operation of an instance or binding is reminded of with a Warning
+	// event, repeated at most once per interval. Zero disables the
+	// reminders.
+	StuckOperationWarningInterval time.Duration
+
+	// NamespaceAnnotationParameters maps annotations of the namespace of an
+	// instance to provisioning parameters of the instance, as a comma
+	// separated list of annotation=parameter pairs. The parameters are
+	// merged beneath the ones set by the user.
+	NamespaceAnnotationParameters string
+
+	// ParametersFromURLAllowedHosts is a comma separated list of the hosts
+	// parameters may be fetched from by URL. Parameters are fetched from no
+	// host if empty.
+	ParametersFromURLAllowedHosts string
+
+	// OriginatingIdentityNamespaceAnnotation is the annotation of the
+	// namespace of an instance or binding whose value is sent as the
+	// username of the originating identity of its broker requests, in place
+	// of the user who made the change. Empty disables the mapping.
+	OriginatingIdentityNamespaceAnnotation string
+
+	// CatalogRewriteRulesFile is the path of a file of rules dropping or
+	// renaming the classes and plans advertised by brokers, per broker.
+	CatalogRewriteRulesFile string
+
 	SecureServingOptions *genericoptions.SecureServingOptions
 
 	// ClusterIDConfigMapName is the k8s name that the clusterid configmap will have
@@ -26,12 +26,14 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	proto "github.com/golang/protobuf/proto"
+	fuzz "github.com/google/gofuzz"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
 	testapi "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/testapi"
 	apitesting "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/testing"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/diff"
@@ -211,6 +213,129 @@ func TestRoundTripTypes(t *testing.T) {
 	fuzzer := fuzzer.FuzzerFor(apitesting.FuzzerFuncs, rand.NewSource(seed), api.Codecs)
 	nonRoundTrippableTypes := map[schema.GroupVersionKind]bool{}
 	roundtrip.RoundTripTypesWithoutProtobuf(t, api.Scheme, api.Codecs, fuzzer, nonRoundTrippableTypes)
+
+	// The fuzzer rarely leaves all but one of the fields of a parametersFrom
+	// source unset, so round-trip such sources explicitly.
+	codec := serviceCatalogAPIGroup().Codec()
+	parametersFromCases := [][]servicecatalog.ParametersFromSource{
+		{
+			{SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "secret", Key: "params"}},
+		},
+		{
+			{
+				SecretKeyRef:  &servicecatalog.SecretKeyReference{Name: "secret", Key: "password"},
+				ParameterName: "password",
+			},
+		},
+		{
+			{URLRef: &servicecatalog.URLReference{URL: "https://example.com/parameters.json"}},
+		},
+		{
+			{ParameterName: "password"},
+		},
+	}
+	for _, parametersFrom := range parametersFromCases {
+		instance := &servicecatalog.ServiceInstance{}
+		instance.Spec.ClusterServiceClassExternalName = "class"
+		instance.Spec.ClusterServicePlanExternalName = "plan"
+		instance.Spec.ParametersFrom = parametersFrom
+		roundTrip(t, codec, instance)
+
+		binding := &servicecatalog.ServiceBinding{}
+		binding.Spec.SecretName = "secret"
+		binding.Spec.ParametersFrom = parametersFrom
+		roundTrip(t, codec, binding)
+	}
+}
+
+// TestRoundTripOperationStatus round-trips the status of resources with an
+// operation in progress through the external version, with all the optional
+// status fields set, so that the operation is not lost when the objects are
+// converted across versions.
+func TestRoundTripOperationStatus(t *testing.T) {
+	codec := serviceCatalogAPIGroup().Codec()
+	startTime := metav1.Unix(1500000000, 0)
+	lastOperation := "operation-key"
+
+	cases := []struct {
+		name string
+		// object returns an object fuzzed by f with an operation in
+		// progress, and its status.
+		object func(f *fuzz.Fuzzer) (runtime.Object, interface{})
+	}{
+		{
+			name: "ServiceInstance",
+			object: func(f *fuzz.Fuzzer) (runtime.Object, interface{}) {
+				instance := &servicecatalog.ServiceInstance{}
+				instance.Spec.ClusterServiceClassExternalName = "class"
+				instance.Spec.ClusterServicePlanExternalName = "plan"
+				f.Fuzz(&instance.Status)
+				instance.Status.AsyncOpInProgress = true
+				instance.Status.CurrentOperation = servicecatalog.ServiceInstanceOperationProvision
+				instance.Status.LastOperation = &lastOperation
+				instance.Status.OperationStartTime = &startTime
+				return instance, &instance.Status
+			},
+		},
+		{
+			name: "ServiceBinding",
+			object: func(f *fuzz.Fuzzer) (runtime.Object, interface{}) {
+				binding := &servicecatalog.ServiceBinding{}
+				binding.Spec.SecretName = "secret"
+				f.Fuzz(&binding.Status)
+				binding.Status.AsyncOpInProgress = true
+				binding.Status.CurrentOperation = servicecatalog.ServiceBindingOperationBind
+				binding.Status.LastOperation = &lastOperation
+				binding.Status.OperationStartTime = &startTime
+				return binding, &binding.Status
+			},
+		},
+		{
+			name: "ClusterServiceBroker",
+			object: func(f *fuzz.Fuzzer) (runtime.Object, interface{}) {
+				broker := &servicecatalog.ClusterServiceBroker{}
+				broker.Spec.RelistBehavior = servicecatalog.ServiceBrokerRelistBehaviorManual
+				f.Fuzz(&broker.Status)
+				broker.Status.OperationStartTime = &startTime
+				broker.Status.LastCatalogRetrievalTime = &startTime
+				return broker, &broker.Status
+			},
+		},
+		{
+			name: "ServiceBroker",
+			object: func(f *fuzz.Fuzzer) (runtime.Object, interface{}) {
+				broker := &servicecatalog.ServiceBroker{}
+				broker.Spec.RelistBehavior = servicecatalog.ServiceBrokerRelistBehaviorManual
+				f.Fuzz(&broker.Status)
+				broker.Status.OperationStartTime = &startTime
+				broker.Status.LastCatalogRetrievalTime = &startTime
+				return broker, &broker.Status
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				seed := rand.Int63()
+				f := fuzzer.FuzzerFor(apitesting.FuzzerFuncs, rand.NewSource(seed), api.Codecs).NilChance(0)
+				item, status := tc.object(f)
+
+				data, err := runtime.Encode(codec, item)
+				if err != nil {
+					t.Fatalf("seed %d: unable to encode: %v", seed, err)
+				}
+				decoded, err := runtime.Decode(codec, data)
+				if err != nil {
+					t.Fatalf("seed %d: unable to decode: %v", seed, err)
+				}
+				decodedStatus := reflect.ValueOf(decoded).Elem().FieldByName("Status").Addr().Interface()
+				if !equality.Semantic.DeepEqual(status, decodedStatus) {
+					t.Fatalf("seed %d: status changed in round trip: %v", seed, diff.ObjectReflectDiff(status, decodedStatus))
+				}
+			}
+		})
+	}
 }
 
 func TestBadJSONRejection(t *testing.T) {
@@ -24,6 +24,7 @@ import (
 
 	"github.com/google/gofuzz"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 
 	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
 	genericfuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
@@ -91,9 +92,52 @@ func createPlanMetadata(c fuzz.Continue) (*runtime.RawExtension, error) {
 	return &runtime.RawExtension{Raw: b}, nil
 }
 
+// defaultPlanReference applies the v1beta1 defaulter to the given plan
+// reference.
+func defaultPlanReference(ref *servicecatalog.PlanReference) {
+	versioned := &v1beta1.PlanReference{}
+	if err := v1beta1.Convert_servicecatalog_PlanReference_To_v1beta1_PlanReference(ref, versioned, nil); err != nil {
+		panic(fmt.Sprintf("Failed to convert plan reference: %v", err))
+	}
+	v1beta1.SetDefaults_PlanReference(versioned)
+	if err := v1beta1.Convert_v1beta1_PlanReference_To_servicecatalog_PlanReference(versioned, ref, nil); err != nil {
+		panic(fmt.Sprintf("Failed to convert plan reference: %v", err))
+	}
+}
+
+// genericObjectMetaFunc returns the fuzzer func of ObjectMeta of the generic
+// fuzzer funcs.
+func genericObjectMetaFunc(codecs runtimeserializer.CodecFactory) func(*metav1.ObjectMeta, fuzz.Continue) {
+	for _, f := range genericfuzzer.Funcs(codecs) {
+		if f, ok := f.(func(*metav1.ObjectMeta, fuzz.Continue)); ok {
+			return f
+		}
+	}
+	panic("no generic fuzzer func of ObjectMeta")
+}
+
 // servicecatalogFuncs defines fuzzer funcs for Service Catalog types
 func servicecatalogFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
+	objectMetaFunc := genericObjectMetaFunc(codecs)
 	return []interface{}{
+		func(j *metav1.ObjectMeta, c fuzz.Continue) {
+			objectMetaFunc(j, c)
+			// The generic func picks deletion timestamps out of the range
+			// of RFC 3339, which are zeroed and so encoded as null.
+			if j.DeletionTimestamp != nil && j.DeletionTimestamp.IsZero() {
+				j.DeletionTimestamp.Fuzz(c)
+			}
+		},
+		func(t **metav1.Time, c fuzz.Continue) {
+			// *metav1.Time fuzzes itself, which leaves nil pointers nil,
+			// so optional timestamps would otherwise never be set.
+			if c.RandBool() {
+				*t = nil
+				return
+			}
+			*t = &metav1.Time{}
+			(*t).Fuzz(c)
+		},
 		func(bs *servicecatalog.ClusterServiceBrokerSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(bs)
 			bs.RelistBehavior = servicecatalog.ServiceBrokerRelistBehaviorDuration
@@ -107,6 +151,10 @@ func servicecatalogFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
 		func(is *servicecatalog.ServiceInstanceSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(is)
 			is.ExternalID = string(uuid.NewUUID())
+			// The defaulter for this object trims surrounding whitespace from
+			// the plan reference, so don't generate any or the round-trip
+			// checking will fail.
+			defaultPlanReference(&is.PlanReference)
 			parameters, err := createParameter(c)
 			if err != nil {
 				panic(fmt.Sprintf("Failed to create parameter object: %v", err))
@@ -145,6 +193,14 @@ func servicecatalogFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
 			}
 			bs.Parameters = parameters
 		},
+		func(vd *servicecatalog.ServiceBindingVolumeDevice, c fuzz.Continue) {
+			c.FuzzNoCustom(vd)
+			mountConfig, err := createParameter(c)
+			if err != nil {
+				panic(fmt.Sprintf("Failed to create mount config object: %v", err))
+			}
+			vd.MountConfig = mountConfig
+		},
 		func(sc *servicecatalog.ClusterServiceClass, c fuzz.Continue) {
 			c.FuzzNoCustom(sc)
 			metadata, err := createServiceMetadata(c)
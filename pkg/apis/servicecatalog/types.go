@@ -84,6 +84,13 @@ type CommonServiceBrokerSpec struct {
 	// +optional
 	CABundle []byte
 
+	// OSBAPIVersion is the version of the Open Service Broker API used to
+	// communicate with the Broker, sent in the X-Broker-API-Version header.
+	// One of "2.11", "2.12" or "2.13". Defaults to the version preferred by
+	// the controller.
+	// +optional
+	OSBAPIVersion string
+
 	// RelistBehavior specifies the type of relist behavior the catalog should
 	// exhibit when relisting ServiceClasses available from a broker.
 	RelistBehavior ServiceBrokerRelistBehavior
@@ -167,6 +174,15 @@ type ClusterServiceBrokerSpec struct {
 	// AuthInfo contains the data that the service catalog should use to authenticate
 	// with the Service Broker.
 	AuthInfo *ClusterServiceBrokerAuthInfo
+
+	// FallbackAuthInfo contains the data that the service catalog should use to
+	// authenticate with the ClusterServiceBroker when the broker rejects the
+	// credentials of AuthInfo with a 401 Unauthorized response, for example
+	// while the credentials of the broker are being rotated. Only basic and
+	// bearer authentication are supported, and AuthInfo must be set to one of
+	// them as well.
+	// +optional
+	FallbackAuthInfo *ClusterServiceBrokerAuthInfo
 }
 
 // ServiceBrokerSpec represents a description of a Broker.
@@ -202,6 +218,9 @@ type ClusterServiceBrokerAuthInfo struct {
 	// The value is referenced from the 'token' field of the given secret.  This value should only
 	// contain the token value and not the `Bearer` scheme.
 	Bearer *ClusterBearerTokenAuthConfig
+	// ClusterBearerTokenFromServiceAccountAuthConfig provides configuration to send a token of a
+	// service account, obtained with the TokenRequest API, as a bearer token.
+	BearerTokenFromServiceAccount *ClusterBearerTokenFromServiceAccountAuthConfig
 }
 
 // ClusterBasicAuthConfig provides config for the basic authentication of
@@ -227,6 +246,24 @@ type ClusterBearerTokenAuthConfig struct {
 	SecretRef *ObjectReference
 }
 
+// ClusterBearerTokenFromServiceAccountAuthConfig provides config for the bearer token
+// authentication of cluster scoped brokers with service account tokens. The tokens are
+// requested for the given audience and refreshed before they expire.
+type ClusterBearerTokenFromServiceAccountAuthConfig struct {
+	// ServiceAccountRef is a reference to the ServiceAccount whose token
+	// the catalog should use to authenticate to this ClusterServiceBroker.
+	ServiceAccountRef *ObjectReference
+
+	// Audience is the intended audience of the token. The broker is expected
+	// to reject tokens issued for any other audience.
+	Audience string
+
+	// ExpirationSeconds is the requested lifetime of the token. Defaults to
+	// one hour and must be at least 10 minutes.
+	// +optional
+	ExpirationSeconds *int64
+}
+
 // ServiceBrokerAuthInfo is a union type that contains information on
 // one of the authentication methods the service catalog and brokers may
 // support, according to the OpenServiceBroker API specification
@@ -238,6 +275,9 @@ type ServiceBrokerAuthInfo struct {
 	// The value is referenced from the 'token' field of the given secret.  This value should only
 	// contain the token value and not the `Bearer` scheme.
 	Bearer *BearerTokenAuthConfig
+	// BearerTokenFromServiceAccountAuthConfig provides configuration to send a token of a
+	// service account, obtained with the TokenRequest API, as a bearer token.
+	BearerTokenFromServiceAccount *BearerTokenFromServiceAccountAuthConfig
 }
 
 // BasicAuthConfig provides config for the basic authentication of
@@ -263,6 +303,25 @@ type BearerTokenAuthConfig struct {
 	SecretRef *LocalObjectReference
 }
 
+// BearerTokenFromServiceAccountAuthConfig provides config for the bearer token
+// authentication of namespace scoped brokers with service account tokens. The tokens are
+// requested for the given audience and refreshed before they expire.
+type BearerTokenFromServiceAccountAuthConfig struct {
+	// ServiceAccountRef is a reference to the ServiceAccount, in the
+	// namespace of the ServiceBroker, whose token the catalog should use to
+	// authenticate to this ServiceBroker.
+	ServiceAccountRef *LocalObjectReference
+
+	// Audience is the intended audience of the token. The broker is expected
+	// to reject tokens issued for any other audience.
+	Audience string
+
+	// ExpirationSeconds is the requested lifetime of the token. Defaults to
+	// one hour and must be at least 10 minutes.
+	// +optional
+	ExpirationSeconds *int64
+}
+
 const (
 	// BasicAuthUsernameKey is the key of the username for SecretTypeBasicAuth secrets
 	BasicAuthUsernameKey = "username"
@@ -292,6 +351,27 @@ type CommonServiceBrokerStatus struct {
 	// LastConditionState aggregates state from the Conditions array
 	// It is used for printing in a kubectl output via additionalPrinterColumns
 	LastConditionState string `json:"lastConditionState"`
+
+	// Features is the list of optional Open Service Broker API features
+	// advertised in the broker's catalog when it was last fetched.
+	// +optional
+	Features []ServiceBrokerFeature
+
+	// LastCatalogClassCount is the number of classes in the broker's catalog
+	// that passed its catalog restrictions when it was last fetched.
+	// +optional
+	LastCatalogClassCount *int64
+
+	// LastCatalogPlanCount is the number of plans in the broker's catalog
+	// that passed its catalog restrictions when it was last fetched.
+	// +optional
+	LastCatalogPlanCount *int64
+
+	// LastSuccessfulEndpoint is the URL of the broker endpoint which served
+	// the last successful catalog fetch. It lags behind the spec when the
+	// broker's URL is changed to an endpoint that cannot be reached.
+	// +optional
+	LastSuccessfulEndpoint string
 }
 
 // ClusterServiceBrokerStatus represents the current status of a
@@ -337,6 +417,33 @@ const (
 	// ServiceBrokerConditionFailed represents information about a final failure
 	// that should not be retried.
 	ServiceBrokerConditionFailed ServiceBrokerConditionType = "Failed"
+
+	// ServiceBrokerConditionInsecureSkipTLSVerify warns that TLS certificate
+	// verification is skipped when communicating with the broker.
+	ServiceBrokerConditionInsecureSkipTLSVerify ServiceBrokerConditionType = "InsecureSkipTLSVerify"
+
+	// ServiceBrokerConditionDeprecated warns that the broker announced the
+	// deprecation or end of life of services in its catalog.
+	ServiceBrokerConditionDeprecated ServiceBrokerConditionType = "Deprecated"
+
+	// ServiceBrokerConditionMalformedCatalogEntries warns that classes or
+	// plans of the catalog of the broker were skipped for being malformed.
+	ServiceBrokerConditionMalformedCatalogEntries ServiceBrokerConditionType = "MalformedCatalogEntries"
+)
+
+// ServiceBrokerFeature is an optional Open Service Broker API feature that a
+// broker advertises in its catalog.
+type ServiceBrokerFeature string
+
+const (
+	// ServiceBrokerFeatureAsyncBindings means that at least one service of
+	// the broker supports fetching bindings, which is required for
+	// asynchronous binding operations.
+	ServiceBrokerFeatureAsyncBindings ServiceBrokerFeature = "AsyncBindings"
+
+	// ServiceBrokerFeaturePlanUpdates means that at least one service of the
+	// broker supports changing the plan of its instances.
+	ServiceBrokerFeaturePlanUpdates ServiceBrokerFeature = "PlanUpdates"
 )
 
 // ConditionStatus represents a condition's status.
@@ -620,6 +727,22 @@ type CommonServicePlanStatus struct {
 	// RemovedFromBrokerCatalog indicates that the broker removed the plan
 	// from its catalog.
 	RemovedFromBrokerCatalog bool
+
+	// Costs are the costs of the plan, as reported by the broker in the
+	// "costs" field of the plan's external metadata.
+	Costs []ServicePlanCost
+}
+
+// ServicePlanCost is a cost of a plan, as described by the Open Service
+// Broker API metadata conventions.
+type ServicePlanCost struct {
+	// Amount maps lowercase currency codes, such as "usd", to the amount
+	// charged in that currency.
+	Amount map[string]string
+
+	// Unit is what the amount is charged for, such as "MONTHLY" or
+	// "PER 1GB".
+	Unit string
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -782,6 +905,11 @@ type PlanReference struct {
 type ServiceInstanceSpec struct {
 	PlanReference
 
+	// ClusterServiceBrokerName is the name of the ClusterServiceBroker whose
+	// ClusterServiceClass and ClusterServicePlan should be resolved. It is
+	// only needed when more than one broker offers a matching class.
+	ClusterServiceBrokerName string
+
 	// ClusterServiceClassRef is a reference to the ClusterServiceClass
 	// that the user selected. This is set by the controller based on the
 	// cluster-scoped values specified in the PlanReference.
@@ -821,6 +949,14 @@ type ServiceInstanceSpec struct {
 	// +optional
 	ParametersFrom []ParametersFromSource
 
+	// GeneratedParameters are parameters whose values are generated by the
+	// controller, for example passwords, instead of being supplied by the
+	// user. The values are stored in a Secret and are only generated once.
+	// A top-level parameter name existing in another source is considered
+	// to be a user error in the specification.
+	// +optional
+	GeneratedParameters *GeneratedParameters
+
 	// ExternalID is the identity of this object for use with the OSB API.
 	//
 	// Immutable.
@@ -840,6 +976,22 @@ type ServiceInstanceSpec struct {
 	// allows for parameters to be updated with any out-of-band changes that have
 	// been made to the secrets from which the parameters are sourced.
 	UpdateRequests int64
+
+	// TTLSecondsAfterFailure limits the lifetime of an instance that failed
+	// to provision. If set, the instance is deleted once it has been in the
+	// terminally failed state for this many seconds. It only applies to
+	// instances that were never provisioned and that do not require
+	// deprovisioning on the broker side.
+	// +optional
+	TTLSecondsAfterFailure *int64
+
+	// TTLSecondsAfterReady limits the lifetime of a provisioned instance,
+	// for instance in ephemeral test environments. If set, the instance and
+	// its bindings are deleted once the instance has been ready for this
+	// many seconds. The bindings are unbound and the instance deprovisioned
+	// as when they are deleted by the user.
+	// +optional
+	TTLSecondsAfterReady *int64
 }
 
 // ServiceInstanceStatus represents the current status of an Instance.
@@ -856,6 +1008,10 @@ type ServiceInstanceStatus struct {
 	// mitigation operation against this ServiceInstance in progress.
 	OrphanMitigationInProgress bool
 
+	// OrphanMitigationAttempts is the number of deprovision requests sent to
+	// the broker by the ongoing orphan mitigation.
+	OrphanMitigationAttempts int64
+
 	// LastOperation is the string that the broker may have returned when
 	// an async operation started, it should be sent back to the broker
 	// on poll requests as a query param.
@@ -865,6 +1021,11 @@ type ServiceInstanceStatus struct {
 	// the service instance.
 	DashboardURL *string
 
+	// ExtensionAPIs are the APIs, beyond the Open Service Broker API, that
+	// the broker offers for the service instance, as returned in its
+	// provision response.
+	ExtensionAPIs []ExtensionAPI
+
 	// CurrentOperation is the operation the Controller is currently performing
 	// on the ServiceInstance.
 	CurrentOperation ServiceInstanceOperation
@@ -903,6 +1064,21 @@ type ServiceInstanceStatus struct {
 	// instance.
 	DefaultProvisionParameters *runtime.RawExtension
 
+	// BindingCount is the number of ServiceBindings that refer to the
+	// ServiceInstance, including those being deleted. It is maintained by
+	// the controller and may briefly lag behind the bindings that exist.
+	BindingCount int64
+
+	// LastProvisionDuration is how long the last successful provision of the
+	// ServiceInstance took, from the start of the provision operation until
+	// the broker reported its completion, including any polling of an
+	// asynchronous operation.
+	LastProvisionDuration *metav1.Duration
+
+	// LastUpdateDuration is how long the last successful update of the
+	// ServiceInstance took, measured in the same way as LastProvisionDuration.
+	LastUpdateDuration *metav1.Duration
+
 	// LastConditionState aggregates state from the Conditions array
 	// It is used for printing in a kubectl output via additionalPrinterColumns
 	LastConditionState string `json:"lastConditionState"`
@@ -914,6 +1090,16 @@ type ServiceInstanceStatus struct {
 	// UserSpecifiedClassName aggregates cluster or namespace ClassName
 	// It is used for printing in a kubectl output via additionalPrinterColumns
 	UserSpecifiedClassName string `json:"userSpecifiedClassName"`
+
+	// ResolvedClassExternalName is the external name of the class the
+	// instance refers to, whichever form of reference is used in the spec.
+	// +optional
+	ResolvedClassExternalName string `json:"resolvedClassExternalName,omitempty"`
+
+	// ResolvedPlanExternalName is the external name of the plan the
+	// instance refers to, whichever form of reference is used in the spec.
+	// +optional
+	ResolvedPlanExternalName string `json:"resolvedPlanExternalName,omitempty"`
 }
 
 // ServiceInstanceCondition contains condition information about an Instance.
@@ -935,6 +1121,11 @@ type ServiceInstanceCondition struct {
 	// Message is a human readable description of the details of the last
 	// transition, complementing reason.
 	Message string
+
+	// ErrorCode is a machine readable classification of the failed request
+	// to the broker the condition reports. It is empty when the condition
+	// does not report a failed request.
+	ErrorCode string
 }
 
 // ServiceInstanceConditionType represents a instance condition value.
@@ -952,6 +1143,16 @@ const (
 	// ServiceInstanceConditionOrphanMitigation represents information about an
 	// orphan mitigation that is required after failed provisioning.
 	ServiceInstanceConditionOrphanMitigation ServiceInstanceConditionType = "OrphanMitigation"
+
+	// ServiceInstanceConditionNonCompliantParameters represents that the
+	// parameters of an instance do not comply with the parameter schema of
+	// its plan, which has changed since the instance was provisioned.
+	ServiceInstanceConditionNonCompliantParameters ServiceInstanceConditionType = "NonCompliantParameters"
+
+	// ServiceInstanceConditionDefaultParametersChanged represents that the
+	// default provisioning parameters of an instance have changed since
+	// they were applied to its parameters.
+	ServiceInstanceConditionDefaultParametersChanged ServiceInstanceConditionType = "DefaultParametersChanged"
 )
 
 // ServiceInstanceOperation represents a type of operation the controller can
@@ -970,6 +1171,22 @@ const (
 	ServiceInstanceOperationDeprovision ServiceInstanceOperation = "Deprovision"
 )
 
+// ExtensionAPI describes an API, beyond the Open Service Broker API, that a
+// broker offers for a ServiceInstance. The credentials the broker may return
+// for the API are not recorded.
+type ExtensionAPI struct {
+	// DiscoveryURL is the URL of an OpenAPI document describing the API.
+	DiscoveryURL string
+
+	// ServerURL is the base URL of the paths described by the OpenAPI
+	// document. If empty, the paths are relative to the URL of the broker.
+	ServerURL string
+
+	// AdheresTo is the URI of a specification the OpenAPI document adheres
+	// to.
+	AdheresTo string
+}
+
 // ServiceInstancePropertiesState is the state of a ServiceInstance that
 // the ServiceBroker knows about.
 type ServiceInstancePropertiesState struct {
@@ -999,6 +1216,9 @@ type ServiceInstancePropertiesState struct {
 	// ParameterChecksum is the checksum of the parameters that were sent.
 	ParameterChecksum string
 
+	// ContextChecksum is the checksum of the context that was sent.
+	ContextChecksum string
+
 	// UserInfo is information about the user that made the request.
 	UserInfo *UserInfo
 }
@@ -1094,6 +1314,12 @@ type ServiceBindingSpec struct {
 	// +optional
 	ParametersFrom []ParametersFromSource
 
+	// Endpoint is the name of the endpoint of the ServiceInstance the
+	// binding is for, when the instance exposes several of them. It is sent
+	// to the broker in the "endpoint" field of the bind request's context.
+	// +optional
+	Endpoint string
+
 	// SecretName is the name of the secret to create in the ServiceBinding's
 	// namespace that will hold the credentials associated with the ServiceBinding.
 	SecretName string
@@ -1102,6 +1328,28 @@ type ServiceBindingSpec struct {
 	// by the broker before they are inserted into the Secret
 	SecretTransforms []SecretTransform
 
+	// SecretKeyFormat is the naming convention all credential keys are
+	// converted to after the SecretTransforms have been applied. When
+	// empty, the keys are written to the Secret unchanged.
+	SecretKeyFormat SecretKeyFormat
+
+	// AdditionalSecretNamespaces is a list of namespaces, other than the
+	// ServiceBinding's namespace, into which a copy of the Secret holding
+	// the credentials should be written. The copies are kept in sync with
+	// the Secret and are deleted when the ServiceBinding is unbound.
+	AdditionalSecretNamespaces []string
+
+	// SecretLabels are labels to set on the Secret holding the credentials
+	// and on its copies. The labels are updated every time the Secret is
+	// written.
+	SecretLabels map[string]string
+
+	// SecretAnnotations are annotations to set on the Secret holding the
+	// credentials and on its copies, in addition to the annotations
+	// describing the instance, class and plan of the ServiceBinding. The
+	// annotations are updated every time the Secret is written.
+	SecretAnnotations map[string]string
+
 	// ExternalID is the identity of this object for use with the OSB API.
 	//
 	// Immutable.
@@ -1146,6 +1394,12 @@ type ServiceBindingStatus struct {
 	// process the spec.
 	ReconciledGeneration int64
 
+	// ObservedGeneration is the 'Generation' of the ServiceBindingSpec that
+	// was last processed by the controller. The observed generation is
+	// updated once an operation on the binding has finished, regardless of
+	// its result.
+	ObservedGeneration int64
+
 	// OperationStartTime is the time at which the current operation began.
 	OperationStartTime *metav1.Time
 
@@ -1162,6 +1416,28 @@ type ServiceBindingStatus struct {
 	// mitigation is in progress.
 	OrphanMitigationInProgress bool
 
+	// SecretWritePending is set to true when the broker has successfully
+	// processed the current Bind operation but the credentials it returned
+	// have not been written to the Secret yet. While it is set, the
+	// controller retries writing the Secret without sending another bind
+	// request to the broker.
+	SecretWritePending bool
+
+	// SecretCopyNamespaces is the list of namespaces into which a copy of
+	// the Secret holding the credentials may have been written. It is used
+	// to clean up the copies on unbind or when a namespace is removed from
+	// AdditionalSecretNamespaces.
+	SecretCopyNamespaces []string
+
+	// RouteServiceURL is the URL returned by the broker to which the
+	// platform must proxy requests for the application the ServiceBinding
+	// is for.
+	RouteServiceURL *string
+
+	// VolumeMounts is the list of volume mounts returned by the broker
+	// for the ServiceBinding.
+	VolumeMounts []ServiceBindingVolumeMount
+
 	// UnbindStatus describes what has been done to unbind a ServiceBinding
 	UnbindStatus ServiceBindingUnbindStatus
 
@@ -1170,6 +1446,59 @@ type ServiceBindingStatus struct {
 	LastConditionState string `json:"lastConditionState"`
 }
 
+// ServiceBindingVolumeMount is a volume mount returned by a broker for a
+// ServiceBinding, as defined by the Open Service Broker API.
+type ServiceBindingVolumeMount struct {
+	// Driver is the name of the volume driver plugin which manages the
+	// device.
+	Driver string
+
+	// ContainerDir is the directory to mount inside the application
+	// container.
+	ContainerDir string
+
+	// Mode describes whether the volume is mounted read-only or
+	// read-write.
+	Mode ServiceBindingVolumeMountMode
+
+	// DeviceType is the type of the device. Only "shared" is currently
+	// defined by the Open Service Broker API.
+	DeviceType ServiceBindingVolumeDeviceType
+
+	// Device is the device object to mount.
+	Device ServiceBindingVolumeDevice
+}
+
+// ServiceBindingVolumeMountMode is the mode in which a volume is mounted.
+type ServiceBindingVolumeMountMode string
+
+const (
+	// ServiceBindingVolumeMountModeReadOnly mounts the volume read-only.
+	ServiceBindingVolumeMountModeReadOnly ServiceBindingVolumeMountMode = "r"
+
+	// ServiceBindingVolumeMountModeReadWrite mounts the volume read-write.
+	ServiceBindingVolumeMountModeReadWrite ServiceBindingVolumeMountMode = "rw"
+)
+
+// ServiceBindingVolumeDeviceType is the type of a volume device.
+type ServiceBindingVolumeDeviceType string
+
+const (
+	// ServiceBindingVolumeDeviceTypeShared is a device which can be mounted
+	// by multiple applications at the same time.
+	ServiceBindingVolumeDeviceTypeShared ServiceBindingVolumeDeviceType = "shared"
+)
+
+// ServiceBindingVolumeDevice is a device returned in a volume mount.
+type ServiceBindingVolumeDevice struct {
+	// VolumeID is the ID of the shared volume to mount.
+	VolumeID string
+
+	// MountConfig is the configuration object passed to the volume driver
+	// when mounting the volume.
+	MountConfig *runtime.RawExtension
+}
+
 // ServiceBindingCondition condition information for a ServiceBinding.
 type ServiceBindingCondition struct {
 	// Type of the condition, currently ('Ready').
@@ -1189,6 +1518,11 @@ type ServiceBindingCondition struct {
 	// Message is a human readable description of the details of the last
 	// transition, complementing reason.
 	Message string
+
+	// ErrorCode is a machine readable classification of the failed request
+	// to the broker the condition reports. It is empty when the condition
+	// does not report a failed request.
+	ErrorCode string
 }
 
 // ServiceBindingConditionType represents a ServiceBindingCondition value.
@@ -1201,6 +1535,16 @@ const (
 	// ServiceBindingConditionFailed represents a ServiceBindingCondition that has failed
 	// completely and should not be retried.
 	ServiceBindingConditionFailed ServiceBindingConditionType = "Failed"
+
+	// ServiceBindingConditionCredentialsStale represents a ServiceBindingCondition
+	// set when the plan of the bound instance changed after the binding was
+	// created, so its credentials may no longer be valid.
+	ServiceBindingConditionCredentialsStale ServiceBindingConditionType = "CredentialsStale"
+
+	// ServiceBindingConditionPlanNotBindable represents a ServiceBindingCondition
+	// set when the plan of the bound instance is no longer bindable according
+	// to the catalog of its broker.
+	ServiceBindingConditionPlanNotBindable ServiceBindingConditionType = "PlanNotBindable"
 )
 
 // ServiceBindingOperation represents a type of operation
@@ -1257,12 +1601,53 @@ const (
 	ServiceBindingUnbindStatusFailed ServiceBindingUnbindStatus = "Failed"
 )
 
+// GeneratedParameters describes the parameters of a ServiceInstance whose
+// values are generated by the controller.
+type GeneratedParameters struct {
+	// SecretName is the name of the Secret, in the namespace of the
+	// ServiceInstance, storing the generated values keyed by parameter name.
+	// The controller creates the Secret, owned by the ServiceInstance, and
+	// only generates the values missing from it.
+	SecretName string
+
+	// Parameters are the parameters to generate a value for.
+	Parameters []GeneratedParameter
+}
+
+// GeneratedParameter describes a parameter whose value is a random string.
+type GeneratedParameter struct {
+	// Name is the name of the top-level parameter, and of the key of the
+	// Secret storing its value.
+	Name string
+
+	// Length is the number of characters of the value. Defaults to 32.
+	// +optional
+	Length int32
+
+	// Charset is the set of characters the value is made of. Defaults to
+	// the ASCII letters and digits.
+	// +optional
+	Charset string
+}
+
 // ParametersFromSource represents the source of a set of Parameters
 type ParametersFromSource struct {
 	// The Secret key to select from.
-	// The value must be a JSON object.
+	// The value must be a JSON object, unless ParameterName is set.
 	// +optional
 	SecretKeyRef *SecretKeyReference
+
+	// URLRef references a document fetched over HTTP(S) whenever the
+	// parameters are sent to the broker.
+	// The document must be a JSON object, unless ParameterName is set.
+	// +optional
+	URLRef *URLReference
+
+	// ParameterName is the name of the parameter the value of the source is
+	// assigned to. If set, the value is passed as a single string parameter
+	// instead of being parsed as a JSON object of parameters.
+	// +optional
+	ParameterName string
 }
 
 // SecretKeyReference references a key of a Secret.
@@ -1273,6 +1658,18 @@ type SecretKeyReference struct {
 	Key string
 }
 
+// URLReference references a document served over HTTP(S).
+type URLReference struct {
+	// URL is the http or https URL of the document.
+	URL string
+
+	// BearerTokenSecretKeyRef references the key of a Secret, in the
+	// namespace of the resource, holding a token sent as the bearer token of
+	// the request.
+	// +optional
+	BearerTokenSecretKeyRef *SecretKeyReference
+}
+
 // ObjectReference contains enough information to let you locate the
 // referenced object.
 type ObjectReference struct {
@@ -1296,6 +1693,20 @@ type ClusterObjectReference struct {
 	Name string
 }
 
+// SecretKeyFormat is a naming convention for the keys of the Secret of a
+// ServiceBinding.
+type SecretKeyFormat string
+
+const (
+	// SecretKeyFormatUpperSnakeCase converts the keys to upper snake case,
+	// e.g. "dbUser" and "db-user" both become "DB_USER".
+	SecretKeyFormatUpperSnakeCase SecretKeyFormat = "UpperSnakeCase"
+
+	// SecretKeyFormatCamelCase converts the keys to camel case, e.g.
+	// "DB_USER" and "db-user" both become "dbUser".
+	SecretKeyFormatCamelCase SecretKeyFormat = "CamelCase"
+)
+
 // SecretTransform is a single transformation of the credentials returned
 // from the broker
 type SecretTransform struct {
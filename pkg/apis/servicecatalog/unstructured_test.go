@@ -103,6 +103,10 @@ func doUnstructuredRoundTrip(t *testing.T, group testapi.TestGroup, kind string)
 			c.FuzzNoCustom(ps)
 			ps.Parameters = nil
 		},
+		func(vd *servicecatalog.ServiceBindingVolumeDevice, c fuzz.Continue) {
+			c.FuzzNoCustom(vd)
+			vd.MountConfig = nil
+		},
 	).Fuzz(internalObj)
 
 	item, err := api.Scheme.New(group.GroupVersion().WithKind(kind))
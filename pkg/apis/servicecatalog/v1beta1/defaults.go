@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	"strings"
+
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -44,3 +46,26 @@ func SetDefaults_ServiceBinding(binding *ServiceBinding) {
 		binding.Spec.SecretName = binding.Name
 	}
 }
+
+// SetDefaults_PlanReference normalizes whichever plan reference form is set by
+// trimming surrounding whitespace, so that the same class or plan is always
+// resolved regardless of how the reference was written. It never fills in a
+// form that was not set; references without one are rejected by validation.
+func SetDefaults_PlanReference(ref *PlanReference) {
+	for _, field := range []*string{
+		&ref.ClusterServiceClassExternalName,
+		&ref.ClusterServicePlanExternalName,
+		&ref.ClusterServiceClassExternalID,
+		&ref.ClusterServicePlanExternalID,
+		&ref.ClusterServiceClassName,
+		&ref.ClusterServicePlanName,
+		&ref.ServiceClassExternalName,
+		&ref.ServicePlanExternalName,
+		&ref.ServiceClassExternalID,
+		&ref.ServicePlanExternalID,
+		&ref.ServiceClassName,
+		&ref.ServicePlanName,
+	} {
+		*field = strings.TrimSpace(*field)
+	}
+}
@@ -19,9 +19,11 @@ package v1beta1_test
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	fuzz "github.com/google/gofuzz"
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
 	_ "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/install"
@@ -117,3 +119,117 @@ func TestSetDefaultClusterServiceBroker(t *testing.T) {
 		}
 	}
 }
+
+func TestSetDefaultServiceInstancePlanReference(t *testing.T) {
+	cases := []struct {
+		name     string
+		ref      versioned.PlanReference
+		expected versioned.PlanReference
+	}{
+		{
+			name: "no reference set",
+		},
+		{
+			name: "external names",
+			ref: versioned.PlanReference{
+				ClusterServiceClassExternalName: " class ",
+				ClusterServicePlanExternalName:  "plan\n",
+			},
+			expected: versioned.PlanReference{
+				ClusterServiceClassExternalName: "class",
+				ClusterServicePlanExternalName:  "plan",
+			},
+		},
+		{
+			name: "external IDs",
+			ref: versioned.PlanReference{
+				ServiceClassExternalID: "\tclass-id",
+				ServicePlanExternalID:  "plan-id",
+			},
+			expected: versioned.PlanReference{
+				ServiceClassExternalID: "class-id",
+				ServicePlanExternalID:  "plan-id",
+			},
+		},
+		{
+			name: "kubernetes names",
+			ref: versioned.PlanReference{
+				ClusterServiceClassName: "class ",
+			},
+			expected: versioned.PlanReference{
+				ClusterServiceClassName: "class",
+			},
+		},
+		{
+			name: "only whitespace",
+			ref: versioned.PlanReference{
+				ServiceClassName: "  ",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		instance := &versioned.ServiceInstance{}
+		instance.Spec.PlanReference = tc.ref
+		o := roundTrip(t, runtime.Object(instance))
+		actual := o.(*versioned.ServiceInstance).Spec.PlanReference
+
+		if !reflect.DeepEqual(tc.expected, actual) {
+			t.Errorf("%v: unexpected plan reference: expected %+v, got %+v", tc.name, tc.expected, actual)
+		}
+	}
+}
+
+func TestSetDefaultPlanReferenceIdempotent(t *testing.T) {
+	// Pad some of the fuzzed values with whitespace and leave others unset,
+	// so all forms and combinations of forms are exercised.
+	f := fuzz.New().NilChance(0).Funcs(func(s *string, c fuzz.Continue) {
+		switch c.Intn(4) {
+		case 0:
+			*s = ""
+		case 1:
+			*s = " " + c.RandString() + "\t"
+		default:
+			*s = c.RandString()
+		}
+	})
+
+	for i := 0; i < 100; i++ {
+		ref := &versioned.PlanReference{}
+		f.Fuzz(ref)
+		original := *ref
+
+		versioned.SetDefaults_PlanReference(ref)
+		defaulted := *ref
+
+		assertPlanReferenceNormalized(t, original, defaulted)
+
+		internal := &servicecatalog.PlanReference{}
+		if err := api.Scheme.Convert(ref, internal, nil); err != nil {
+			t.Fatalf("unable to convert to internal: %v", err)
+		}
+		converted := &versioned.PlanReference{}
+		if err := api.Scheme.Convert(internal, converted, nil); err != nil {
+			t.Fatalf("unable to convert from internal: %v", err)
+		}
+		versioned.SetDefaults_PlanReference(converted)
+
+		if !reflect.DeepEqual(defaulted, *converted) {
+			t.Fatalf("defaulting and conversion are not idempotent: expected %+v, got %+v", defaulted, *converted)
+		}
+	}
+}
+
+func assertPlanReferenceNormalized(t *testing.T, original, defaulted versioned.PlanReference) {
+	o, d := reflect.ValueOf(original), reflect.ValueOf(defaulted)
+	for i := 0; i < o.NumField(); i++ {
+		name := o.Type().Field(i).Name
+		in, out := o.Field(i).String(), d.Field(i).String()
+		if e := strings.TrimSpace(in); e != out {
+			t.Fatalf("%s: expected %q, got %q", name, e, out)
+		}
+		if in == "" && out != "" {
+			t.Fatalf("%s: defaulting set a reference form that was not set: %q", name, out)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"strconv"
+
+	"github.com/pborman/uuid"
+)
+
+// HasStableExternalID returns whether the external ID of the instance is
+// derived from its namespace and name.
+func (i *ServiceInstance) HasStableExternalID() bool {
+	return i.Annotations[ServiceInstanceStableExternalIDAnnotation] == "true"
+}
+
+// StableExternalID returns the external ID of an instance with the
+// ServiceInstanceStableExternalIDAnnotation. It is a name based UUID of the
+// namespace and name of the instance, so an instance recreated with the same
+// name gets the external ID the broker already knows.
+func StableExternalID(namespace, name string) string {
+	return uuid.NewSHA1(uuid.NameSpace_URL, []byte("servicecatalog.k8s.io/serviceinstances/"+namespace+"/"+name)).String()
+}
+
+// ReconcilePriority returns the priority of the instance in the queue of the
+// controller, set by the ServiceInstanceReconcilePriorityAnnotation. It is 0
+// when the annotation is not set or is not a valid priority.
+func (i *ServiceInstance) ReconcilePriority() int32 {
+	priority, err := strconv.ParseInt(i.Annotations[ServiceInstanceReconcilePriorityAnnotation], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(priority)
+}
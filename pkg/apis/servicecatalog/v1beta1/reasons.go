@@ -0,0 +1,334 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// The reasons set by the controller on the conditions of the Service Catalog
+// resources, and on the events it records for them. Their values are stable,
+// so that tooling can rely on them instead of parsing condition messages.
+
+// Reasons shared by the conditions of several kinds of resources.
+const (
+	// ReasonErrorReconciliationRetryTimeout means the controller stopped
+	// retrying an operation because it has been failing for longer than the
+	// reconciliation retry duration.
+	ReasonErrorReconciliationRetryTimeout = "ErrorReconciliationRetryTimeout"
+	// ReasonErrorWithParameters means the parameters of an instance or a
+	// binding could not be built, for example from a missing secret.
+	ReasonErrorWithParameters = "ErrorWithParameters"
+	// ReasonErrorWithOriginatingIdentity means the originating identity
+	// header could not be built for a request to the broker.
+	ReasonErrorWithOriginatingIdentity = "ErrorWithOriginatingIdentity"
+	// ReasonErrorAsyncOperationInProgress means a change was requested
+	// while an asynchronous operation is still in progress.
+	ReasonErrorAsyncOperationInProgress = "ErrorAsyncOperationInProgress"
+	// ReasonErrorPollingLastOperation means the last operation endpoint of
+	// the broker could not be polled or reported a failure.
+	ReasonErrorPollingLastOperation = "ErrorPollingLastOperation"
+	// ReasonErrorFindingNamespaceForInstance means the namespace of an
+	// instance, or of the instance of a binding, could not be retrieved.
+	ReasonErrorFindingNamespaceForInstance = "ErrorFindingNamespaceForInstance"
+	// ReasonReferencesNonexistentServiceClass means the class referred to
+	// does not exist.
+	ReasonReferencesNonexistentServiceClass = "ReferencesNonexistentServiceClass"
+	// ReasonReferencesNonexistentServicePlan means the plan referred to does
+	// not exist.
+	ReasonReferencesNonexistentServicePlan = "ReferencesNonexistentServicePlan"
+	// ReasonReferencesNonexistentBroker means the broker of the class
+	// referred to does not exist.
+	ReasonReferencesNonexistentBroker = "ReferencesNonexistentBroker"
+	// ReasonOrphanMitigationSuccessful means the resource left behind by a
+	// failed provision or bind request was removed from the broker.
+	ReasonOrphanMitigationSuccessful = "OrphanMitigationSuccessful"
+	// ReasonOrphanMitigationFailed means the resource left behind by a
+	// failed provision or bind request could not be removed from the broker.
+	ReasonOrphanMitigationFailed = "OrphanMitigationFailed"
+	// ReasonRetryBackoff means the controller waits before retrying a
+	// failed operation.
+	ReasonRetryBackoff = "RetryBackoff"
+)
+
+// Reasons of the conditions of ServiceInstances.
+const (
+	// ReasonProvisionedSuccessfully means the instance was provisioned.
+	ReasonProvisionedSuccessfully = "ProvisionedSuccessfully"
+	// ReasonInstanceUpdatedSuccessfully means the instance was updated.
+	ReasonInstanceUpdatedSuccessfully = "InstanceUpdatedSuccessfully"
+	// ReasonDeprovisionedSuccessfully means the instance was deprovisioned.
+	ReasonDeprovisionedSuccessfully = "DeprovisionedSuccessfully"
+	// ReasonProvisioning means the broker provisions the instance
+	// asynchronously.
+	ReasonProvisioning = "Provisioning"
+	// ReasonUpdatingInstance means the broker updates the instance
+	// asynchronously.
+	ReasonUpdatingInstance = "UpdatingInstance"
+	// ReasonDeprovisioning means the broker deprovisions the instance
+	// asynchronously.
+	ReasonDeprovisioning = "Deprovisioning"
+	// ReasonProvisionRequestInFlight means a provision request is being sent
+	// to the broker.
+	ReasonProvisionRequestInFlight = "ProvisionRequestInFlight"
+	// ReasonUpdateInstanceRequestInFlight means an update request is being
+	// sent to the broker.
+	ReasonUpdateInstanceRequestInFlight = "UpdateInstanceRequestInFlight"
+	// ReasonDeprovisionRequestInFlight means a deprovision request is being
+	// sent to the broker.
+	ReasonDeprovisionRequestInFlight = "DeprovisionRequestInFlight"
+	// ReasonProvisionCallFailed means the broker answered the provision
+	// request with an error.
+	ReasonProvisionCallFailed = "ProvisionCallFailed"
+	// ReasonErrorCallingProvision means the provision request could not be
+	// sent to the broker, or its response could not be read.
+	ReasonErrorCallingProvision = "ErrorCallingProvision"
+	// ReasonUpdateInstanceCallFailed means the broker answered the update
+	// request with an error.
+	ReasonUpdateInstanceCallFailed = "UpdateInstanceCallFailed"
+	// ReasonErrorCallingUpdateInstance means the update request could not be
+	// sent to the broker, or its response could not be read.
+	ReasonErrorCallingUpdateInstance = "ErrorCallingUpdateInstance"
+	// ReasonDeprovisionCallFailed means the deprovision request failed.
+	ReasonDeprovisionCallFailed = "DeprovisionCallFailed"
+	// ReasonDeprovisionBlockedByExistingCredentials means the instance is
+	// not deprovisioned while bindings refer to it.
+	ReasonDeprovisionBlockedByExistingCredentials = "DeprovisionBlockedByExistingCredentials"
+	// ReasonClusterServiceBrokerReturnedFailure means the broker answered the
+	// provision request with an error which is not retried.
+	ReasonClusterServiceBrokerReturnedFailure = "ClusterServiceBrokerReturnedFailure"
+	// ReasonReferencesDeletedServiceClass means the class of the instance
+	// was removed from the catalog of its broker.
+	ReasonReferencesDeletedServiceClass = "ReferencesDeletedServiceClass"
+	// ReasonReferencesDeletedServicePlan means the plan of the instance was
+	// removed from the catalog of its broker.
+	ReasonReferencesDeletedServicePlan = "ReferencesDeletedServicePlan"
+	// ReasonInvalidDeprovisionStatus means the deprovision status of the
+	// instance is not a known one.
+	ReasonInvalidDeprovisionStatus = "InvalidDeprovisionStatus"
+	// ReasonInvalidDashboardURL means the broker returned a dashboard URL
+	// which is not a valid URL.
+	ReasonInvalidDashboardURL = "InvalidDashboardURL"
+	// ReasonInvalidExtensionAPI means the broker returned an extension API
+	// whose URLs are not valid.
+	ReasonInvalidExtensionAPI = "InvalidExtensionAPI"
+	// ReasonOperationStuck means an operation on the instance or binding has
+	// been in progress for longer than expected.
+	ReasonOperationStuck = "OperationStuck"
+	// ReasonStartingInstanceOrphanMitigation means a deprovision request is
+	// sent after a provision request failed ambiguously.
+	ReasonStartingInstanceOrphanMitigation = "StartingInstanceOrphanMitigation"
+	// ReasonOrphanMitigationAttemptsExceeded means orphan mitigation was
+	// given up after the maximum number of deprovision requests.
+	ReasonOrphanMitigationAttemptsExceeded = "OrphanMitigationAttemptsExceeded"
+	// ReasonResumingInstanceOrphanMitigation means orphan mitigation which
+	// ran out of retries is resumed because its broker is reachable again.
+	ReasonResumingInstanceOrphanMitigation = "ResumingInstanceOrphanMitigation"
+	// ReasonTTLAfterFailureExpired means the instance is deleted because it
+	// failed longer ago than its ttlSecondsAfterFailure.
+	ReasonTTLAfterFailureExpired = "TTLAfterFailureExpired"
+	// ReasonTTLAfterReadyExpired means the instance is deleted because it
+	// became ready longer ago than its ttlSecondsAfterReady.
+	ReasonTTLAfterReadyExpired = "TTLAfterReadyExpired"
+	// ReasonPlanSchemaChanged means the parameters of the instance do not
+	// comply with the changed parameter schema of its plan.
+	ReasonPlanSchemaChanged = "PlanSchemaChanged"
+	// ReasonDefaultParametersChanged means the default provisioning
+	// parameters of the instance have changed since they were applied.
+	ReasonDefaultParametersChanged = "DefaultParametersChanged"
+)
+
+// Reasons of the conditions of ServiceBindings.
+const (
+	// ReasonInjectedBindResult means the credentials returned by the broker
+	// were written to the secret of the binding.
+	ReasonInjectedBindResult = "InjectedBindResult"
+	// ReasonUnboundSuccessfully means the binding was unbound.
+	ReasonUnboundSuccessfully = "UnboundSuccessfully"
+	// ReasonBinding means the broker binds asynchronously.
+	ReasonBinding = "Binding"
+	// ReasonUnbinding means the broker unbinds asynchronously.
+	ReasonUnbinding = "Unbinding"
+	// ReasonBindingRequestInFlight means a bind request is being sent to the
+	// broker.
+	ReasonBindingRequestInFlight = "BindingRequestInFlight"
+	// ReasonUnbindingRequestInFlight means an unbind request is being sent to
+	// the broker.
+	ReasonUnbindingRequestInFlight = "UnbindingRequestInFlight"
+	// ReasonBindCallFailed means the bind request failed.
+	ReasonBindCallFailed = "BindCallFailed"
+	// ReasonUnbindCallFailed means the unbind request failed.
+	ReasonUnbindCallFailed = "UnbindCallFailed"
+	// ReasonServiceBindingReturnedFailure means the broker answered the bind
+	// request with an error which is not retried.
+	ReasonServiceBindingReturnedFailure = "ServiceBindingReturnedFailure"
+	// ReasonBindRequiresApp means the broker only supports bindings for an
+	// application and rejected the bind request with the RequiresApp error.
+	ReasonBindRequiresApp = "BindRequiresApp"
+	// ReasonFetchingBindingFailed means the binding of an asynchronous bind
+	// operation could not be retrieved from the broker.
+	ReasonFetchingBindingFailed = "FetchingBindingFailed"
+	// ReasonAsyncOperationTimeout means an asynchronous operation did not
+	// complete in time.
+	ReasonAsyncOperationTimeout = "AsyncOperationTimeout"
+	// ReasonErrorInjectingBindResult means the credentials returned by the
+	// broker could not be written to the secret of the binding.
+	ReasonErrorInjectingBindResult = "ErrorInjectingBindResult"
+	// ReasonErrorEjectingServiceBinding means the secret of the binding could
+	// not be deleted.
+	ReasonErrorEjectingServiceBinding = "ErrorEjectingServiceBinding"
+	// ReasonReferencesNonexistentInstance means the instance of the binding
+	// does not exist.
+	ReasonReferencesNonexistentInstance = "ReferencesNonexistentInstance"
+	// ReasonErrorNonbindableServiceClass means the class or plan of the
+	// instance is not bindable.
+	ReasonErrorNonbindableServiceClass = "ErrorNonbindableServiceClass"
+	// ReasonErrorInstanceRefsUnresolved means the class and plan of the
+	// instance have not been resolved yet.
+	ReasonErrorInstanceRefsUnresolved = "ErrorInstanceRefsUnresolved"
+	// ReasonErrorInstanceNotReady means the instance is not ready yet.
+	ReasonErrorInstanceNotReady = "ErrorInstanceNotReady"
+	// ReasonServiceBindingNeedsOrphanMitigation means an unbind request is
+	// sent after a bind request failed ambiguously.
+	ReasonServiceBindingNeedsOrphanMitigation = "ServiceBindingNeedsOrphanMitigation"
+	// ReasonSecretCopyForbidden means the secret of the binding may not be
+	// copied to the namespaces it asks for.
+	ReasonSecretCopyForbidden = "SecretCopyForbidden"
+	// ReasonInvalidVolumeMounts means the broker returned invalid volume
+	// mounts.
+	ReasonInvalidVolumeMounts = "InvalidVolumeMounts"
+	// ReasonInvalidRouteServiceURL means the broker returned a route service
+	// URL which is not a valid URL.
+	ReasonInvalidRouteServiceURL = "InvalidRouteServiceURL"
+	// ReasonInstancePlanChanged means the plan of the instance changed after
+	// the credentials of the binding were issued.
+	ReasonInstancePlanChanged = "InstancePlanChanged"
+	// ReasonPlanNotBindable means the plan of the instance became
+	// non-bindable after the binding was created.
+	ReasonPlanNotBindable = "PlanNotBindable"
+	// ReasonInstancePaused means the reconciliation of the instance, and so
+	// of its bindings, is paused.
+	ReasonInstancePaused = "InstancePaused"
+	// ReasonSecretDeleted means the secret of the binding was deleted.
+	ReasonSecretDeleted = "SecretDeleted"
+)
+
+// Reasons of the conditions of ClusterServiceBrokers and ServiceBrokers.
+const (
+	// ReasonFetchedCatalog means the catalog of the broker was fetched and
+	// its classes and plans were synchronized.
+	ReasonFetchedCatalog = "FetchedCatalog"
+	// ReasonErrorFetchingCatalog means the catalog of the broker could not
+	// be fetched.
+	ReasonErrorFetchingCatalog = "ErrorFetchingCatalog"
+	// ReasonErrorSyncingCatalog means the classes and plans of the catalog
+	// could not be synchronized.
+	ReasonErrorSyncingCatalog = "ErrorSyncingCatalog"
+	// ReasonCatalogTooLarge means the catalog of the broker has more classes
+	// and plans than allowed.
+	ReasonCatalogTooLarge = "CatalogTooLarge"
+	// ReasonErrorGettingAuthCredentials means the credentials to authenticate
+	// to the broker could not be read.
+	ReasonErrorGettingAuthCredentials = "ErrorGettingAuthCredentials"
+	// ReasonInsecureSkipTLSVerify means the TLS certificate of the broker is
+	// not verified.
+	ReasonInsecureSkipTLSVerify = "InsecureSkipTLSVerify"
+	// ReasonInsecureSkipTLSVerifyNotAllowed means the broker asks to skip
+	// the verification of its TLS certificate, which the controller does
+	// not allow.
+	ReasonInsecureSkipTLSVerifyNotAllowed = "InsecureSkipTLSVerifyNotAllowed"
+	// ReasonTLSVerificationEnabled means the TLS certificate of the broker is
+	// verified.
+	ReasonTLSVerificationEnabled = "TLSVerificationEnabled"
+	// ReasonBrokerDeprecated means the broker announced the deprecation or
+	// end of life of services in its catalog.
+	ReasonBrokerDeprecated = "BrokerDeprecated"
+	// ReasonBrokerNotDeprecated means the catalog of the broker no longer
+	// announces any deprecation.
+	ReasonBrokerNotDeprecated = "BrokerNotDeprecated"
+	// ReasonMalformedCatalogEntriesSkipped means malformed classes or plans
+	// of the catalog of the broker were skipped while the rest of the
+	// catalog was synchronized.
+	ReasonMalformedCatalogEntriesSkipped = "MalformedCatalogEntriesSkipped"
+	// ReasonUnsupportedParameterSchema means a parameter schema of a plan
+	// of the catalog of the broker declares a JSON Schema draft which is not
+	// supported. The plan is synchronized nonetheless.
+	ReasonUnsupportedParameterSchema = "UnsupportedParameterSchema"
+	// ReasonNoMalformedCatalogEntries means the catalog of the broker no
+	// longer has malformed classes or plans.
+	ReasonNoMalformedCatalogEntries = "NoMalformedCatalogEntries"
+	// ReasonErrorListingClusterServiceClasses means the classes of the
+	// cluster broker could not be listed.
+	ReasonErrorListingClusterServiceClasses = "ErrorListingClusterServiceClasses"
+	// ReasonErrorListingClusterServicePlans means the plans of the cluster
+	// broker could not be listed.
+	ReasonErrorListingClusterServicePlans = "ErrorListingClusterServicePlans"
+	// ReasonErrorDeletingClusterServiceClass means a class of the deleted
+	// cluster broker could not be deleted.
+	ReasonErrorDeletingClusterServiceClass = "ErrorDeletingClusterServiceClass"
+	// ReasonErrorDeletingClusterServicePlan means a plan of the deleted
+	// cluster broker could not be deleted.
+	ReasonErrorDeletingClusterServicePlan = "ErrorDeletingClusterServicePlan"
+	// ReasonDeletedClusterServiceBrokerSuccessfully means the classes and
+	// plans of the deleted cluster broker were deleted.
+	ReasonDeletedClusterServiceBrokerSuccessfully = "DeletedClusterServiceBrokerSuccessfully"
+	// ReasonErrorListingServiceClasses means the classes of the namespaced
+	// broker could not be listed.
+	ReasonErrorListingServiceClasses = "ErrorListingServiceClasses"
+	// ReasonErrorListingServicePlans means the plans of the namespaced
+	// broker could not be listed.
+	ReasonErrorListingServicePlans = "ErrorListingServicePlans"
+	// ReasonErrorDeletingServiceClass means a class of the deleted namespaced
+	// broker could not be deleted.
+	ReasonErrorDeletingServiceClass = "ErrorDeletingServiceClass"
+	// ReasonErrorDeletingServicePlan means a plan of the deleted namespaced
+	// broker could not be deleted.
+	ReasonErrorDeletingServicePlan = "ErrorDeletingServicePlan"
+	// ReasonDeletedSuccessfully means the classes and plans of the deleted
+	// namespaced broker were deleted.
+	ReasonDeletedSuccessfully = "DeletedSuccessfully"
+)
+
+// The error codes set on the conditions of ServiceInstances and
+// ServiceBindings which report a failed request to a broker. They classify
+// the failure independently of the operation, named by the reason.
+const (
+	// ErrorCodeBrokerTimeout means the broker did not answer in time.
+	ErrorCodeBrokerTimeout = "BrokerTimeout"
+	// ErrorCodeBrokerUnreachable means the request could not be sent to the
+	// broker, or its response could not be read.
+	ErrorCodeBrokerUnreachable = "BrokerUnreachable"
+	// ErrorCodeBrokerBadRequest means the broker answered 400 Bad Request.
+	ErrorCodeBrokerBadRequest = "BrokerBadRequest"
+	// ErrorCodeBrokerConflict means the broker answered 409 Conflict.
+	ErrorCodeBrokerConflict = "BrokerConflict"
+	// ErrorCodeBrokerGone means the broker answered 410 Gone.
+	ErrorCodeBrokerGone = "BrokerGone"
+	// ErrorCodeBrokerUnprocessableEntity means the broker answered 422
+	// Unprocessable Entity without one of the error codes below.
+	ErrorCodeBrokerUnprocessableEntity = "BrokerUnprocessableEntity"
+	// ErrorCodeBrokerAsyncRequired means the broker only supports the
+	// operation asynchronously, the AsyncRequired OSB error.
+	ErrorCodeBrokerAsyncRequired = "BrokerAsyncRequired"
+	// ErrorCodeBrokerConcurrencyError means the broker does not support
+	// concurrent operations on the resource, the ConcurrencyError OSB error.
+	ErrorCodeBrokerConcurrencyError = "BrokerConcurrencyError"
+	// ErrorCodeBrokerRequiresApp means the broker requires an application
+	// to bind to, the RequiresApp OSB error.
+	ErrorCodeBrokerRequiresApp = "BrokerRequiresApp"
+	// ErrorCodeBrokerServerError means the broker answered with a 5xx status.
+	ErrorCodeBrokerServerError = "BrokerServerError"
+	// ErrorCodeBrokerHTTPError means the broker answered with any other error
+	// status.
+	ErrorCodeBrokerHTTPError = "BrokerHTTPError"
+)
@@ -102,6 +102,13 @@ type CommonServiceBrokerSpec struct {
 	// +optional
 	CABundle []byte `json:"caBundle,omitempty"`
 
+	// OSBAPIVersion is the version of the Open Service Broker API used to
+	// communicate with the Broker, sent in the X-Broker-API-Version header.
+	// One of "2.11", "2.12" or "2.13". Defaults to the version preferred by
+	// the controller.
+	// +optional
+	OSBAPIVersion string `json:"osbApiVersion,omitempty"`
+
 	// RelistBehavior specifies the type of relist behavior the catalog should
 	// exhibit when relisting ServiceClasses available from a broker.
 	// +optional
@@ -188,6 +195,15 @@ type ClusterServiceBrokerSpec struct {
 	// AuthInfo contains the data that the service catalog should use to authenticate
 	// with the ClusterServiceBroker.
 	AuthInfo *ClusterServiceBrokerAuthInfo `json:"authInfo,omitempty"`
+
+	// FallbackAuthInfo contains the data that the service catalog should use to
+	// authenticate with the ClusterServiceBroker when the broker rejects the
+	// credentials of AuthInfo with a 401 Unauthorized response, for example
+	// while the credentials of the broker are being rotated. Only basic and
+	// bearer authentication are supported, and AuthInfo must be set to one of
+	// them as well.
+	// +optional
+	FallbackAuthInfo *ClusterServiceBrokerAuthInfo `json:"fallbackAuthInfo,omitempty"`
 }
 
 // ServiceBrokerSpec represents a description of a Broker.
@@ -223,6 +239,9 @@ type ClusterServiceBrokerAuthInfo struct {
 	// The value is referenced from the 'token' field of the given secret.  This value should only
 	// contain the token value and not the `Bearer` scheme.
 	Bearer *ClusterBearerTokenAuthConfig `json:"bearer,omitempty"`
+	// ClusterBearerTokenFromServiceAccountAuthConfig provides configuration to send a token of a
+	// service account, obtained with the TokenRequest API, as a bearer token.
+	BearerTokenFromServiceAccount *ClusterBearerTokenFromServiceAccountAuthConfig `json:"bearerTokenFromServiceAccount,omitempty"`
 }
 
 // ClusterBasicAuthConfig provides config for the basic authentication of
@@ -248,6 +267,24 @@ type ClusterBearerTokenAuthConfig struct {
 	SecretRef *ObjectReference `json:"secretRef,omitempty"`
 }
 
+// ClusterBearerTokenFromServiceAccountAuthConfig provides config for the bearer token
+// authentication of cluster scoped brokers with service account tokens. The tokens are
+// requested for the given audience and refreshed before they expire.
+type ClusterBearerTokenFromServiceAccountAuthConfig struct {
+	// ServiceAccountRef is a reference to the ServiceAccount whose token
+	// the catalog should use to authenticate to this ClusterServiceBroker.
+	ServiceAccountRef *ObjectReference `json:"serviceAccountRef,omitempty"`
+
+	// Audience is the intended audience of the token. The broker is expected
+	// to reject tokens issued for any other audience.
+	Audience string `json:"audience,omitempty"`
+
+	// ExpirationSeconds is the requested lifetime of the token. Defaults to
+	// one hour and must be at least 10 minutes.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
 // ServiceBrokerAuthInfo is a union type that contains information on
 // one of the authentication methods the service catalog and brokers may
 // support, according to the OpenServiceBroker API specification
@@ -259,6 +296,9 @@ type ServiceBrokerAuthInfo struct {
 	// The value is referenced from the 'token' field of the given secret.  This value should only
 	// contain the token value and not the `Bearer` scheme.
 	Bearer *BearerTokenAuthConfig `json:"bearer,omitempty"`
+	// BearerTokenFromServiceAccountAuthConfig provides configuration to send a token of a
+	// service account, obtained with the TokenRequest API, as a bearer token.
+	BearerTokenFromServiceAccount *BearerTokenFromServiceAccountAuthConfig `json:"bearerTokenFromServiceAccount,omitempty"`
 }
 
 // BasicAuthConfig provides config for the basic authentication of
@@ -284,6 +324,25 @@ type BearerTokenAuthConfig struct {
 	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
 }
 
+// BearerTokenFromServiceAccountAuthConfig provides config for the bearer token
+// authentication of namespace scoped brokers with service account tokens. The tokens are
+// requested for the given audience and refreshed before they expire.
+type BearerTokenFromServiceAccountAuthConfig struct {
+	// ServiceAccountRef is a reference to the ServiceAccount, in the
+	// namespace of the ServiceBroker, whose token the catalog should use to
+	// authenticate to this ServiceBroker.
+	ServiceAccountRef *LocalObjectReference `json:"serviceAccountRef,omitempty"`
+
+	// Audience is the intended audience of the token. The broker is expected
+	// to reject tokens issued for any other audience.
+	Audience string `json:"audience,omitempty"`
+
+	// ExpirationSeconds is the requested lifetime of the token. Defaults to
+	// one hour and must be at least 10 minutes.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
 const (
 	// BasicAuthUsernameKey is the key of the username for SecretTypeBasicAuth secrets
 	BasicAuthUsernameKey = "username"
@@ -313,6 +372,27 @@ type CommonServiceBrokerStatus struct {
 	// LastConditionState aggregates state from the Conditions array
 	// It is used for printing in a kubectl output via additionalPrinterColumns
 	LastConditionState string `json:"lastConditionState"`
+
+	// Features is the list of optional Open Service Broker API features
+	// advertised in the broker's catalog when it was last fetched.
+	// +optional
+	Features []ServiceBrokerFeature `json:"features,omitempty"`
+
+	// LastCatalogClassCount is the number of classes in the broker's catalog
+	// that passed its catalog restrictions when it was last fetched.
+	// +optional
+	LastCatalogClassCount *int64 `json:"lastCatalogClassCount,omitempty"`
+
+	// LastCatalogPlanCount is the number of plans in the broker's catalog
+	// that passed its catalog restrictions when it was last fetched.
+	// +optional
+	LastCatalogPlanCount *int64 `json:"lastCatalogPlanCount,omitempty"`
+
+	// LastSuccessfulEndpoint is the URL of the broker endpoint which served
+	// the last successful catalog fetch. It lags behind the spec when the
+	// broker's URL is changed to an endpoint that cannot be reached.
+	// +optional
+	LastSuccessfulEndpoint string `json:"lastSuccessfulEndpoint,omitempty"`
 }
 
 // ClusterServiceBrokerStatus represents the current status of a
@@ -358,6 +438,33 @@ const (
 	// ServiceBrokerConditionFailed represents information about a final failure
 	// that should not be retried.
 	ServiceBrokerConditionFailed ServiceBrokerConditionType = "Failed"
+
+	// ServiceBrokerConditionInsecureSkipTLSVerify warns that TLS certificate
+	// verification is skipped when communicating with the broker.
+	ServiceBrokerConditionInsecureSkipTLSVerify ServiceBrokerConditionType = "InsecureSkipTLSVerify"
+
+	// ServiceBrokerConditionDeprecated warns that the broker announced the
+	// deprecation or end of life of services in its catalog.
+	ServiceBrokerConditionDeprecated ServiceBrokerConditionType = "Deprecated"
+
+	// ServiceBrokerConditionMalformedCatalogEntries warns that classes or
+	// plans of the catalog of the broker were skipped for being malformed.
+	ServiceBrokerConditionMalformedCatalogEntries ServiceBrokerConditionType = "MalformedCatalogEntries"
+)
+
+// ServiceBrokerFeature is an optional Open Service Broker API feature that a
+// broker advertises in its catalog.
+type ServiceBrokerFeature string
+
+const (
+	// ServiceBrokerFeatureAsyncBindings means that at least one service of
+	// the broker supports fetching bindings, which is required for
+	// asynchronous binding operations.
+	ServiceBrokerFeatureAsyncBindings ServiceBrokerFeature = "AsyncBindings"
+
+	// ServiceBrokerFeaturePlanUpdates means that at least one service of the
+	// broker supports changing the plan of its instances.
+	ServiceBrokerFeaturePlanUpdates ServiceBrokerFeature = "PlanUpdates"
 )
 
 // ConditionStatus represents a condition's status.
@@ -679,6 +786,23 @@ type CommonServicePlanStatus struct {
 	// RemovedFromBrokerCatalog indicates that the broker removed the plan
 	// from its catalog.
 	RemovedFromBrokerCatalog bool `json:"removedFromBrokerCatalog"`
+
+	// Costs are the costs of the plan, as reported by the broker in the
+	// "costs" field of the plan's external metadata.
+	// +optional
+	Costs []ServicePlanCost `json:"costs,omitempty"`
+}
+
+// ServicePlanCost is a cost of a plan, as described by the Open Service
+// Broker API metadata conventions.
+type ServicePlanCost struct {
+	// Amount maps lowercase currency codes, such as "usd", to the amount
+	// charged in that currency.
+	Amount map[string]string `json:"amount"`
+
+	// Unit is what the amount is charged for, such as "MONTHLY" or
+	// "PER 1GB".
+	Unit string `json:"unit"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -869,6 +993,13 @@ type ServiceInstanceSpec struct {
 	// Specification of what ServiceClass/ServicePlan is being provisioned.
 	PlanReference `json:",inline"`
 
+	// ClusterServiceBrokerName is the name of the ClusterServiceBroker whose
+	// ClusterServiceClass and ClusterServicePlan should be resolved. It is
+	// only needed when more than one broker offers a matching class.
+	//
+	// +optional
+	ClusterServiceBrokerName string `json:"clusterServiceBrokerName,omitempty"`
+
 	// ClusterServiceClassRef is a reference to the ClusterServiceClass
 	// that the user selected. This is set by the controller based on the
 	// cluster-scoped values specified in the PlanReference.
@@ -908,6 +1039,14 @@ type ServiceInstanceSpec struct {
 	// +optional
 	ParametersFrom []ParametersFromSource `json:"parametersFrom,omitempty"`
 
+	// GeneratedParameters are parameters whose values are generated by the
+	// controller, for example passwords, instead of being supplied by the
+	// user. The values are stored in a Secret and are only generated once.
+	// A top-level parameter name existing in another source is considered
+	// to be a user error in the specification.
+	// +optional
+	GeneratedParameters *GeneratedParameters `json:"generatedParameters,omitempty"`
+
 	// ExternalID is the identity of this object for use with the OSB SB API.
 	//
 	// Immutable.
@@ -929,6 +1068,22 @@ type ServiceInstanceSpec struct {
 	// been made to the secrets from which the parameters are sourced.
 	// +optional
 	UpdateRequests int64 `json:"updateRequests"`
+
+	// TTLSecondsAfterFailure limits the lifetime of an instance that failed
+	// to provision. If set, the instance is deleted once it has been in the
+	// terminally failed state for this many seconds. It only applies to
+	// instances that were never provisioned and that do not require
+	// deprovisioning on the broker side.
+	// +optional
+	TTLSecondsAfterFailure *int64 `json:"ttlSecondsAfterFailure,omitempty"`
+
+	// TTLSecondsAfterReady limits the lifetime of a provisioned instance,
+	// for instance in ephemeral test environments. If set, the instance and
+	// its bindings are deleted once the instance has been ready for this
+	// many seconds. The bindings are unbound and the instance deprovisioned
+	// as when they are deleted by the user.
+	// +optional
+	TTLSecondsAfterReady *int64 `json:"ttlSecondsAfterReady,omitempty"`
 }
 
 // ServiceInstanceStatus represents the current status of an Instance.
@@ -945,6 +1100,11 @@ type ServiceInstanceStatus struct {
 	// mitigation operation against this ServiceInstance in progress.
 	OrphanMitigationInProgress bool `json:"orphanMitigationInProgress"`
 
+	// OrphanMitigationAttempts is the number of deprovision requests sent to
+	// the broker by the ongoing orphan mitigation.
+	// +optional
+	OrphanMitigationAttempts int64 `json:"orphanMitigationAttempts,omitempty"`
+
 	// LastOperation is the string that the broker may have returned when
 	// an async operation started, it should be sent back to the broker
 	// on poll requests as a query param.
@@ -954,6 +1114,11 @@ type ServiceInstanceStatus struct {
 	// the service instance.
 	DashboardURL *string `json:"dashboardURL,omitempty"`
 
+	// ExtensionAPIs are the APIs, beyond the Open Service Broker API, that
+	// the broker offers for the service instance, as returned in its
+	// provision response.
+	ExtensionAPIs []ExtensionAPI `json:"extensionAPIs,omitempty"`
+
 	// CurrentOperation is the operation the Controller is currently performing
 	// on the ServiceInstance.
 	CurrentOperation ServiceInstanceOperation `json:"currentOperation,omitempty"`
@@ -992,6 +1157,24 @@ type ServiceInstanceStatus struct {
 	// instance.
 	DefaultProvisionParameters *runtime.RawExtension `json:"defaultProvisionParameters,omitempty"`
 
+	// BindingCount is the number of ServiceBindings that refer to the
+	// ServiceInstance, including those being deleted. It is maintained by
+	// the controller and may briefly lag behind the bindings that exist.
+	// +optional
+	BindingCount int64 `json:"bindingCount,omitempty"`
+
+	// LastProvisionDuration is how long the last successful provision of the
+	// ServiceInstance took, from the start of the provision operation until
+	// the broker reported its completion, including any polling of an
+	// asynchronous operation.
+	// +optional
+	LastProvisionDuration *metav1.Duration `json:"lastProvisionDuration,omitempty"`
+
+	// LastUpdateDuration is how long the last successful update of the
+	// ServiceInstance took, measured in the same way as LastProvisionDuration.
+	// +optional
+	LastUpdateDuration *metav1.Duration `json:"lastUpdateDuration,omitempty"`
+
 	// LastConditionState aggregates state from the Conditions array
 	// It is used for printing in a kubectl output via additionalPrinterColumns
 	LastConditionState string `json:"lastConditionState"`
@@ -1003,6 +1186,16 @@ type ServiceInstanceStatus struct {
 	// UserSpecifiedClassName aggregates cluster or namespace ClassName
 	// It is used for printing in a kubectl output via additionalPrinterColumns
 	UserSpecifiedClassName string `json:"userSpecifiedClassName"`
+
+	// ResolvedClassExternalName is the external name of the class the
+	// instance refers to, whichever form of reference is used in the spec.
+	// +optional
+	ResolvedClassExternalName string `json:"resolvedClassExternalName,omitempty"`
+
+	// ResolvedPlanExternalName is the external name of the plan the
+	// instance refers to, whichever form of reference is used in the spec.
+	// +optional
+	ResolvedPlanExternalName string `json:"resolvedPlanExternalName,omitempty"`
 }
 
 // ServiceInstanceCondition contains condition information about an Instance.
@@ -1024,6 +1217,12 @@ type ServiceInstanceCondition struct {
 	// Message is a human readable description of the details of the last
 	// transition, complementing reason.
 	Message string `json:"message"`
+
+	// ErrorCode is a machine readable classification of the failed request
+	// to the broker the condition reports, one of the ErrorCode constants.
+	// It is empty when the condition does not report a failed request.
+	// +optional
+	ErrorCode string `json:"errorCode,omitempty"`
 }
 
 // ServiceInstanceConditionType represents a instance condition value.
@@ -1041,6 +1240,16 @@ const (
 	// ServiceInstanceConditionOrphanMitigation represents information about an
 	// orphan mitigation that is required after failed provisioning.
 	ServiceInstanceConditionOrphanMitigation ServiceInstanceConditionType = "OrphanMitigation"
+
+	// ServiceInstanceConditionNonCompliantParameters represents that the
+	// parameters of an instance do not comply with the parameter schema of
+	// its plan, which has changed since the instance was provisioned.
+	ServiceInstanceConditionNonCompliantParameters ServiceInstanceConditionType = "NonCompliantParameters"
+
+	// ServiceInstanceConditionDefaultParametersChanged represents that the
+	// default provisioning parameters of an instance have changed since
+	// they were applied to its parameters.
+	ServiceInstanceConditionDefaultParametersChanged ServiceInstanceConditionType = "DefaultParametersChanged"
 )
 
 // ServiceInstanceOperation represents a type of operation the controller can
@@ -1059,6 +1268,22 @@ const (
 	ServiceInstanceOperationDeprovision ServiceInstanceOperation = "Deprovision"
 )
 
+// ExtensionAPI describes an API, beyond the Open Service Broker API, that a
+// broker offers for a ServiceInstance. The credentials the broker may return
+// for the API are not recorded.
+type ExtensionAPI struct {
+	// DiscoveryURL is the URL of an OpenAPI document describing the API.
+	DiscoveryURL string `json:"discoveryURL"`
+
+	// ServerURL is the base URL of the paths described by the OpenAPI
+	// document. If empty, the paths are relative to the URL of the broker.
+	ServerURL string `json:"serverURL,omitempty"`
+
+	// AdheresTo is the URI of a specification the OpenAPI document adheres
+	// to.
+	AdheresTo string `json:"adheresTo,omitempty"`
+}
+
 // ServiceInstancePropertiesState is the state of a ServiceInstance that
 // the ClusterServiceBroker knows about.
 type ServiceInstancePropertiesState struct {
@@ -1088,6 +1313,9 @@ type ServiceInstancePropertiesState struct {
 	// ParameterChecksum is the checksum of the parameters that were sent.
 	ParameterChecksum string `json:"parameterChecksum,omitempty"`
 
+	// ContextChecksum is the checksum of the context that was sent.
+	ContextChecksum string `json:"contextChecksum,omitempty"`
+
 	// UserInfo is information about the user that made the request.
 	UserInfo *UserInfo `json:"userInfo,omitempty"`
 }
@@ -1193,6 +1421,12 @@ type ServiceBindingSpec struct {
 	// +optional
 	ParametersFrom []ParametersFromSource `json:"parametersFrom,omitempty"`
 
+	// Endpoint is the name of the endpoint of the ServiceInstance the
+	// binding is for, when the instance exposes several of them. It is sent
+	// to the broker in the "endpoint" field of the bind request's context.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
 	// SecretName is the name of the secret to create in the ServiceBinding's
 	// namespace that will hold the credentials associated with the ServiceBinding.
 	SecretName string `json:"secretName,omitempty"`
@@ -1201,6 +1435,33 @@ type ServiceBindingSpec struct {
 	// associated with the ServiceBinding before they are inserted into the Secret.
 	SecretTransforms []SecretTransform `json:"secretTransforms,omitempty"`
 
+	// SecretKeyFormat is the naming convention all credential keys are
+	// converted to after the SecretTransforms have been applied. Keys that
+	// collide after the conversion cause the binding to fail. When empty,
+	// the keys are written to the Secret unchanged.
+	// +optional
+	SecretKeyFormat SecretKeyFormat `json:"secretKeyFormat,omitempty"`
+
+	// AdditionalSecretNamespaces is a list of namespaces, other than the
+	// ServiceBinding's namespace, into which a copy of the Secret holding
+	// the credentials should be written. The copies are kept in sync with
+	// the Secret and are deleted when the ServiceBinding is unbound.
+	// +optional
+	AdditionalSecretNamespaces []string `json:"additionalSecretNamespaces,omitempty"`
+
+	// SecretLabels are labels to set on the Secret holding the credentials
+	// and on its copies. The labels are updated every time the Secret is
+	// written.
+	// +optional
+	SecretLabels map[string]string `json:"secretLabels,omitempty"`
+
+	// SecretAnnotations are annotations to set on the Secret holding the
+	// credentials and on its copies, in addition to the annotations
+	// describing the instance, class and plan of the ServiceBinding. The
+	// annotations are updated every time the Secret is written.
+	// +optional
+	SecretAnnotations map[string]string `json:"secretAnnotations,omitempty"`
+
 	// ExternalID is the identity of this object for use with the OSB API.
 	//
 	// Immutable.
@@ -1246,6 +1507,12 @@ type ServiceBindingStatus struct {
 	// process the spec.
 	ReconciledGeneration int64 `json:"reconciledGeneration"`
 
+	// ObservedGeneration is the 'Generation' of the ServiceBindingSpec that
+	// was last processed by the controller. The observed generation is
+	// updated once an operation on the binding has finished, regardless of
+	// its result.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
 	// OperationStartTime is the time at which the current operation began.
 	OperationStartTime *metav1.Time `json:"operationStartTime,omitempty"`
 
@@ -1262,6 +1529,32 @@ type ServiceBindingStatus struct {
 	// mitigation is in progress.
 	OrphanMitigationInProgress bool `json:"orphanMitigationInProgress"`
 
+	// SecretWritePending is set to true when the broker has successfully
+	// processed the current Bind operation but the credentials it returned
+	// have not been written to the Secret yet. While it is set, the
+	// controller retries writing the Secret without sending another bind
+	// request to the broker.
+	// +optional
+	SecretWritePending bool `json:"secretWritePending,omitempty"`
+
+	// SecretCopyNamespaces is the list of namespaces into which a copy of
+	// the Secret holding the credentials may have been written. It is used
+	// to clean up the copies on unbind or when a namespace is removed from
+	// AdditionalSecretNamespaces.
+	// +optional
+	SecretCopyNamespaces []string `json:"secretCopyNamespaces,omitempty"`
+
+	// RouteServiceURL is the URL returned by the broker to which the
+	// platform must proxy requests for the application the ServiceBinding
+	// is for.
+	// +optional
+	RouteServiceURL *string `json:"routeServiceURL,omitempty"`
+
+	// VolumeMounts is the list of volume mounts returned by the broker
+	// for the ServiceBinding.
+	// +optional
+	VolumeMounts []ServiceBindingVolumeMount `json:"volumeMounts,omitempty"`
+
 	// UnbindStatus describes what has been done to unbind the ServiceBinding.
 	UnbindStatus ServiceBindingUnbindStatus `json:"unbindStatus"`
 
@@ -1270,6 +1563,60 @@ type ServiceBindingStatus struct {
 	LastConditionState string `json:"lastConditionState"`
 }
 
+// ServiceBindingVolumeMount is a volume mount returned by a broker for a
+// ServiceBinding, as defined by the Open Service Broker API.
+type ServiceBindingVolumeMount struct {
+	// Driver is the name of the volume driver plugin which manages the
+	// device.
+	Driver string `json:"driver"`
+
+	// ContainerDir is the directory to mount inside the application
+	// container.
+	ContainerDir string `json:"containerDir"`
+
+	// Mode describes whether the volume is mounted read-only or
+	// read-write.
+	Mode ServiceBindingVolumeMountMode `json:"mode"`
+
+	// DeviceType is the type of the device. Only "shared" is currently
+	// defined by the Open Service Broker API.
+	DeviceType ServiceBindingVolumeDeviceType `json:"deviceType"`
+
+	// Device is the device object to mount.
+	Device ServiceBindingVolumeDevice `json:"device"`
+}
+
+// ServiceBindingVolumeMountMode is the mode in which a volume is mounted.
+type ServiceBindingVolumeMountMode string
+
+const (
+	// ServiceBindingVolumeMountModeReadOnly mounts the volume read-only.
+	ServiceBindingVolumeMountModeReadOnly ServiceBindingVolumeMountMode = "r"
+
+	// ServiceBindingVolumeMountModeReadWrite mounts the volume read-write.
+	ServiceBindingVolumeMountModeReadWrite ServiceBindingVolumeMountMode = "rw"
+)
+
+// ServiceBindingVolumeDeviceType is the type of a volume device.
+type ServiceBindingVolumeDeviceType string
+
+const (
+	// ServiceBindingVolumeDeviceTypeShared is a device which can be mounted
+	// by multiple applications at the same time.
+	ServiceBindingVolumeDeviceTypeShared ServiceBindingVolumeDeviceType = "shared"
+)
+
+// ServiceBindingVolumeDevice is a device returned in a volume mount.
+type ServiceBindingVolumeDevice struct {
+	// VolumeID is the ID of the shared volume to mount.
+	VolumeID string `json:"volumeID"`
+
+	// MountConfig is the configuration object passed to the volume driver
+	// when mounting the volume.
+	// +optional
+	MountConfig *runtime.RawExtension `json:"mountConfig,omitempty"`
+}
+
 // ServiceBindingCondition condition information for a ServiceBinding.
 type ServiceBindingCondition struct {
 	// Type of the condition, currently ('Ready').
@@ -1289,6 +1636,12 @@ type ServiceBindingCondition struct {
 	// Message is a human readable description of the details of the last
 	// transition, complementing reason.
 	Message string `json:"message"`
+
+	// ErrorCode is a machine readable classification of the failed request
+	// to the broker the condition reports, one of the ErrorCode constants.
+	// It is empty when the condition does not report a failed request.
+	// +optional
+	ErrorCode string `json:"errorCode,omitempty"`
 }
 
 // ServiceBindingConditionType represents a ServiceBindingCondition value.
@@ -1301,6 +1654,16 @@ const (
 	// ServiceBindingConditionFailed represents a ServiceBindingCondition that has failed
 	// completely and should not be retried.
 	ServiceBindingConditionFailed ServiceBindingConditionType = "Failed"
+
+	// ServiceBindingConditionCredentialsStale represents a ServiceBindingCondition
+	// set when the plan of the bound instance changed after the binding was
+	// created, so its credentials may no longer be valid.
+	ServiceBindingConditionCredentialsStale ServiceBindingConditionType = "CredentialsStale"
+
+	// ServiceBindingConditionPlanNotBindable represents a ServiceBindingCondition
+	// set when the plan of the bound instance is no longer bindable according
+	// to the catalog of its broker.
+	ServiceBindingConditionPlanNotBindable ServiceBindingConditionType = "PlanNotBindable"
 )
 
 // ServiceBindingOperation represents a type of operation
@@ -1342,6 +1705,34 @@ const (
 	FinalizerServiceCatalog string = "kubernetes-incubator/service-catalog"
 )
 
+// ServiceInstancePausedAnnotation pauses the reconciliation of a
+// ServiceInstance, and of the ServiceBindings that refer to it, while it is
+// set to "true".
+const ServiceInstancePausedAnnotation = "servicecatalog.k8s.io/paused"
+
+// ServiceInstanceResendParametersAnnotation makes the next update request of a
+// ServiceInstance include its parameters, even if they have not changed since
+// they were last sent to the broker, when it is set to "true". The controller
+// removes the annotation once the update has succeeded.
+const ServiceInstanceResendParametersAnnotation = "servicecatalog.k8s.io/resend-parameters"
+
+// ServiceInstanceStableExternalIDAnnotation makes a ServiceInstance created
+// without an external ID get one derived from its namespace and name, instead
+// of a random one, while it is set to "true". Deleting and recreating the
+// instance with the same name then addresses the same broker resource.
+const ServiceInstanceStableExternalIDAnnotation = "servicecatalog.k8s.io/stable-external-id"
+
+// ServiceInstanceReconcilePriorityAnnotation sets the priority of a
+// ServiceInstance in the queue of the controller, an integer defaulting to 0.
+// When several instances are waiting to be reconciled, for example during a
+// cluster bootstrap, the ones with a higher priority are reconciled first.
+const ServiceInstanceReconcilePriorityAnnotation = "servicecatalog.k8s.io/reconcile-priority"
+
+// ServiceClassDefaultPlanAnnotation names, by its external name, the plan that
+// is used for a ServiceInstance of a ClusterServiceClass or ServiceClass
+// created without a plan, when the class has more than one plan.
+const ServiceClassDefaultPlanAnnotation = "servicecatalog.k8s.io/default-plan"
+
 // ServiceBindingPropertiesState is the state of a
 // ServiceBinding that the ClusterServiceBroker knows about.
 type ServiceBindingPropertiesState struct {
@@ -1357,12 +1748,53 @@ type ServiceBindingPropertiesState struct {
 	UserInfo *UserInfo `json:"userInfo,omitempty"`
 }
 
+// GeneratedParameters describes the parameters of a ServiceInstance whose
+// values are generated by the controller.
+type GeneratedParameters struct {
+	// SecretName is the name of the Secret, in the namespace of the
+	// ServiceInstance, storing the generated values keyed by parameter name.
+	// The controller creates the Secret, owned by the ServiceInstance, and
+	// only generates the values missing from it.
+	SecretName string `json:"secretName"`
+
+	// Parameters are the parameters to generate a value for.
+	Parameters []GeneratedParameter `json:"parameters"`
+}
+
+// GeneratedParameter describes a parameter whose value is a random string.
+type GeneratedParameter struct {
+	// Name is the name of the top-level parameter, and of the key of the
+	// Secret storing its value.
+	Name string `json:"name"`
+
+	// Length is the number of characters of the value. Defaults to 32.
+	// +optional
+	Length int32 `json:"length,omitempty"`
+
+	// Charset is the set of characters the value is made of. Defaults to
+	// the ASCII letters and digits.
+	// +optional
+	Charset string `json:"charset,omitempty"`
+}
+
 // ParametersFromSource represents the source of a set of Parameters
 type ParametersFromSource struct {
 	// The Secret key to select from.
-	// The value must be a JSON object.
+	// The value must be a JSON object, unless ParameterName is set.
 	// +optional
 	SecretKeyRef *SecretKeyReference `json:"secretKeyRef,omitempty"`
+
+	// URLRef references a document fetched over HTTP(S) whenever the
+	// parameters are sent to the broker.
+	// The document must be a JSON object, unless ParameterName is set.
+	// +optional
+	URLRef *URLReference `json:"urlRef,omitempty"`
+
+	// ParameterName is the name of the parameter the value of the source is
+	// assigned to. If set, the value is passed as a single string parameter
+	// instead of being parsed as a JSON object of parameters.
+	// +optional
+	ParameterName string `json:"parameterName,omitempty"`
 }
 
 // SecretKeyReference references a key of a Secret.
@@ -1373,6 +1805,18 @@ type SecretKeyReference struct {
 	Key string `json:"key"`
 }
 
+// URLReference references a document served over HTTP(S).
+type URLReference struct {
+	// URL is the http or https URL of the document.
+	URL string `json:"url"`
+
+	// BearerTokenSecretKeyRef references the key of a Secret, in the
+	// namespace of the resource, holding a token sent as the bearer token of
+	// the request.
+	// +optional
+	BearerTokenSecretKeyRef *SecretKeyReference `json:"bearerTokenSecretKeyRef,omitempty"`
+}
+
 // ObjectReference contains enough information to let you locate the
 // referenced object.
 type ObjectReference struct {
@@ -1428,6 +1872,20 @@ const (
 	FilterSpecFree = "spec.free"
 )
 
+// SecretKeyFormat is a naming convention for the keys of the Secret of a
+// ServiceBinding.
+type SecretKeyFormat string
+
+const (
+	// SecretKeyFormatUpperSnakeCase converts the keys to upper snake case,
+	// e.g. "dbUser" and "db-user" both become "DB_USER".
+	SecretKeyFormatUpperSnakeCase SecretKeyFormat = "UpperSnakeCase"
+
+	// SecretKeyFormatCamelCase converts the keys to camel case, e.g.
+	// "DB_USER" and "db-user" both become "dbUser".
+	SecretKeyFormatCamelCase SecretKeyFormat = "CamelCase"
+)
+
 // SecretTransform is a single transformation that is applied to the
 // credentials returned from the broker before they are inserted into
 // the Secret associated with the ServiceBinding.
@@ -76,6 +76,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BearerTokenFromServiceAccountAuthConfig)(nil), (*servicecatalog.BearerTokenFromServiceAccountAuthConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BearerTokenFromServiceAccountAuthConfig_To_servicecatalog_BearerTokenFromServiceAccountAuthConfig(a.(*BearerTokenFromServiceAccountAuthConfig), b.(*servicecatalog.BearerTokenFromServiceAccountAuthConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*servicecatalog.BearerTokenFromServiceAccountAuthConfig)(nil), (*BearerTokenFromServiceAccountAuthConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_servicecatalog_BearerTokenFromServiceAccountAuthConfig_To_v1beta1_BearerTokenFromServiceAccountAuthConfig(a.(*servicecatalog.BearerTokenFromServiceAccountAuthConfig), b.(*BearerTokenFromServiceAccountAuthConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CatalogRestrictions)(nil), (*servicecatalog.CatalogRestrictions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CatalogRestrictions_To_servicecatalog_CatalogRestrictions(a.(*CatalogRestrictions), b.(*servicecatalog.CatalogRestrictions), scope)
 	}); err != nil {
@@ -106,6 +116,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterBearerTokenFromServiceAccountAuthConfig)(nil), (*servicecatalog.ClusterBearerTokenFromServiceAccountAuthConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig_To_servicecatalog_ClusterBearerTokenFromServiceAccountAuthConfig(a.(*ClusterBearerTokenFromServiceAccountAuthConfig), b.(*servicecatalog.ClusterBearerTokenFromServiceAccountAuthConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*servicecatalog.ClusterBearerTokenFromServiceAccountAuthConfig)(nil), (*ClusterBearerTokenFromServiceAccountAuthConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_servicecatalog_ClusterBearerTokenFromServiceAccountAuthConfig_To_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig(a.(*servicecatalog.ClusterBearerTokenFromServiceAccountAuthConfig), b.(*ClusterBearerTokenFromServiceAccountAuthConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterObjectReference)(nil), (*servicecatalog.ClusterObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ClusterObjectReference_To_servicecatalog_ClusterObjectReference(a.(*ClusterObjectReference), b.(*servicecatalog.ClusterObjectReference), scope)
 	}); err != nil {
@@ -306,6 +326,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExtensionAPI)(nil), (*servicecatalog.ExtensionAPI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExtensionAPI_To_servicecatalog_ExtensionAPI(a.(*ExtensionAPI), b.(*servicecatalog.ExtensionAPI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*servicecatalog.ExtensionAPI)(nil), (*ExtensionAPI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_servicecatalog_ExtensionAPI_To_v1beta1_ExtensionAPI(a.(*servicecatalog.ExtensionAPI), b.(*ExtensionAPI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GeneratedParameter)(nil), (*servicecatalog.GeneratedParameter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GeneratedParameter_To_servicecatalog_GeneratedParameter(a.(*GeneratedParameter), b.(*servicecatalog.GeneratedParameter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*servicecatalog.GeneratedParameter)(nil), (*GeneratedParameter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_servicecatalog_GeneratedParameter_To_v1beta1_GeneratedParameter(a.(*servicecatalog.GeneratedParameter), b.(*GeneratedParameter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GeneratedParameters)(nil), (*servicecatalog.GeneratedParameters)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GeneratedParameters_To_servicecatalog_GeneratedParameters(a.(*GeneratedParameters), b.(*servicecatalog.GeneratedParameters), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*servicecatalog.GeneratedParameters)(nil), (*GeneratedParameters)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_servicecatalog_GeneratedParameters_To_v1beta1_GeneratedParameters(a.(*servicecatalog.GeneratedParameters), b.(*GeneratedParameters), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LocalObjectReference)(nil), (*servicecatalog.LocalObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_LocalObjectReference_To_servicecatalog_LocalObjectReference(a.(*LocalObjectReference), b.(*servicecatalog.LocalObjectReference), scope)
 	}); err != nil {
@@ -446,6 +496,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBindingVolumeDevice)(nil), (*servicecatalog.ServiceBindingVolumeDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBindingVolumeDevice_To_servicecatalog_ServiceBindingVolumeDevice(a.(*ServiceBindingVolumeDevice), b.(*servicecatalog.ServiceBindingVolumeDevice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*servicecatalog.ServiceBindingVolumeDevice)(nil), (*ServiceBindingVolumeDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_servicecatalog_ServiceBindingVolumeDevice_To_v1beta1_ServiceBindingVolumeDevice(a.(*servicecatalog.ServiceBindingVolumeDevice), b.(*ServiceBindingVolumeDevice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceBindingVolumeMount)(nil), (*servicecatalog.ServiceBindingVolumeMount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServiceBindingVolumeMount_To_servicecatalog_ServiceBindingVolumeMount(a.(*ServiceBindingVolumeMount), b.(*servicecatalog.ServiceBindingVolumeMount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*servicecatalog.ServiceBindingVolumeMount)(nil), (*ServiceBindingVolumeMount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_servicecatalog_ServiceBindingVolumeMount_To_v1beta1_ServiceBindingVolumeMount(a.(*servicecatalog.ServiceBindingVolumeMount), b.(*ServiceBindingVolumeMount), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ServiceBroker)(nil), (*servicecatalog.ServiceBroker)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ServiceBroker_To_servicecatalog_ServiceBroker(a.(*ServiceBroker), b.(*servicecatalog.ServiceBroker), scope)
 	}); err != nil {
@@ -616,6 +686,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ServicePlanCost)(nil), (*servicecatalog.ServicePlanCost)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ServicePlanCost_To_servicecatalog_ServicePlanCost(a.(*ServicePlanCost), b.(*servicecatalog.ServicePlanCost), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*servicecatalog.ServicePlanCost)(nil), (*ServicePlanCost)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_servicecatalog_ServicePlanCost_To_v1beta1_ServicePlanCost(a.(*servicecatalog.ServicePlanCost), b.(*ServicePlanCost), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ServicePlanList)(nil), (*servicecatalog.ServicePlanList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ServicePlanList_To_servicecatalog_ServicePlanList(a.(*ServicePlanList), b.(*servicecatalog.ServicePlanList), scope)
 	}); err != nil {
@@ -646,6 +726,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*URLReference)(nil), (*servicecatalog.URLReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_URLReference_To_servicecatalog_URLReference(a.(*URLReference), b.(*servicecatalog.URLReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*servicecatalog.URLReference)(nil), (*URLReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_servicecatalog_URLReference_To_v1beta1_URLReference(a.(*servicecatalog.URLReference), b.(*URLReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*UserInfo)(nil), (*servicecatalog.UserInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_UserInfo_To_servicecatalog_UserInfo(a.(*UserInfo), b.(*servicecatalog.UserInfo), scope)
 	}); err != nil {
@@ -745,6 +835,30 @@ func Convert_servicecatalog_BearerTokenAuthConfig_To_v1beta1_BearerTokenAuthConf
 	return autoConvert_servicecatalog_BearerTokenAuthConfig_To_v1beta1_BearerTokenAuthConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_BearerTokenFromServiceAccountAuthConfig_To_servicecatalog_BearerTokenFromServiceAccountAuthConfig(in *BearerTokenFromServiceAccountAuthConfig, out *servicecatalog.BearerTokenFromServiceAccountAuthConfig, s conversion.Scope) error {
+	out.ServiceAccountRef = (*servicecatalog.LocalObjectReference)(unsafe.Pointer(in.ServiceAccountRef))
+	out.Audience = in.Audience
+	out.ExpirationSeconds = (*int64)(unsafe.Pointer(in.ExpirationSeconds))
+	return nil
+}
+
+// Convert_v1beta1_BearerTokenFromServiceAccountAuthConfig_To_servicecatalog_BearerTokenFromServiceAccountAuthConfig is an autogenerated conversion function.
+func Convert_v1beta1_BearerTokenFromServiceAccountAuthConfig_To_servicecatalog_BearerTokenFromServiceAccountAuthConfig(in *BearerTokenFromServiceAccountAuthConfig, out *servicecatalog.BearerTokenFromServiceAccountAuthConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_BearerTokenFromServiceAccountAuthConfig_To_servicecatalog_BearerTokenFromServiceAccountAuthConfig(in, out, s)
+}
+
+func autoConvert_servicecatalog_BearerTokenFromServiceAccountAuthConfig_To_v1beta1_BearerTokenFromServiceAccountAuthConfig(in *servicecatalog.BearerTokenFromServiceAccountAuthConfig, out *BearerTokenFromServiceAccountAuthConfig, s conversion.Scope) error {
+	out.ServiceAccountRef = (*LocalObjectReference)(unsafe.Pointer(in.ServiceAccountRef))
+	out.Audience = in.Audience
+	out.ExpirationSeconds = (*int64)(unsafe.Pointer(in.ExpirationSeconds))
+	return nil
+}
+
+// Convert_servicecatalog_BearerTokenFromServiceAccountAuthConfig_To_v1beta1_BearerTokenFromServiceAccountAuthConfig is an autogenerated conversion function.
+func Convert_servicecatalog_BearerTokenFromServiceAccountAuthConfig_To_v1beta1_BearerTokenFromServiceAccountAuthConfig(in *servicecatalog.BearerTokenFromServiceAccountAuthConfig, out *BearerTokenFromServiceAccountAuthConfig, s conversion.Scope) error {
+	return autoConvert_servicecatalog_BearerTokenFromServiceAccountAuthConfig_To_v1beta1_BearerTokenFromServiceAccountAuthConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_CatalogRestrictions_To_servicecatalog_CatalogRestrictions(in *CatalogRestrictions, out *servicecatalog.CatalogRestrictions, s conversion.Scope) error {
 	out.ServiceClass = *(*[]string)(unsafe.Pointer(&in.ServiceClass))
 	out.ServicePlan = *(*[]string)(unsafe.Pointer(&in.ServicePlan))
@@ -807,6 +921,30 @@ func Convert_servicecatalog_ClusterBearerTokenAuthConfig_To_v1beta1_ClusterBeare
 	return autoConvert_servicecatalog_ClusterBearerTokenAuthConfig_To_v1beta1_ClusterBearerTokenAuthConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig_To_servicecatalog_ClusterBearerTokenFromServiceAccountAuthConfig(in *ClusterBearerTokenFromServiceAccountAuthConfig, out *servicecatalog.ClusterBearerTokenFromServiceAccountAuthConfig, s conversion.Scope) error {
+	out.ServiceAccountRef = (*servicecatalog.ObjectReference)(unsafe.Pointer(in.ServiceAccountRef))
+	out.Audience = in.Audience
+	out.ExpirationSeconds = (*int64)(unsafe.Pointer(in.ExpirationSeconds))
+	return nil
+}
+
+// Convert_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig_To_servicecatalog_ClusterBearerTokenFromServiceAccountAuthConfig is an autogenerated conversion function.
+func Convert_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig_To_servicecatalog_ClusterBearerTokenFromServiceAccountAuthConfig(in *ClusterBearerTokenFromServiceAccountAuthConfig, out *servicecatalog.ClusterBearerTokenFromServiceAccountAuthConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig_To_servicecatalog_ClusterBearerTokenFromServiceAccountAuthConfig(in, out, s)
+}
+
+func autoConvert_servicecatalog_ClusterBearerTokenFromServiceAccountAuthConfig_To_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig(in *servicecatalog.ClusterBearerTokenFromServiceAccountAuthConfig, out *ClusterBearerTokenFromServiceAccountAuthConfig, s conversion.Scope) error {
+	out.ServiceAccountRef = (*ObjectReference)(unsafe.Pointer(in.ServiceAccountRef))
+	out.Audience = in.Audience
+	out.ExpirationSeconds = (*int64)(unsafe.Pointer(in.ExpirationSeconds))
+	return nil
+}
+
+// Convert_servicecatalog_ClusterBearerTokenFromServiceAccountAuthConfig_To_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig is an autogenerated conversion function.
+func Convert_servicecatalog_ClusterBearerTokenFromServiceAccountAuthConfig_To_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig(in *servicecatalog.ClusterBearerTokenFromServiceAccountAuthConfig, out *ClusterBearerTokenFromServiceAccountAuthConfig, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ClusterBearerTokenFromServiceAccountAuthConfig_To_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_ClusterObjectReference_To_servicecatalog_ClusterObjectReference(in *ClusterObjectReference, out *servicecatalog.ClusterObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -862,6 +1000,7 @@ func Convert_servicecatalog_ClusterServiceBroker_To_v1beta1_ClusterServiceBroker
 func autoConvert_v1beta1_ClusterServiceBrokerAuthInfo_To_servicecatalog_ClusterServiceBrokerAuthInfo(in *ClusterServiceBrokerAuthInfo, out *servicecatalog.ClusterServiceBrokerAuthInfo, s conversion.Scope) error {
 	out.Basic = (*servicecatalog.ClusterBasicAuthConfig)(unsafe.Pointer(in.Basic))
 	out.Bearer = (*servicecatalog.ClusterBearerTokenAuthConfig)(unsafe.Pointer(in.Bearer))
+	out.BearerTokenFromServiceAccount = (*servicecatalog.ClusterBearerTokenFromServiceAccountAuthConfig)(unsafe.Pointer(in.BearerTokenFromServiceAccount))
 	return nil
 }
 
@@ -873,6 +1012,7 @@ func Convert_v1beta1_ClusterServiceBrokerAuthInfo_To_servicecatalog_ClusterServi
 func autoConvert_servicecatalog_ClusterServiceBrokerAuthInfo_To_v1beta1_ClusterServiceBrokerAuthInfo(in *servicecatalog.ClusterServiceBrokerAuthInfo, out *ClusterServiceBrokerAuthInfo, s conversion.Scope) error {
 	out.Basic = (*ClusterBasicAuthConfig)(unsafe.Pointer(in.Basic))
 	out.Bearer = (*ClusterBearerTokenAuthConfig)(unsafe.Pointer(in.Bearer))
+	out.BearerTokenFromServiceAccount = (*ClusterBearerTokenFromServiceAccountAuthConfig)(unsafe.Pointer(in.BearerTokenFromServiceAccount))
 	return nil
 }
 
@@ -908,6 +1048,7 @@ func autoConvert_v1beta1_ClusterServiceBrokerSpec_To_servicecatalog_ClusterServi
 		return err
 	}
 	out.AuthInfo = (*servicecatalog.ClusterServiceBrokerAuthInfo)(unsafe.Pointer(in.AuthInfo))
+	out.FallbackAuthInfo = (*servicecatalog.ClusterServiceBrokerAuthInfo)(unsafe.Pointer(in.FallbackAuthInfo))
 	return nil
 }
 
@@ -921,6 +1062,7 @@ func autoConvert_servicecatalog_ClusterServiceBrokerSpec_To_v1beta1_ClusterServi
 		return err
 	}
 	out.AuthInfo = (*ClusterServiceBrokerAuthInfo)(unsafe.Pointer(in.AuthInfo))
+	out.FallbackAuthInfo = (*ClusterServiceBrokerAuthInfo)(unsafe.Pointer(in.FallbackAuthInfo))
 	return nil
 }
 
@@ -1171,6 +1313,7 @@ func autoConvert_v1beta1_CommonServiceBrokerSpec_To_servicecatalog_CommonService
 	out.URL = in.URL
 	out.InsecureSkipTLSVerify = in.InsecureSkipTLSVerify
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.OSBAPIVersion = in.OSBAPIVersion
 	out.RelistBehavior = servicecatalog.ServiceBrokerRelistBehavior(in.RelistBehavior)
 	out.RelistDuration = (*v1.Duration)(unsafe.Pointer(in.RelistDuration))
 	out.RelistRequests = in.RelistRequests
@@ -1187,6 +1330,7 @@ func autoConvert_servicecatalog_CommonServiceBrokerSpec_To_v1beta1_CommonService
 	out.URL = in.URL
 	out.InsecureSkipTLSVerify = in.InsecureSkipTLSVerify
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.OSBAPIVersion = in.OSBAPIVersion
 	out.RelistBehavior = ServiceBrokerRelistBehavior(in.RelistBehavior)
 	out.RelistDuration = (*v1.Duration)(unsafe.Pointer(in.RelistDuration))
 	out.RelistRequests = in.RelistRequests
@@ -1205,6 +1349,10 @@ func autoConvert_v1beta1_CommonServiceBrokerStatus_To_servicecatalog_CommonServi
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
 	out.LastCatalogRetrievalTime = (*v1.Time)(unsafe.Pointer(in.LastCatalogRetrievalTime))
 	out.LastConditionState = in.LastConditionState
+	out.Features = *(*[]servicecatalog.ServiceBrokerFeature)(unsafe.Pointer(&in.Features))
+	out.LastCatalogClassCount = (*int64)(unsafe.Pointer(in.LastCatalogClassCount))
+	out.LastCatalogPlanCount = (*int64)(unsafe.Pointer(in.LastCatalogPlanCount))
+	out.LastSuccessfulEndpoint = in.LastSuccessfulEndpoint
 	return nil
 }
 
@@ -1219,6 +1367,10 @@ func autoConvert_servicecatalog_CommonServiceBrokerStatus_To_v1beta1_CommonServi
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
 	out.LastCatalogRetrievalTime = (*v1.Time)(unsafe.Pointer(in.LastCatalogRetrievalTime))
 	out.LastConditionState = in.LastConditionState
+	out.Features = *(*[]ServiceBrokerFeature)(unsafe.Pointer(&in.Features))
+	out.LastCatalogClassCount = (*int64)(unsafe.Pointer(in.LastCatalogClassCount))
+	out.LastCatalogPlanCount = (*int64)(unsafe.Pointer(in.LastCatalogPlanCount))
+	out.LastSuccessfulEndpoint = in.LastSuccessfulEndpoint
 	return nil
 }
 
@@ -1327,6 +1479,7 @@ func Convert_servicecatalog_CommonServicePlanSpec_To_v1beta1_CommonServicePlanSp
 
 func autoConvert_v1beta1_CommonServicePlanStatus_To_servicecatalog_CommonServicePlanStatus(in *CommonServicePlanStatus, out *servicecatalog.CommonServicePlanStatus, s conversion.Scope) error {
 	out.RemovedFromBrokerCatalog = in.RemovedFromBrokerCatalog
+	out.Costs = *(*[]servicecatalog.ServicePlanCost)(unsafe.Pointer(&in.Costs))
 	return nil
 }
 
@@ -1337,6 +1490,7 @@ func Convert_v1beta1_CommonServicePlanStatus_To_servicecatalog_CommonServicePlan
 
 func autoConvert_servicecatalog_CommonServicePlanStatus_To_v1beta1_CommonServicePlanStatus(in *servicecatalog.CommonServicePlanStatus, out *CommonServicePlanStatus, s conversion.Scope) error {
 	out.RemovedFromBrokerCatalog = in.RemovedFromBrokerCatalog
+	out.Costs = *(*[]ServicePlanCost)(unsafe.Pointer(&in.Costs))
 	return nil
 }
 
@@ -1345,6 +1499,76 @@ func Convert_servicecatalog_CommonServicePlanStatus_To_v1beta1_CommonServicePlan
 	return autoConvert_servicecatalog_CommonServicePlanStatus_To_v1beta1_CommonServicePlanStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ExtensionAPI_To_servicecatalog_ExtensionAPI(in *ExtensionAPI, out *servicecatalog.ExtensionAPI, s conversion.Scope) error {
+	out.DiscoveryURL = in.DiscoveryURL
+	out.ServerURL = in.ServerURL
+	out.AdheresTo = in.AdheresTo
+	return nil
+}
+
+// Convert_v1beta1_ExtensionAPI_To_servicecatalog_ExtensionAPI is an autogenerated conversion function.
+func Convert_v1beta1_ExtensionAPI_To_servicecatalog_ExtensionAPI(in *ExtensionAPI, out *servicecatalog.ExtensionAPI, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExtensionAPI_To_servicecatalog_ExtensionAPI(in, out, s)
+}
+
+func autoConvert_servicecatalog_ExtensionAPI_To_v1beta1_ExtensionAPI(in *servicecatalog.ExtensionAPI, out *ExtensionAPI, s conversion.Scope) error {
+	out.DiscoveryURL = in.DiscoveryURL
+	out.ServerURL = in.ServerURL
+	out.AdheresTo = in.AdheresTo
+	return nil
+}
+
+// Convert_servicecatalog_ExtensionAPI_To_v1beta1_ExtensionAPI is an autogenerated conversion function.
+func Convert_servicecatalog_ExtensionAPI_To_v1beta1_ExtensionAPI(in *servicecatalog.ExtensionAPI, out *ExtensionAPI, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ExtensionAPI_To_v1beta1_ExtensionAPI(in, out, s)
+}
+
+func autoConvert_v1beta1_GeneratedParameter_To_servicecatalog_GeneratedParameter(in *GeneratedParameter, out *servicecatalog.GeneratedParameter, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Length = in.Length
+	out.Charset = in.Charset
+	return nil
+}
+
+// Convert_v1beta1_GeneratedParameter_To_servicecatalog_GeneratedParameter is an autogenerated conversion function.
+func Convert_v1beta1_GeneratedParameter_To_servicecatalog_GeneratedParameter(in *GeneratedParameter, out *servicecatalog.GeneratedParameter, s conversion.Scope) error {
+	return autoConvert_v1beta1_GeneratedParameter_To_servicecatalog_GeneratedParameter(in, out, s)
+}
+
+func autoConvert_servicecatalog_GeneratedParameter_To_v1beta1_GeneratedParameter(in *servicecatalog.GeneratedParameter, out *GeneratedParameter, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Length = in.Length
+	out.Charset = in.Charset
+	return nil
+}
+
+// Convert_servicecatalog_GeneratedParameter_To_v1beta1_GeneratedParameter is an autogenerated conversion function.
+func Convert_servicecatalog_GeneratedParameter_To_v1beta1_GeneratedParameter(in *servicecatalog.GeneratedParameter, out *GeneratedParameter, s conversion.Scope) error {
+	return autoConvert_servicecatalog_GeneratedParameter_To_v1beta1_GeneratedParameter(in, out, s)
+}
+
+func autoConvert_v1beta1_GeneratedParameters_To_servicecatalog_GeneratedParameters(in *GeneratedParameters, out *servicecatalog.GeneratedParameters, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Parameters = *(*[]servicecatalog.GeneratedParameter)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_v1beta1_GeneratedParameters_To_servicecatalog_GeneratedParameters is an autogenerated conversion function.
+func Convert_v1beta1_GeneratedParameters_To_servicecatalog_GeneratedParameters(in *GeneratedParameters, out *servicecatalog.GeneratedParameters, s conversion.Scope) error {
+	return autoConvert_v1beta1_GeneratedParameters_To_servicecatalog_GeneratedParameters(in, out, s)
+}
+
+func autoConvert_servicecatalog_GeneratedParameters_To_v1beta1_GeneratedParameters(in *servicecatalog.GeneratedParameters, out *GeneratedParameters, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Parameters = *(*[]GeneratedParameter)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_servicecatalog_GeneratedParameters_To_v1beta1_GeneratedParameters is an autogenerated conversion function.
+func Convert_servicecatalog_GeneratedParameters_To_v1beta1_GeneratedParameters(in *servicecatalog.GeneratedParameters, out *GeneratedParameters, s conversion.Scope) error {
+	return autoConvert_servicecatalog_GeneratedParameters_To_v1beta1_GeneratedParameters(in, out, s)
+}
+
 func autoConvert_v1beta1_LocalObjectReference_To_servicecatalog_LocalObjectReference(in *LocalObjectReference, out *servicecatalog.LocalObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -1389,6 +1613,8 @@ func Convert_servicecatalog_ObjectReference_To_v1beta1_ObjectReference(in *servi
 
 func autoConvert_v1beta1_ParametersFromSource_To_servicecatalog_ParametersFromSource(in *ParametersFromSource, out *servicecatalog.ParametersFromSource, s conversion.Scope) error {
 	out.SecretKeyRef = (*servicecatalog.SecretKeyReference)(unsafe.Pointer(in.SecretKeyRef))
+	out.URLRef = (*servicecatalog.URLReference)(unsafe.Pointer(in.URLRef))
+	out.ParameterName = in.ParameterName
 	return nil
 }
 
@@ -1399,6 +1625,8 @@ func Convert_v1beta1_ParametersFromSource_To_servicecatalog_ParametersFromSource
 
 func autoConvert_servicecatalog_ParametersFromSource_To_v1beta1_ParametersFromSource(in *servicecatalog.ParametersFromSource, out *ParametersFromSource, s conversion.Scope) error {
 	out.SecretKeyRef = (*SecretKeyReference)(unsafe.Pointer(in.SecretKeyRef))
+	out.URLRef = (*URLReference)(unsafe.Pointer(in.URLRef))
+	out.ParameterName = in.ParameterName
 	return nil
 }
 
@@ -1577,6 +1805,7 @@ func autoConvert_v1beta1_ServiceBindingCondition_To_servicecatalog_ServiceBindin
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.ErrorCode = in.ErrorCode
 	return nil
 }
 
@@ -1591,6 +1820,7 @@ func autoConvert_servicecatalog_ServiceBindingCondition_To_v1beta1_ServiceBindin
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.ErrorCode = in.ErrorCode
 	return nil
 }
 
@@ -1651,8 +1881,13 @@ func autoConvert_v1beta1_ServiceBindingSpec_To_servicecatalog_ServiceBindingSpec
 	}
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersFrom = *(*[]servicecatalog.ParametersFromSource)(unsafe.Pointer(&in.ParametersFrom))
+	out.Endpoint = in.Endpoint
 	out.SecretName = in.SecretName
 	out.SecretTransforms = *(*[]servicecatalog.SecretTransform)(unsafe.Pointer(&in.SecretTransforms))
+	out.SecretKeyFormat = servicecatalog.SecretKeyFormat(in.SecretKeyFormat)
+	out.AdditionalSecretNamespaces = *(*[]string)(unsafe.Pointer(&in.AdditionalSecretNamespaces))
+	out.SecretLabels = *(*map[string]string)(unsafe.Pointer(&in.SecretLabels))
+	out.SecretAnnotations = *(*map[string]string)(unsafe.Pointer(&in.SecretAnnotations))
 	out.ExternalID = in.ExternalID
 	out.UserInfo = (*servicecatalog.UserInfo)(unsafe.Pointer(in.UserInfo))
 	return nil
@@ -1669,8 +1904,13 @@ func autoConvert_servicecatalog_ServiceBindingSpec_To_v1beta1_ServiceBindingSpec
 	}
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersFrom = *(*[]ParametersFromSource)(unsafe.Pointer(&in.ParametersFrom))
+	out.Endpoint = in.Endpoint
 	out.SecretName = in.SecretName
 	out.SecretTransforms = *(*[]SecretTransform)(unsafe.Pointer(&in.SecretTransforms))
+	out.SecretKeyFormat = SecretKeyFormat(in.SecretKeyFormat)
+	out.AdditionalSecretNamespaces = *(*[]string)(unsafe.Pointer(&in.AdditionalSecretNamespaces))
+	out.SecretLabels = *(*map[string]string)(unsafe.Pointer(&in.SecretLabels))
+	out.SecretAnnotations = *(*map[string]string)(unsafe.Pointer(&in.SecretAnnotations))
 	out.ExternalID = in.ExternalID
 	out.UserInfo = (*UserInfo)(unsafe.Pointer(in.UserInfo))
 	return nil
@@ -1687,10 +1927,15 @@ func autoConvert_v1beta1_ServiceBindingStatus_To_servicecatalog_ServiceBindingSt
 	out.LastOperation = (*string)(unsafe.Pointer(in.LastOperation))
 	out.CurrentOperation = servicecatalog.ServiceBindingOperation(in.CurrentOperation)
 	out.ReconciledGeneration = in.ReconciledGeneration
+	out.ObservedGeneration = in.ObservedGeneration
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
 	out.InProgressProperties = (*servicecatalog.ServiceBindingPropertiesState)(unsafe.Pointer(in.InProgressProperties))
 	out.ExternalProperties = (*servicecatalog.ServiceBindingPropertiesState)(unsafe.Pointer(in.ExternalProperties))
 	out.OrphanMitigationInProgress = in.OrphanMitigationInProgress
+	out.SecretWritePending = in.SecretWritePending
+	out.SecretCopyNamespaces = *(*[]string)(unsafe.Pointer(&in.SecretCopyNamespaces))
+	out.RouteServiceURL = (*string)(unsafe.Pointer(in.RouteServiceURL))
+	out.VolumeMounts = *(*[]servicecatalog.ServiceBindingVolumeMount)(unsafe.Pointer(&in.VolumeMounts))
 	out.UnbindStatus = servicecatalog.ServiceBindingUnbindStatus(in.UnbindStatus)
 	out.LastConditionState = in.LastConditionState
 	return nil
@@ -1707,10 +1952,15 @@ func autoConvert_servicecatalog_ServiceBindingStatus_To_v1beta1_ServiceBindingSt
 	out.LastOperation = (*string)(unsafe.Pointer(in.LastOperation))
 	out.CurrentOperation = ServiceBindingOperation(in.CurrentOperation)
 	out.ReconciledGeneration = in.ReconciledGeneration
+	out.ObservedGeneration = in.ObservedGeneration
 	out.OperationStartTime = (*v1.Time)(unsafe.Pointer(in.OperationStartTime))
 	out.InProgressProperties = (*ServiceBindingPropertiesState)(unsafe.Pointer(in.InProgressProperties))
 	out.ExternalProperties = (*ServiceBindingPropertiesState)(unsafe.Pointer(in.ExternalProperties))
 	out.OrphanMitigationInProgress = in.OrphanMitigationInProgress
+	out.SecretWritePending = in.SecretWritePending
+	out.SecretCopyNamespaces = *(*[]string)(unsafe.Pointer(&in.SecretCopyNamespaces))
+	out.RouteServiceURL = (*string)(unsafe.Pointer(in.RouteServiceURL))
+	out.VolumeMounts = *(*[]ServiceBindingVolumeMount)(unsafe.Pointer(&in.VolumeMounts))
 	out.UnbindStatus = ServiceBindingUnbindStatus(in.UnbindStatus)
 	out.LastConditionState = in.LastConditionState
 	return nil
@@ -1721,6 +1971,60 @@ func Convert_servicecatalog_ServiceBindingStatus_To_v1beta1_ServiceBindingStatus
 	return autoConvert_servicecatalog_ServiceBindingStatus_To_v1beta1_ServiceBindingStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_ServiceBindingVolumeDevice_To_servicecatalog_ServiceBindingVolumeDevice(in *ServiceBindingVolumeDevice, out *servicecatalog.ServiceBindingVolumeDevice, s conversion.Scope) error {
+	out.VolumeID = in.VolumeID
+	out.MountConfig = (*runtime.RawExtension)(unsafe.Pointer(in.MountConfig))
+	return nil
+}
+
+// Convert_v1beta1_ServiceBindingVolumeDevice_To_servicecatalog_ServiceBindingVolumeDevice is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBindingVolumeDevice_To_servicecatalog_ServiceBindingVolumeDevice(in *ServiceBindingVolumeDevice, out *servicecatalog.ServiceBindingVolumeDevice, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBindingVolumeDevice_To_servicecatalog_ServiceBindingVolumeDevice(in, out, s)
+}
+
+func autoConvert_servicecatalog_ServiceBindingVolumeDevice_To_v1beta1_ServiceBindingVolumeDevice(in *servicecatalog.ServiceBindingVolumeDevice, out *ServiceBindingVolumeDevice, s conversion.Scope) error {
+	out.VolumeID = in.VolumeID
+	out.MountConfig = (*runtime.RawExtension)(unsafe.Pointer(in.MountConfig))
+	return nil
+}
+
+// Convert_servicecatalog_ServiceBindingVolumeDevice_To_v1beta1_ServiceBindingVolumeDevice is an autogenerated conversion function.
+func Convert_servicecatalog_ServiceBindingVolumeDevice_To_v1beta1_ServiceBindingVolumeDevice(in *servicecatalog.ServiceBindingVolumeDevice, out *ServiceBindingVolumeDevice, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ServiceBindingVolumeDevice_To_v1beta1_ServiceBindingVolumeDevice(in, out, s)
+}
+
+func autoConvert_v1beta1_ServiceBindingVolumeMount_To_servicecatalog_ServiceBindingVolumeMount(in *ServiceBindingVolumeMount, out *servicecatalog.ServiceBindingVolumeMount, s conversion.Scope) error {
+	out.Driver = in.Driver
+	out.ContainerDir = in.ContainerDir
+	out.Mode = servicecatalog.ServiceBindingVolumeMountMode(in.Mode)
+	out.DeviceType = servicecatalog.ServiceBindingVolumeDeviceType(in.DeviceType)
+	if err := Convert_v1beta1_ServiceBindingVolumeDevice_To_servicecatalog_ServiceBindingVolumeDevice(&in.Device, &out.Device, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ServiceBindingVolumeMount_To_servicecatalog_ServiceBindingVolumeMount is an autogenerated conversion function.
+func Convert_v1beta1_ServiceBindingVolumeMount_To_servicecatalog_ServiceBindingVolumeMount(in *ServiceBindingVolumeMount, out *servicecatalog.ServiceBindingVolumeMount, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServiceBindingVolumeMount_To_servicecatalog_ServiceBindingVolumeMount(in, out, s)
+}
+
+func autoConvert_servicecatalog_ServiceBindingVolumeMount_To_v1beta1_ServiceBindingVolumeMount(in *servicecatalog.ServiceBindingVolumeMount, out *ServiceBindingVolumeMount, s conversion.Scope) error {
+	out.Driver = in.Driver
+	out.ContainerDir = in.ContainerDir
+	out.Mode = ServiceBindingVolumeMountMode(in.Mode)
+	out.DeviceType = ServiceBindingVolumeDeviceType(in.DeviceType)
+	if err := Convert_servicecatalog_ServiceBindingVolumeDevice_To_v1beta1_ServiceBindingVolumeDevice(&in.Device, &out.Device, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_servicecatalog_ServiceBindingVolumeMount_To_v1beta1_ServiceBindingVolumeMount is an autogenerated conversion function.
+func Convert_servicecatalog_ServiceBindingVolumeMount_To_v1beta1_ServiceBindingVolumeMount(in *servicecatalog.ServiceBindingVolumeMount, out *ServiceBindingVolumeMount, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ServiceBindingVolumeMount_To_v1beta1_ServiceBindingVolumeMount(in, out, s)
+}
+
 func autoConvert_v1beta1_ServiceBroker_To_servicecatalog_ServiceBroker(in *ServiceBroker, out *servicecatalog.ServiceBroker, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ServiceBrokerSpec_To_servicecatalog_ServiceBrokerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1756,6 +2060,7 @@ func Convert_servicecatalog_ServiceBroker_To_v1beta1_ServiceBroker(in *serviceca
 func autoConvert_v1beta1_ServiceBrokerAuthInfo_To_servicecatalog_ServiceBrokerAuthInfo(in *ServiceBrokerAuthInfo, out *servicecatalog.ServiceBrokerAuthInfo, s conversion.Scope) error {
 	out.Basic = (*servicecatalog.BasicAuthConfig)(unsafe.Pointer(in.Basic))
 	out.Bearer = (*servicecatalog.BearerTokenAuthConfig)(unsafe.Pointer(in.Bearer))
+	out.BearerTokenFromServiceAccount = (*servicecatalog.BearerTokenFromServiceAccountAuthConfig)(unsafe.Pointer(in.BearerTokenFromServiceAccount))
 	return nil
 }
 
@@ -1767,6 +2072,7 @@ func Convert_v1beta1_ServiceBrokerAuthInfo_To_servicecatalog_ServiceBrokerAuthIn
 func autoConvert_servicecatalog_ServiceBrokerAuthInfo_To_v1beta1_ServiceBrokerAuthInfo(in *servicecatalog.ServiceBrokerAuthInfo, out *ServiceBrokerAuthInfo, s conversion.Scope) error {
 	out.Basic = (*BasicAuthConfig)(unsafe.Pointer(in.Basic))
 	out.Bearer = (*BearerTokenAuthConfig)(unsafe.Pointer(in.Bearer))
+	out.BearerTokenFromServiceAccount = (*BearerTokenFromServiceAccountAuthConfig)(unsafe.Pointer(in.BearerTokenFromServiceAccount))
 	return nil
 }
 
@@ -2017,6 +2323,7 @@ func autoConvert_v1beta1_ServiceInstanceCondition_To_servicecatalog_ServiceInsta
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.ErrorCode = in.ErrorCode
 	return nil
 }
 
@@ -2031,6 +2338,7 @@ func autoConvert_servicecatalog_ServiceInstanceCondition_To_v1beta1_ServiceInsta
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.ErrorCode = in.ErrorCode
 	return nil
 }
 
@@ -2068,6 +2376,7 @@ func autoConvert_v1beta1_ServiceInstancePropertiesState_To_servicecatalog_Servic
 	out.ServicePlanExternalID = in.ServicePlanExternalID
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParameterChecksum = in.ParameterChecksum
+	out.ContextChecksum = in.ContextChecksum
 	out.UserInfo = (*servicecatalog.UserInfo)(unsafe.Pointer(in.UserInfo))
 	return nil
 }
@@ -2084,6 +2393,7 @@ func autoConvert_servicecatalog_ServiceInstancePropertiesState_To_v1beta1_Servic
 	out.ServicePlanExternalID = in.ServicePlanExternalID
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParameterChecksum = in.ParameterChecksum
+	out.ContextChecksum = in.ContextChecksum
 	out.UserInfo = (*UserInfo)(unsafe.Pointer(in.UserInfo))
 	return nil
 }
@@ -2097,15 +2407,19 @@ func autoConvert_v1beta1_ServiceInstanceSpec_To_servicecatalog_ServiceInstanceSp
 	if err := Convert_v1beta1_PlanReference_To_servicecatalog_PlanReference(&in.PlanReference, &out.PlanReference, s); err != nil {
 		return err
 	}
+	out.ClusterServiceBrokerName = in.ClusterServiceBrokerName
 	out.ClusterServiceClassRef = (*servicecatalog.ClusterObjectReference)(unsafe.Pointer(in.ClusterServiceClassRef))
 	out.ClusterServicePlanRef = (*servicecatalog.ClusterObjectReference)(unsafe.Pointer(in.ClusterServicePlanRef))
 	out.ServiceClassRef = (*servicecatalog.LocalObjectReference)(unsafe.Pointer(in.ServiceClassRef))
 	out.ServicePlanRef = (*servicecatalog.LocalObjectReference)(unsafe.Pointer(in.ServicePlanRef))
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersFrom = *(*[]servicecatalog.ParametersFromSource)(unsafe.Pointer(&in.ParametersFrom))
+	out.GeneratedParameters = (*servicecatalog.GeneratedParameters)(unsafe.Pointer(in.GeneratedParameters))
 	out.ExternalID = in.ExternalID
 	out.UserInfo = (*servicecatalog.UserInfo)(unsafe.Pointer(in.UserInfo))
 	out.UpdateRequests = in.UpdateRequests
+	out.TTLSecondsAfterFailure = (*int64)(unsafe.Pointer(in.TTLSecondsAfterFailure))
+	out.TTLSecondsAfterReady = (*int64)(unsafe.Pointer(in.TTLSecondsAfterReady))
 	return nil
 }
 
@@ -2118,15 +2432,19 @@ func autoConvert_servicecatalog_ServiceInstanceSpec_To_v1beta1_ServiceInstanceSp
 	if err := Convert_servicecatalog_PlanReference_To_v1beta1_PlanReference(&in.PlanReference, &out.PlanReference, s); err != nil {
 		return err
 	}
+	out.ClusterServiceBrokerName = in.ClusterServiceBrokerName
 	out.ClusterServiceClassRef = (*ClusterObjectReference)(unsafe.Pointer(in.ClusterServiceClassRef))
 	out.ClusterServicePlanRef = (*ClusterObjectReference)(unsafe.Pointer(in.ClusterServicePlanRef))
 	out.ServiceClassRef = (*LocalObjectReference)(unsafe.Pointer(in.ServiceClassRef))
 	out.ServicePlanRef = (*LocalObjectReference)(unsafe.Pointer(in.ServicePlanRef))
 	out.Parameters = (*runtime.RawExtension)(unsafe.Pointer(in.Parameters))
 	out.ParametersFrom = *(*[]ParametersFromSource)(unsafe.Pointer(&in.ParametersFrom))
+	out.GeneratedParameters = (*GeneratedParameters)(unsafe.Pointer(in.GeneratedParameters))
 	out.ExternalID = in.ExternalID
 	out.UserInfo = (*UserInfo)(unsafe.Pointer(in.UserInfo))
 	out.UpdateRequests = in.UpdateRequests
+	out.TTLSecondsAfterFailure = (*int64)(unsafe.Pointer(in.TTLSecondsAfterFailure))
+	out.TTLSecondsAfterReady = (*int64)(unsafe.Pointer(in.TTLSecondsAfterReady))
 	return nil
 }
 
@@ -2139,8 +2457,10 @@ func autoConvert_v1beta1_ServiceInstanceStatus_To_servicecatalog_ServiceInstance
 	out.Conditions = *(*[]servicecatalog.ServiceInstanceCondition)(unsafe.Pointer(&in.Conditions))
 	out.AsyncOpInProgress = in.AsyncOpInProgress
 	out.OrphanMitigationInProgress = in.OrphanMitigationInProgress
+	out.OrphanMitigationAttempts = in.OrphanMitigationAttempts
 	out.LastOperation = (*string)(unsafe.Pointer(in.LastOperation))
 	out.DashboardURL = (*string)(unsafe.Pointer(in.DashboardURL))
+	out.ExtensionAPIs = *(*[]servicecatalog.ExtensionAPI)(unsafe.Pointer(&in.ExtensionAPIs))
 	out.CurrentOperation = servicecatalog.ServiceInstanceOperation(in.CurrentOperation)
 	out.ReconciledGeneration = in.ReconciledGeneration
 	out.ObservedGeneration = in.ObservedGeneration
@@ -2150,9 +2470,14 @@ func autoConvert_v1beta1_ServiceInstanceStatus_To_servicecatalog_ServiceInstance
 	out.ProvisionStatus = servicecatalog.ServiceInstanceProvisionStatus(in.ProvisionStatus)
 	out.DeprovisionStatus = servicecatalog.ServiceInstanceDeprovisionStatus(in.DeprovisionStatus)
 	out.DefaultProvisionParameters = (*runtime.RawExtension)(unsafe.Pointer(in.DefaultProvisionParameters))
+	out.BindingCount = in.BindingCount
+	out.LastProvisionDuration = (*v1.Duration)(unsafe.Pointer(in.LastProvisionDuration))
+	out.LastUpdateDuration = (*v1.Duration)(unsafe.Pointer(in.LastUpdateDuration))
 	out.LastConditionState = in.LastConditionState
 	out.UserSpecifiedPlanName = in.UserSpecifiedPlanName
 	out.UserSpecifiedClassName = in.UserSpecifiedClassName
+	out.ResolvedClassExternalName = in.ResolvedClassExternalName
+	out.ResolvedPlanExternalName = in.ResolvedPlanExternalName
 	return nil
 }
 
@@ -2165,8 +2490,10 @@ func autoConvert_servicecatalog_ServiceInstanceStatus_To_v1beta1_ServiceInstance
 	out.Conditions = *(*[]ServiceInstanceCondition)(unsafe.Pointer(&in.Conditions))
 	out.AsyncOpInProgress = in.AsyncOpInProgress
 	out.OrphanMitigationInProgress = in.OrphanMitigationInProgress
+	out.OrphanMitigationAttempts = in.OrphanMitigationAttempts
 	out.LastOperation = (*string)(unsafe.Pointer(in.LastOperation))
 	out.DashboardURL = (*string)(unsafe.Pointer(in.DashboardURL))
+	out.ExtensionAPIs = *(*[]ExtensionAPI)(unsafe.Pointer(&in.ExtensionAPIs))
 	out.CurrentOperation = ServiceInstanceOperation(in.CurrentOperation)
 	out.ReconciledGeneration = in.ReconciledGeneration
 	out.ObservedGeneration = in.ObservedGeneration
@@ -2176,9 +2503,14 @@ func autoConvert_servicecatalog_ServiceInstanceStatus_To_v1beta1_ServiceInstance
 	out.ProvisionStatus = ServiceInstanceProvisionStatus(in.ProvisionStatus)
 	out.DeprovisionStatus = ServiceInstanceDeprovisionStatus(in.DeprovisionStatus)
 	out.DefaultProvisionParameters = (*runtime.RawExtension)(unsafe.Pointer(in.DefaultProvisionParameters))
+	out.BindingCount = in.BindingCount
+	out.LastProvisionDuration = (*v1.Duration)(unsafe.Pointer(in.LastProvisionDuration))
+	out.LastUpdateDuration = (*v1.Duration)(unsafe.Pointer(in.LastUpdateDuration))
 	out.LastConditionState = in.LastConditionState
 	out.UserSpecifiedPlanName = in.UserSpecifiedPlanName
 	out.UserSpecifiedClassName = in.UserSpecifiedClassName
+	out.ResolvedClassExternalName = in.ResolvedClassExternalName
+	out.ResolvedPlanExternalName = in.ResolvedPlanExternalName
 	return nil
 }
 
@@ -2219,6 +2551,28 @@ func Convert_servicecatalog_ServicePlan_To_v1beta1_ServicePlan(in *servicecatalo
 	return autoConvert_servicecatalog_ServicePlan_To_v1beta1_ServicePlan(in, out, s)
 }
 
+func autoConvert_v1beta1_ServicePlanCost_To_servicecatalog_ServicePlanCost(in *ServicePlanCost, out *servicecatalog.ServicePlanCost, s conversion.Scope) error {
+	out.Amount = *(*map[string]string)(unsafe.Pointer(&in.Amount))
+	out.Unit = in.Unit
+	return nil
+}
+
+// Convert_v1beta1_ServicePlanCost_To_servicecatalog_ServicePlanCost is an autogenerated conversion function.
+func Convert_v1beta1_ServicePlanCost_To_servicecatalog_ServicePlanCost(in *ServicePlanCost, out *servicecatalog.ServicePlanCost, s conversion.Scope) error {
+	return autoConvert_v1beta1_ServicePlanCost_To_servicecatalog_ServicePlanCost(in, out, s)
+}
+
+func autoConvert_servicecatalog_ServicePlanCost_To_v1beta1_ServicePlanCost(in *servicecatalog.ServicePlanCost, out *ServicePlanCost, s conversion.Scope) error {
+	out.Amount = *(*map[string]string)(unsafe.Pointer(&in.Amount))
+	out.Unit = in.Unit
+	return nil
+}
+
+// Convert_servicecatalog_ServicePlanCost_To_v1beta1_ServicePlanCost is an autogenerated conversion function.
+func Convert_servicecatalog_ServicePlanCost_To_v1beta1_ServicePlanCost(in *servicecatalog.ServicePlanCost, out *ServicePlanCost, s conversion.Scope) error {
+	return autoConvert_servicecatalog_ServicePlanCost_To_v1beta1_ServicePlanCost(in, out, s)
+}
+
 func autoConvert_v1beta1_ServicePlanList_To_servicecatalog_ServicePlanList(in *ServicePlanList, out *servicecatalog.ServicePlanList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]servicecatalog.ServicePlan)(unsafe.Pointer(&in.Items))
@@ -2297,6 +2651,28 @@ func Convert_servicecatalog_ServicePlanStatus_To_v1beta1_ServicePlanStatus(in *s
 	return autoConvert_servicecatalog_ServicePlanStatus_To_v1beta1_ServicePlanStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_URLReference_To_servicecatalog_URLReference(in *URLReference, out *servicecatalog.URLReference, s conversion.Scope) error {
+	out.URL = in.URL
+	out.BearerTokenSecretKeyRef = (*servicecatalog.SecretKeyReference)(unsafe.Pointer(in.BearerTokenSecretKeyRef))
+	return nil
+}
+
+// Convert_v1beta1_URLReference_To_servicecatalog_URLReference is an autogenerated conversion function.
+func Convert_v1beta1_URLReference_To_servicecatalog_URLReference(in *URLReference, out *servicecatalog.URLReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_URLReference_To_servicecatalog_URLReference(in, out, s)
+}
+
+func autoConvert_servicecatalog_URLReference_To_v1beta1_URLReference(in *servicecatalog.URLReference, out *URLReference, s conversion.Scope) error {
+	out.URL = in.URL
+	out.BearerTokenSecretKeyRef = (*SecretKeyReference)(unsafe.Pointer(in.BearerTokenSecretKeyRef))
+	return nil
+}
+
+// Convert_servicecatalog_URLReference_To_v1beta1_URLReference is an autogenerated conversion function.
+func Convert_servicecatalog_URLReference_To_v1beta1_URLReference(in *servicecatalog.URLReference, out *URLReference, s conversion.Scope) error {
+	return autoConvert_servicecatalog_URLReference_To_v1beta1_URLReference(in, out, s)
+}
+
 func autoConvert_v1beta1_UserInfo_To_servicecatalog_UserInfo(in *UserInfo, out *servicecatalog.UserInfo, s conversion.Scope) error {
 	out.Username = in.Username
 	out.UID = in.UID
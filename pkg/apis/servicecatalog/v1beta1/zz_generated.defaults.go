@@ -34,6 +34,8 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&ServiceBindingList{}, func(obj interface{}) { SetObjectDefaults_ServiceBindingList(obj.(*ServiceBindingList)) })
 	scheme.AddTypeDefaultingFunc(&ServiceBroker{}, func(obj interface{}) { SetObjectDefaults_ServiceBroker(obj.(*ServiceBroker)) })
 	scheme.AddTypeDefaultingFunc(&ServiceBrokerList{}, func(obj interface{}) { SetObjectDefaults_ServiceBrokerList(obj.(*ServiceBrokerList)) })
+	scheme.AddTypeDefaultingFunc(&ServiceInstance{}, func(obj interface{}) { SetObjectDefaults_ServiceInstance(obj.(*ServiceInstance)) })
+	scheme.AddTypeDefaultingFunc(&ServiceInstanceList{}, func(obj interface{}) { SetObjectDefaults_ServiceInstanceList(obj.(*ServiceInstanceList)) })
 	return nil
 }
 
@@ -69,3 +71,14 @@ func SetObjectDefaults_ServiceBrokerList(in *ServiceBrokerList) {
 		SetObjectDefaults_ServiceBroker(a)
 	}
 }
+
+func SetObjectDefaults_ServiceInstance(in *ServiceInstance) {
+	SetDefaults_PlanReference(&in.Spec.PlanReference)
+}
+
+func SetObjectDefaults_ServiceInstanceList(in *ServiceInstanceList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ServiceInstance(a)
+	}
+}
@@ -17,9 +17,16 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
 	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"sigs.k8s.io/yaml"
@@ -73,6 +80,7 @@ func internalValidateServiceBinding(binding *sc.ServiceBinding, create bool) fie
 		validateServiceBindingName,
 		field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateServiceBindingSpec(&binding.Spec, field.NewPath("spec"), create)...)
+	allErrs = append(allErrs, validateServiceBindingAdditionalSecretNamespaces(binding, field.NewPath("spec").Child("additionalSecretNamespaces"))...)
 	if create {
 		allErrs = append(allErrs, validateServiceBindingCreate(binding)...)
 	} else {
@@ -96,6 +104,62 @@ func validateServiceBindingSpec(spec *sc.ServiceBindingSpec, fldPath *field.Path
 		allErrs = append(allErrs, validateParametersFromSource(spec.ParametersFrom, fldPath)...)
 	}
 
+	if spec.Endpoint != "" {
+		for _, msg := range utilvalidation.IsDNS1123Label(spec.Endpoint) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("endpoint"), spec.Endpoint, msg))
+		}
+	}
+
+	switch spec.SecretKeyFormat {
+	case "", sc.SecretKeyFormatUpperSnakeCase, sc.SecretKeyFormatCamelCase:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("secretKeyFormat"), spec.SecretKeyFormat, validServiceBindingSecretKeyFormats))
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(spec.SecretLabels, fldPath.Child("secretLabels"))...)
+	allErrs = append(allErrs, validateReservedSecretMetadataKeys(spec.SecretLabels, fldPath.Child("secretLabels"))...)
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(spec.SecretAnnotations, fldPath.Child("secretAnnotations"))...)
+	allErrs = append(allErrs, validateReservedSecretMetadataKeys(spec.SecretAnnotations, fldPath.Child("secretAnnotations"))...)
+
+	return allErrs
+}
+
+// reservedSecretMetadataPrefix is the prefix of the labels and annotations
+// the controller manages on the Secret of a ServiceBinding.
+const reservedSecretMetadataPrefix = "servicecatalog.k8s.io/"
+
+// validateReservedSecretMetadataKeys checks that none of the given label or
+// annotation keys use the prefix reserved for the controller.
+func validateReservedSecretMetadataKeys(metadata map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for k := range metadata {
+		if strings.HasPrefix(k, reservedSecretMetadataPrefix) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(k), k, fmt.Sprintf("keys with the prefix %q are reserved", reservedSecretMetadataPrefix)))
+		}
+	}
+	return allErrs
+}
+
+// validateServiceBindingAdditionalSecretNamespaces checks that the namespaces
+// the Secret should be copied into are valid, unique and different from the
+// ServiceBinding's own namespace.
+func validateServiceBindingAdditionalSecretNamespaces(binding *sc.ServiceBinding, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := make(map[string]bool, len(binding.Spec.AdditionalSecretNamespaces))
+	for i, namespace := range binding.Spec.AdditionalSecretNamespaces {
+		for _, msg := range apivalidation.ValidateNamespaceName(namespace, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), namespace, msg))
+		}
+		if namespace == binding.Namespace {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), namespace, "must not be the namespace of the ServiceBinding"))
+		}
+		if seen[namespace] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), namespace))
+		}
+		seen[namespace] = true
+	}
+
 	return allErrs
 }
 
@@ -144,6 +208,10 @@ func validateServiceBindingStatus(status *sc.ServiceBindingStatus, fldPath *fiel
 		}
 	}
 
+	if status.SecretWritePending && status.CurrentOperation != sc.ServiceBindingOperationBind {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("secretWritePending"), `secretWritePending cannot be true when currentOperation is not "Bind"`))
+	}
+
 	if status.InProgressProperties != nil {
 		allErrs = append(allErrs, validateServiceBindingPropertiesState(status.InProgressProperties, fldPath.Child("inProgressProperties"), create)...)
 	}
@@ -152,6 +220,14 @@ func validateServiceBindingStatus(status *sc.ServiceBindingStatus, fldPath *fiel
 		allErrs = append(allErrs, validateServiceBindingPropertiesState(status.ExternalProperties, fldPath.Child("externalProperties"), create)...)
 	}
 
+	if status.RouteServiceURL != nil {
+		allErrs = append(allErrs, ValidateServiceBindingRouteServiceURL(*status.RouteServiceURL, fldPath.Child("routeServiceURL"))...)
+	}
+
+	for i, volumeMount := range status.VolumeMounts {
+		allErrs = append(allErrs, ValidateServiceBindingVolumeMount(&volumeMount, fldPath.Child("volumeMounts").Index(i))...)
+	}
+
 	if create {
 		if status.UnbindStatus != sc.ServiceBindingUnbindStatusNotRequired {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("unbindStatus"), status.UnbindStatus, `unbindStatus must be "NotRequired" on create`))
@@ -218,6 +294,67 @@ func validateServiceBindingUpdate(binding *sc.ServiceBinding) field.ErrorList {
 	return allErrs
 }
 
+// ValidateServiceBindingRouteServiceURL validates that a route service URL
+// returned by the broker is an absolute https URL, as required by the Open
+// Service Broker API.
+func ValidateServiceBindingRouteServiceURL(routeServiceURL string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	u, err := url.Parse(routeServiceURL)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, routeServiceURL, err.Error()))
+		return allErrs
+	}
+	if u.Scheme != "https" {
+		allErrs = append(allErrs, field.Invalid(fldPath, routeServiceURL, "scheme must be https"))
+	}
+	if u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, routeServiceURL, "host must not be empty"))
+	}
+
+	return allErrs
+}
+
+var validServiceBindingSecretKeyFormats = []string{
+	string(sc.SecretKeyFormatUpperSnakeCase),
+	string(sc.SecretKeyFormatCamelCase),
+}
+
+var validServiceBindingVolumeMountModes = []string{
+	string(sc.ServiceBindingVolumeMountModeReadOnly),
+	string(sc.ServiceBindingVolumeMountModeReadWrite),
+}
+
+var validServiceBindingVolumeDeviceTypes = []string{
+	string(sc.ServiceBindingVolumeDeviceTypeShared),
+}
+
+// ValidateServiceBindingVolumeMount validates a volume mount returned by the
+// broker against the structure defined by the Open Service Broker API.
+func ValidateServiceBindingVolumeMount(volumeMount *sc.ServiceBindingVolumeMount, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if volumeMount.Driver == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("driver"), "driver is required"))
+	}
+	if volumeMount.ContainerDir == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("containerDir"), "containerDir is required"))
+	}
+	switch volumeMount.Mode {
+	case sc.ServiceBindingVolumeMountModeReadOnly, sc.ServiceBindingVolumeMountModeReadWrite:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), volumeMount.Mode, validServiceBindingVolumeMountModes))
+	}
+	if volumeMount.DeviceType != sc.ServiceBindingVolumeDeviceTypeShared {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("deviceType"), volumeMount.DeviceType, validServiceBindingVolumeDeviceTypes))
+	}
+	if volumeMount.Device.VolumeID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("device", "volumeID"), "volumeID is required"))
+	}
+
+	return allErrs
+}
+
 // internalValidateServiceBindingUpdateAllowed ensures there is not a
 // pending update on-going with the spec of the binding before allowing an update
 // to the spec to go through.
@@ -243,9 +380,37 @@ func ValidateServiceBindingUpdate(new *sc.ServiceBinding, old *sc.ServiceBinding
 	return allErrs
 }
 
+// ValidateServiceBindingParameters checks the parameters of a binding against
+// the given limits. On update, old is the previous version of the binding and
+// the parameters are only checked if they changed.
+func ValidateServiceBindingParameters(new *sc.ServiceBinding, old *sc.ServiceBinding, limits ParametersLimits) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if old != nil &&
+		apiequality.Semantic.DeepEqual(new.Spec.Parameters, old.Spec.Parameters) &&
+		apiequality.Semantic.DeepEqual(new.Spec.ParametersFrom, old.Spec.ParametersFrom) {
+		return allErrs
+	}
+
+	specFieldPath := field.NewPath("spec")
+	allErrs = append(allErrs, validateParametersSize(new.Spec.Parameters, limits.MaxSize, specFieldPath.Child("parameters"))...)
+	allErrs = append(allErrs, validateReservedParameterKeys(new.Spec.Parameters, new.Spec.ParametersFrom, limits.ReservedKeys, specFieldPath)...)
+
+	return allErrs
+}
+
+func internalValidateServiceBindingStatusUpdateAllowed(new *sc.ServiceBinding, old *sc.ServiceBinding) field.ErrorList {
+	errors := field.ErrorList{}
+	if !apiequality.Semantic.DeepEqual(new.Spec, old.Spec) {
+		errors = append(errors, field.Forbidden(field.NewPath("spec"), "spec cannot be changed by a status update"))
+	}
+	return errors
+}
+
 // ValidateServiceBindingStatusUpdate checks that when changing from an older binding to a newer binding is okay.
 func ValidateServiceBindingStatusUpdate(new *sc.ServiceBinding, old *sc.ServiceBinding) field.ErrorList {
 	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, internalValidateServiceBindingStatusUpdateAllowed(new, old)...)
 	allErrs = append(allErrs, internalValidateServiceBinding(new, false)...)
 	allErrs = append(allErrs, validateServiceBindingStatus(&new.Status, field.NewPath("status"), false)...)
 	return allErrs
@@ -17,6 +17,7 @@ limitations under the License.
 package validation
 
 import (
+	"strings"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -55,6 +56,18 @@ func validServiceBindingWithInProgressBind() *servicecatalog.ServiceBinding {
 	return binding
 }
 
+func validServiceBindingVolumeMount() servicecatalog.ServiceBindingVolumeMount {
+	return servicecatalog.ServiceBindingVolumeMount{
+		Driver:       "cephdriver",
+		ContainerDir: "/data/images",
+		Mode:         servicecatalog.ServiceBindingVolumeMountModeReadWrite,
+		DeviceType:   servicecatalog.ServiceBindingVolumeDeviceTypeShared,
+		Device: servicecatalog.ServiceBindingVolumeDevice{
+			VolumeID: "bc2c1eab-05b9-482d-b0cf-750ee07de311",
+		},
+	}
+}
+
 func validServiceBindingPropertiesState() *servicecatalog.ServiceBindingPropertiesState {
 	return &servicecatalog.ServiceBindingPropertiesState{
 		Parameters:        &runtime.RawExtension{Raw: []byte("a: 1\nb: \"2\"")},
@@ -128,6 +141,160 @@ func TestValidateServiceBinding(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid dotted secretName",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretName = "test-secret.example.com"
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "secretName with trailing dash",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretName = "test-secret-"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "secretName too long",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretName = strings.Repeat("a", 254)
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid additionalSecretNamespaces",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdditionalSecretNamespaces = []string{"other-ns", "another-ns"}
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid namespace in additionalSecretNamespaces",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdditionalSecretNamespaces = []string{"Other_NS"}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "binding namespace in additionalSecretNamespaces",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdditionalSecretNamespaces = []string{b.Namespace}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "duplicated namespace in additionalSecretNamespaces",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.AdditionalSecretNamespaces = []string{"other-ns", "other-ns"}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid endpoint",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.Endpoint = "read-replica"
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid endpoint",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.Endpoint = "Read Replica"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid secretLabels and secretAnnotations",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretLabels = map[string]string{"app.kubernetes.io/name": "my-app"}
+				b.Spec.SecretAnnotations = map[string]string{"example.com/owner": "team a"}
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid secretLabels value",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretLabels = map[string]string{"app": "not a label value"}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "invalid secretAnnotations key",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretAnnotations = map[string]string{"not a key": "value"}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "reserved secretLabels key",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretLabels = map[string]string{"servicecatalog.k8s.io/binding-uid": "uid"}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "reserved secretAnnotations key",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretAnnotations = map[string]string{"servicecatalog.k8s.io/instance-name": "other"}
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid upper snake case secretKeyFormat",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretKeyFormat = servicecatalog.SecretKeyFormatUpperSnakeCase
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "valid camel case secretKeyFormat",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretKeyFormat = servicecatalog.SecretKeyFormatCamelCase
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "unsupported secretKeyFormat",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Spec.SecretKeyFormat = "kebab-case"
+				return b
+			}(),
+			valid: false,
+		},
 		{
 			name: "valid parametersFrom",
 			binding: func() *servicecatalog.ServiceBinding {
@@ -573,6 +740,113 @@ func TestValidateServiceBinding(t *testing.T) {
 			}(),
 			valid: true,
 		},
+		{
+			name: "in-progress bind with pending secret write",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBindingWithInProgressBind()
+				b.Status.SecretWritePending = true
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "not in-progress with pending secret write",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Status.SecretWritePending = true
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid route service URL",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				routeServiceURL := "https://route.example.com/proxy"
+				b.Status.RouteServiceURL = &routeServiceURL
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "route service URL with http scheme",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				routeServiceURL := "http://route.example.com/proxy"
+				b.Status.RouteServiceURL = &routeServiceURL
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "route service URL without host",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				routeServiceURL := "https:///proxy"
+				b.Status.RouteServiceURL = &routeServiceURL
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid volume mount",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Status.VolumeMounts = []servicecatalog.ServiceBindingVolumeMount{validServiceBindingVolumeMount()}
+				return b
+			}(),
+			valid: true,
+		},
+		{
+			name: "volume mount missing driver",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Status.VolumeMounts = []servicecatalog.ServiceBindingVolumeMount{validServiceBindingVolumeMount()}
+				b.Status.VolumeMounts[0].Driver = ""
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "volume mount missing container dir",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Status.VolumeMounts = []servicecatalog.ServiceBindingVolumeMount{validServiceBindingVolumeMount()}
+				b.Status.VolumeMounts[0].ContainerDir = ""
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "volume mount with invalid mode",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Status.VolumeMounts = []servicecatalog.ServiceBindingVolumeMount{validServiceBindingVolumeMount()}
+				b.Status.VolumeMounts[0].Mode = "w"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "volume mount with invalid device type",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Status.VolumeMounts = []servicecatalog.ServiceBindingVolumeMount{validServiceBindingVolumeMount()}
+				b.Status.VolumeMounts[0].DeviceType = "exclusive"
+				return b
+			}(),
+			valid: false,
+		},
+		{
+			name: "volume mount missing volume ID",
+			binding: func() *servicecatalog.ServiceBinding {
+				b := validServiceBinding()
+				b.Status.VolumeMounts = []servicecatalog.ServiceBindingVolumeMount{validServiceBindingVolumeMount()}
+				b.Status.VolumeMounts[0].Device.VolumeID = ""
+				return b
+			}(),
+			valid: false,
+		},
 		{
 			name: "LastOperation too long",
 			binding: func() *servicecatalog.ServiceBinding {
@@ -597,6 +871,65 @@ func TestValidateServiceBinding(t *testing.T) {
 	}
 }
 
+func TestValidateServiceBindingParameters(t *testing.T) {
+	limits := ParametersLimits{MaxSize: DefaultMaxParametersSize, ReservedKeys: []string{"platform"}}
+	cases := []struct {
+		name       string
+		parameters *runtime.RawExtension
+		old        *runtime.RawExtension
+		update     bool
+		valid      bool
+	}{
+		{
+			name:       "parameters at the size limit",
+			parameters: parametersOfSize(DefaultMaxParametersSize),
+			valid:      true,
+		},
+		{
+			name:       "parameters exceeding the size limit",
+			parameters: parametersOfSize(DefaultMaxParametersSize + 1),
+			valid:      false,
+		},
+		{
+			name:       "parameters with reserved key",
+			parameters: &runtime.RawExtension{Raw: []byte(`{"platform":"kubernetes"}`)},
+			valid:      false,
+		},
+		{
+			name:       "update changing parameters to a reserved key",
+			parameters: &runtime.RawExtension{Raw: []byte(`{"platform":"kubernetes"}`)},
+			update:     true,
+			valid:      false,
+		},
+		{
+			name:       "update keeping parameters with a reserved key",
+			parameters: &runtime.RawExtension{Raw: []byte(`{"platform":"kubernetes"}`)},
+			old:        &runtime.RawExtension{Raw: []byte(`{"platform":"kubernetes"}`)},
+			update:     true,
+			valid:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := validServiceBinding()
+			binding.Spec.Parameters = tc.parameters
+			var old *servicecatalog.ServiceBinding
+			if tc.update {
+				old = validServiceBinding()
+				old.Spec.Parameters = tc.old
+			}
+
+			errs := ValidateServiceBindingParameters(binding, old, limits)
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("unexpected error: %v", errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Error("unexpected success")
+			}
+		})
+	}
+}
+
 func TestInternalValidateServiceBindingUpdateAllowed(t *testing.T) {
 	cases := []struct {
 		name              string
@@ -657,3 +990,41 @@ func TestInternalValidateServiceBindingUpdateAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateServiceBindingStatusUpdate(t *testing.T) {
+	cases := []struct {
+		name   string
+		update func(*servicecatalog.ServiceBinding)
+		valid  bool
+	}{
+		{
+			name: "status change",
+			update: func(b *servicecatalog.ServiceBinding) {
+				b.Status.UnbindStatus = servicecatalog.ServiceBindingUnbindStatusRequired
+			},
+			valid: true,
+		},
+		{
+			name: "spec change",
+			update: func(b *servicecatalog.ServiceBinding) {
+				b.Spec.SecretName = "other-secret"
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			old := validServiceBinding()
+			new := old.DeepCopy()
+			tc.update(new)
+
+			errs := ValidateServiceBindingStatusUpdate(new, old)
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("unexpected error: %v", errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Error("unexpected success")
+			}
+		})
+	}
+}
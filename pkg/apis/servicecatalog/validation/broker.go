@@ -19,8 +19,10 @@ package validation
 import (
 	"fmt"
 
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
@@ -31,6 +33,18 @@ import (
 // broker names.
 var validateCommonServiceBrokerName = apivalidation.NameIsDNSSubdomain
 
+// minServiceAccountTokenExpirationSeconds is the shortest token lifetime
+// accepted by the TokenRequest API.
+const minServiceAccountTokenExpirationSeconds = 10 * 60
+
+// validOSBAPIVersions are the versions of the Open Service Broker API a
+// broker can be configured to use.
+var validOSBAPIVersions = sets.NewString(
+	osb.Version2_11().HeaderValue(),
+	osb.Version2_12().HeaderValue(),
+	osb.Version2_13().HeaderValue(),
+)
+
 // ValidateClusterServiceBroker implements the validation rules for a
 // ClusterServiceBroker.
 func ValidateClusterServiceBroker(broker *sc.ClusterServiceBroker) field.ErrorList {
@@ -81,6 +95,20 @@ func validateClusterServiceBrokerSpec(spec *sc.ClusterServiceBrokerSpec, fldPath
 					field.Required(fldPath.Child("authInfo", "bearer", "secretRef"), "a basic auth secret is required"),
 				)
 			}
+		} else if spec.AuthInfo.BearerTokenFromServiceAccount != nil {
+			saAuth := spec.AuthInfo.BearerTokenFromServiceAccount
+			saFldPath := fldPath.Child("authInfo", "bearerTokenFromServiceAccount")
+			if saRef := saAuth.ServiceAccountRef; saRef != nil {
+				for _, msg := range apivalidation.ValidateNamespaceName(saRef.Namespace, false /* prefix */) {
+					allErrs = append(allErrs, field.Invalid(saFldPath.Child("serviceAccountRef", "namespace"), saRef.Namespace, msg))
+				}
+				for _, msg := range apivalidation.ValidateServiceAccountName(saRef.Name, false /* prefix */) {
+					allErrs = append(allErrs, field.Invalid(saFldPath.Child("serviceAccountRef", "name"), saRef.Name, msg))
+				}
+			} else {
+				allErrs = append(allErrs, field.Required(saFldPath.Child("serviceAccountRef"), "a service account is required"))
+			}
+			allErrs = append(allErrs, validateServiceAccountTokenRequest(saAuth.Audience, saAuth.ExpirationSeconds, saFldPath)...)
 		} else {
 			// Authentication
 			allErrs = append(
@@ -90,6 +118,10 @@ func validateClusterServiceBrokerSpec(spec *sc.ClusterServiceBrokerSpec, fldPath
 		}
 	}
 
+	if spec.FallbackAuthInfo != nil {
+		allErrs = append(allErrs, validateClusterServiceBrokerFallbackAuthInfo(spec, fldPath)...)
+	}
+
 	commonErrs := validateCommonServiceBrokerSpec(&spec.CommonServiceBrokerSpec, fldPath, true)
 
 	if len(commonErrs) != 0 {
@@ -99,6 +131,68 @@ func validateClusterServiceBrokerSpec(spec *sc.ClusterServiceBrokerSpec, fldPath
 	return allErrs
 }
 
+// validateClusterServiceBrokerFallbackAuthInfo validates the fallback auth
+// info of a broker. The fallback and the primary auth info must both use
+// credentials from a secret.
+func validateClusterServiceBrokerFallbackAuthInfo(spec *sc.ClusterServiceBrokerSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.AuthInfo == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("authInfo"), "auth config is required when fallbackAuthInfo is set"))
+	} else if spec.AuthInfo.BearerTokenFromServiceAccount != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("authInfo", "bearerTokenFromServiceAccount"), "may not be used together with fallbackAuthInfo"))
+	}
+
+	fallbackPath := fldPath.Child("fallbackAuthInfo")
+	fallback := spec.FallbackAuthInfo
+	switch {
+	case fallback.Basic != nil:
+		allErrs = append(allErrs, validateClusterServiceBrokerAuthSecretRef(fallback.Basic.SecretRef, fallbackPath.Child("basic", "secretRef"))...)
+	case fallback.Bearer != nil:
+		allErrs = append(allErrs, validateClusterServiceBrokerAuthSecretRef(fallback.Bearer.SecretRef, fallbackPath.Child("bearer", "secretRef"))...)
+	case fallback.BearerTokenFromServiceAccount != nil:
+		allErrs = append(allErrs, field.Forbidden(fallbackPath.Child("bearerTokenFromServiceAccount"), "service account tokens are not supported as fallback auth"))
+	default:
+		allErrs = append(allErrs, field.Required(fallbackPath, "auth config is required"))
+	}
+
+	return allErrs
+}
+
+// validateClusterServiceBrokerAuthSecretRef validates a reference to the
+// secret holding the credentials of a broker.
+func validateClusterServiceBrokerAuthSecretRef(secretRef *sc.ObjectReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if secretRef == nil {
+		return append(allErrs, field.Required(fldPath, "an auth secret is required"))
+	}
+	for _, msg := range apivalidation.ValidateNamespaceName(secretRef.Namespace, false /* prefix */) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), secretRef.Namespace, msg))
+	}
+	for _, msg := range apivalidation.NameIsDNSSubdomain(secretRef.Name, false /* prefix */) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), secretRef.Name, msg))
+	}
+
+	return allErrs
+}
+
+// validateServiceAccountTokenRequest validates the parameters of the
+// TokenRequest used to obtain a service account token for a broker.
+func validateServiceAccountTokenRequest(audience string, expirationSeconds *int64, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if audience == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("audience"), "an audience is required"))
+	}
+	if expirationSeconds != nil && *expirationSeconds < minServiceAccountTokenExpirationSeconds {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("expirationSeconds"), *expirationSeconds,
+			fmt.Sprintf("must be at least %d seconds", minServiceAccountTokenExpirationSeconds)))
+	}
+
+	return allErrs
+}
+
 // ValidateServiceBroker implements the validation rules for a
 // ServiceBroker.
 func ValidateServiceBroker(broker *sc.ServiceBroker) field.ErrorList {
@@ -143,6 +237,17 @@ func validateServiceBrokerSpec(spec *sc.ServiceBrokerSpec, fldPath *field.Path)
 					field.Required(fldPath.Child("authInfo", "bearer", "secretRef"), "a basic auth secret is required"),
 				)
 			}
+		} else if spec.AuthInfo.BearerTokenFromServiceAccount != nil {
+			saAuth := spec.AuthInfo.BearerTokenFromServiceAccount
+			saFldPath := fldPath.Child("authInfo", "bearerTokenFromServiceAccount")
+			if saRef := saAuth.ServiceAccountRef; saRef != nil {
+				for _, msg := range apivalidation.ValidateServiceAccountName(saRef.Name, false /* prefix */) {
+					allErrs = append(allErrs, field.Invalid(saFldPath.Child("serviceAccountRef", "name"), saRef.Name, msg))
+				}
+			} else {
+				allErrs = append(allErrs, field.Required(saFldPath.Child("serviceAccountRef"), "a service account is required"))
+			}
+			allErrs = append(allErrs, validateServiceAccountTokenRequest(saAuth.Audience, saAuth.ExpirationSeconds, saFldPath)...)
 		} else {
 			// Authentication
 			allErrs = append(
@@ -174,6 +279,10 @@ func validateCommonServiceBrokerSpec(spec *sc.CommonServiceBrokerSpec, fldPath *
 		commonErrs = append(commonErrs, field.Invalid(fldPath.Child("caBundle"), spec.CABundle, "caBundle cannot be used when insecureSkipTLSVerify is true"))
 	}
 
+	if spec.OSBAPIVersion != "" && !validOSBAPIVersions.Has(spec.OSBAPIVersion) {
+		commonErrs = append(commonErrs, field.NotSupported(fldPath.Child("osbApiVersion"), spec.OSBAPIVersion, validOSBAPIVersions.List()))
+	}
+
 	if "" == spec.RelistBehavior {
 		commonErrs = append(commonErrs,
 			field.Required(fldPath.Child("relistBehavior"),
@@ -18,11 +18,15 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
+	"strconv"
 
 	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
-	"github.com/kubernetes-incubator/service-catalog/pkg/controller"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"sigs.k8s.io/yaml"
@@ -30,6 +34,10 @@ import (
 
 const lastOperationMaxLength int = 10000
 
+// maxGeneratedParameterLength is the maximum length of a generated parameter
+// value.
+const maxGeneratedParameterLength = 1024
+
 // validateServiceInstanceName is the validation function for Instance names.
 var validateServiceInstanceName = apivalidation.NameIsDNSSubdomain
 
@@ -78,6 +86,7 @@ func internalValidateServiceInstance(instance *sc.ServiceInstance, create bool)
 		validateServiceInstanceName,
 		field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateServiceInstanceSpec(&instance.Spec, field.NewPath("spec"), create)...)
+	allErrs = append(allErrs, validateServiceInstanceReconcilePriority(instance)...)
 	if create {
 		allErrs = append(allErrs, validateServiceInstanceCreate(instance)...)
 	} else {
@@ -92,6 +101,15 @@ func validateServiceInstanceSpec(spec *sc.ServiceInstanceSpec, fldPath *field.Pa
 	allErrs = append(allErrs, validateObjectReferences(spec, fldPath)...)
 	allErrs = append(allErrs, validatePlanReference(&spec.PlanReference, fldPath)...)
 
+	if spec.ClusterServiceBrokerName != "" {
+		if !spec.ClusterServiceClassSpecified() {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("clusterServiceBrokerName"), "clusterServiceBrokerName may only be set for cluster-scoped classes"))
+		}
+		for _, msg := range validateCommonServiceBrokerName(spec.ClusterServiceBrokerName, false /* prefix */) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("clusterServiceBrokerName"), spec.ClusterServiceBrokerName, msg))
+		}
+	}
+
 	if spec.ParametersFrom != nil {
 		allErrs = append(allErrs, validateParametersFromSource(spec.ParametersFrom, fldPath)...)
 	}
@@ -99,12 +117,69 @@ func validateServiceInstanceSpec(spec *sc.ServiceInstanceSpec, fldPath *field.Pa
 		if len(spec.Parameters.Raw) == 0 {
 			allErrs = append(allErrs, field.Required(fldPath.Child("parameters"), "inline parameters must not be empty if present"))
 		}
-		if _, err := controller.UnmarshalRawParameters(spec.Parameters.Raw); err != nil {
+		if _, err := unmarshalParameters(spec.Parameters.Raw); err != nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("parameters"), "invalid inline parameters"))
 		}
 	}
 
+	if spec.GeneratedParameters != nil {
+		allErrs = append(allErrs, validateGeneratedParameters(spec, fldPath.Child("generatedParameters"))...)
+	}
+
 	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(spec.UpdateRequests, fldPath.Child("updateRequests"))...)
+	if spec.TTLSecondsAfterFailure != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(*spec.TTLSecondsAfterFailure, fldPath.Child("ttlSecondsAfterFailure"))...)
+	}
+	if spec.TTLSecondsAfterReady != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(*spec.TTLSecondsAfterReady, fldPath.Child("ttlSecondsAfterReady"))...)
+	}
+
+	return allErrs
+}
+
+// validateGeneratedParameters checks the generated parameters of an instance,
+// including that their names are not assigned by another source.
+func validateGeneratedParameters(spec *sc.ServiceInstanceSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	generated := spec.GeneratedParameters
+
+	for _, msg := range apivalidation.NameIsDNSSubdomain(generated.SecretName, false /* prefix */) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("secretName"), generated.SecretName, msg))
+	}
+	if len(generated.Parameters) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("parameters"), "at least one parameter is required"))
+	}
+
+	// The names assigned by the other sources; invalid inline parameters are
+	// reported by the caller.
+	assigned := sets.NewString()
+	if spec.Parameters != nil {
+		if params, err := unmarshalParameters(spec.Parameters.Raw); err == nil {
+			assigned.Insert(sets.StringKeySet(params).List()...)
+		}
+	}
+	for _, paramsFrom := range spec.ParametersFrom {
+		assigned.Insert(paramsFrom.ParameterName)
+	}
+
+	seen := sets.NewString()
+	for i, param := range generated.Parameters {
+		paramPath := fldPath.Child("parameters").Index(i)
+		namePath := paramPath.Child("name")
+		for _, msg := range utilvalidation.IsConfigMapKey(param.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, param.Name, msg))
+		}
+		if seen.Has(param.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, param.Name))
+		} else if assigned.Has(param.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, param.Name, "parameter name is already assigned by parameters or parametersFrom"))
+		}
+		seen.Insert(param.Name)
+
+		if param.Length < 0 || param.Length > maxGeneratedParameterLength {
+			allErrs = append(allErrs, field.Invalid(paramPath.Child("length"), param.Length, fmt.Sprintf("must be between 0 and %d", maxGeneratedParameterLength)))
+		}
+	}
 
 	return allErrs
 }
@@ -166,6 +241,10 @@ func validateServiceInstanceStatus(status *sc.ServiceInstanceStatus, fldPath *fi
 		allErrs = append(allErrs, validateServiceInstancePropertiesState(status.ExternalProperties, fldPath.Child("externalProperties"), create)...)
 	}
 
+	for i, extensionAPI := range status.ExtensionAPIs {
+		allErrs = append(allErrs, ValidateExtensionAPI(&extensionAPI, fldPath.Child("extensionAPIs").Index(i))...)
+	}
+
 	if create {
 		if status.DeprovisionStatus != sc.ServiceInstanceDeprovisionStatusNotRequired {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("deprovisionStatus"), status.DeprovisionStatus, `deprovisionStatus must be "NotRequired" on create`))
@@ -179,6 +258,29 @@ func validateServiceInstanceStatus(status *sc.ServiceInstanceStatus, fldPath *fi
 	return allErrs
 }
 
+// ValidateExtensionAPI checks that the URLs of an extension API of an
+// instance are absolute http or https URLs, and that it adheres to an
+// absolute URI.
+func ValidateExtensionAPI(extensionAPI *sc.ExtensionAPI, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if extensionAPI.DiscoveryURL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("discoveryURL"), "discoveryURL is required"))
+	} else {
+		allErrs = append(allErrs, ValidateHTTPURL(extensionAPI.DiscoveryURL, fldPath.Child("discoveryURL"))...)
+	}
+	if extensionAPI.ServerURL != "" {
+		allErrs = append(allErrs, ValidateHTTPURL(extensionAPI.ServerURL, fldPath.Child("serverURL"))...)
+	}
+	if extensionAPI.AdheresTo != "" {
+		if u, err := url.Parse(extensionAPI.AdheresTo); err != nil || !u.IsAbs() {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("adheresTo"), extensionAPI.AdheresTo, "must be an absolute URI"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateServiceInstancePropertiesState(propertiesState *sc.ServiceInstancePropertiesState, fldPath *field.Path, create bool) field.ErrorList {
 	var errMsg string
 	allErrs := field.ErrorList{}
@@ -254,6 +356,43 @@ func validateServiceInstanceCreate(instance *sc.ServiceInstance) field.ErrorList
 	if instance.Spec.ServicePlanRef != nil {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("servicePlanRef"), "servicePlanRef must not be present on create"))
 	}
+	allErrs = append(allErrs, validateServiceInstanceStableExternalID(instance)...)
+	return allErrs
+}
+
+// validateServiceInstanceStableExternalID checks that an instance asking for
+// a stable external ID got the one derived from its namespace and name. It
+// does not when its name is generated, or when it specifies another one.
+func validateServiceInstanceStableExternalID(instance *sc.ServiceInstance) field.ErrorList {
+	allErrs := field.ErrorList{}
+	value, ok := instance.Annotations[sc.ServiceInstanceStableExternalIDAnnotation]
+	if !ok {
+		return allErrs
+	}
+	annotationPath := field.NewPath("metadata").Child("annotations").Key(sc.ServiceInstanceStableExternalIDAnnotation)
+	if value != "true" && value != "false" {
+		return append(allErrs, field.NotSupported(annotationPath, value, []string{"true", "false"}))
+	}
+	if value == "true" && instance.Spec.ExternalID != sc.StableExternalID(instance.Namespace, instance.Name) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("externalID"), instance.Spec.ExternalID,
+			"externalID must be derived from the namespace and name of the instance when "+sc.ServiceInstanceStableExternalIDAnnotation+
+				" is set; specify metadata.name instead of metadata.generateName and leave externalID empty"))
+	}
+	return allErrs
+}
+
+// validateServiceInstanceReconcilePriority checks that the reconcile priority
+// annotation of the instance, if set, is a 32-bit integer.
+func validateServiceInstanceReconcilePriority(instance *sc.ServiceInstance) field.ErrorList {
+	allErrs := field.ErrorList{}
+	value, ok := instance.Annotations[sc.ServiceInstanceReconcilePriorityAnnotation]
+	if !ok {
+		return allErrs
+	}
+	if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+		annotationPath := field.NewPath("metadata").Child("annotations").Key(sc.ServiceInstanceReconcilePriorityAnnotation)
+		allErrs = append(allErrs, field.Invalid(annotationPath, value, "must be a 32-bit integer"))
+	}
 	return allErrs
 }
 
@@ -340,6 +479,8 @@ func ValidateServiceInstanceUpdate(new *sc.ServiceInstance, old *sc.ServiceInsta
 	allErrs = append(allErrs, internalValidateServiceInstance(new, false)...)
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ExternalID, old.Spec.ExternalID, specFieldPath.Child("externalID"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ClusterServiceBrokerName, old.Spec.ClusterServiceBrokerName, specFieldPath.Child("clusterServiceBrokerName"))...)
+	allErrs = append(allErrs, validateServiceInstanceRefsUpdate(new, old, specFieldPath)...)
 
 	if new.Spec.UpdateRequests < old.Spec.UpdateRequests {
 		allErrs = append(allErrs, field.Invalid(specFieldPath.Child("updateRequests"), new.Spec.UpdateRequests, "new updateRequests value must not be less than the old one"))
@@ -348,8 +489,64 @@ func ValidateServiceInstanceUpdate(new *sc.ServiceInstance, old *sc.ServiceInsta
 	return allErrs
 }
 
+// ValidateServiceInstanceParameters checks the parameters of an instance
+// against the given limits. On update, old is the previous version of the
+// instance and the parameters are only checked if they changed, so that
+// instances created before the limits were tightened can still be updated.
+func ValidateServiceInstanceParameters(new *sc.ServiceInstance, old *sc.ServiceInstance, limits ParametersLimits) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if old != nil &&
+		apiequality.Semantic.DeepEqual(new.Spec.Parameters, old.Spec.Parameters) &&
+		apiequality.Semantic.DeepEqual(new.Spec.ParametersFrom, old.Spec.ParametersFrom) &&
+		apiequality.Semantic.DeepEqual(new.Spec.GeneratedParameters, old.Spec.GeneratedParameters) {
+		return allErrs
+	}
+
+	specFieldPath := field.NewPath("spec")
+	allErrs = append(allErrs, validateParametersSize(new.Spec.Parameters, limits.MaxSize, specFieldPath.Child("parameters"))...)
+	allErrs = append(allErrs, validateReservedParameterKeys(new.Spec.Parameters, new.Spec.ParametersFrom, limits.ReservedKeys, specFieldPath)...)
+	if new.Spec.GeneratedParameters != nil && len(limits.ReservedKeys) > 0 {
+		reserved := sets.NewString(limits.ReservedKeys...)
+		for i, param := range new.Spec.GeneratedParameters.Parameters {
+			if reserved.Has(param.Name) {
+				namePath := specFieldPath.Child("generatedParameters", "parameters").Index(i).Child("name")
+				allErrs = append(allErrs, field.Forbidden(namePath, fmt.Sprintf("parameter name is reserved; reserved names are %v", reserved.List())))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateServiceInstanceRefsUpdate ensures that the resolved class of an
+// instance never changes once set, and that its resolved plan is only ever
+// cleared, which happens when the plan is being changed, so that the
+// controller can resolve the new one.
+func validateServiceInstanceRefsUpdate(new *sc.ServiceInstance, old *sc.ServiceInstance, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if old.Spec.ClusterServiceClassRef != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ClusterServiceClassRef, old.Spec.ClusterServiceClassRef, fldPath.Child("clusterServiceClassRef"))...)
+	}
+	if old.Spec.ServiceClassRef != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ServiceClassRef, old.Spec.ServiceClassRef, fldPath.Child("serviceClassRef"))...)
+	}
+	if old.Spec.ClusterServicePlanRef != nil && new.Spec.ClusterServicePlanRef != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ClusterServicePlanRef, old.Spec.ClusterServicePlanRef, fldPath.Child("clusterServicePlanRef"))...)
+	}
+	if old.Spec.ServicePlanRef != nil && new.Spec.ServicePlanRef != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Spec.ServicePlanRef, old.Spec.ServicePlanRef, fldPath.Child("servicePlanRef"))...)
+	}
+
+	return allErrs
+}
+
 func internalValidateServiceInstanceStatusUpdateAllowed(new *sc.ServiceInstance, old *sc.ServiceInstance) field.ErrorList {
 	errors := field.ErrorList{}
+	if !apiequality.Semantic.DeepEqual(new.Spec, old.Spec) {
+		errors = append(errors, field.Forbidden(field.NewPath("spec"), "spec cannot be changed by a status update"))
+	}
 	// TODO(vaikas): Are there any cases where we do not allow updates to
 	// Status during Async updates in progress?
 	return errors
@@ -25,8 +25,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 )
 
 const (
@@ -183,6 +185,14 @@ func invalidServiceInstanceStatusLastOperation() *string {
 	return &lastOperation
 }
 
+// parametersOfSize returns serialized parameters of exactly size bytes.
+func parametersOfSize(size int) *runtime.RawExtension {
+	const envelope = `{"a":""}`
+	return &runtime.RawExtension{
+		Raw: []byte(`{"a":"` + strings.Repeat("x", size-len(envelope)) + `"}`),
+	}
+}
+
 func TestValidateServiceInstance(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -210,6 +220,157 @@ func TestValidateServiceInstance(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid ttlSecondsAfterFailure",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				ttl := int64(3600)
+				i.Spec.TTLSecondsAfterFailure = &ttl
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "negative ttlSecondsAfterFailure",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				ttl := int64(-1)
+				i.Spec.TTLSecondsAfterFailure = &ttl
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid ttlSecondsAfterReady",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				ttl := int64(3600)
+				i.Spec.TTLSecondsAfterReady = &ttl
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "negative ttlSecondsAfterReady",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				ttl := int64(-1)
+				i.Spec.TTLSecondsAfterReady = &ttl
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid generatedParameters",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.GeneratedParameters = &servicecatalog.GeneratedParameters{
+					SecretName: "generated",
+					Parameters: []servicecatalog.GeneratedParameter{
+						{Name: "password"},
+						{Name: "pin", Length: 6, Charset: "0123456789"},
+					},
+				}
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "generatedParameters without secretName",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.GeneratedParameters = &servicecatalog.GeneratedParameters{
+					Parameters: []servicecatalog.GeneratedParameter{{Name: "password"}},
+				}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "generatedParameters without parameters",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.GeneratedParameters = &servicecatalog.GeneratedParameters{SecretName: "generated"}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "generatedParameters with duplicate names",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.GeneratedParameters = &servicecatalog.GeneratedParameters{
+					SecretName: "generated",
+					Parameters: []servicecatalog.GeneratedParameter{{Name: "password"}, {Name: "password"}},
+				}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "generatedParameters with a name of an inline parameter",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"a": 1}`)}
+				i.Spec.GeneratedParameters = &servicecatalog.GeneratedParameters{
+					SecretName: "generated",
+					Parameters: []servicecatalog.GeneratedParameter{{Name: "a"}},
+				}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "generatedParameters with an invalid name",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.GeneratedParameters = &servicecatalog.GeneratedParameters{
+					SecretName: "generated",
+					Parameters: []servicecatalog.GeneratedParameter{{Name: "pass word"}},
+				}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "generatedParameters with a too long length",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.GeneratedParameters = &servicecatalog.GeneratedParameters{
+					SecretName: "generated",
+					Parameters: []servicecatalog.GeneratedParameter{{Name: "password", Length: maxGeneratedParameterLength + 1}},
+				}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "valid clusterServiceBrokerName",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ClusterServiceBrokerName = "test-broker"
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "invalid clusterServiceBrokerName",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ClusterServiceBrokerName = "Test_Broker"
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "clusterServiceBrokerName with namespaced class",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validNamespacedRefServiceInstance()
+				i.Spec.ClusterServiceBrokerName = "test-broker"
+				return i
+			}(),
+			valid: false,
+		},
 		{
 			name: "valid planName",
 			instance: func() *servicecatalog.ServiceInstance {
@@ -298,6 +459,73 @@ func TestValidateServiceInstance(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid URL in parametersFrom",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{URLRef: &servicecatalog.URLReference{
+							URL:                     "https://example.com/parameters.json",
+							BearerTokenSecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-key-name", Key: "test-key"},
+						}}}
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "URL is not http in parametersFrom",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{URLRef: &servicecatalog.URLReference{URL: "file:///etc/parameters.json"}}}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "http URL with bearer token in parametersFrom",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{URLRef: &servicecatalog.URLReference{
+							URL:                     "http://example.com/parameters.json",
+							BearerTokenSecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-key-name", Key: "test-key"},
+						}}}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "bearer token key is missing in parametersFrom",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{URLRef: &servicecatalog.URLReference{
+							URL:                     "https://example.com/parameters.json",
+							BearerTokenSecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-key-name"},
+						}}}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "both secret key and URL in parametersFrom",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Spec.ParametersFrom =
+					[]servicecatalog.ParametersFromSource{
+						{
+							SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "test-key-name", Key: "test-key"},
+							URLRef:       &servicecatalog.URLReference{URL: "https://example.com/parameters.json"},
+						}}
+				return i
+			}(),
+			valid: false,
+		},
 		{
 			name:     "valid with in-progress provision",
 			instance: validServiceInstanceWithInProgressProvision(),
@@ -566,6 +794,72 @@ func TestValidateServiceInstance(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid extension APIs",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Status.ExtensionAPIs = []servicecatalog.ExtensionAPI{
+					{DiscoveryURL: "https://broker.example.com/extensions/openapi.json"},
+					{
+						DiscoveryURL: "https://broker.example.com/backup/openapi.json",
+						ServerURL:    "https://backup.example.com",
+						AdheresTo:    "http://example.com/specs/backup",
+					},
+				}
+				return i
+			}(),
+			valid: true,
+		},
+		{
+			name: "extension API without discovery URL",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Status.ExtensionAPIs = []servicecatalog.ExtensionAPI{
+					{ServerURL: "https://backup.example.com"},
+				}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "extension API with relative discovery URL",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Status.ExtensionAPIs = []servicecatalog.ExtensionAPI{
+					{DiscoveryURL: "/extensions/openapi.json"},
+				}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "extension API with non-http server URL",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Status.ExtensionAPIs = []servicecatalog.ExtensionAPI{
+					{
+						DiscoveryURL: "https://broker.example.com/extensions/openapi.json",
+						ServerURL:    "ftp://backup.example.com",
+					},
+				}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "extension API adhering to a relative URI",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Status.ExtensionAPIs = []servicecatalog.ExtensionAPI{
+					{
+						DiscoveryURL: "https://broker.example.com/extensions/openapi.json",
+						AdheresTo:    "specs/backup",
+					},
+				}
+				return i
+			}(),
+			valid: false,
+		},
 		{
 			name: "valid external properties with no parameters",
 			instance: func() *servicecatalog.ServiceInstance {
@@ -888,8 +1182,96 @@ func TestValidateServiceInstance(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "negative reconcile priority",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validServiceInstanceForCreateClusterPlanRef()
+				i.Annotations = map[string]string{servicecatalog.ServiceInstanceReconcilePriorityAnnotation: "-10"}
+				return i
+			}(),
+			create: true,
+			valid:  true,
+		},
+		{
+			name: "invalid reconcile priority",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validServiceInstanceForCreateClusterPlanRef()
+				i.Annotations = map[string]string{servicecatalog.ServiceInstanceReconcilePriorityAnnotation: "high"}
+				return i
+			}(),
+			create: true,
+			valid:  false,
+		},
+		{
+			name: "out of range reconcile priority on update",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validClusterRefServiceInstance()
+				i.Annotations = map[string]string{servicecatalog.ServiceInstanceReconcilePriorityAnnotation: "4294967296"}
+				return i
+			}(),
+			valid: false,
+		},
+		{
+			name: "stable external ID on create",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validServiceInstanceForCreateClusterPlanRef()
+				i.Annotations = map[string]string{servicecatalog.ServiceInstanceStableExternalIDAnnotation: "true"}
+				i.Spec.ExternalID = servicecatalog.StableExternalID(i.Namespace, i.Name)
+				return i
+			}(),
+			create: true,
+			valid:  true,
+		},
+		{
+			name: "stable external ID of another instance on create",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validServiceInstanceForCreateClusterPlanRef()
+				i.Annotations = map[string]string{servicecatalog.ServiceInstanceStableExternalIDAnnotation: "true"}
+				i.Spec.ExternalID = servicecatalog.StableExternalID(i.Namespace, "other-instance")
+				return i
+			}(),
+			create: true,
+			valid:  false,
+		},
+		{
+			name: "random external ID with stable external ID on create",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validServiceInstanceForCreateClusterPlanRef()
+				i.Annotations = map[string]string{servicecatalog.ServiceInstanceStableExternalIDAnnotation: "true"}
+				i.Spec.ExternalID = "6b5ff9f3-6a3a-4f29-b2c4-6c6b7e6e4b44"
+				return i
+			}(),
+			create: true,
+			valid:  false,
+		},
+		{
+			name: "random external ID without stable external ID on create",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validServiceInstanceForCreateClusterPlanRef()
+				i.Annotations = map[string]string{servicecatalog.ServiceInstanceStableExternalIDAnnotation: "false"}
+				i.Spec.ExternalID = "6b5ff9f3-6a3a-4f29-b2c4-6c6b7e6e4b44"
+				return i
+			}(),
+			create: true,
+			valid:  true,
+		},
+		{
+			name: "invalid stable external ID annotation on create",
+			instance: func() *servicecatalog.ServiceInstance {
+				i := validServiceInstanceForCreateClusterPlanRef()
+				i.Annotations = map[string]string{servicecatalog.ServiceInstanceStableExternalIDAnnotation: "yes"}
+				return i
+			}(),
+			create: true,
+			valid:  false,
+		},
 	}
 
+	if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.ParametersFromURL)); err != nil {
+		t.Fatalf("Failed to enable parameters from URL feature: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ParametersFromURL))
+
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			errs := internalValidateServiceInstance(tc.instance, tc.create)
@@ -903,6 +1285,146 @@ func TestValidateServiceInstance(t *testing.T) {
 	}
 }
 
+func TestValidateServiceInstanceParametersFromURLFeatureDisabled(t *testing.T) {
+	instance := validClusterRefServiceInstance()
+	instance.Spec.ParametersFrom = []servicecatalog.ParametersFromSource{
+		{URLRef: &servicecatalog.URLReference{URL: "https://example.com/parameters.json"}},
+	}
+
+	errs := internalValidateServiceInstance(instance, false)
+	if len(errs) != 1 {
+		t.Fatalf("expected exactly one error, got %v", errs)
+	}
+	if errs[0].Type != field.ErrorTypeForbidden {
+		t.Errorf("expected forbidden error, got %v", errs[0])
+	}
+}
+
+func TestValidateServiceInstanceReservedParameterKeys(t *testing.T) {
+	cases := []struct {
+		name          string
+		reservedKeys  []string
+		parameters    string
+		parameterName string
+		generatedName string
+		valid         bool
+	}{
+		{
+			name:       "no reserved keys",
+			parameters: `{"platform":"kubernetes"}`,
+			valid:      true,
+		},
+		{
+			name:         "parameters without reserved keys",
+			reservedKeys: []string{"platform", "instance_id"},
+			parameters:   `{"name":"value","nested":{"platform":"kubernetes"}}`,
+			valid:        true,
+		},
+		{
+			name:         "parameters with reserved key",
+			reservedKeys: []string{"platform", "instance_id"},
+			parameters:   `{"name":"value","instance_id":"id"}`,
+			valid:        false,
+		},
+		{
+			name:          "parametersFrom assigning reserved key",
+			reservedKeys:  []string{"platform", "instance_id"},
+			parameterName: "platform",
+			valid:         false,
+		},
+		{
+			name:          "generated parameter with reserved key",
+			reservedKeys:  []string{"platform", "instance_id"},
+			generatedName: "instance_id",
+			valid:         false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := validClusterRefServiceInstance()
+			if tc.parameters != "" {
+				instance.Spec.Parameters = &runtime.RawExtension{Raw: []byte(tc.parameters)}
+			}
+			if tc.parameterName != "" {
+				instance.Spec.ParametersFrom = []servicecatalog.ParametersFromSource{
+					{
+						SecretKeyRef:  &servicecatalog.SecretKeyReference{Name: "secret", Key: "key"},
+						ParameterName: tc.parameterName,
+					},
+				}
+			}
+			if tc.generatedName != "" {
+				instance.Spec.GeneratedParameters = &servicecatalog.GeneratedParameters{
+					SecretName: "generated",
+					Parameters: []servicecatalog.GeneratedParameter{{Name: tc.generatedName, Length: 16}},
+				}
+			}
+
+			errs := ValidateServiceInstanceParameters(instance, nil, ParametersLimits{ReservedKeys: tc.reservedKeys})
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("unexpected error: %v", errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Error("unexpected success")
+			}
+		})
+	}
+}
+
+func TestValidateServiceInstanceParameters(t *testing.T) {
+	cases := []struct {
+		name       string
+		parameters *runtime.RawExtension
+		old        *runtime.RawExtension
+		update     bool
+		valid      bool
+	}{
+		{
+			name:       "parameters at the size limit",
+			parameters: parametersOfSize(DefaultMaxParametersSize),
+			valid:      true,
+		},
+		{
+			name:       "parameters exceeding the size limit",
+			parameters: parametersOfSize(DefaultMaxParametersSize + 1),
+			valid:      false,
+		},
+		{
+			name:       "update growing parameters past the size limit",
+			parameters: parametersOfSize(DefaultMaxParametersSize + 1),
+			old:        parametersOfSize(DefaultMaxParametersSize),
+			update:     true,
+			valid:      false,
+		},
+		{
+			name:       "update keeping parameters past the size limit",
+			parameters: parametersOfSize(DefaultMaxParametersSize + 1),
+			old:        parametersOfSize(DefaultMaxParametersSize + 1),
+			update:     true,
+			valid:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := validClusterRefServiceInstance()
+			instance.Spec.Parameters = tc.parameters
+			var old *servicecatalog.ServiceInstance
+			if tc.update {
+				old = validClusterRefServiceInstance()
+				old.Spec.Parameters = tc.old
+			}
+
+			errs := ValidateServiceInstanceParameters(instance, old, DefaultParametersLimits)
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("unexpected error: %v", errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Error("unexpected success")
+			}
+		})
+	}
+}
+
 func TestInternalValidateServiceInstanceUpdateAllowed(t *testing.T) {
 	cases := []struct {
 		name             string
@@ -1245,6 +1767,104 @@ func TestInternalValidateServiceInstanceUpdateAllowedForPlanChange(t *testing.T)
 	}
 }
 
+func TestValidateServiceInstanceUpdateImmutableFields(t *testing.T) {
+	cases := []struct {
+		name    string
+		oldSpec func(*servicecatalog.ServiceInstanceSpec)
+		newSpec func(*servicecatalog.ServiceInstanceSpec)
+		valid   bool
+	}{
+		{
+			name:  "unchanged",
+			valid: true,
+		},
+		{
+			name: "clusterServiceBrokerName changed",
+			newSpec: func(s *servicecatalog.ServiceInstanceSpec) {
+				s.ClusterServiceBrokerName = "other-broker"
+			},
+			valid: false,
+		},
+		{
+			name: "externalID changed",
+			newSpec: func(s *servicecatalog.ServiceInstanceSpec) {
+				s.ExternalID = "other-external-id"
+			},
+			valid: false,
+		},
+		{
+			name: "externalID cleared",
+			newSpec: func(s *servicecatalog.ServiceInstanceSpec) {
+				s.ExternalID = ""
+			},
+			valid: false,
+		},
+		{
+			name: "class ref resolved",
+			oldSpec: func(s *servicecatalog.ServiceInstanceSpec) {
+				s.ClusterServiceClassRef = nil
+				s.ClusterServicePlanRef = nil
+			},
+			valid: true,
+		},
+		{
+			name: "class ref changed",
+			newSpec: func(s *servicecatalog.ServiceInstanceSpec) {
+				s.ClusterServiceClassRef = &servicecatalog.ClusterObjectReference{Name: "other-class"}
+			},
+			valid: false,
+		},
+		{
+			name: "class ref cleared",
+			newSpec: func(s *servicecatalog.ServiceInstanceSpec) {
+				s.ClusterServiceClassRef = nil
+			},
+			valid: false,
+		},
+		{
+			name: "plan ref cleared",
+			newSpec: func(s *servicecatalog.ServiceInstanceSpec) {
+				s.ClusterServicePlanRef = nil
+			},
+			valid: true,
+		},
+		{
+			name: "plan ref changed",
+			newSpec: func(s *servicecatalog.ServiceInstanceSpec) {
+				s.ClusterServicePlanRef = &servicecatalog.ClusterObjectReference{Name: "other-plan"}
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldInstance := validClusterRefServiceInstance()
+			oldInstance.Spec.ExternalID = "external-id"
+			oldInstance.Spec.ClusterServiceClassRef.Name = clusterServiceClassName
+			oldInstance.Spec.ClusterServicePlanRef.Name = clusterServicePlanName
+			if tc.oldSpec != nil {
+				tc.oldSpec(&oldInstance.Spec)
+			}
+
+			newInstance := validClusterRefServiceInstance()
+			newInstance.Spec.ExternalID = "external-id"
+			newInstance.Spec.ClusterServiceClassRef.Name = clusterServiceClassName
+			newInstance.Spec.ClusterServicePlanRef.Name = clusterServicePlanName
+			if tc.newSpec != nil {
+				tc.newSpec(&newInstance.Spec)
+			}
+
+			errs := ValidateServiceInstanceUpdate(newInstance, oldInstance)
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("unexpected error: %v", errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Error("unexpected success")
+			}
+		})
+	}
+}
+
 func TestValidateServiceInstanceStatusUpdate(t *testing.T) {
 	now := metav1.Now()
 	cases := []struct {
@@ -1482,11 +2102,11 @@ func TestValidateServiceInstanceStatusUpdate(t *testing.T) {
 				},
 				Spec: servicecatalog.ServiceInstanceSpec{
 					PlanReference: servicecatalog.PlanReference{
-						ClusterServiceClassExternalName: clusterServiceClassExternalName,
-						ClusterServicePlanExternalName:  clusterServicePlanExternalName,
+						ServiceClassExternalName: serviceClassExternalName,
+						ServicePlanExternalName:  servicePlanExternalName,
 					},
-					ClusterServiceClassRef: &servicecatalog.ClusterObjectReference{},
-					ClusterServicePlanRef:  &servicecatalog.ClusterObjectReference{},
+					ServiceClassRef: &servicecatalog.LocalObjectReference{},
+					ServicePlanRef:  &servicecatalog.LocalObjectReference{},
 				},
 				Status: *tc.new,
 			}
@@ -1509,6 +2129,21 @@ func TestValidateServiceInstanceStatusUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateServiceInstanceStatusUpdateSpecChange(t *testing.T) {
+	old := validClusterRefServiceInstance()
+	old.Status.DeprovisionStatus = servicecatalog.ServiceInstanceDeprovisionStatusRequired
+	new := old.DeepCopy()
+	new.Spec.UpdateRequests = 1
+
+	errs := ValidateServiceInstanceStatusUpdate(new, old)
+	if len(errs) != 1 {
+		t.Fatalf("expected exactly one error, got: %v", errs)
+	}
+	if e, a := field.NewPath("spec").String(), errs[0].Field; e != a {
+		t.Errorf("unexpected error field: expected %q, got %q", e, a)
+	}
+}
+
 func TestValidateServiceInstanceReferencesUpdate(t *testing.T) {
 	cases := []struct {
 		name  string
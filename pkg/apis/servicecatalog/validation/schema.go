@@ -0,0 +1,55 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateParameterSchema validates a parameter schema published by a
+// broker. It must be a JSON object whose `$schema` keyword, if any, is a
+// string. The declared draft is not checked here: a plan failing validation
+// fails the relist of its whole broker, so the controller only warns about
+// drafts it does not support.
+func validateParameterSchema(schema *runtime.RawExtension, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if schema == nil || len(schema.Raw) == 0 {
+		return allErrs
+	}
+
+	var root interface{}
+	if err := json.Unmarshal(schema.Raw, &root); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, string(schema.Raw), fmt.Sprintf("schema must be valid JSON: %v", err)))
+		return allErrs
+	}
+	rootObject, ok := root.(map[string]interface{})
+	if !ok {
+		allErrs = append(allErrs, field.Invalid(fldPath, string(schema.Raw), "schema must be a JSON object"))
+		return allErrs
+	}
+
+	if value, found := rootObject["$schema"]; found {
+		if _, ok := value.(string); !ok {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("$schema"), value, "$schema must be a string"))
+		}
+	}
+	return allErrs
+}
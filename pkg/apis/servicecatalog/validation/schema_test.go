@@ -0,0 +1,96 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateParameterSchema(t *testing.T) {
+	testCases := []struct {
+		name   string
+		schema string
+		valid  bool
+	}{
+		{
+			name:   "schema without $schema",
+			schema: `{"type": "object", "properties": {"size": {"type": "integer", "maximum": 10, "exclusiveMaximum": true}}}`,
+			valid:  true,
+		},
+		{
+			name:   "valid draft-04 schema",
+			schema: `{"$schema": "http://json-schema.org/draft-04/schema#", "type": "object", "properties": {"size": {"type": "integer", "minimum": 1, "exclusiveMinimum": true}}}`,
+			valid:  true,
+		},
+		{
+			name:   "valid draft-07 schema",
+			schema: `{"$schema": "http://json-schema.org/draft-07/schema#", "type": "object", "properties": {"size": {"type": "integer", "exclusiveMaximum": 10}}, "if": {"required": ["size"]}, "then": {"required": ["name"]}}`,
+			valid:  true,
+		},
+		{
+			name:   "valid draft-07 schema without trailing hash",
+			schema: `{"$schema": "https://json-schema.org/draft-07/schema", "type": "object"}`,
+			valid:  true,
+		},
+		{
+			name:   "valid draft-06 schema",
+			schema: `{"$schema": "http://json-schema.org/draft-06/schema#", "type": "object", "allOf": [{"properties": {"size": {"exclusiveMinimum": 0}}}]}`,
+			valid:  true,
+		},
+		{
+			name:   "unsupported draft",
+			schema: `{"$schema": "http://json-schema.org/draft-03/schema#", "type": "object"}`,
+			valid:  true,
+		},
+		{
+			name:   "unknown meta-schema",
+			schema: `{"$schema": "https://json-schema.org/draft/2019-09/schema", "type": "object"}`,
+			valid:  true,
+		},
+		{
+			name:   "non-string $schema",
+			schema: `{"$schema": 4, "type": "object"}`,
+			valid:  false,
+		},
+		{
+			name:   "schema not an object",
+			schema: `["type", "object"]`,
+			valid:  false,
+		},
+		{
+			name:   "invalid JSON",
+			schema: `{"type": `,
+			valid:  false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			errs := validateParameterSchema(&runtime.RawExtension{Raw: []byte(tc.schema)}, field.NewPath("schema"))
+			t.Log(errs)
+			if len(errs) != 0 && tc.valid {
+				t.Errorf("unexpected error: %v", errs)
+			} else if len(errs) == 0 && !tc.valid {
+				t.Error("unexpected success")
+			}
+		})
+	}
+}
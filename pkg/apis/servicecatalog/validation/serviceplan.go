@@ -100,6 +100,10 @@ func validateCommonServicePlanSpec(spec sc.CommonServicePlanSpec, fldPath *field
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("externalName"), spec.ExternalName, msg))
 	}
 
+	allErrs = append(allErrs, validateParameterSchema(spec.InstanceCreateParameterSchema, fldPath.Child("instanceCreateParameterSchema"))...)
+	allErrs = append(allErrs, validateParameterSchema(spec.InstanceUpdateParameterSchema, fldPath.Child("instanceUpdateParameterSchema"))...)
+	allErrs = append(allErrs, validateParameterSchema(spec.ServiceBindingCreateParameterSchema, fldPath.Child("serviceBindingCreateParameterSchema"))...)
+
 	return allErrs
 
 }
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 )
@@ -167,6 +168,28 @@ func TestValidateClusterServicePlan(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid draft-07 instance create parameter schema",
+			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
+				s := validClusterServicePlan()
+				s.Spec.InstanceCreateParameterSchema = &runtime.RawExtension{
+					Raw: []byte(`{"$schema": "http://json-schema.org/draft-07/schema#", "type": "object"}`),
+				}
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "non-string $schema in instance update parameter schema",
+			clusterServicePlan: func() *servicecatalog.ClusterServicePlan {
+				s := validClusterServicePlan()
+				s.Spec.InstanceUpdateParameterSchema = &runtime.RawExtension{
+					Raw: []byte(`{"$schema": 3, "type": "object"}`),
+				}
+				return s
+			}(),
+			valid: false,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -287,6 +310,28 @@ func TestValidateServicePlan(t *testing.T) {
 			}(),
 			valid: false,
 		},
+		{
+			name: "valid draft-04 binding create parameter schema",
+			servicePlan: func() *servicecatalog.ServicePlan {
+				s := validServicePlan()
+				s.Spec.ServiceBindingCreateParameterSchema = &runtime.RawExtension{
+					Raw: []byte(`{"$schema": "http://json-schema.org/draft-04/schema#", "type": "object"}`),
+				}
+				return s
+			}(),
+			valid: true,
+		},
+		{
+			name: "binding create parameter schema not an object",
+			servicePlan: func() *servicecatalog.ServicePlan {
+				s := validServicePlan()
+				s.Spec.ServiceBindingCreateParameterSchema = &runtime.RawExtension{
+					Raw: []byte(`["type", "object"]`),
+				}
+				return s
+			}(),
+			valid: false,
+		},
 		{
 			name: "missing namespace",
 			servicePlan: func() *servicecatalog.ServicePlan {
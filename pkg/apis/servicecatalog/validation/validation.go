@@ -17,11 +17,39 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
+	"net/url"
+
 	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"regexp"
+	"sigs.k8s.io/yaml"
 )
 
+// DefaultMaxParametersSize is the default limit, in bytes, of the serialized
+// inline parameters of ServiceInstances and ServiceBindings.
+const DefaultMaxParametersSize = 64 * 1024
+
+// ParametersLimits are the operator-configured limits on the parameters of
+// ServiceInstances and ServiceBindings.
+type ParametersLimits struct {
+	// MaxSize is the maximum size, in bytes, of the serialized inline
+	// parameters. The parameters are stored as part of the resource, so
+	// large values bloat the storage. Zero disables the check.
+	MaxSize int
+	// ReservedKeys are the parameter names which may not be set, such as
+	// names that brokers could confuse with fields of the OSB context.
+	ReservedKeys []string
+}
+
+// DefaultParametersLimits are the limits on parameters applied when the
+// operator configures none.
+var DefaultParametersLimits = ParametersLimits{MaxSize: DefaultMaxParametersSize}
+
 var hexademicalStringRegexp = regexp.MustCompile("^[[:xdigit:]]*$")
 
 func stringIsHexadecimal(s string) bool {
@@ -39,6 +67,11 @@ func validateParametersFromSource(parametersFrom []sc.ParametersFromSource, fldP
 			if paramsFrom.SecretKeyRef.Key == "" {
 				allErrs = append(allErrs, field.Required(fldPath.Child("parametersFrom.secretKeyRef.key"), "key is required"))
 			}
+			if paramsFrom.URLRef != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("parametersFrom.urlRef"), "urlRef may not be set along with secretKeyRef"))
+			}
+		} else if paramsFrom.URLRef != nil {
+			allErrs = append(allErrs, validateURLReference(paramsFrom.URLRef, fldPath.Child("parametersFrom.urlRef"))...)
 		} else {
 			allErrs = append(allErrs, field.Required(fldPath.Child("parametersFrom"), "source must not be empty if present"))
 		}
@@ -46,3 +79,107 @@ func validateParametersFromSource(parametersFrom []sc.ParametersFromSource, fldP
 
 	return allErrs
 }
+
+// validateURLReference checks that the URL of a parametersFrom source is an
+// absolute http or https URL, and an https one when a bearer token is sent.
+// The source requires the ParametersFromURL feature gate.
+func validateURLReference(urlRef *sc.URLReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.ParametersFromURL) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("urlRef requires the %s feature gate", scfeatures.ParametersFromURL)))
+		return allErrs
+	}
+
+	if urlRef.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "url is required"))
+	} else {
+		allErrs = append(allErrs, ValidateHTTPURL(urlRef.URL, fldPath.Child("url"))...)
+		if u, err := url.Parse(urlRef.URL); err == nil && urlRef.BearerTokenSecretKeyRef != nil && u.Scheme != "https" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), urlRef.URL, "must be an https URL when bearerTokenSecretKeyRef is set"))
+		}
+	}
+	if urlRef.BearerTokenSecretKeyRef != nil {
+		if urlRef.BearerTokenSecretKeyRef.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("bearerTokenSecretKeyRef.name"), "name is required"))
+		}
+		if urlRef.BearerTokenSecretKeyRef.Key == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("bearerTokenSecretKeyRef.key"), "key is required"))
+		}
+	}
+
+	return allErrs
+}
+
+// unmarshalParameters produces a map structure from raw YAML/JSON inline
+// parameters. It is decoded here rather than with the controller's helper as
+// the controller imports this package to validate broker responses.
+func unmarshalParameters(in []byte) (map[string]interface{}, error) {
+	parameters := make(map[string]interface{})
+	if len(in) > 0 {
+		if err := yaml.Unmarshal(in, &parameters); err != nil {
+			return parameters, err
+		}
+	}
+	return parameters, nil
+}
+
+// ValidateHTTPURL checks that a value is an absolute http or https URL.
+func ValidateHTTPURL(value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if u, err := url.Parse(value); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, err.Error()))
+	} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must be an absolute http or https URL"))
+	}
+
+	return allErrs
+}
+
+// validateParametersSize checks that the serialized inline parameters do not
+// exceed maxSize bytes.
+func validateParametersSize(parameters *runtime.RawExtension, maxSize int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if parameters == nil || maxSize <= 0 {
+		return allErrs
+	}
+	if size := len(parameters.Raw); size > maxSize {
+		// the value itself is not reported, as it is too big by definition
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("serialized parameters must not exceed %d bytes, got %d bytes; use parametersFrom to reference large values", maxSize, size)))
+	}
+
+	return allErrs
+}
+
+// validateReservedParameterKeys checks that neither the top-level keys of the
+// inline parameters nor the parameter names assigned by parametersFrom are
+// reserved.
+func validateReservedParameterKeys(parameters *runtime.RawExtension, parametersFrom []sc.ParametersFromSource, reservedKeys []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(reservedKeys) == 0 {
+		return allErrs
+	}
+	reserved := sets.NewString(reservedKeys...)
+	msg := fmt.Sprintf("parameter name is reserved; reserved names are %v", reserved.List())
+
+	if parameters != nil {
+		// invalid parameters are reported by the callers
+		if params, err := unmarshalParameters(parameters.Raw); err == nil {
+			for _, key := range sets.StringKeySet(params).List() {
+				if reserved.Has(key) {
+					allErrs = append(allErrs, field.Forbidden(fldPath.Child("parameters").Key(key), msg))
+				}
+			}
+		}
+	}
+	for i, paramsFrom := range parametersFrom {
+		if reserved.Has(paramsFrom.ParameterName) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("parametersFrom").Index(i).Child("parameterName"), msg))
+		}
+	}
+
+	return allErrs
+}
@@ -119,6 +119,32 @@ func (in *BearerTokenAuthConfig) DeepCopy() *BearerTokenAuthConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BearerTokenFromServiceAccountAuthConfig) DeepCopyInto(out *BearerTokenFromServiceAccountAuthConfig) {
+	*out = *in
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BearerTokenFromServiceAccountAuthConfig.
+func (in *BearerTokenFromServiceAccountAuthConfig) DeepCopy() *BearerTokenFromServiceAccountAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BearerTokenFromServiceAccountAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CatalogRestrictions) DeepCopyInto(out *CatalogRestrictions) {
 	*out = *in
@@ -187,6 +213,32 @@ func (in *ClusterBearerTokenAuthConfig) DeepCopy() *ClusterBearerTokenAuthConfig
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterBearerTokenFromServiceAccountAuthConfig) DeepCopyInto(out *ClusterBearerTokenFromServiceAccountAuthConfig) {
+	*out = *in
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterBearerTokenFromServiceAccountAuthConfig.
+func (in *ClusterBearerTokenFromServiceAccountAuthConfig) DeepCopy() *ClusterBearerTokenFromServiceAccountAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterBearerTokenFromServiceAccountAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterObjectReference) DeepCopyInto(out *ClusterObjectReference) {
 	*out = *in
@@ -244,6 +296,11 @@ func (in *ClusterServiceBrokerAuthInfo) DeepCopyInto(out *ClusterServiceBrokerAu
 		*out = new(ClusterBearerTokenAuthConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BearerTokenFromServiceAccount != nil {
+		in, out := &in.BearerTokenFromServiceAccount, &out.BearerTokenFromServiceAccount
+		*out = new(ClusterBearerTokenFromServiceAccountAuthConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -299,6 +356,11 @@ func (in *ClusterServiceBrokerSpec) DeepCopyInto(out *ClusterServiceBrokerSpec)
 		*out = new(ClusterServiceBrokerAuthInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FallbackAuthInfo != nil {
+		in, out := &in.FallbackAuthInfo, &out.FallbackAuthInfo
+		*out = new(ClusterServiceBrokerAuthInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -430,7 +492,7 @@ func (in *ClusterServicePlan) DeepCopyInto(out *ClusterServicePlan) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -506,7 +568,7 @@ func (in *ClusterServicePlanSpec) DeepCopy() *ClusterServicePlanSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterServicePlanStatus) DeepCopyInto(out *ClusterServicePlanStatus) {
 	*out = *in
-	out.CommonServicePlanStatus = in.CommonServicePlanStatus
+	in.CommonServicePlanStatus.DeepCopyInto(&out.CommonServicePlanStatus)
 	return
 }
 
@@ -569,6 +631,21 @@ func (in *CommonServiceBrokerStatus) DeepCopyInto(out *CommonServiceBrokerStatus
 		in, out := &in.LastCatalogRetrievalTime, &out.LastCatalogRetrievalTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]ServiceBrokerFeature, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastCatalogClassCount != nil {
+		in, out := &in.LastCatalogClassCount, &out.LastCatalogClassCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastCatalogPlanCount != nil {
+		in, out := &in.LastCatalogPlanCount, &out.LastCatalogPlanCount
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -688,6 +765,13 @@ func (in *CommonServicePlanSpec) DeepCopy() *CommonServicePlanSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonServicePlanStatus) DeepCopyInto(out *CommonServicePlanStatus) {
 	*out = *in
+	if in.Costs != nil {
+		in, out := &in.Costs, &out.Costs
+		*out = make([]ServicePlanCost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -701,6 +785,22 @@ func (in *CommonServicePlanStatus) DeepCopy() *CommonServicePlanStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionAPI) DeepCopyInto(out *ExtensionAPI) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionAPI.
+func (in *ExtensionAPI) DeepCopy() *ExtensionAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ExtraValue) DeepCopyInto(out *ExtraValue) {
 	{
@@ -721,6 +821,43 @@ func (in ExtraValue) DeepCopy() ExtraValue {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedParameter) DeepCopyInto(out *GeneratedParameter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedParameter.
+func (in *GeneratedParameter) DeepCopy() *GeneratedParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedParameters) DeepCopyInto(out *GeneratedParameters) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]GeneratedParameter, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedParameters.
+func (in *GeneratedParameters) DeepCopy() *GeneratedParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalObjectReference) DeepCopyInto(out *LocalObjectReference) {
 	*out = *in
@@ -761,6 +898,11 @@ func (in *ParametersFromSource) DeepCopyInto(out *ParametersFromSource) {
 		*out = new(SecretKeyReference)
 		**out = **in
 	}
+	if in.URLRef != nil {
+		in, out := &in.URLRef, &out.URLRef
+		*out = new(URLReference)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1001,6 +1143,25 @@ func (in *ServiceBindingSpec) DeepCopyInto(out *ServiceBindingSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AdditionalSecretNamespaces != nil {
+		in, out := &in.AdditionalSecretNamespaces, &out.AdditionalSecretNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretLabels != nil {
+		in, out := &in.SecretLabels, &out.SecretLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretAnnotations != nil {
+		in, out := &in.SecretAnnotations, &out.SecretAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.UserInfo != nil {
 		in, out := &in.UserInfo, &out.UserInfo
 		*out = new(UserInfo)
@@ -1048,6 +1209,23 @@ func (in *ServiceBindingStatus) DeepCopyInto(out *ServiceBindingStatus) {
 		*out = new(ServiceBindingPropertiesState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretCopyNamespaces != nil {
+		in, out := &in.SecretCopyNamespaces, &out.SecretCopyNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteServiceURL != nil {
+		in, out := &in.RouteServiceURL, &out.RouteServiceURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]ServiceBindingVolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1061,6 +1239,44 @@ func (in *ServiceBindingStatus) DeepCopy() *ServiceBindingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingVolumeDevice) DeepCopyInto(out *ServiceBindingVolumeDevice) {
+	*out = *in
+	if in.MountConfig != nil {
+		in, out := &in.MountConfig, &out.MountConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingVolumeDevice.
+func (in *ServiceBindingVolumeDevice) DeepCopy() *ServiceBindingVolumeDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingVolumeDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBindingVolumeMount) DeepCopyInto(out *ServiceBindingVolumeMount) {
+	*out = *in
+	in.Device.DeepCopyInto(&out.Device)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingVolumeMount.
+func (in *ServiceBindingVolumeMount) DeepCopy() *ServiceBindingVolumeMount {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBindingVolumeMount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBroker) DeepCopyInto(out *ServiceBroker) {
 	*out = *in
@@ -1102,6 +1318,11 @@ func (in *ServiceBrokerAuthInfo) DeepCopyInto(out *ServiceBrokerAuthInfo) {
 		*out = new(BearerTokenAuthConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BearerTokenFromServiceAccount != nil {
+		in, out := &in.BearerTokenFromServiceAccount, &out.BearerTokenFromServiceAccount
+		*out = new(BearerTokenFromServiceAccountAuthConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1439,11 +1660,26 @@ func (in *ServiceInstanceSpec) DeepCopyInto(out *ServiceInstanceSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GeneratedParameters != nil {
+		in, out := &in.GeneratedParameters, &out.GeneratedParameters
+		*out = new(GeneratedParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UserInfo != nil {
 		in, out := &in.UserInfo, &out.UserInfo
 		*out = new(UserInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TTLSecondsAfterFailure != nil {
+		in, out := &in.TTLSecondsAfterFailure, &out.TTLSecondsAfterFailure
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TTLSecondsAfterReady != nil {
+		in, out := &in.TTLSecondsAfterReady, &out.TTLSecondsAfterReady
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -1477,6 +1713,11 @@ func (in *ServiceInstanceStatus) DeepCopyInto(out *ServiceInstanceStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExtensionAPIs != nil {
+		in, out := &in.ExtensionAPIs, &out.ExtensionAPIs
+		*out = make([]ExtensionAPI, len(*in))
+		copy(*out, *in)
+	}
 	if in.OperationStartTime != nil {
 		in, out := &in.OperationStartTime, &out.OperationStartTime
 		*out = (*in).DeepCopy()
@@ -1496,6 +1737,16 @@ func (in *ServiceInstanceStatus) DeepCopyInto(out *ServiceInstanceStatus) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastProvisionDuration != nil {
+		in, out := &in.LastProvisionDuration, &out.LastProvisionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LastUpdateDuration != nil {
+		in, out := &in.LastUpdateDuration, &out.LastUpdateDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1515,7 +1766,7 @@ func (in *ServicePlan) DeepCopyInto(out *ServicePlan) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1537,6 +1788,29 @@ func (in *ServicePlan) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePlanCost) DeepCopyInto(out *ServicePlanCost) {
+	*out = *in
+	if in.Amount != nil {
+		in, out := &in.Amount, &out.Amount
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePlanCost.
+func (in *ServicePlanCost) DeepCopy() *ServicePlanCost {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlanCost)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServicePlanList) DeepCopyInto(out *ServicePlanList) {
 	*out = *in
@@ -1591,7 +1865,7 @@ func (in *ServicePlanSpec) DeepCopy() *ServicePlanSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServicePlanStatus) DeepCopyInto(out *ServicePlanStatus) {
 	*out = *in
-	out.CommonServicePlanStatus = in.CommonServicePlanStatus
+	in.CommonServicePlanStatus.DeepCopyInto(&out.CommonServicePlanStatus)
 	return
 }
 
@@ -1605,6 +1879,27 @@ func (in *ServicePlanStatus) DeepCopy() *ServicePlanStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *URLReference) DeepCopyInto(out *URLReference) {
+	*out = *in
+	if in.BearerTokenSecretKeyRef != nil {
+		in, out := &in.BearerTokenSecretKeyRef, &out.BearerTokenSecretKeyRef
+		*out = new(SecretKeyReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new URLReference.
+func (in *URLReference) DeepCopy() *URLReference {
+	if in == nil {
+		return nil
+	}
+	out := new(URLReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserInfo) DeepCopyInto(out *UserInfo) {
 	*out = *in
@@ -0,0 +1,41 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ReadPolicy parses the YAML configuration of the admission plugin named
+// pluginName into policy. A missing configuration leaves policy unchanged.
+func ReadPolicy(pluginName string, config io.Reader, policy interface{}) error {
+	if config == nil {
+		return nil
+	}
+	data, err := ioutil.ReadAll(config)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, policy); err != nil {
+		return fmt.Errorf("unable to parse %s configuration: %v", pluginName, err)
+	}
+	return nil
+}
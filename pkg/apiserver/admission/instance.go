@@ -0,0 +1,203 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
+)
+
+// GetClusterServiceClasses returns the cluster service classes the Service
+// Instance could be resolved to. A class referred to by name which does not
+// exist yet is left out.
+func GetClusterServiceClasses(lister internalversion.ClusterServiceClassLister, instance *servicecatalog.ServiceInstance) ([]*servicecatalog.ClusterServiceClass, error) {
+	ref := instance.Spec.PlanReference
+
+	if instance.Spec.ClusterServiceClassRef != nil || ref.ClusterServiceClassName != "" {
+		name := ref.ClusterServiceClassName
+		if instance.Spec.ClusterServiceClassRef != nil {
+			name = instance.Spec.ClusterServiceClassRef.Name
+		}
+		class, err := lister.Get(name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []*servicecatalog.ClusterServiceClass{class}, nil
+	}
+	if !ref.ClusterServiceClassSpecified() {
+		return nil, nil
+	}
+
+	classes, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var matching []*servicecatalog.ClusterServiceClass
+	for _, class := range classes {
+		if instance.Spec.ClusterServiceBrokerName != "" && class.Spec.ClusterServiceBrokerName != instance.Spec.ClusterServiceBrokerName {
+			continue
+		}
+		if (ref.ClusterServiceClassExternalName != "" && class.Spec.ExternalName == ref.ClusterServiceClassExternalName) ||
+			(ref.ClusterServiceClassExternalID != "" && class.Spec.ExternalID == ref.ClusterServiceClassExternalID) {
+			matching = append(matching, class)
+		}
+	}
+	return matching, nil
+}
+
+// GetServiceClasses returns the namespaced service classes the Service
+// Instance could be resolved to. A class referred to by name which does not
+// exist yet is left out.
+func GetServiceClasses(lister internalversion.ServiceClassLister, instance *servicecatalog.ServiceInstance) ([]*servicecatalog.ServiceClass, error) {
+	ref := instance.Spec.PlanReference
+
+	if instance.Spec.ServiceClassRef != nil || ref.ServiceClassName != "" {
+		name := ref.ServiceClassName
+		if instance.Spec.ServiceClassRef != nil {
+			name = instance.Spec.ServiceClassRef.Name
+		}
+		class, err := lister.ServiceClasses(instance.Namespace).Get(name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []*servicecatalog.ServiceClass{class}, nil
+	}
+	if !ref.ServiceClassSpecified() {
+		return nil, nil
+	}
+
+	classes, err := lister.ServiceClasses(instance.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var matching []*servicecatalog.ServiceClass
+	for _, class := range classes {
+		if (ref.ServiceClassExternalName != "" && class.Spec.ExternalName == ref.ServiceClassExternalName) ||
+			(ref.ServiceClassExternalID != "" && class.Spec.ExternalID == ref.ServiceClassExternalID) {
+			matching = append(matching, class)
+		}
+	}
+	return matching, nil
+}
+
+// GetClusterServicePlans returns the cluster service plans the Service
+// Instance could be resolved to. A plan referred to by name which does not
+// exist yet is left out.
+func GetClusterServicePlans(classLister internalversion.ClusterServiceClassLister, planLister internalversion.ClusterServicePlanLister, instance *servicecatalog.ServiceInstance) ([]*servicecatalog.ClusterServicePlan, error) {
+	ref := instance.Spec.PlanReference
+
+	if instance.Spec.ClusterServicePlanRef != nil || ref.ClusterServicePlanName != "" {
+		name := ref.ClusterServicePlanName
+		if instance.Spec.ClusterServicePlanRef != nil {
+			name = instance.Spec.ClusterServicePlanRef.Name
+		}
+		plan, err := planLister.Get(name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []*servicecatalog.ClusterServicePlan{plan}, nil
+	}
+	if !ref.ClusterServicePlanSpecified() {
+		return nil, nil
+	}
+
+	classes, err := GetClusterServiceClasses(classLister, instance)
+	if err != nil {
+		return nil, err
+	}
+	classNames := sets.NewString()
+	for _, class := range classes {
+		classNames.Insert(class.Name)
+	}
+	plans, err := planLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var matching []*servicecatalog.ClusterServicePlan
+	for _, plan := range plans {
+		if !classNames.Has(plan.Spec.ClusterServiceClassRef.Name) {
+			continue
+		}
+		if (ref.ClusterServicePlanExternalName != "" && plan.Spec.ExternalName == ref.ClusterServicePlanExternalName) ||
+			(ref.ClusterServicePlanExternalID != "" && plan.Spec.ExternalID == ref.ClusterServicePlanExternalID) {
+			matching = append(matching, plan)
+		}
+	}
+	return matching, nil
+}
+
+// GetServicePlans returns the namespaced service plans the Service Instance
+// could be resolved to. A plan referred to by name which does not exist yet
+// is left out.
+func GetServicePlans(classLister internalversion.ServiceClassLister, planLister internalversion.ServicePlanLister, instance *servicecatalog.ServiceInstance) ([]*servicecatalog.ServicePlan, error) {
+	ref := instance.Spec.PlanReference
+
+	if instance.Spec.ServicePlanRef != nil || ref.ServicePlanName != "" {
+		name := ref.ServicePlanName
+		if instance.Spec.ServicePlanRef != nil {
+			name = instance.Spec.ServicePlanRef.Name
+		}
+		plan, err := planLister.ServicePlans(instance.Namespace).Get(name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []*servicecatalog.ServicePlan{plan}, nil
+	}
+	if !ref.ServicePlanSpecified() {
+		return nil, nil
+	}
+
+	classes, err := GetServiceClasses(classLister, instance)
+	if err != nil {
+		return nil, err
+	}
+	classNames := sets.NewString()
+	for _, class := range classes {
+		classNames.Insert(class.Name)
+	}
+	plans, err := planLister.ServicePlans(instance.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var matching []*servicecatalog.ServicePlan
+	for _, plan := range plans {
+		if !classNames.Has(plan.Spec.ServiceClassRef.Name) {
+			continue
+		}
+		if (ref.ServicePlanExternalName != "" && plan.Spec.ExternalName == ref.ServicePlanExternalName) ||
+			(ref.ServicePlanExternalID != "" && plan.Spec.ExternalID == ref.ServicePlanExternalID) {
+			matching = append(matching, plan)
+		}
+	}
+	return matching, nil
+}
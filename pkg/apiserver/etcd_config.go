@@ -17,6 +17,7 @@ limitations under the License.
 package apiserver
 
 import (
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -37,6 +38,9 @@ type extraConfig struct {
 	// BABYNETES: cargo culted from master.go
 	deleteCollectionWorkers int
 	storageFactory          storage.StorageFactory
+	// parametersLimits are the limits on the parameters of
+	// ServiceInstances and ServiceBindings
+	parametersLimits scv.ParametersLimits
 }
 
 // NewEtcdConfig returns a new server config to describe an etcd-backed API server
@@ -44,12 +48,14 @@ func NewEtcdConfig(
 	genCfg *genericapiserver.RecommendedConfig,
 	deleteCollWorkers int,
 	factory storage.StorageFactory,
+	parametersLimits scv.ParametersLimits,
 ) Config {
 	return &etcdConfig{
 		genericConfig: genCfg,
 		extraConfig: &extraConfig{
 			deleteCollectionWorkers: deleteCollWorkers,
 			storageFactory:          factory,
+			parametersLimits:        parametersLimits,
 		},
 	}
 }
@@ -98,7 +104,7 @@ func (c completedEtcdConfig) NewServer(stopCh <-chan struct{}) (*ServiceCatalogA
 
 	klog.V(4).Infoln("Installing API groups")
 	// default namespace doesn't matter for etcd
-	providers := restStorageProviders("" /* default namespace */, nil)
+	providers := restStorageProviders("" /* default namespace */, nil, c.extraConfig.parametersLimits)
 	for _, provider := range providers {
 		groupInfo, err := provider.NewRESTStorage(c.apiResourceConfigSource, roFactory)
 		if IsErrAPIGroupDisabled(err) {
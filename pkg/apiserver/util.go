@@ -18,6 +18,7 @@ package apiserver
 
 import (
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	servicecatalogrest "github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/rest"
 	settingsrest "github.com/kubernetes-incubator/service-catalog/pkg/registry/settings/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
@@ -32,11 +33,13 @@ const (
 func restStorageProviders(
 	defaultNamespace string,
 	restClient restclient.Interface,
+	parametersLimits scv.ParametersLimits,
 ) []RESTStorageProvider {
 	return []RESTStorageProvider{
 		servicecatalogrest.StorageProvider{
 			DefaultNamespace: defaultNamespace,
 			RESTClient:       restClient,
+			ParametersLimits: parametersLimits,
 		},
 		settingsrest.StorageProvider{
 			RESTClient: restClient,
@@ -0,0 +1,78 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// BindingCredentialsStore keeps the credentials returned by a broker for
+// ServiceBindings whose Secret could not be written yet. The credentials are
+// held in memory only, so they never end up in the binding's status.
+type BindingCredentialsStore struct {
+	mu          sync.Mutex
+	credentials map[types.UID]map[string]interface{}
+}
+
+// NewBindingCredentialsStore creates BindingCredentialsStore instance
+func NewBindingCredentialsStore() *BindingCredentialsStore {
+	return &BindingCredentialsStore{
+		credentials: map[types.UID]map[string]interface{}{},
+	}
+}
+
+// Put stores a copy of the credentials for the given binding.
+func (s *BindingCredentialsStore) Put(binding *v1beta1.ServiceBinding, credentials map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.credentials[binding.UID] = copyCredentials(credentials)
+}
+
+// Get returns a copy of the credentials stored for the given binding.
+func (s *BindingCredentialsStore) Get(binding *v1beta1.ServiceBinding) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	credentials, found := s.credentials[binding.UID]
+	if !found {
+		return nil, false
+	}
+	return copyCredentials(credentials), true
+}
+
+// Remove removes the credentials stored for the given binding.
+func (s *BindingCredentialsStore) Remove(binding *v1beta1.ServiceBinding) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.credentials, binding.UID)
+}
+
+// copyCredentials returns a shallow copy of the credentials. Secret
+// transformations only add, rename or remove top-level keys, so a shallow
+// copy is enough to keep the stored credentials untouched.
+func copyCredentials(credentials map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(credentials))
+	for k, v := range credentials {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller_test
+
+import (
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/controller"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBindingCredentialsStore(t *testing.T) {
+	// GIVEN
+	store := controller.NewBindingCredentialsStore()
+	binding1 := &v1beta1.ServiceBinding{ObjectMeta: metav1.ObjectMeta{UID: "uid-1"}}
+	binding2 := &v1beta1.ServiceBinding{ObjectMeta: metav1.ObjectMeta{UID: "uid-2"}}
+	credentials := map[string]interface{}{"user": "admin"}
+
+	// WHEN
+	store.Put(binding1, credentials)
+	credentials["user"] = "changed"
+	got, exists1 := store.Get(binding1)
+	got["password"] = "secret"
+	_, exists2 := store.Get(binding2)
+
+	// THEN
+	if !exists1 {
+		t.Fatal("Credentials for binding1 do not exist")
+	}
+	if exists2 {
+		t.Fatal("Credentials for binding2 must not exist")
+	}
+	if got["user"] != "admin" {
+		t.Fatalf("Stored credentials must not be affected by changes to the original map, got %q", got["user"])
+	}
+	if again, _ := store.Get(binding1); len(again) != 1 {
+		t.Fatalf("Stored credentials must not be affected by changes to the returned map, got %v", again)
+	}
+
+	// WHEN
+	store.Remove(binding1)
+	_, exists1 = store.Get(binding1)
+
+	// THEN
+	if exists1 {
+		t.Fatal("Credentials for binding1 must not exist after removal")
+	}
+}
@@ -84,7 +84,7 @@ func (m *BrokerClientManager) UpdateBrokerClient(brokerKey BrokerKey, clientConf
 
 	existing, found := m.clients[brokerKey]
 
-	if !found || configHasChanged(existing.clientConfig, clientConfig) {
+	if !found || configHasChanged(existing.clientConfig, clientConfig) || existing.tokenConfig != nil || existing.fallbackConfig != nil {
 		klog.V(4).Infof("Updating OSB client for broker %q, URL: %s", brokerKey.String(), clientConfig.URL)
 		return m.createClient(brokerKey, clientConfig)
 	}
@@ -92,6 +92,58 @@ func (m *BrokerClientManager) UpdateBrokerClient(brokerKey BrokerKey, clientConf
 	return existing.OSBClient, nil
 }
 
+// UpdateBrokerClientWithTokenSource works like UpdateBrokerClient, but the created client authenticates with
+// service account tokens obtained from the token source returned by newTokenSource. The token source is only
+// created together with a new client, so cached tokens survive as long as the configuration is unchanged.
+func (m *BrokerClientManager) UpdateBrokerClientWithTokenSource(brokerKey BrokerKey, clientConfig *osb.ClientConfiguration, tokenConfig ServiceAccountTokenConfig, newTokenSource func() TokenSource) (osb.Client, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	existing, found := m.clients[brokerKey]
+
+	if !found || configHasChanged(existing.clientConfig, clientConfig) || !reflect.DeepEqual(existing.tokenConfig, &tokenConfig) {
+		klog.V(4).Infof("Updating OSB client for broker %q, URL: %s, ServiceAccount: %s/%s", brokerKey.String(), clientConfig.URL, tokenConfig.Namespace, tokenConfig.Name)
+		client, err := newTokenRefreshingClient(m.brokerClientCreateFunc, clientConfig, newTokenSource())
+		if err != nil {
+			return nil, err
+		}
+		m.clients[brokerKey] = clientWithConfig{
+			OSBClient:    client,
+			clientConfig: clientConfig,
+			tokenConfig:  &tokenConfig,
+		}
+		return client, nil
+	}
+
+	return existing.OSBClient, nil
+}
+
+// UpdateBrokerClientWithFallbackAuth works like UpdateBrokerClient, but the created client sends the requests
+// rejected with a 401 Unauthorized response again with fallbackConfig, which only differs from clientConfig by its
+// auth config.
+func (m *BrokerClientManager) UpdateBrokerClientWithFallbackAuth(brokerKey BrokerKey, clientConfig, fallbackConfig *osb.ClientConfiguration) (osb.Client, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	existing, found := m.clients[brokerKey]
+
+	if !found || configHasChanged(existing.clientConfig, clientConfig) || configHasChanged(existing.fallbackConfig, fallbackConfig) {
+		klog.V(4).Infof("Updating OSB client with fallback auth for broker %q, URL: %s", brokerKey.String(), clientConfig.URL)
+		client, err := newFallbackAuthClient(m.brokerClientCreateFunc, clientConfig, fallbackConfig)
+		if err != nil {
+			return nil, err
+		}
+		m.clients[brokerKey] = clientWithConfig{
+			OSBClient:      client,
+			clientConfig:   clientConfig,
+			fallbackConfig: fallbackConfig,
+		}
+		return client, nil
+	}
+
+	return existing.OSBClient, nil
+}
+
 // RemoveBrokerClient removes broker client broker
 func (m *BrokerClientManager) RemoveBrokerClient(brokerKey BrokerKey) {
 	m.mu.Lock()
@@ -128,6 +180,8 @@ func configHasChanged(cfg1 *osb.ClientConfiguration, cfg2 *osb.ClientConfigurati
 }
 
 type clientWithConfig struct {
-	OSBClient    osb.Client
-	clientConfig *osb.ClientConfiguration
+	OSBClient      osb.Client
+	clientConfig   *osb.ClientConfiguration
+	tokenConfig    *ServiceAccountTokenConfig
+	fallbackConfig *osb.ClientConfiguration
 }
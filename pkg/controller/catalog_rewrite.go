@@ -0,0 +1,185 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// CatalogRewriteRules are the rules rewriting the catalogs of brokers before
+// they are converted into classes and plans, by broker. Cluster brokers are
+// keyed by name, namespaced brokers by namespace/name.
+type CatalogRewriteRules map[string][]CatalogRewriteRule
+
+// CatalogRewriteRule drops or renames the classes, or the plans, advertised by
+// a broker that it matches.
+type CatalogRewriteRule struct {
+	// Class matches the classes with this external name.
+	Class string `json:"class,omitempty"`
+	// Tag matches the classes with this tag.
+	Tag string `json:"tag,omitempty"`
+	// Plan makes the rule match the plans with this external name of the
+	// matched classes, instead of the classes themselves.
+	Plan string `json:"plan,omitempty"`
+
+	// Drop removes the matched classes or plans from the catalog.
+	Drop bool `json:"drop,omitempty"`
+	// Rename replaces the external name of the matched classes or plans.
+	Rename string `json:"rename,omitempty"`
+}
+
+// catalogRewriteConfig is the format of the catalog rewrite rules file.
+type catalogRewriteConfig struct {
+	Brokers CatalogRewriteRules `json:"brokers"`
+}
+
+// LoadCatalogRewriteRules reads the catalog rewrite rules from a file. No file
+// means no rules.
+func LoadCatalogRewriteRules(path string) (CatalogRewriteRules, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCatalogRewriteRules(data)
+}
+
+// ParseCatalogRewriteRules parses and validates catalog rewrite rules.
+func ParseCatalogRewriteRules(data []byte) (CatalogRewriteRules, error) {
+	config := catalogRewriteConfig{}
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, err
+	}
+	for broker, rules := range config.Brokers {
+		for i, rule := range rules {
+			if err := rule.validate(); err != nil {
+				return nil, fmt.Errorf("rule %d of broker %q: %v", i, broker, err)
+			}
+		}
+	}
+	return config.Brokers, nil
+}
+
+func (r CatalogRewriteRule) validate() error {
+	if r.Class == "" && r.Tag == "" && r.Plan == "" {
+		return fmt.Errorf("one of class, tag or plan is required")
+	}
+	if r.Drop == (r.Rename != "") {
+		return fmt.Errorf("exactly one of drop or rename is required")
+	}
+	return nil
+}
+
+// matches returns whether the rule matches the class, or the plan of the
+// class when one is given.
+func (r CatalogRewriteRule) matches(service *osb.Service, plan *osb.Plan) bool {
+	if (plan == nil) != (r.Plan == "") {
+		return false
+	}
+	if r.Class != "" && r.Class != service.Name {
+		return false
+	}
+	if r.Tag != "" && !containsTag(service.Tags, r.Tag) {
+		return false
+	}
+	return plan == nil || r.Plan == plan.Name
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// rewriteName returns the name of the class, or of the plan of the class when
+// one is given, after applying the rules, and false when a rule drops it.
+// Rules match the names advertised by the broker: a drop wins over any
+// rename, and the first matching rename wins over the later ones.
+func rewriteName(rules []CatalogRewriteRule, service *osb.Service, plan *osb.Plan, name string) (string, bool) {
+	renamed := ""
+	for _, rule := range rules {
+		if !rule.matches(service, plan) {
+			continue
+		}
+		if rule.Drop {
+			return "", false
+		}
+		if renamed == "" {
+			renamed = rule.Rename
+		}
+	}
+	if renamed == "" {
+		return name, true
+	}
+	return renamed, true
+}
+
+// rewriteCatalog applies the rules of the broker to its catalog; namespace is
+// empty for cluster brokers. The catalog of the broker is not modified; a
+// rewritten copy is returned instead.
+func (rules CatalogRewriteRules) rewriteCatalog(namespace, name string, in *osb.CatalogResponse) (*osb.CatalogResponse, error) {
+	brokerKey := name
+	if namespace != "" {
+		brokerKey = namespace + "/" + name
+	}
+	brokerRules := rules[brokerKey]
+	if len(brokerRules) == 0 {
+		return in, nil
+	}
+
+	out := &osb.CatalogResponse{}
+	serviceNames := make(map[string]bool)
+	for _, service := range in.Services {
+		name, ok := rewriteName(brokerRules, &service, nil, service.Name)
+		if !ok {
+			continue
+		}
+		if serviceNames[name] {
+			return nil, fmt.Errorf("more than one class is named %q after rewriting the catalog", name)
+		}
+		serviceNames[name] = true
+
+		plans := []osb.Plan{}
+		planNames := make(map[string]bool)
+		for _, plan := range service.Plans {
+			planName, ok := rewriteName(brokerRules, &service, &plan, plan.Name)
+			if !ok {
+				continue
+			}
+			if planNames[planName] {
+				return nil, fmt.Errorf("more than one plan of class %q is named %q after rewriting the catalog", name, planName)
+			}
+			planNames[planName] = true
+			plan.Name = planName
+			plans = append(plans, plan)
+		}
+
+		service.Name = name
+		service.Plans = plans
+		out.Services = append(out.Services, service)
+	}
+	return out, nil
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+)
+
+func TestParseCatalogRewriteRules(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   string
+		expected CatalogRewriteRules
+		err      string
+	}{
+		{
+			name: "rules",
+			config: `
+brokers:
+  ups-broker:
+  - class: internal-db
+    drop: true
+  - tag: beta
+    plan: large
+    rename: large-beta
+  team-a/team-broker:
+  - plan: internal
+    drop: true
+`,
+			expected: CatalogRewriteRules{
+				"ups-broker": {
+					{Class: "internal-db", Drop: true},
+					{Tag: "beta", Plan: "large", Rename: "large-beta"},
+				},
+				"team-a/team-broker": {
+					{Plan: "internal", Drop: true},
+				},
+			},
+		},
+		{
+			name:   "no match",
+			config: "brokers:\n  ups-broker:\n  - drop: true\n",
+			err:    `rule 0 of broker "ups-broker": one of class, tag or plan is required`,
+		},
+		{
+			name:   "no action",
+			config: "brokers:\n  ups-broker:\n  - class: db\n",
+			err:    "exactly one of drop or rename is required",
+		},
+		{
+			name:   "drop and rename",
+			config: "brokers:\n  ups-broker:\n  - class: db\n    drop: true\n    rename: other\n",
+			err:    "exactly one of drop or rename is required",
+		},
+		{
+			name:   "unknown field",
+			config: "brokers:\n  ups-broker:\n  - class: db\n    remove: true\n",
+			err:    "unknown field",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ParseCatalogRewriteRules([]byte(tc.config))
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Fatalf("unexpected rules; %s", expectedGot(tc.expected, actual))
+			}
+		})
+	}
+}
+
+func TestRewriteCatalog(t *testing.T) {
+	catalog := func() *osb.CatalogResponse {
+		return &osb.CatalogResponse{
+			Services: []osb.Service{
+				{
+					ID:   "db-id",
+					Name: "db",
+					Tags: []string{"beta"},
+					Plans: []osb.Plan{
+						{ID: "small-id", Name: "small"},
+						{ID: "internal-id", Name: "internal"},
+					},
+				},
+				{
+					ID:    "cache-id",
+					Name:  "cache",
+					Plans: []osb.Plan{{ID: "small-cache-id", Name: "small"}},
+				},
+			},
+		}
+	}
+	names := func(catalog *osb.CatalogResponse) []string {
+		var names []string
+		for _, service := range catalog.Services {
+			for _, plan := range service.Plans {
+				names = append(names, service.Name+"/"+plan.Name)
+			}
+		}
+		return names
+	}
+
+	cases := []struct {
+		name      string
+		namespace string
+		rules     []CatalogRewriteRule
+		expected  []string
+		err       string
+	}{
+		{
+			name:     "no rules",
+			expected: []string{"db/small", "db/internal", "cache/small"},
+		},
+		{
+			name:     "drop class",
+			rules:    []CatalogRewriteRule{{Class: "db", Drop: true}},
+			expected: []string{"cache/small"},
+		},
+		{
+			name:     "drop plan of all classes",
+			rules:    []CatalogRewriteRule{{Plan: "small", Drop: true}},
+			expected: []string{"db/internal"},
+		},
+		{
+			name:     "drop plan by tag",
+			rules:    []CatalogRewriteRule{{Tag: "beta", Plan: "internal", Drop: true}},
+			expected: []string{"db/small", "cache/small"},
+		},
+		{
+			name: "rename class and plan by advertised names",
+			rules: []CatalogRewriteRule{
+				{Class: "db", Rename: "database"},
+				{Class: "db", Plan: "small", Rename: "tiny"},
+			},
+			expected: []string{"database/tiny", "database/internal", "cache/small"},
+		},
+		{
+			name: "drop wins over rename",
+			rules: []CatalogRewriteRule{
+				{Class: "db", Rename: "database"},
+				{Tag: "beta", Drop: true},
+			},
+			expected: []string{"cache/small"},
+		},
+		{
+			name: "first rename wins",
+			rules: []CatalogRewriteRule{
+				{Class: "db", Rename: "database"},
+				{Tag: "beta", Rename: "beta-db"},
+			},
+			expected: []string{"database/small", "database/internal", "cache/small"},
+		},
+		{
+			name:      "rules of another broker",
+			namespace: "other-ns",
+			rules:     []CatalogRewriteRule{{Class: "db", Drop: true}},
+			expected:  []string{"db/small", "db/internal", "cache/small"},
+		},
+		{
+			name:  "class name conflict",
+			rules: []CatalogRewriteRule{{Class: "db", Rename: "cache"}},
+			err:   `more than one class is named "cache"`,
+		},
+		{
+			name:  "plan name conflict",
+			rules: []CatalogRewriteRule{{Plan: "internal", Rename: "small"}},
+			err:   `more than one plan of class "db" is named "small"`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := CatalogRewriteRules{"test-ns/test-broker": tc.rules}
+			namespace := tc.namespace
+			if namespace == "" {
+				namespace = "test-ns"
+			}
+			in := catalog()
+
+			actual, err := rules.rewriteCatalog(namespace, "test-broker", in)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if e, a := tc.expected, names(actual); !reflect.DeepEqual(e, a) {
+				t.Fatalf("unexpected catalog; %s", expectedGot(e, a))
+			}
+			if !reflect.DeepEqual(catalog(), in) {
+				t.Fatalf("the catalog of the broker was modified")
+			}
+		})
+	}
+}
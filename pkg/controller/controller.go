@@ -21,7 +21,9 @@ import (
 	"crypto/md5"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -30,16 +32,22 @@ import (
 	"k8s.io/klog"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	corev1 "k8s.io/api/core/v1"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -60,8 +68,15 @@ const (
 	//
 	// 5ms, 10ms, 20ms, 40ms, 80ms, 160ms, 320ms, 640ms, 1.3s, 2.6s, 5.1s, 10.2s, 20.4s, 41s, 82s
 	maxRetries = 15
-	// pollingStartInterval is the initial interval to use when polling async OSB operations.
+	// pollingStartInterval is the initial interval of the exponential backoff
+	// of the broker queues.
 	pollingStartInterval = 1 * time.Second
+	// instanceReferenceHealingInterval is the interval at which the missing
+	// class and plan references of processed instances are resolved again.
+	instanceReferenceHealingInterval = 10 * time.Minute
+	// bindingSecretHealingInterval is the interval between the requests sent
+	// to brokers when verifying binding Secrets on startup.
+	bindingSecretHealingInterval = 100 * time.Millisecond
 
 	// ContextProfilePlatformKubernetes is the platform name sent in the OSB
 	// ContextProfile for requests coming from Kubernetes.
@@ -72,6 +87,77 @@ const (
 	DefaultClusterIDConfigMapNamespace string = "default"
 )
 
+// Options holds the settings of the controller which tune its behavior, as
+// opposed to the clients and informers it works with.
+type Options struct {
+	// OperationPollingInitialInterval is the delay before the first poll of
+	// an asynchronous operation.
+	OperationPollingInitialInterval time.Duration
+	// AllowBrokerInsecureSkipTLSVerify is whether brokers may ask for the
+	// verification of their TLS certificate to be skipped.
+	AllowBrokerInsecureSkipTLSVerify bool
+	// BrokerContextNamespacePrefix, BrokerContextPlatform and
+	// BrokerContextAnnotations shape the OSB context sent to brokers.
+	BrokerContextNamespacePrefix string
+	BrokerContextPlatform        string
+	BrokerContextAnnotations     []string
+	// NamespaceAnnotationParameters maps the annotations of namespaces to
+	// the parameters they provide to the instances in them.
+	NamespaceAnnotationParameters map[string]string
+	// ParametersFromURLAllowedHosts are the lowercased hosts parameters may
+	// be fetched from by URL. Parameters are fetched from no host if empty.
+	ParametersFromURLAllowedHosts []string
+	// OriginatingIdentityNamespaceAnnotation is the annotation of namespaces
+	// whose value is sent as the originating identity of their requests.
+	OriginatingIdentityNamespaceAnnotation string
+	// CatalogRewriteRules are applied to the catalogs fetched from brokers.
+	CatalogRewriteRules CatalogRewriteRules
+	// MaxCatalogSize is the maximum number of classes and plans a single
+	// broker may publish. Zero means no limit.
+	MaxCatalogSize int64
+	// SkipMalformedCatalogEntries is whether the malformed classes and plans
+	// of a catalog are skipped instead of failing the whole relist.
+	SkipMalformedCatalogEntries bool
+	// MaxOrphanMitigationAttempts is the number of deprovision requests sent
+	// to mitigate an orphaned instance before giving up. Zero means no
+	// limit.
+	MaxOrphanMitigationAttempts int64
+	// ResumeOrphanMitigationOnBrokerRecovery is whether the failed orphan
+	// mitigations of a broker are resumed on every successful relist of it.
+	ResumeOrphanMitigationOnBrokerRecovery bool
+	// RefetchBindingCredentialsOnInstancePlanChange is whether the bind
+	// requests of the bindings of an instance are sent again, with the same
+	// binding IDs, after its plan changes.
+	RefetchBindingCredentialsOnInstancePlanChange bool
+	// RevalidateInstancesOnPlanSchemaChange is whether the parameters of
+	// instances are checked against the new parameter schema of their plan.
+	RevalidateInstancesOnPlanSchemaChange bool
+	// ResendParametersOnDefaultsChange is whether instances are updated when
+	// the default parameters of their plan change.
+	ResendParametersOnDefaultsChange bool
+	// AllowBindToNonBindablePlans is whether instances of non-bindable plans
+	// may be bound.
+	AllowBindToNonBindablePlans bool
+	// ReconcileInstancesOnlyOnChange is whether instances are left alone on
+	// informer resyncs when they did not change.
+	ReconcileInstancesOnlyOnChange bool
+	// StuckOperationWarningInterval is the duration after which an operation
+	// in progress is reported as stuck. Zero disables the warnings.
+	StuckOperationWarningInterval time.Duration
+	// HealBindingSecretsOnStartup is whether the Secrets of the bindings of
+	// brokers advertising bindings_retrievable are verified against the
+	// brokers on startup.
+	HealBindingSecretsOnStartup bool
+	// WatchSecrets is whether the Secrets of bindings are written again when
+	// deleted, and instances are updated when the Secrets their parameters
+	// are read from change.
+	WatchSecrets bool
+	// Selector restricts the brokers, instances and bindings reconciled by
+	// the controller, so that reconciliation can be sharded across several
+	// controllers. Nil means all of them.
+	Selector labels.Selector
+}
+
 // NewController returns a new Open Service Broker catalog controller.
 func NewController(
 	kubeClient kubernetes.Interface,
@@ -84,6 +170,8 @@ func NewController(
 	bindingInformer informers.ServiceBindingInformer,
 	clusterServicePlanInformer informers.ClusterServicePlanInformer,
 	servicePlanInformer informers.ServicePlanInformer,
+	secretInformer coreinformers.SecretInformer,
+	namespaceInformer coreinformers.NamespaceInformer,
 	brokerClientCreateFunc osb.CreateFunc,
 	brokerRelistInterval time.Duration,
 	osbAPIPreferredVersion string,
@@ -92,27 +180,54 @@ func NewController(
 	operationPollingMaximumBackoffDuration time.Duration,
 	clusterIDConfigMapName string,
 	clusterIDConfigMapNamespace string,
+	options Options,
 ) (Controller, error) {
 	controller := &controller{
-		kubeClient:                  kubeClient,
-		serviceCatalogClient:        serviceCatalogClient,
-		brokerRelistInterval:        brokerRelistInterval,
-		OSBAPIPreferredVersion:      osbAPIPreferredVersion,
-		recorder:                    recorder,
-		reconciliationRetryDuration: reconciliationRetryDuration,
-		clusterServiceBrokerQueue:   workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "cluster-service-broker"),
-		serviceBrokerQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "service-broker"),
-		clusterServiceClassQueue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cluster-service-class"),
-		serviceClassQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-class"),
-		clusterServicePlanQueue:     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cluster-service-plan"),
-		servicePlanQueue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-plan"),
-		instanceQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-instance"),
-		bindingQueue:                workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-binding"),
-		instancePollingQueue:        workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "instance-poller"),
-		bindingPollingQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "binding-poller"),
-		clusterIDConfigMapName:      clusterIDConfigMapName,
-		clusterIDConfigMapNamespace: clusterIDConfigMapNamespace,
-		brokerClientManager:         NewBrokerClientManager(brokerClientCreateFunc),
+		kubeClient:                                    kubeClient,
+		serviceCatalogClient:                          serviceCatalogClient,
+		brokerRelistInterval:                          brokerRelistInterval,
+		OSBAPIPreferredVersion:                        osbAPIPreferredVersion,
+		recorder:                                      recorder,
+		reconciliationRetryDuration:                   reconciliationRetryDuration,
+		clusterServiceBrokerQueue:                     workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "cluster-service-broker"),
+		serviceBrokerQueue:                            workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(pollingStartInterval, operationPollingMaximumBackoffDuration), "service-broker"),
+		clusterServiceClassQueue:                      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cluster-service-class"),
+		serviceClassQueue:                             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-class"),
+		clusterServicePlanQueue:                       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cluster-service-plan"),
+		servicePlanQueue:                              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-plan"),
+		instanceQueue:                                 workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-instance"),
+		bindingQueue:                                  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "service-binding"),
+		instancePollingQueue:                          workqueue.NewNamedRateLimitingQueue(newOperationPollingRateLimiter(options.OperationPollingInitialInterval, operationPollingMaximumBackoffDuration), "instance-poller"),
+		bindingPollingQueue:                           workqueue.NewNamedRateLimitingQueue(newOperationPollingRateLimiter(options.OperationPollingInitialInterval, operationPollingMaximumBackoffDuration), "binding-poller"),
+		allowBrokerInsecureSkipTLSVerify:              options.AllowBrokerInsecureSkipTLSVerify,
+		brokerContextNamespacePrefix:                  options.BrokerContextNamespacePrefix,
+		brokerContextPlatform:                         options.BrokerContextPlatform,
+		brokerContextAnnotations:                      options.BrokerContextAnnotations,
+		healBindingSecretsOnStartup:                   options.HealBindingSecretsOnStartup,
+		watchSecrets:                                  options.WatchSecrets,
+		skipMalformedCatalogEntries:                   options.SkipMalformedCatalogEntries,
+		maxOrphanMitigationAttempts:                   options.MaxOrphanMitigationAttempts,
+		resumeOrphanMitigationOnBrokerRecovery:        options.ResumeOrphanMitigationOnBrokerRecovery,
+		maxCatalogSize:                                options.MaxCatalogSize,
+		refetchBindingCredentialsOnInstancePlanChange: options.RefetchBindingCredentialsOnInstancePlanChange,
+		revalidateInstancesOnPlanSchemaChange:         options.RevalidateInstancesOnPlanSchemaChange,
+		resendParametersOnDefaultsChange:              options.ResendParametersOnDefaultsChange,
+		allowBindToNonBindablePlans:                   options.AllowBindToNonBindablePlans,
+		reconcileInstancesOnlyOnChange:                options.ReconcileInstancesOnlyOnChange,
+		stuckOperationWarningInterval:                 options.StuckOperationWarningInterval,
+		selector:                                      options.Selector,
+		stuckOperationWarnings:                        make(map[types.UID]stuckOperationWarning),
+		namespaceAnnotationParameters:                 options.NamespaceAnnotationParameters,
+		parametersFromURLAllowedHosts:                 sets.NewString(options.ParametersFromURLAllowedHosts...),
+		originatingIdentityNamespaceAnnotation:        options.OriginatingIdentityNamespaceAnnotation,
+		catalogRewriteRules:                           options.CatalogRewriteRules,
+		clusterIDConfigMapName:                        clusterIDConfigMapName,
+		clusterIDConfigMapNamespace:                   clusterIDConfigMapNamespace,
+		brokerClientManager:                           NewBrokerClientManager(brokerClientCreateFunc),
+		bindingCredentialsStore:                       NewBindingCredentialsStore(),
+		deletedBindingSecrets:                         make(map[types.UID]bool),
+		changedParametersSecretInstances:              make(map[types.UID]bool),
+		pausedInstanceBindings:                        make(map[types.UID]bool),
 	}
 
 	controller.clusterServiceBrokerLister = clusterServiceBrokerInformer.Lister()
@@ -137,6 +252,14 @@ func NewController(
 	})
 
 	controller.instanceLister = instanceInformer.Lister()
+	if err := instanceInformer.Informer().AddIndexers(cache.Indexers{
+		instanceParametersSecretIndex: indexServiceInstanceByParametersSecret,
+		instancePlanIndex:             indexServiceInstanceByPlan,
+	}); err != nil {
+		return nil, err
+	}
+	controller.instanceIndexer = instanceInformer.Informer().GetIndexer()
+	controller.instanceQueue = newPriorityQueue(controller.instanceQueue, "service-instance", controller.getServiceInstancePriority)
 	instanceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    controller.instanceAdd,
 		UpdateFunc: controller.instanceUpdate,
@@ -144,12 +267,32 @@ func NewController(
 	})
 
 	controller.bindingLister = bindingInformer.Lister()
+	if err := bindingInformer.Informer().AddIndexers(cache.Indexers{
+		bindingInstanceIndex: indexServiceBindingByInstance,
+	}); err != nil {
+		return nil, err
+	}
+	controller.bindingIndexer = bindingInformer.Informer().GetIndexer()
 	bindingInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    controller.bindingAdd,
+		AddFunc:    controller.bindingCreate,
 		UpdateFunc: controller.bindingUpdate,
 		DeleteFunc: controller.bindingDelete,
 	})
 
+	// The namespaces are only cached when their annotations are read, which
+	// happens when instances are provisioned and on every resync after, and
+	// on every broker request when they carry the originating identity.
+	if len(controller.namespaceAnnotationParameters) > 0 || controller.originatingIdentityNamespaceAnnotation != "" {
+		controller.namespaceLister = namespaceInformer.Lister()
+	}
+
+	if controller.watchSecrets {
+		secretInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: controller.secretUpdate,
+			DeleteFunc: controller.secretDelete,
+		})
+	}
+
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
 		controller.serviceBrokerLister = serviceBrokerInformer.Lister()
 		serviceBrokerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -175,13 +318,22 @@ func NewController(
 	return controller, nil
 }
 
+// newOperationPollingRateLimiter returns the rate limiter of the polling queues.
+// The interval between two polls of an operation starts at initialInterval and
+// doubles with every poll, up to maxInterval. The polling code makes the rate
+// limiter forget a resource when its operation progresses, so that the next
+// change is noticed early again.
+func newOperationPollingRateLimiter(initialInterval, maxInterval time.Duration) workqueue.RateLimiter {
+	return workqueue.NewItemExponentialFailureRateLimiter(initialInterval, maxInterval)
+}
+
 // Controller describes a controller that backs the service catalog API for
 // Open Service Broker compliant Brokers.
 type Controller interface {
 	// Run runs the controller until the given stop channel can be read from.
 	// workers specifies the number of goroutines, per resource, processing work
 	// from the resource workqueues
-	Run(workers int, stopCh <-chan struct{})
+	Run(workers Workers, stopCh <-chan struct{})
 }
 
 // controller is a concrete Controller.
@@ -193,7 +345,10 @@ type controller struct {
 	clusterServiceClassLister   listers.ClusterServiceClassLister
 	serviceClassLister          listers.ServiceClassLister
 	instanceLister              listers.ServiceInstanceLister
+	instanceIndexer             cache.Indexer
 	bindingLister               listers.ServiceBindingLister
+	bindingIndexer              cache.Indexer
+	namespaceLister             corelisters.NamespaceLister
 	clusterServicePlanLister    listers.ClusterServicePlanLister
 	servicePlanLister           listers.ServicePlanLister
 	brokerRelistInterval        time.Duration
@@ -210,6 +365,78 @@ type controller struct {
 	bindingQueue                workqueue.RateLimitingInterface
 	instancePollingQueue        workqueue.RateLimitingInterface
 	bindingPollingQueue         workqueue.RateLimitingInterface
+	// allowBrokerInsecureSkipTLSVerify is whether brokers may disable TLS
+	// certificate verification through spec.insecureSkipTLSVerify.
+	allowBrokerInsecureSkipTLSVerify bool
+	// brokerContextNamespacePrefix is prepended to the namespace sent to
+	// brokers in the OSB context.
+	brokerContextNamespacePrefix string
+	// brokerContextPlatform is the platform sent to brokers in the OSB
+	// context.
+	brokerContextPlatform string
+	// brokerContextAnnotations are the annotations of instances copied into
+	// the OSB context of their provision and update requests.
+	brokerContextAnnotations []string
+	// healBindingSecretsOnStartup is whether the Secrets of the bindings of
+	// brokers advertising bindings_retrievable are verified against the
+	// brokers on startup.
+	healBindingSecretsOnStartup bool
+	// watchSecrets is whether the controller watches the Secrets of bindings
+	// and the Secrets the parameters of instances are read from.
+	watchSecrets bool
+	// skipMalformedCatalogEntries is whether the malformed classes and plans
+	// of a catalog are skipped instead of failing the whole relist.
+	skipMalformedCatalogEntries bool
+	// maxOrphanMitigationAttempts is the number of deprovision requests
+	// sent to mitigate an orphaned instance before giving up. Zero means
+	// no limit.
+	maxOrphanMitigationAttempts int64
+	// resumeOrphanMitigationOnBrokerRecovery is whether the failed orphan
+	// mitigations of a broker are resumed on every successful relist of it.
+	resumeOrphanMitigationOnBrokerRecovery bool
+	// maxCatalogSize is the maximum number of classes and plans a single
+	// broker may publish. Zero means no limit.
+	maxCatalogSize int64
+	// refetchBindingCredentialsOnInstancePlanChange is whether the bind
+	// requests of the bindings of an instance are sent again, with the same
+	// binding IDs, after its plan changes.
+	refetchBindingCredentialsOnInstancePlanChange bool
+	// revalidateInstancesOnPlanSchemaChange is whether the parameters of
+	// the instances of a plan are checked against its new instance create
+	// schema when a broker relist changes it.
+	revalidateInstancesOnPlanSchemaChange bool
+	// resendParametersOnDefaultsChange is whether the parameters of
+	// provisioned instances are sent again when the default provisioning
+	// parameters they were provisioned with change.
+	resendParametersOnDefaultsChange bool
+	// allowBindToNonBindablePlans is whether bind requests are sent for
+	// instances whose plan is not bindable instead of being rejected.
+	allowBindToNonBindablePlans bool
+	// reconcileInstancesOnlyOnChange is whether resyncs of instances whose
+	// spec has been observed and which have no operation in progress are
+	// ignored.
+	reconcileInstancesOnlyOnChange bool
+	// stuckOperationWarningInterval is the age past which ongoing operations
+	// are reminded of with Warning events, at most once per interval, if
+	// not zero.
+	stuckOperationWarningInterval time.Duration
+	// selector restricts the brokers, instances and bindings reconciled by
+	// the controller, if not nil. The listers are not filtered, so that the
+	// objects of other shards are still seen when looking references up.
+	selector labels.Selector
+	// namespaceAnnotationParameters maps annotations of the namespace of
+	// an instance to the provisioning parameters they provide defaults for.
+	namespaceAnnotationParameters map[string]string
+	// parametersFromURLAllowedHosts are the lowercased hosts parameters may
+	// be fetched from by URL.
+	parametersFromURLAllowedHosts sets.String
+	// originatingIdentityNamespaceAnnotation is the annotation of namespaces
+	// whose value is sent as the username of the originating identity of
+	// broker requests, if set.
+	originatingIdentityNamespaceAnnotation string
+	// catalogRewriteRules drop or rename the classes and plans advertised
+	// by brokers before their catalogs are converted.
+	catalogRewriteRules CatalogRewriteRules
 	// clusterIDConfigMapName is the k8s name that the clusterid
 	// configmap will have.
 	clusterIDConfigMapName string
@@ -228,29 +455,64 @@ type controller struct {
 	instanceOperationRetryQueue instanceOperationBackoff
 	// BrokerClientManager holds all OSB clients for brokers.
 	brokerClientManager *BrokerClientManager
+	// bindingCredentialsStore holds the credentials of bindings whose
+	// Secret still has to be written.
+	bindingCredentialsStore *BindingCredentialsStore
+	// deletedBindingSecrets holds the UIDs of ready bindings whose Secret
+	// has been deleted and has to be written again.
+	deletedBindingSecrets map[types.UID]bool
+	// deletedBindingSecretsLock protects access to deletedBindingSecrets
+	// between the Secret informer and the binding workers.
+	deletedBindingSecretsLock sync.Mutex
+	// changedParametersSecretInstances holds the UIDs of instances whose
+	// parametersFrom Secrets have changed since their parameters were last
+	// sent to the broker.
+	changedParametersSecretInstances map[types.UID]bool
+	// changedParametersSecretInstancesLock protects access to
+	// changedParametersSecretInstances between the Secret informer and the
+	// instance workers.
+	changedParametersSecretInstancesLock sync.Mutex
+	// pausedInstanceBindings holds the UIDs of the bindings for which the
+	// pause of their instance has been reported.
+	pausedInstanceBindings map[types.UID]bool
+	// pausedInstanceBindingsLock protects access to pausedInstanceBindings
+	// between the binding workers and the binding informer.
+	pausedInstanceBindingsLock sync.Mutex
+	// stuckOperationWarnings holds, per UID, when the ongoing operation of an
+	// instance or binding was last reminded of.
+	stuckOperationWarnings map[types.UID]stuckOperationWarning
+	// stuckOperationWarningsLock protects access to stuckOperationWarnings
+	// between the instance and binding workers.
+	stuckOperationWarningsLock sync.Mutex
 }
 
 // Run runs the controller until the given stop channel can be read from.
-func (c *controller) Run(workers int, stopCh <-chan struct{}) {
+func (c *controller) Run(workers Workers, stopCh <-chan struct{}) {
 	defer runtimeutil.HandleCrash()
 
-	klog.Info("Starting service-catalog controller")
+	klog.Infof("Starting service-catalog controller with %d broker, %d instance and %d binding workers", workers.Brokers, workers.Instances, workers.Bindings)
 
 	var waitGroup sync.WaitGroup
 
-	for i := 0; i < workers; i++ {
+	for i := 0; i < workers.Brokers; i++ {
 		createWorker(c.clusterServiceBrokerQueue, "ClusterServiceBroker", maxRetries, true, c.reconcileClusterServiceBrokerKey, stopCh, &waitGroup)
 		createWorker(c.clusterServiceClassQueue, "ClusterServiceClass", maxRetries, true, c.reconcileClusterServiceClassKey, stopCh, &waitGroup)
 		createWorker(c.clusterServicePlanQueue, "ClusterServicePlan", maxRetries, true, c.reconcileClusterServicePlanKey, stopCh, &waitGroup)
-		createWorker(c.instanceQueue, "ServiceInstance", maxRetries, true, c.reconcileServiceInstanceKey, stopCh, &waitGroup)
-		createWorker(c.bindingQueue, "ServiceBinding", maxRetries, true, c.reconcileServiceBindingKey, stopCh, &waitGroup)
-		createWorker(c.instancePollingQueue, "InstancePoller", maxRetries, false, c.requeueServiceInstanceForPoll, stopCh, &waitGroup)
 
 		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
 			createWorker(c.serviceBrokerQueue, "ServiceBroker", maxRetries, true, c.reconcileServiceBrokerKey, stopCh, &waitGroup)
 			createWorker(c.serviceClassQueue, "ServiceClass", maxRetries, true, c.reconcileServiceClassKey, stopCh, &waitGroup)
 			createWorker(c.servicePlanQueue, "ServicePlan", maxRetries, true, c.reconcileServicePlanKey, stopCh, &waitGroup)
 		}
+	}
+
+	for i := 0; i < workers.Instances; i++ {
+		createWorker(c.instanceQueue, "ServiceInstance", maxRetries, true, c.reconcileServiceInstanceKey, stopCh, &waitGroup)
+		createWorker(c.instancePollingQueue, "InstancePoller", maxRetries, false, c.requeueServiceInstanceForPoll, stopCh, &waitGroup)
+	}
+
+	for i := 0; i < workers.Bindings; i++ {
+		createWorker(c.bindingQueue, "ServiceBinding", maxRetries, true, c.reconcileServiceBindingKey, stopCh, &waitGroup)
 
 		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.AsyncBindingOperations) {
 			createWorker(c.bindingPollingQueue, "BindingPoller", maxRetries, false, c.requeueServiceBindingForPoll, stopCh, &waitGroup)
@@ -267,6 +529,16 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 	// instance operation retry entries
 	c.createPurgeExpiredRetryEntriesWorker(stopCh, &waitGroup)
 
+	// create a task that runs at startup and periodically to resolve the
+	// missing references of instances nothing else reconciles
+	c.createHealServiceInstanceReferencesWorker(stopCh, &waitGroup)
+
+	// create a task that runs once at startup to heal the binding Secrets
+	// modified while the controller was down
+	if c.healBindingSecretsOnStartup {
+		c.createHealServiceBindingSecretsWorker(stopCh, &waitGroup)
+	}
+
 	<-stopCh
 	klog.Info("Shutting down service-catalog controller")
 
@@ -288,6 +560,17 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) {
 	klog.Info("Shutdown service-catalog controller")
 }
 
+// Workers is the number of workers reconciling each type of resource
+// concurrently.
+type Workers struct {
+	// Brokers is the number of workers for brokers, classes and plans.
+	Brokers int
+	// Instances is the number of workers for service instances.
+	Instances int
+	// Bindings is the number of workers for service bindings.
+	Bindings int
+}
+
 // createWorker creates and runs a worker thread that just processes items in the
 // specified queue. The worker will run until stopCh is closed. The worker will be
 // added to the wait group when started and marked done when finished.
@@ -317,6 +600,27 @@ func (c *controller) createPurgeExpiredRetryEntriesWorker(stopCh <-chan struct{}
 	}()
 }
 
+// createHealServiceInstanceReferencesWorker creates a task that runs at
+// startup and periodically to resolve the missing class and plan references
+// of instances
+func (c *controller) createHealServiceInstanceReferencesWorker(stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+	waitGroup.Add(1)
+	go func() {
+		wait.Until(c.healServiceInstanceReferences, instanceReferenceHealingInterval, stopCh)
+		waitGroup.Done()
+	}()
+}
+
+// createHealServiceBindingSecretsWorker creates a task that verifies the
+// binding Secrets against the brokers once, at startup.
+func (c *controller) createHealServiceBindingSecretsWorker(stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+	waitGroup.Add(1)
+	go func() {
+		c.healServiceBindingSecrets(stopCh)
+		waitGroup.Done()
+	}()
+}
+
 func (c *controller) monitorConfigMap() {
 	// Cannot wait for the informer to push something into a queue.
 	// What we're waiting on may never exist without us configuring
@@ -367,6 +671,8 @@ func (c *controller) monitorConfigMap() {
 // It enforces that the reconciler is never invoked concurrently with the same key.
 // If forgetAfterSuccess is true, it will cause the queue to forget the item should reconciliation
 // have no error.
+// Items failing with a nonRetryableError are dropped right away, and reconciled again only when
+// they, or the resources they depend on, change, or on the next resync.
 func worker(queue workqueue.RateLimitingInterface, resourceType string, maxRetries int, forgetAfterSuccess bool, reconciler func(key string) error) func() {
 	return func() {
 		exit := false
@@ -386,6 +692,12 @@ func worker(queue workqueue.RateLimitingInterface, resourceType string, maxRetri
 					return false
 				}
 
+				if _, ok := err.(*nonRetryableError); ok {
+					klog.V(4).Infof("Dropping %s %q out of the queue: %v", resourceType, key, err)
+					queue.Forget(key)
+					return false
+				}
+
 				numRequeues := queue.NumRequeues(key)
 				if numRequeues < maxRetries {
 					klog.V(4).Infof("Error syncing %s %v (retry: %d/%d): %v", resourceType, key, numRequeues, maxRetries, err)
@@ -410,6 +722,24 @@ type operationError struct {
 
 func (e *operationError) Error() string { return e.message }
 
+// nonRetryableError is returned by a reconciler when the resource cannot be
+// reconciled because of a problem, such as invalid parameters, that retrying
+// will not resolve. The worker drops such resources instead of retrying them.
+type nonRetryableError struct {
+	message string
+}
+
+func (e *nonRetryableError) Error() string { return e.message }
+
+// reconciliationErrorFor returns the error a reconciler returns to the worker
+// after recording the given condition reason and message on a resource.
+func reconciliationErrorFor(reason, message string) error {
+	if reason == v1beta1.ReasonErrorWithParameters {
+		return &nonRetryableError{message: message}
+	}
+	return fmt.Errorf("%s", message)
+}
+
 // getClusterServiceClassPlanAndClusterServiceBroker is a sequence of operations that's done in couple of
 // places so this method fetches the Service Class, Service Plan and creates
 // a brokerClient to use for that method given an ServiceInstance.
@@ -428,7 +758,7 @@ func (c *controller) getClusterServiceClassPlanAndClusterServiceBroker(instance
 		servicePlan, err = c.clusterServicePlanLister.Get(instance.Spec.ClusterServicePlanRef.Name)
 		if nil != err {
 			return nil, nil, "", nil, &operationError{
-				reason: errorNonexistentClusterServicePlanReason,
+				reason: v1beta1.ReasonReferencesNonexistentServicePlan,
 				message: fmt.Sprintf(
 					"The instance references a non-existent ClusterServicePlan %q - %v",
 					instance.Spec.ClusterServicePlanRef.Name, instance.Spec.PlanReference,
@@ -451,7 +781,7 @@ func (c *controller) getServiceClassPlanAndServiceBroker(instance *v1beta1.Servi
 		servicePlan, err = c.servicePlanLister.ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name)
 		if nil != err {
 			return nil, nil, "", nil, &operationError{
-				reason: errorNonexistentServicePlanReason,
+				reason: v1beta1.ReasonReferencesNonexistentServicePlan,
 				message: fmt.Sprintf(
 					"The instance references a non-existent ServicePlan %q - %v",
 					instance.Spec.ServicePlanRef.Name, instance.Spec.PlanReference,
@@ -469,7 +799,7 @@ func (c *controller) getClusterServiceClassAndClusterServiceBroker(instance *v1b
 	serviceClass, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
 	if err != nil {
 		return nil, "", nil, &operationError{
-			reason: errorNonexistentClusterServiceClassReason,
+			reason: v1beta1.ReasonReferencesNonexistentServiceClass,
 			message: fmt.Sprintf(
 				"The instance references a non-existent ClusterServiceClass (K8S: %q ExternalName: %q)",
 				instance.Spec.ClusterServiceClassRef.Name, instance.Spec.ClusterServiceClassExternalName,
@@ -480,7 +810,7 @@ func (c *controller) getClusterServiceClassAndClusterServiceBroker(instance *v1b
 	broker, err := c.clusterServiceBrokerLister.Get(serviceClass.Spec.ClusterServiceBrokerName)
 	if err != nil {
 		return nil, "", nil, &operationError{
-			reason: errorNonexistentClusterServiceBrokerReason,
+			reason: v1beta1.ReasonReferencesNonexistentBroker,
 			message: fmt.Sprintf(
 				"The instance references a non-existent broker %q",
 				serviceClass.Spec.ClusterServiceBrokerName,
@@ -492,7 +822,7 @@ func (c *controller) getClusterServiceClassAndClusterServiceBroker(instance *v1b
 	brokerClient, found := c.brokerClientManager.BrokerClient(NewClusterServiceBrokerKey(serviceClass.Spec.ClusterServiceBrokerName))
 	if !found {
 		return nil, "", nil, &operationError{
-			reason: errorNonexistentClusterServiceBrokerReason,
+			reason: v1beta1.ReasonReferencesNonexistentBroker,
 			message: fmt.Sprintf(
 				"The instance references a broker %q which has no OSB client created",
 				serviceClass.Spec.ClusterServiceBrokerName,
@@ -510,7 +840,7 @@ func (c *controller) getServiceClassAndServiceBroker(instance *v1beta1.ServiceIn
 	serviceClass, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
 	if err != nil {
 		return nil, "", nil, &operationError{
-			reason: errorNonexistentServiceClassReason,
+			reason: v1beta1.ReasonReferencesNonexistentServiceClass,
 			message: fmt.Sprintf(
 				"The instance references a non-existent ServiceClass (K8S: %q ExternalName: %q)",
 				instance.Spec.ServiceClassRef.Name, instance.Spec.ServiceClassExternalName,
@@ -521,7 +851,7 @@ func (c *controller) getServiceClassAndServiceBroker(instance *v1beta1.ServiceIn
 	broker, err := c.serviceBrokerLister.ServiceBrokers(instance.Namespace).Get(serviceClass.Spec.ServiceBrokerName)
 	if err != nil {
 		return nil, "", nil, &operationError{
-			reason: errorNonexistentServiceBrokerReason,
+			reason: v1beta1.ReasonReferencesNonexistentBroker,
 			message: fmt.Sprintf(
 				"The instance references a non-existent broker %q",
 				serviceClass.Spec.ServiceBrokerName,
@@ -533,7 +863,7 @@ func (c *controller) getServiceClassAndServiceBroker(instance *v1beta1.ServiceIn
 	brokerClient, found := c.brokerClientManager.BrokerClient(NewServiceBrokerKey(instance.Namespace, serviceClass.Spec.ServiceBrokerName))
 	if !found {
 		return nil, "", nil, &operationError{
-			reason: errorNonexistentClusterServiceBrokerReason,
+			reason: v1beta1.ReasonReferencesNonexistentBroker,
 			message: fmt.Sprintf(
 				"The instance references a broker %q which has no OSB client created",
 				serviceClass.Spec.ServiceBrokerName,
@@ -592,7 +922,7 @@ func (c *controller) getClusterServiceClassForServiceBinding(instance *v1beta1.S
 			binding,
 			v1beta1.ServiceBindingConditionReady,
 			v1beta1.ConditionFalse,
-			errorNonexistentClusterServiceClassReason,
+			v1beta1.ReasonReferencesNonexistentServiceClass,
 			"The binding references a ClusterServiceClass that does not exist. "+s,
 		)
 		c.recorder.Event(binding, corev1.EventTypeWarning, errorNonexistentClusterServiceClassMessage, s)
@@ -614,10 +944,10 @@ func (c *controller) getClusterServicePlanForServiceBinding(instance *v1beta1.Se
 			binding,
 			v1beta1.ServiceBindingConditionReady,
 			v1beta1.ConditionFalse,
-			errorNonexistentClusterServicePlanReason,
+			v1beta1.ReasonReferencesNonexistentServicePlan,
 			"The ServiceBinding references an ServiceInstance which references ClusterServicePlan that does not exist. "+s,
 		)
-		c.recorder.Event(binding, corev1.EventTypeWarning, errorNonexistentClusterServicePlanReason, s)
+		c.recorder.Event(binding, corev1.EventTypeWarning, v1beta1.ReasonReferencesNonexistentServicePlan, s)
 		return nil, fmt.Errorf(s)
 	}
 	return servicePlan, nil
@@ -634,10 +964,10 @@ func (c *controller) getClusterServiceBrokerForServiceBinding(instance *v1beta1.
 			binding,
 			v1beta1.ServiceBindingConditionReady,
 			v1beta1.ConditionFalse,
-			errorNonexistentClusterServiceBrokerReason,
+			v1beta1.ReasonReferencesNonexistentBroker,
 			"The binding references a ClusterServiceBroker that does not exist. "+s,
 		)
-		c.recorder.Event(binding, corev1.EventTypeWarning, errorNonexistentClusterServiceBrokerReason, s)
+		c.recorder.Event(binding, corev1.EventTypeWarning, v1beta1.ReasonReferencesNonexistentBroker, s)
 		return nil, err
 	}
 	return broker, nil
@@ -694,11 +1024,22 @@ func (c *controller) getBrokerClientForServiceBinding(instance *v1beta1.ServiceI
 // returns an error. If the AuthInfo field is nil, empty values are
 // returned.
 func getAuthCredentialsFromClusterServiceBroker(client kubernetes.Interface, broker *v1beta1.ClusterServiceBroker) (*osb.AuthConfig, error) {
-	if broker.Spec.AuthInfo == nil {
+	return getAuthCredentialsFromClusterServiceBrokerAuthInfo(client, broker.Spec.AuthInfo)
+}
+
+// getFallbackAuthCredentialsFromClusterServiceBroker returns the fallback auth
+// credentials of the broker, if any, or returns an error.
+func getFallbackAuthCredentialsFromClusterServiceBroker(client kubernetes.Interface, broker *v1beta1.ClusterServiceBroker) (*osb.AuthConfig, error) {
+	return getAuthCredentialsFromClusterServiceBrokerAuthInfo(client, broker.Spec.FallbackAuthInfo)
+}
+
+// getAuthCredentialsFromClusterServiceBrokerAuthInfo returns the auth
+// credentials described by authInfo, or nil if authInfo is nil.
+func getAuthCredentialsFromClusterServiceBrokerAuthInfo(client kubernetes.Interface, authInfo *v1beta1.ClusterServiceBrokerAuthInfo) (*osb.AuthConfig, error) {
+	if authInfo == nil {
 		return nil, nil
 	}
 
-	authInfo := broker.Spec.AuthInfo
 	if authInfo.Basic != nil {
 		secretRef := authInfo.Basic.SecretRef
 		secret, err := client.CoreV1().Secrets(secretRef.Namespace).Get(secretRef.Name, metav1.GetOptions{})
@@ -725,6 +1066,10 @@ func getAuthCredentialsFromClusterServiceBroker(client kubernetes.Interface, bro
 		return &osb.AuthConfig{
 			BearerConfig: bearerConfig,
 		}, nil
+	} else if authInfo.BearerTokenFromServiceAccount != nil {
+		// the token is obtained by the broker client itself, see
+		// getServiceAccountTokenConfigFromClusterServiceBroker
+		return nil, nil
 	}
 	return nil, fmt.Errorf("empty auth info or unsupported auth mode: %s", authInfo)
 }
@@ -763,10 +1108,46 @@ func getAuthCredentialsFromServiceBroker(client kubernetes.Interface, broker *v1
 		return &osb.AuthConfig{
 			BearerConfig: bearerConfig,
 		}, nil
+	} else if authInfo.BearerTokenFromServiceAccount != nil {
+		// the token is obtained by the broker client itself, see
+		// getServiceAccountTokenConfigFromServiceBroker
+		return nil, nil
 	}
 	return nil, fmt.Errorf("empty auth info or unsupported auth mode: %s", authInfo)
 }
 
+// getServiceAccountTokenConfigFromClusterServiceBroker returns the service
+// account token config of the broker, or nil if the broker does not
+// authenticate with service account tokens.
+func getServiceAccountTokenConfigFromClusterServiceBroker(broker *v1beta1.ClusterServiceBroker) *ServiceAccountTokenConfig {
+	if broker.Spec.AuthInfo == nil || broker.Spec.AuthInfo.BearerTokenFromServiceAccount == nil {
+		return nil
+	}
+	saAuth := broker.Spec.AuthInfo.BearerTokenFromServiceAccount
+	return &ServiceAccountTokenConfig{
+		Namespace:         saAuth.ServiceAccountRef.Namespace,
+		Name:              saAuth.ServiceAccountRef.Name,
+		Audience:          saAuth.Audience,
+		ExpirationSeconds: saAuth.ExpirationSeconds,
+	}
+}
+
+// getServiceAccountTokenConfigFromServiceBroker returns the service account
+// token config of the broker, or nil if the broker does not authenticate
+// with service account tokens.
+func getServiceAccountTokenConfigFromServiceBroker(broker *v1beta1.ServiceBroker) *ServiceAccountTokenConfig {
+	if broker.Spec.AuthInfo == nil || broker.Spec.AuthInfo.BearerTokenFromServiceAccount == nil {
+		return nil
+	}
+	saAuth := broker.Spec.AuthInfo.BearerTokenFromServiceAccount
+	return &ServiceAccountTokenConfig{
+		Namespace:         broker.Namespace,
+		Name:              saAuth.ServiceAccountRef.Name,
+		Audience:          saAuth.Audience,
+		ExpirationSeconds: saAuth.ExpirationSeconds,
+	}
+}
+
 func getBasicAuthConfig(secret *corev1.Secret) (*osb.BasicAuthConfig, error) {
 	usernameBytes, ok := secret.Data["username"]
 	if !ok {
@@ -926,6 +1307,106 @@ func GenerateEscapedName(externalID string) string {
 	return escapedName
 }
 
+// getServiceBrokerFeatures returns the optional features advertised by the
+// services in the given broker catalog.
+func getServiceBrokerFeatures(catalog *osb.CatalogResponse) []v1beta1.ServiceBrokerFeature {
+	var asyncBindings, planUpdates bool
+	for _, svc := range catalog.Services {
+		asyncBindings = asyncBindings || svc.BindingsRetrievable
+		planUpdates = planUpdates || (svc.PlanUpdatable != nil && *svc.PlanUpdatable)
+	}
+
+	var features []v1beta1.ServiceBrokerFeature
+	if asyncBindings {
+		features = append(features, v1beta1.ServiceBrokerFeatureAsyncBindings)
+	}
+	if planUpdates {
+		features = append(features, v1beta1.ServiceBrokerFeaturePlanUpdates)
+	}
+	return features
+}
+
+const (
+	// deprecationNoticeMetadataKey is the key of the metadata of a service in
+	// a broker catalog holding a deprecation notice for users of the service.
+	deprecationNoticeMetadataKey = "deprecationNotice"
+	// endOfLifeMetadataKey is the key of the metadata of a service in a
+	// broker catalog holding the date, in the YYYY-MM-DD format, after which
+	// the broker stops offering the service.
+	endOfLifeMetadataKey = "endOfLife"
+)
+
+// getServiceBrokerDeprecationNotice returns the deprecation notices and end of
+// life dates published in the metadata of the services in the given broker
+// catalog, or an empty string if the catalog announces no deprecation. Values
+// of the wrong type and end of life dates which can't be parsed are ignored.
+func getServiceBrokerDeprecationNotice(catalog *osb.CatalogResponse) string {
+	var notices []string
+	for _, svc := range catalog.Services {
+		notice, _ := svc.Metadata[deprecationNoticeMetadataKey].(string)
+		notice = strings.TrimSpace(notice)
+		var endOfLife string
+		if value, ok := svc.Metadata[endOfLifeMetadataKey].(string); ok {
+			if date, err := time.Parse("2006-01-02", value); err == nil {
+				endOfLife = date.Format("2006-01-02")
+			}
+		}
+
+		switch {
+		case notice != "" && endOfLife != "":
+			notices = append(notices, fmt.Sprintf("service %q reaches its end of life on %s: %s", svc.Name, endOfLife, notice))
+		case endOfLife != "":
+			notices = append(notices, fmt.Sprintf("service %q reaches its end of life on %s", svc.Name, endOfLife))
+		case notice != "":
+			notices = append(notices, fmt.Sprintf("service %q is deprecated: %s", svc.Name, notice))
+		}
+	}
+	return strings.Join(notices, "; ")
+}
+
+// removeServicesWithoutPlans returns the catalog without the services which
+// have no plans, which would fail the conversion of the whole catalog, along
+// with the names of the removed services. The given catalog is not modified.
+func removeServicesWithoutPlans(catalog *osb.CatalogResponse) (*osb.CatalogResponse, []string) {
+	var removed []string
+	services := make([]osb.Service, 0, len(catalog.Services))
+	for _, svc := range catalog.Services {
+		if len(svc.Plans) == 0 {
+			removed = append(removed, svc.Name)
+			continue
+		}
+		services = append(services, svc)
+	}
+	if len(removed) == 0 {
+		return catalog, nil
+	}
+
+	filtered := *catalog
+	filtered.Services = services
+	return &filtered, removed
+}
+
+// malformedServiceClassEntry and malformedServicePlanEntry describe the
+// malformed classes and plans listed in the MalformedCatalogEntries condition
+// of a broker.
+func malformedServiceClassEntry(className string) string {
+	return fmt.Sprintf("class %q", className)
+}
+
+func malformedServicePlanEntry(className, planName string) string {
+	return fmt.Sprintf("plan %q of class %q", planName, className)
+}
+
+// catalogSizeExceeded returns whether a broker publishing the given number
+// of classes and plans exceeds the maximum catalog size.
+func (c *controller) catalogSizeExceeded(classes, plans int) bool {
+	return c.maxCatalogSize > 0 && int64(classes+plans) > c.maxCatalogSize
+}
+
+func catalogSizeExceededMessage(classes, plans int, maxCatalogSize int64) string {
+	return fmt.Sprintf("The broker publishes %d classes and %d plans, more than the maximum of %d classes and plans per broker", classes, plans, maxCatalogSize)
+}
+
 // convertAndFilterCatalog converts a service broker catalog into an array of
 // ClusterServiceClasses and an array of ClusterServicePlans and filters these
 // through the restrictions provided. The ClusterServiceClasses and
@@ -1096,10 +1577,62 @@ func convertServicePlans(namespace string, plans []osb.Plan, serviceClassID stri
 		if err != nil {
 			return nil, err
 		}
+		servicePlan.Status.Costs = parseServicePlanCosts(plan.Metadata)
 	}
 	return servicePlans, nil
 }
 
+// parseServicePlanCosts returns the costs listed in the metadata of a plan,
+// as described by the OSB API metadata conventions:
+//
+//	"costs": [{"amount": {"usd": 99.0}, "unit": "MONTHLY"}]
+//
+// Costs that do not have this shape are skipped, so that unexpected metadata
+// does not prevent the catalog from being synced.
+func parseServicePlanCosts(metadata map[string]interface{}) []v1beta1.ServicePlanCost {
+	rawCosts, ok := metadata["costs"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var costs []v1beta1.ServicePlanCost
+	for _, rawCost := range rawCosts {
+		cost, ok := parseServicePlanCost(rawCost)
+		if !ok {
+			klog.V(4).Infof("Skipping plan cost %v that does not follow the OSB metadata conventions", rawCost)
+			continue
+		}
+		costs = append(costs, cost)
+	}
+	return costs
+}
+
+// parseServicePlanCost parses a single entry of the costs of a plan.
+func parseServicePlanCost(rawCost interface{}) (v1beta1.ServicePlanCost, bool) {
+	fields, ok := rawCost.(map[string]interface{})
+	if !ok {
+		return v1beta1.ServicePlanCost{}, false
+	}
+	unit, ok := fields["unit"].(string)
+	if !ok || unit == "" {
+		return v1beta1.ServicePlanCost{}, false
+	}
+	rawAmount, ok := fields["amount"].(map[string]interface{})
+	if !ok || len(rawAmount) == 0 {
+		return v1beta1.ServicePlanCost{}, false
+	}
+
+	amount := make(map[string]string, len(rawAmount))
+	for currency, value := range rawAmount {
+		number, ok := value.(float64)
+		if !ok {
+			return v1beta1.ServicePlanCost{}, false
+		}
+		amount[strings.ToLower(currency)] = strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	return v1beta1.ServicePlanCost{Amount: amount, Unit: unit}, true
+}
+
 func convertCommonServicePlan(plan osb.Plan, commonServicePlanSpec *v1beta1.CommonServicePlanSpec) error {
 	if plan.Bindable != nil {
 		b := plan.Bindable
@@ -1193,6 +1726,8 @@ func convertClusterServicePlans(plans []osb.Plan, serviceClassID string, existin
 			servicePlans[i].Spec.Bindable = &b
 		}
 
+		servicePlans[i].Status.Costs = parseServicePlanCosts(plan.Metadata)
+
 		if plan.Metadata != nil {
 			metadata, err := json.Marshal(plan.Metadata)
 			if err != nil {
@@ -1282,18 +1817,41 @@ func isServiceInstanceOrphanMitigation(instance *v1beta1.ServiceInstance) bool {
 }
 
 // NewClientConfigurationForBroker creates a new ClientConfiguration for connecting
-// to the specified Broker
-func NewClientConfigurationForBroker(meta metav1.ObjectMeta, commonSpec *v1beta1.CommonServiceBrokerSpec, authConfig *osb.AuthConfig) *osb.ClientConfiguration {
+// to the specified Broker. TLS certificate verification is only skipped if the
+// broker asks for it and allowInsecureSkipTLSVerify is set.
+func NewClientConfigurationForBroker(meta metav1.ObjectMeta, commonSpec *v1beta1.CommonServiceBrokerSpec, authConfig *osb.AuthConfig, allowInsecureSkipTLSVerify bool) *osb.ClientConfiguration {
 	clientConfig := osb.DefaultClientConfiguration()
 	clientConfig.Name = meta.Name
 	clientConfig.URL = commonSpec.URL
 	clientConfig.AuthConfig = authConfig
 	clientConfig.EnableAlphaFeatures = true
-	clientConfig.Insecure = commonSpec.InsecureSkipTLSVerify
+	clientConfig.Insecure = allowInsecureSkipTLSVerify && commonSpec.InsecureSkipTLSVerify
 	clientConfig.CAData = commonSpec.CABundle
 	return clientConfig
 }
 
+// osbAPIVersions maps the values of the X-Broker-API-Version header to the
+// versions of the Open Service Broker API the broker client supports.
+var osbAPIVersions = map[string]osb.APIVersion{
+	osb.Version2_11().HeaderValue(): osb.Version2_11(),
+	osb.Version2_12().HeaderValue(): osb.Version2_12(),
+	osb.Version2_13().HeaderValue(): osb.Version2_13(),
+}
+
+// brokerOSBAPIVersion returns the version of the Open Service Broker API to
+// use with a broker: the one set in its spec, or else the one preferred by
+// the controller. Unknown versions fall back to the latest one.
+func (c *controller) brokerOSBAPIVersion(commonSpec *v1beta1.CommonServiceBrokerSpec) osb.APIVersion {
+	version := commonSpec.OSBAPIVersion
+	if version == "" {
+		version = c.OSBAPIPreferredVersion
+	}
+	if apiVersion, ok := osbAPIVersions[version]; ok {
+		return apiVersion
+	}
+	return osb.LatestAPIVersion()
+}
+
 // reconciliationRetryDurationExceeded returns whether the given operation
 // start time has exceeded the controller's set reconciliation retry duration.
 func (c *controller) reconciliationRetryDurationExceeded(operationStartTime *metav1.Time) bool {
@@ -1303,6 +1861,73 @@ func (c *controller) reconciliationRetryDurationExceeded(operationStartTime *met
 	return true
 }
 
+// isSelected returns whether the given broker, instance or binding is
+// reconciled by this controller according to its selector. Objects which
+// cannot be inspected are reconciled, so that nothing is silently dropped.
+func (c *controller) isSelected(obj interface{}) bool {
+	if c.selector == nil {
+		return true
+	}
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return true
+	}
+	return c.selector.Matches(labels.Set(accessor.GetLabels()))
+}
+
+// stuckOperationWarning records when the operation of an instance or binding
+// which started at operationStartTime was last reminded of.
+type stuckOperationWarning struct {
+	operationStartTime time.Time
+	lastWarningTime    time.Time
+}
+
+// warnIfOperationStuck records a Warning event for the given instance or
+// binding if its ongoing operation has been in progress for longer than
+// stuckOperationWarningInterval, at most once per interval.
+func (c *controller) warnIfOperationStuck(obj runtime.Object, uid types.UID, operation string, operationStartTime *metav1.Time) {
+	if c.stuckOperationWarningInterval <= 0 {
+		return
+	}
+
+	c.stuckOperationWarningsLock.Lock()
+	defer c.stuckOperationWarningsLock.Unlock()
+
+	if operation == "" || operationStartTime == nil {
+		delete(c.stuckOperationWarnings, uid)
+		return
+	}
+
+	now := time.Now()
+	age := now.Sub(operationStartTime.Time)
+	if age < c.stuckOperationWarningInterval {
+		return
+	}
+	warning, ok := c.stuckOperationWarnings[uid]
+	if ok && warning.operationStartTime.Equal(operationStartTime.Time) && now.Sub(warning.lastWarningTime) < c.stuckOperationWarningInterval {
+		return
+	}
+	c.stuckOperationWarnings[uid] = stuckOperationWarning{
+		operationStartTime: operationStartTime.Time,
+		lastWarningTime:    now,
+	}
+
+	msg := fmt.Sprintf("The %s operation has been in progress for %v", operation, age.Round(time.Second))
+	c.recorder.Event(obj, corev1.EventTypeWarning, v1beta1.ReasonOperationStuck, msg)
+}
+
+// forgetStuckOperation drops the stuck operation reminders of a deleted
+// instance or binding.
+func (c *controller) forgetStuckOperation(uid types.UID) {
+	c.stuckOperationWarningsLock.Lock()
+	defer c.stuckOperationWarningsLock.Unlock()
+
+	delete(c.stuckOperationWarnings, uid)
+}
+
 // shouldStartOrphanMitigation returns whether an error with the given status
 // code indicates that orphan migitation should start.
 func shouldStartOrphanMitigation(statusCode int) bool {
@@ -1318,6 +1943,48 @@ func isRetriableHTTPStatus(statusCode int) bool {
 	return statusCode != http.StatusBadRequest
 }
 
+// concurrencyErrorMessage is the error code brokers answer with when they do
+// not support concurrent operations on an instance.
+const concurrencyErrorMessage = "ConcurrencyError"
+
+// brokerErrorCode returns the machine-readable error code of an error
+// returned by a broker client call, to set on the condition reporting it.
+func brokerErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return v1beta1.ErrorCodeBrokerTimeout
+	}
+	httpErr, ok := osb.IsHTTPError(err)
+	if !ok {
+		return v1beta1.ErrorCodeBrokerUnreachable
+	}
+	switch {
+	case httpErr.StatusCode == http.StatusBadRequest:
+		return v1beta1.ErrorCodeBrokerBadRequest
+	case httpErr.StatusCode == http.StatusConflict:
+		return v1beta1.ErrorCodeBrokerConflict
+	case httpErr.StatusCode == http.StatusGone:
+		return v1beta1.ErrorCodeBrokerGone
+	case httpErr.StatusCode == http.StatusUnprocessableEntity:
+		if httpErr.ErrorMessage != nil {
+			switch *httpErr.ErrorMessage {
+			case osb.AsyncErrorMessage:
+				return v1beta1.ErrorCodeBrokerAsyncRequired
+			case concurrencyErrorMessage:
+				return v1beta1.ErrorCodeBrokerConcurrencyError
+			case osb.AppGUIDRequiredErrorMessage:
+				return v1beta1.ErrorCodeBrokerRequiresApp
+			}
+		}
+		return v1beta1.ErrorCodeBrokerUnprocessableEntity
+	case httpErr.StatusCode >= 500 && httpErr.StatusCode < 600:
+		return v1beta1.ErrorCodeBrokerServerError
+	}
+	return v1beta1.ErrorCodeBrokerHTTPError
+}
+
 // ReconciliationAction represents a type of action the reconciler should take
 // for a resource.
 type ReconciliationAction string
@@ -1358,6 +2025,12 @@ func (c *controller) setClusterID(id string) {
 	c.clusterIDLock.Unlock()
 }
 
+// getBrokerContextNamespace returns the namespace sent to brokers in the OSB
+// context for resources in the given namespace.
+func (c *controller) getBrokerContextNamespace(namespace string) string {
+	return c.brokerContextNamespacePrefix + namespace
+}
+
 // getServiceClassPlanAndServiceBrokerForServiceBinding is a sequence of operations that's
 // done to validate service plan, service class exist, and handles creating
 // a brokerclient to use for a given ServiceInstance.
@@ -1407,7 +2080,7 @@ func (c *controller) getServiceClassForServiceBinding(instance *v1beta1.ServiceI
 			binding,
 			v1beta1.ServiceBindingConditionReady,
 			v1beta1.ConditionFalse,
-			errorNonexistentClusterServiceClassReason,
+			v1beta1.ReasonReferencesNonexistentServiceClass,
 			"The binding references a ServiceClass that does not exist. "+s,
 		)
 		c.recorder.Event(binding, corev1.EventTypeWarning, errorNonexistentClusterServiceClassMessage, s)
@@ -1429,10 +2102,10 @@ func (c *controller) getServicePlanForServiceBinding(instance *v1beta1.ServiceIn
 			binding,
 			v1beta1.ServiceBindingConditionReady,
 			v1beta1.ConditionFalse,
-			errorNonexistentClusterServicePlanReason,
+			v1beta1.ReasonReferencesNonexistentServicePlan,
 			"The ServiceBinding references an ServiceInstance which references ServicePlan that does not exist. "+s,
 		)
-		c.recorder.Event(binding, corev1.EventTypeWarning, errorNonexistentClusterServicePlanReason, s)
+		c.recorder.Event(binding, corev1.EventTypeWarning, v1beta1.ReasonReferencesNonexistentServicePlan, s)
 		return nil, fmt.Errorf(s)
 	}
 	return servicePlan, nil
@@ -1449,10 +2122,10 @@ func (c *controller) getServiceBrokerForServiceBinding(instance *v1beta1.Service
 			binding,
 			v1beta1.ServiceBindingConditionReady,
 			v1beta1.ConditionFalse,
-			errorNonexistentClusterServiceBrokerReason,
+			v1beta1.ReasonReferencesNonexistentBroker,
 			"The binding references a ServiceBroker that does not exist. "+s,
 		)
-		c.recorder.Event(binding, corev1.EventTypeWarning, errorNonexistentClusterServiceBrokerReason, s)
+		c.recorder.Event(binding, corev1.EventTypeWarning, v1beta1.ReasonReferencesNonexistentBroker, s)
 		return nil, err
 	}
 	return broker, nil
@@ -18,11 +18,17 @@ package controller
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"net"
 	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
 
@@ -31,7 +37,10 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/jsonpath"
@@ -39,37 +48,51 @@ import (
 )
 
 const (
-	errorNonexistentServiceInstanceReason     string = "ReferencesNonexistentInstance"
-	errorBindCallReason                       string = "BindCallFailed"
-	errorInjectingBindResultReason            string = "ErrorInjectingBindResult"
-	errorEjectingBindReason                   string = "ErrorEjectingServiceBinding"
-	errorUnbindCallReason                     string = "UnbindCallFailed"
-	errorNonbindableClusterServiceClassReason string = "ErrorNonbindableServiceClass"
-	errorServiceInstanceRefsUnresolved        string = "ErrorInstanceRefsUnresolved"
-	errorServiceInstanceNotReadyReason        string = "ErrorInstanceNotReady"
-	errorServiceBindingOrphanMitigation       string = "ServiceBindingNeedsOrphanMitigation"
-	errorFetchingBindingFailedReason          string = "FetchingBindingFailed"
-	errorAsyncOpTimeoutReason                 string = "AsyncOperationTimeout"
-
-	successInjectedBindResultReason  string = "InjectedBindResult"
 	successInjectedBindResultMessage string = "Injected bind result"
-	successUnboundReason             string = "UnboundSuccessfully"
-	asyncBindingReason               string = "Binding"
 	asyncBindingMessage              string = "The binding is being created asynchronously"
-	asyncUnbindingReason             string = "Unbinding"
 	asyncUnbindingMessage            string = "The binding is being deleted asynchronously"
-	bindingInFlightReason            string = "BindingRequestInFlight"
 	bindingInFlightMessage           string = "Binding request for ServiceBinding in-flight to Broker"
-	unbindingInFlightReason          string = "UnbindingRequestInFlight"
 	unbindingInFlightMessage         string = "Unbind request for ServiceBinding in-flight to Broker"
 )
 
+// bindingSecretCopyLabel is set on the copies of a ServiceBinding's Secret
+// written into additional namespaces. Owner references cannot point across
+// namespaces, so the label, holding the UID of the ServiceBinding, is used to
+// tell which copies are managed by which ServiceBinding.
+const bindingSecretCopyLabel = "servicecatalog.k8s.io/binding-uid"
+
+// Annotations set on a ServiceBinding's Secret and its copies so that
+// consumers of the Secret can discover which instance, class and plan the
+// credentials belong to.
+const (
+	bindingSecretInstanceAnnotation = "servicecatalog.k8s.io/instance-name"
+	bindingSecretClassAnnotation    = "servicecatalog.k8s.io/class-name"
+	bindingSecretPlanAnnotation     = "servicecatalog.k8s.io/plan-name"
+)
+
+// bindingSecretLastRotatedAnnotation is set on a ServiceBinding's Secret and
+// its copies to the time the credentials they hold last changed, so that
+// external tools can audit the age of the credentials.
+const bindingSecretLastRotatedAnnotation = "servicecatalog.k8s.io/last-rotated"
+
+// Annotations set on a ServiceBinding's Secret and its copies to the
+// comma-separated keys of the labels and annotations taken from the
+// ServiceBinding, so that the keys no longer specified are removed the next
+// time the Secret is written.
+const (
+	bindingSecretManagedLabelsAnnotation      = "servicecatalog.k8s.io/managed-labels"
+	bindingSecretManagedAnnotationsAnnotation = "servicecatalog.k8s.io/managed-annotations"
+)
+
 // bindingControllerKind contains the schema.GroupVersionKind for this controller type.
 var bindingControllerKind = v1beta1.SchemeGroupVersion.WithKind("ServiceBinding")
 
 // ServiceBinding handlers and control-loop
 
 func (c *controller) bindingAdd(obj interface{}) {
+	if !c.isSelected(obj) {
+		return
+	}
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		pcb := pretty.NewContextBuilder(pretty.ServiceBinding, "", "", "")
@@ -92,6 +115,28 @@ func (c *controller) bindingAdd(obj interface{}) {
 	c.bindingQueue.Add(key)
 }
 
+// bindingCreate handles the ServiceBinding ADDED watch event. Besides the
+// binding, its ServiceInstance is enqueued so that its binding count is
+// updated.
+func (c *controller) bindingCreate(obj interface{}) {
+	c.bindingAdd(obj)
+	if binding, ok := obj.(*v1beta1.ServiceBinding); ok {
+		c.enqueueServiceBindingInstance(binding)
+	}
+}
+
+// enqueueServiceBindingInstance adds the ServiceInstance the binding refers
+// to to the instance work queue.
+func (c *controller) enqueueServiceBindingInstance(binding *v1beta1.ServiceBinding) {
+	if c.selector != nil {
+		instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
+		if err == nil && !c.isSelected(instance) {
+			return
+		}
+	}
+	c.instanceQueue.Add(binding.Namespace + "/" + binding.Spec.InstanceRef.Name)
+}
+
 func (c *controller) bindingUpdate(oldObj, newObj interface{}) {
 	// Bindings with ongoing asynchronous operations will be manually added
 	// to the polling queue by the reconciler. They should be ignored here in
@@ -108,10 +153,83 @@ func (c *controller) bindingDelete(obj interface{}) {
 		return
 	}
 
+	c.bindingCredentialsStore.Remove(binding)
+	c.takeDeletedServiceBindingSecret(binding)
+	c.forgetStuckOperation(binding.UID)
+	c.setServiceBindingInstancePaused(binding, false)
+	c.enqueueServiceBindingInstance(binding)
+
 	pcb := pretty.NewBindingContextBuilder(binding)
 	klog.V(4).Info(pcb.Messagef("Received DELETE event; no further processing will occur; resourceVersion %v", binding.ResourceVersion))
 }
 
+// secretDelete enqueues the ready ServiceBinding owning a deleted Secret, so
+// that the Secret is written again.
+func (c *controller) secretDelete(obj interface{}) {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if secret, ok = tombstone.Obj.(*corev1.Secret); !ok {
+			return
+		}
+	}
+
+	controllerRef := metav1.GetControllerOf(secret)
+	if controllerRef == nil || controllerRef.Kind != bindingControllerKind.Kind || controllerRef.APIVersion != bindingControllerKind.GroupVersion().String() {
+		return
+	}
+
+	binding, err := c.bindingLister.ServiceBindings(secret.Namespace).Get(controllerRef.Name)
+	if err != nil || binding.UID != controllerRef.UID {
+		return
+	}
+	// The Secret is expected to go away while the binding is being deleted
+	// or while its credentials are still being written.
+	if binding.DeletionTimestamp != nil || !isServiceBindingReady(binding) {
+		return
+	}
+
+	pcb := pretty.NewBindingContextBuilder(binding)
+	klog.V(4).Info(pcb.Messagef("Received DELETE event for Secret %q", secret.Name))
+
+	c.deletedBindingSecretsLock.Lock()
+	c.deletedBindingSecrets[binding.UID] = true
+	c.deletedBindingSecretsLock.Unlock()
+
+	c.bindingAdd(binding)
+}
+
+// takeDeletedServiceBindingSecret returns whether the Secret of the binding
+// has been deleted since it was last written, and forgets about the deletion.
+func (c *controller) takeDeletedServiceBindingSecret(binding *v1beta1.ServiceBinding) bool {
+	c.deletedBindingSecretsLock.Lock()
+	defer c.deletedBindingSecretsLock.Unlock()
+
+	deleted := c.deletedBindingSecrets[binding.UID]
+	delete(c.deletedBindingSecrets, binding.UID)
+	return deleted
+}
+
+// setServiceBindingInstancePaused records whether the instance of the binding
+// is paused, and returns whether it has just become paused.
+func (c *controller) setServiceBindingInstancePaused(binding *v1beta1.ServiceBinding, paused bool) bool {
+	c.pausedInstanceBindingsLock.Lock()
+	defer c.pausedInstanceBindingsLock.Unlock()
+
+	if !paused {
+		delete(c.pausedInstanceBindings, binding.UID)
+		return false
+	}
+	if c.pausedInstanceBindings[binding.UID] {
+		return false
+	}
+	c.pausedInstanceBindings[binding.UID] = true
+	return true
+}
+
 func (c *controller) reconcileServiceBindingKey(key string) error {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -131,6 +249,17 @@ func (c *controller) reconcileServiceBindingKey(key string) error {
 	return c.reconcileServiceBinding(binding)
 }
 
+// isServiceBindingReady returns whether the binding has a ready condition
+// with status true.
+func isServiceBindingReady(binding *v1beta1.ServiceBinding) bool {
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == v1beta1.ServiceBindingConditionReady && condition.Status == v1beta1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
 func isServiceBindingFailed(binding *v1beta1.ServiceBinding) bool {
 	for _, condition := range binding.Status.Conditions {
 		if condition.Type == v1beta1.ServiceBindingConditionFailed && condition.Status == v1beta1.ConditionTrue {
@@ -140,6 +269,31 @@ func isServiceBindingFailed(binding *v1beta1.ServiceBinding) bool {
 	return false
 }
 
+// bindingInstanceIndex is the name of the index of the binding informer which
+// maps the "namespace/name" key of an instance to the bindings referencing it.
+const bindingInstanceIndex = "instance"
+
+// indexServiceBindingByInstance returns the "namespace/name" key of the
+// instance the binding references.
+func indexServiceBindingByInstance(obj interface{}) ([]string, error) {
+	binding, ok := obj.(*v1beta1.ServiceBinding)
+	if !ok {
+		return nil, nil
+	}
+	return []string{binding.Namespace + "/" + binding.Spec.InstanceRef.Name}, nil
+}
+
+// isServiceBindingPlanNotBindable returns whether the binding is marked as
+// bound to an instance whose plan is no longer bindable.
+func isServiceBindingPlanNotBindable(binding *v1beta1.ServiceBinding) bool {
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == v1beta1.ServiceBindingConditionPlanNotBindable && condition.Status == v1beta1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
 // getReconciliationActionForServiceBinding gets the action the reconciler
 // should be taking on the given binding.
 func getReconciliationActionForServiceBinding(binding *v1beta1.ServiceBinding) ReconciliationAction {
@@ -160,6 +314,22 @@ func (c *controller) reconcileServiceBinding(binding *v1beta1.ServiceBinding) er
 	pcb := pretty.NewBindingContextBuilder(binding)
 	klog.V(6).Info(pcb.Messagef(`beginning to process resourceVersion: %v`, binding.ResourceVersion))
 
+	// Acting on a binding whose instance is paused would mean acting on the
+	// instance itself, so the binding is left alone until it is resumed.
+	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
+	paused := err == nil && isServiceInstancePaused(instance)
+	justPaused := c.setServiceBindingInstancePaused(binding, paused)
+	if paused {
+		msg := fmt.Sprintf("%s %q is paused; the binding will not be reconciled until it is resumed", pretty.ServiceInstance, instance.Name)
+		klog.V(4).Info(pcb.Message("Not processing event; " + msg))
+		if justPaused {
+			c.recorder.Event(binding, corev1.EventTypeNormal, v1beta1.ReasonInstancePaused, msg)
+		}
+		return nil
+	}
+
+	c.warnIfOperationStuck(binding, binding.UID, string(binding.Status.CurrentOperation), binding.Status.OperationStartTime)
+
 	reconciliationAction := getReconciliationActionForServiceBinding(binding)
 	switch reconciliationAction {
 	case reconcileAdd:
@@ -193,6 +363,9 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 	}
 
 	if binding.Status.ReconciledGeneration == binding.Generation {
+		if c.takeDeletedServiceBindingSecret(binding) && isServiceBindingReady(binding) {
+			return c.recreateServiceBindingSecret(binding)
+		}
 		klog.V(4).Info(pcb.Message("Not processing event; reconciled generation showed there is no work to do"))
 		return nil
 	}
@@ -201,10 +374,22 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 
 	binding = binding.DeepCopy()
 
+	if binding.Status.SecretWritePending {
+		if credentials, ok := c.bindingCredentialsStore.Get(binding); ok {
+			klog.V(4).Info(pcb.Message("Retrying to write the Secret with the credentials already received from the broker"))
+			return c.processServiceBindingSecretWrite(binding, credentials)
+		}
+		// The credentials are kept in memory only, so they are lost when
+		// the controller restarts. Sending the same bind request again is
+		// safe as the broker must treat it as idempotent.
+		klog.V(4).Info(pcb.Message("Credentials received from the broker are no longer available; the bind request will be sent again"))
+		binding.Status.SecretWritePending = false
+	}
+
 	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
 	if err != nil {
 		msg := fmt.Sprintf(`References a non-existent %s "%s/%s"`, pretty.ServiceInstance, binding.Namespace, binding.Spec.InstanceRef.Name)
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorNonexistentServiceInstanceReason, msg)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonReferencesNonexistentInstance, msg)
 		return c.processServiceBindingOperationError(binding, readyCond)
 	}
 
@@ -217,7 +402,7 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 		if instance.Spec.ClusterServiceClassRef == nil || instance.Spec.ClusterServicePlanRef == nil {
 			// retry later
 			msg := fmt.Sprintf(`Binding cannot begin because ClusterServiceClass and ClusterServicePlan references for %s have not been resolved yet`, pretty.ServiceInstanceName(instance))
-			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorServiceInstanceRefsUnresolved, msg)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonErrorInstanceRefsUnresolved, msg)
 			return c.processServiceBindingOperationError(binding, readyCond)
 		}
 
@@ -228,16 +413,16 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 
 		brokerClient = bClient
 
-		if !isClusterServicePlanBindable(serviceClass, servicePlan) {
+		if !c.allowBindToNonBindablePlans && !isClusterServicePlanBindable(serviceClass, servicePlan) {
 			msg := fmt.Sprintf(`References a non-bindable %s and Plan (%q) combination`, pretty.ClusterServiceClassName(serviceClass), instance.Spec.ClusterServicePlanExternalName)
-			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorNonbindableClusterServiceClassReason, msg)
-			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorNonbindableClusterServiceClassReason, msg)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonErrorNonbindableServiceClass, msg)
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonErrorNonbindableServiceClass, msg)
 			return c.processBindFailure(binding, readyCond, failedCond, false)
 		}
 
 		if !isServiceInstanceReady(instance) {
 			msg := fmt.Sprintf(`Binding cannot begin because referenced %s is not ready`, pretty.ServiceInstanceName(instance))
-			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorServiceInstanceNotReadyReason, msg)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonErrorInstanceNotReady, msg)
 			return c.processServiceBindingOperationError(binding, readyCond)
 		}
 
@@ -254,7 +439,7 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 		if instance.Spec.ServiceClassRef == nil || instance.Spec.ServicePlanRef == nil {
 			// retry later
 			msg := fmt.Sprintf(`Binding cannot begin because ServiceClass and ServicePlan references for %s have not been resolved yet`, pretty.ServiceInstanceName(instance))
-			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorServiceInstanceRefsUnresolved, msg)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonErrorInstanceRefsUnresolved, msg)
 			return c.processServiceBindingOperationError(binding, readyCond)
 		}
 
@@ -265,16 +450,16 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 
 		brokerClient = bClient
 
-		if !isServicePlanBindable(serviceClass, servicePlan) {
+		if !c.allowBindToNonBindablePlans && !isServicePlanBindable(serviceClass, servicePlan) {
 			msg := fmt.Sprintf(`References a non-bindable %s and Plan (%q) combination`, pretty.ServiceClassName(serviceClass), instance.Spec.ClusterServicePlanExternalName)
-			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorNonbindableClusterServiceClassReason, msg)
-			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorNonbindableClusterServiceClassReason, msg)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonErrorNonbindableServiceClass, msg)
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonErrorNonbindableServiceClass, msg)
 			return c.processBindFailure(binding, readyCond, failedCond, false)
 		}
 
 		if !isServiceInstanceReady(instance) {
 			msg := fmt.Sprintf(`Binding cannot begin because referenced %s is not ready`, pretty.ServiceInstanceName(instance))
-			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorServiceInstanceNotReadyReason, msg)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonErrorInstanceNotReady, msg)
 			return c.processServiceBindingOperationError(binding, readyCond)
 		}
 
@@ -301,25 +486,39 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 
 	response, err := brokerClient.Bind(request)
 	if err != nil {
+		if osb.IsAppGUIDRequiredError(err) {
+			msg := fmt.Sprintf("ServiceBroker only supports bindings for an application; bind operation will not be retried: %v. "+
+				"Supply the application the binding is for in the binding parameters as documented by the service, or use a plan which supports credentials-only bindings", err.Error())
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonBindRequiresApp, msg)
+			readyCond.ErrorCode = v1beta1.ErrorCodeBrokerRequiresApp
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonBindRequiresApp, msg)
+			failedCond.ErrorCode = v1beta1.ErrorCodeBrokerRequiresApp
+			return c.processBindFailure(binding, readyCond, failedCond, false)
+		}
+
 		if httpErr, ok := osb.IsHTTPError(err); ok {
 			msg := fmt.Sprintf("ServiceBroker returned failure; bind operation will not be retried: %v", err.Error())
-			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorBindCallReason, msg)
-			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, "ServiceBindingReturnedFailure", msg)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonBindCallFailed, msg)
+			readyCond.ErrorCode = brokerErrorCode(err)
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonServiceBindingReturnedFailure, msg)
+			failedCond.ErrorCode = brokerErrorCode(err)
 			return c.processBindFailure(binding, readyCond, failedCond, shouldStartOrphanMitigation(httpErr.StatusCode))
 		}
 
 		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
 			msg := "Communication with the ServiceBroker timed out; Bind operation will not be retried: " + err.Error()
-			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorBindCallReason, msg)
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonBindCallFailed, msg)
+			failedCond.ErrorCode = brokerErrorCode(err)
 			return c.processBindFailure(binding, nil, failedCond, true)
 		}
 
 		msg := fmt.Sprintf(`Error creating ServiceBinding for %s: %s`, prettyName, err)
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorBindCallReason, msg)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonBindCallFailed, msg)
+		readyCond.ErrorCode = brokerErrorCode(err)
 
 		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries, too much time has elapsed"
-			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonErrorReconciliationRetryTimeout, msg)
 			return c.processBindFailure(binding, readyCond, failedCond, false)
 		}
 
@@ -336,23 +535,202 @@ func (c *controller) reconcileServiceBindingAdd(binding *v1beta1.ServiceBinding)
 	// binding.
 	binding.Status.ExternalProperties = binding.Status.InProgressProperties
 
-	err = c.injectServiceBinding(binding, response.Credentials)
+	volumeMounts, err := getServiceBindingVolumeMounts(response.VolumeMounts)
+	if err != nil {
+		msg := fmt.Sprintf("ServiceBroker returned invalid volume mounts: %v", err)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonInvalidVolumeMounts, msg)
+		failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonInvalidVolumeMounts, msg)
+		return c.processBindFailure(binding, readyCond, failedCond, false)
+	}
+	binding.Status.VolumeMounts = volumeMounts
+
+	if err := validateRouteServiceURL(response.RouteServiceURL); err != nil {
+		msg := fmt.Sprintf("ServiceBroker returned an invalid route service URL: %v", err)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonInvalidRouteServiceURL, msg)
+		failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonInvalidRouteServiceURL, msg)
+		return c.processBindFailure(binding, readyCond, failedCond, false)
+	}
+	binding.Status.RouteServiceURL = response.RouteServiceURL
+
+	return c.processServiceBindingSecretWrite(binding, response.Credentials)
+}
+
+// recreateServiceBindingSecret makes the deleted Secret of a ready binding be
+// written again. The credentials are not kept once the Secret is written, so
+// they are fetched from the broker if it advertises bindings_retrievable.
+// Otherwise the bind request is sent again with the same binding ID; the
+// broker must treat it as idempotent and answer with the credentials of the
+// existing binding.
+func (c *controller) recreateServiceBindingSecret(binding *v1beta1.ServiceBinding) error {
+	pcb := pretty.NewBindingContextBuilder(binding)
+
+	msg := fmt.Sprintf(`Secret "%s/%s" has been deleted; recreating it`, binding.Namespace, binding.Spec.SecretName)
+	klog.V(4).Info(pcb.Message(msg))
+	c.recorder.Event(binding, corev1.EventTypeWarning, v1beta1.ReasonSecretDeleted, msg)
+
+	// The bindings of brokers advertising bindings_retrievable are fetched
+	// from the broker instead of being created again.
+	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
+	if err == nil {
+		retrievable, err := c.isServiceBindingRetrievable(instance)
+		if err != nil {
+			return err
+		}
+		if retrievable {
+			if err := c.healServiceBindingSecret(instance, binding.DeepCopy()); err != nil {
+				// Keep the deletion around for the retry.
+				c.deletedBindingSecretsLock.Lock()
+				c.deletedBindingSecrets[binding.UID] = true
+				c.deletedBindingSecretsLock.Unlock()
+				return err
+			}
+			return nil
+		}
+	}
+
+	// Resetting the reconciled generation makes the next reconciliation
+	// send the bind request again.
+	toUpdate := binding.DeepCopy()
+	toUpdate.Status.ReconciledGeneration = 0
+	_, err = c.updateServiceBindingStatus(toUpdate)
+	return err
+}
+
+// healServiceBindingSecrets verifies the Secrets of the ready bindings whose
+// broker advertises bindings_retrievable against the credentials the broker
+// returns for them, and rewrites the Secrets modified out of band while the
+// controller was down. The bindings are verified one at a time,
+// bindingSecretHealingInterval apart, so that brokers are not flooded with
+// requests on startup. As the pass spans many intervals, each binding is
+// read again from the cache before it is verified, and skipped if its
+// generation or status changed since the bindings were listed, as a binding
+// changed meanwhile is reconciled by the workers instead. Invoked once by a
+// worker on startup.
+func (c *controller) healServiceBindingSecrets(stopCh <-chan struct{}) {
+	listed, err := c.bindingLister.List(labels.Everything())
 	if err != nil {
+		klog.Warningf("Error listing bindings to verify their Secrets: %v", err)
+		return
+	}
+
+	for _, listedBinding := range listed {
+		binding, err := c.bindingLister.ServiceBindings(listedBinding.Namespace).Get(listedBinding.Name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				klog.Warning(pretty.NewBindingContextBuilder(listedBinding).Messagef("Error getting the binding to verify the Secret: %v", err))
+			}
+			continue
+		}
+		if binding.Generation != listedBinding.Generation || !reflect.DeepEqual(binding.Status, listedBinding.Status) {
+			continue
+		}
+		if binding.DeletionTimestamp != nil || binding.Status.AsyncOpInProgress || !isServiceBindingReady(binding) || !c.isSelected(binding) {
+			continue
+		}
+
+		pcb := pretty.NewBindingContextBuilder(binding)
+		instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
+		if err != nil {
+			klog.Warning(pcb.Messagef("Error getting the instance to verify the Secret: %v", err))
+			continue
+		}
+		retrievable, err := c.isServiceBindingRetrievable(instance)
+		if err != nil {
+			klog.Warning(pcb.Messagef("Error getting the class to verify the Secret: %v", err))
+			continue
+		}
+		if !retrievable {
+			continue
+		}
+
+		klog.V(4).Info(pcb.Message("Verifying Secret against the broker"))
+		if err := c.healServiceBindingSecret(instance, binding); err != nil {
+			klog.Warning(pcb.Messagef("Error verifying Secret against the broker: %v", err))
+		}
+
+		select {
+		case <-stopCh:
+			return
+		case <-time.After(bindingSecretHealingInterval):
+		}
+	}
+}
+
+// isServiceBindingRetrievable returns whether the class of the instance is
+// offered by a broker advertising bindings_retrievable, whose bindings can be
+// fetched again.
+func (c *controller) isServiceBindingRetrievable(instance *v1beta1.ServiceInstance) (bool, error) {
+	switch {
+	case instance.Spec.ClusterServiceClassRef != nil:
+		serviceClass, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return false, err
+		}
+		return serviceClass.Spec.BindingRetrievable, nil
+	case instance.Spec.ServiceClassRef != nil:
+		serviceClass, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+		if err != nil {
+			return false, err
+		}
+		return serviceClass.Spec.BindingRetrievable, nil
+	default:
+		return false, nil
+	}
+}
+
+// healServiceBindingSecret fetches the binding from the broker and writes its
+// credentials into the binding's Secret. The Secret is only updated if it no
+// longer matches them.
+func (c *controller) healServiceBindingSecret(instance *v1beta1.ServiceInstance, binding *v1beta1.ServiceBinding) error {
+	brokerClient, err := c.getBrokerClientForServiceBinding(instance, binding)
+	if err != nil {
+		return err
+	}
+
+	response, err := brokerClient.GetBinding(&osb.GetBindingRequest{
+		InstanceID: instance.Spec.ExternalID,
+		BindingID:  binding.Spec.ExternalID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.injectServiceBinding(binding, response.Credentials)
+}
+
+// processServiceBindingSecretWrite writes the credentials returned by the
+// broker into the binding's Secret. If the write fails, the binding is marked
+// as waiting for its Secret and the credentials are kept, so that the write
+// is retried without sending another bind request to the broker.
+func (c *controller) processServiceBindingSecretWrite(binding *v1beta1.ServiceBinding, credentials map[string]interface{}) error {
+	// injectServiceBinding transforms the credentials in place, so keep
+	// the untouched credentials around before writing the Secret.
+	c.bindingCredentialsStore.Put(binding, credentials)
+
+	if err := c.injectServiceBinding(binding, credentials); err != nil {
+		reason := v1beta1.ReasonErrorInjectingBindResult
+		if opErr, ok := err.(*operationError); ok {
+			reason = opErr.reason
+		}
 		msg := fmt.Sprintf(`Error injecting bind result: %s`, err)
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorInjectingBindResultReason, msg)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, reason, msg)
 
 		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries, too much time has elapsed"
-			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonErrorReconciliationRetryTimeout, msg)
 			return c.processBindFailure(binding, readyCond, failedCond, true)
 		}
 
-		// TODO: solve scenario where bind request successful, credential injection fails, later reconciliations have non-failing errors
-		// with Bind request. After retry duration, reconciler gives up but will not do orphan mitigation.
+		binding.Status.SecretWritePending = true
 		return c.processServiceBindingOperationError(binding, readyCond)
 	}
 
-	return c.processBindSuccess(binding)
+	if err := c.processBindSuccess(binding); err != nil {
+		return err
+	}
+
+	c.bindingCredentialsStore.Remove(binding)
+	return nil
 }
 
 func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBinding) error {
@@ -386,8 +764,12 @@ func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBindi
 	}
 
 	if err := c.ejectServiceBinding(binding); err != nil {
+		reason := v1beta1.ReasonErrorEjectingServiceBinding
+		if opErr, ok := err.(*operationError); ok {
+			reason = opErr.reason
+		}
 		msg := fmt.Sprintf(`Error ejecting binding. Error deleting secret: %s`, err)
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorEjectingBindReason, msg)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, reason, msg)
 		return c.processServiceBindingOperationError(binding, readyCond)
 	}
 
@@ -415,7 +797,7 @@ func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBindi
 			`References a non-existent %s "%s/%s"`,
 			pretty.ServiceInstance, binding.Namespace, binding.Spec.InstanceRef.Name,
 		)
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorNonexistentServiceInstanceReason, msg)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonReferencesNonexistentInstance, msg)
 		return c.processServiceBindingOperationError(binding, readyCond)
 	}
 
@@ -424,7 +806,7 @@ func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBindi
 			`trying to unbind to %s "%s/%s" that has ongoing asynchronous operation`,
 			pretty.ServiceInstance, binding.Namespace, binding.Spec.InstanceRef.Name,
 		)
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorWithOngoingAsyncOperationReason, msg)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonErrorAsyncOperationInProgress, msg)
 		return c.processServiceBindingOperationError(binding, readyCond)
 	}
 
@@ -476,11 +858,12 @@ func (c *controller) reconcileServiceBindingDelete(binding *v1beta1.ServiceBindi
 		msg := fmt.Sprintf(
 			`Error unbinding from %s: %s`, prettyBrokerName, err,
 		)
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, errorUnbindCallReason, msg)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, v1beta1.ReasonUnbindCallFailed, msg)
+		readyCond.ErrorCode = brokerErrorCode(err)
 
 		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries, too much time has elapsed"
-			failedCond := newServiceBindingReadyCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
+			failedCond := newServiceBindingReadyCondition(v1beta1.ConditionTrue, v1beta1.ReasonErrorReconciliationRetryTimeout, msg)
 			return c.processUnbindFailure(binding, readyCond, failedCond)
 		}
 
@@ -533,6 +916,9 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 	if err := c.transformCredentials(binding.Spec.SecretTransforms, credentials); err != nil {
 		return fmt.Errorf(`Unexpected error while transforming credentials for ServiceBinding "%s/%s": %v`, binding.Namespace, binding.Name, err)
 	}
+	if err := formatCredentialKeys(binding.Spec.SecretKeyFormat, credentials); err != nil {
+		return fmt.Errorf(`Unable to format the credential keys of ServiceBinding "%s/%s": %v`, binding.Namespace, binding.Name, err)
+	}
 
 	secretData := make(map[string][]byte)
 	for k, v := range credentials {
@@ -542,6 +928,8 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 		}
 	}
 
+	metadata := c.getServiceBindingSecretMetadata(binding)
+
 	// Creating/updating the Secret
 	secretClient := c.kubeClient.CoreV1().Secrets(binding.Namespace)
 	existingSecret, err := secretClient.Get(binding.Spec.SecretName, metav1.GetOptions{})
@@ -551,8 +939,13 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 			controllerRef := metav1.GetControllerOf(existingSecret)
 			return fmt.Errorf(`Secret "%s/%s" is not owned by ServiceBinding, controllerRef: %v`, binding.Namespace, existingSecret.Name, controllerRef)
 		}
-		existingSecret.Data = secretData
-		if _, err = secretClient.Update(existingSecret); err != nil {
+		metadata.annotations[bindingSecretLastRotatedAnnotation] = getServiceBindingSecretLastRotated(existingSecret, secretData)
+		updatedSecret := existingSecret.DeepCopy()
+		updatedSecret.Data = secretData
+		metadata.apply(&updatedSecret.ObjectMeta)
+		if isSecretUnchanged(existingSecret, updatedSecret) {
+			klog.V(5).Info(pcb.Messagef(`Secret "%s/%s" is up to date; not updating it`, binding.Namespace, existingSecret.Name))
+		} else if _, err = secretClient.Update(updatedSecret); err != nil {
 			if apierrors.IsConflict(err) {
 				// Conflicting update detected, try again later
 				return fmt.Errorf(`Conflicting Secret "%s/%s" update detected`, binding.Namespace, existingSecret.Name)
@@ -565,6 +958,7 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 			return fmt.Errorf(`Unexpected error getting Secret "%s/%s": %v`, binding.Namespace, existingSecret.Name, err)
 		}
 		err = nil
+		metadata.annotations[bindingSecretLastRotatedAnnotation] = getServiceBindingSecretLastRotated(nil, secretData)
 		// Create new secret
 		secret := &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
@@ -576,6 +970,7 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 			},
 			Data: secretData,
 		}
+		metadata.apply(&secret.ObjectMeta)
 
 		if _, err = secretClient.Create(secret); err != nil {
 			if apierrors.IsAlreadyExists(err) {
@@ -588,7 +983,249 @@ func (c *controller) injectServiceBinding(binding *v1beta1.ServiceBinding, crede
 		}
 	}
 
-	return err
+	return c.syncServiceBindingSecretCopies(binding, secretData, metadata)
+}
+
+// serviceBindingSecretMetadata holds the labels and annotations to set on a
+// ServiceBinding's Secret and its copies.
+type serviceBindingSecretMetadata struct {
+	labels      map[string]string
+	annotations map[string]string
+}
+
+// getServiceBindingSecretMetadata returns the labels and annotations to set
+// on the binding's Secret: the ones specified by the user, along with
+// annotations describing the instance, class and plan of the binding.
+func (c *controller) getServiceBindingSecretMetadata(binding *v1beta1.ServiceBinding) serviceBindingSecretMetadata {
+	metadata := serviceBindingSecretMetadata{
+		labels:      make(map[string]string, len(binding.Spec.SecretLabels)),
+		annotations: make(map[string]string, len(binding.Spec.SecretAnnotations)+5),
+	}
+	for k, v := range binding.Spec.SecretLabels {
+		metadata.labels[k] = v
+	}
+	for k, v := range binding.Spec.SecretAnnotations {
+		metadata.annotations[k] = v
+	}
+	if len(binding.Spec.SecretLabels) > 0 {
+		metadata.annotations[bindingSecretManagedLabelsAnnotation] = strings.Join(sets.StringKeySet(binding.Spec.SecretLabels).List(), ",")
+	}
+	if len(binding.Spec.SecretAnnotations) > 0 {
+		metadata.annotations[bindingSecretManagedAnnotationsAnnotation] = strings.Join(sets.StringKeySet(binding.Spec.SecretAnnotations).List(), ",")
+	}
+
+	metadata.annotations[bindingSecretInstanceAnnotation] = binding.Spec.InstanceRef.Name
+	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
+	if err != nil {
+		pcb := pretty.NewBindingContextBuilder(binding)
+		klog.V(4).Info(pcb.Messagef("Unable to get the instance to annotate the Secret with its class and plan: %v", err))
+		return metadata
+	}
+	class, plan := getServiceInstanceCommonClassAndPlan(*instance)
+	metadata.annotations[bindingSecretClassAnnotation] = class
+	metadata.annotations[bindingSecretPlanAnnotation] = plan
+	return metadata
+}
+
+// getServiceBindingSecretLastRotated returns the rotation time to record on a
+// binding's Secret written with the given data: the time recorded on the
+// existing Secret if its data is unchanged, the current time otherwise.
+func getServiceBindingSecretLastRotated(existingSecret *corev1.Secret, secretData map[string][]byte) string {
+	if existingSecret != nil && isSecretDataEqual(existingSecret.Data, secretData) {
+		if lastRotated, ok := existingSecret.Annotations[bindingSecretLastRotatedAnnotation]; ok {
+			return lastRotated
+		}
+	}
+	return metav1.Now().UTC().Format(time.RFC3339)
+}
+
+// isSecretDataEqual returns whether the two sets of Secret data hold the same
+// keys and values.
+func isSecretDataEqual(a, b map[string][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || !bytes.Equal(v, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSecretUnchanged returns whether the updated Secret holds the same data,
+// labels and annotations as the existing one. Writing it would then only bump
+// the resourceVersion of the Secret, which can needlessly restart the pods
+// watching it.
+func isSecretUnchanged(existing, updated *corev1.Secret) bool {
+	return isSecretDataEqual(existing.Data, updated.Data) &&
+		reflect.DeepEqual(existing.Labels, updated.Labels) &&
+		reflect.DeepEqual(existing.Annotations, updated.Annotations)
+}
+
+// apply sets the labels and annotations on the given object, overwriting the
+// values of keys that are already present. The labels and annotations which
+// were taken from the ServiceBinding the last time the object was written,
+// and are no longer set, are removed.
+func (m serviceBindingSecretMetadata) apply(meta *metav1.ObjectMeta) {
+	previousLabels := splitManagedKeys(meta.Annotations[bindingSecretManagedLabelsAnnotation])
+	previousAnnotations := append(splitManagedKeys(meta.Annotations[bindingSecretManagedAnnotationsAnnotation]),
+		bindingSecretManagedLabelsAnnotation, bindingSecretManagedAnnotationsAnnotation)
+	for _, k := range previousLabels {
+		if _, ok := m.labels[k]; !ok {
+			delete(meta.Labels, k)
+		}
+	}
+	for _, k := range previousAnnotations {
+		if _, ok := m.annotations[k]; !ok {
+			delete(meta.Annotations, k)
+		}
+	}
+
+	if len(m.labels) > 0 && meta.Labels == nil {
+		meta.Labels = make(map[string]string, len(m.labels))
+	}
+	for k, v := range m.labels {
+		meta.Labels[k] = v
+	}
+	if len(m.annotations) > 0 && meta.Annotations == nil {
+		meta.Annotations = make(map[string]string, len(m.annotations))
+	}
+	for k, v := range m.annotations {
+		meta.Annotations[k] = v
+	}
+}
+
+// splitManagedKeys returns the keys recorded in one of the annotations
+// listing the labels and annotations taken from the ServiceBinding.
+func splitManagedKeys(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// syncServiceBindingSecretCopies writes a copy of the binding's Secret data
+// into each of the binding's additional secret namespaces and deletes the
+// copies from namespaces that are no longer listed. The namespaces that may
+// hold a copy are recorded in the binding's status; the status is *not*
+// recorded in the registry.
+func (c *controller) syncServiceBindingSecretCopies(binding *v1beta1.ServiceBinding, secretData map[string][]byte, metadata serviceBindingSecretMetadata) error {
+	wanted := sets.NewString(binding.Spec.AdditionalSecretNamespaces...)
+	existing := sets.NewString(binding.Status.SecretCopyNamespaces...)
+	if wanted.Len() == 0 && existing.Len() == 0 {
+		return nil
+	}
+
+	// Record every namespace that may hold a copy before writing anything,
+	// so that a partial failure never leaves a copy that cannot be cleaned up.
+	binding.Status.SecretCopyNamespaces = wanted.Union(existing).List()
+
+	for _, namespace := range wanted.List() {
+		if err := c.writeServiceBindingSecretCopy(binding, namespace, secretData, metadata); err != nil {
+			return err
+		}
+	}
+
+	for _, namespace := range existing.Difference(wanted).List() {
+		if err := c.deleteServiceBindingSecretCopy(binding, namespace); err != nil {
+			return err
+		}
+	}
+
+	binding.Status.SecretCopyNamespaces = wanted.List()
+	if len(binding.Status.SecretCopyNamespaces) == 0 {
+		binding.Status.SecretCopyNamespaces = nil
+	}
+	return nil
+}
+
+func (c *controller) writeServiceBindingSecretCopy(binding *v1beta1.ServiceBinding, namespace string, secretData map[string][]byte, metadata serviceBindingSecretMetadata) error {
+	pcb := pretty.NewBindingContextBuilder(binding)
+	klog.V(5).Info(pcb.Messagef(`Creating/updating copy of Secret in "%s/%s"`, namespace, binding.Spec.SecretName))
+
+	secretClient := c.kubeClient.CoreV1().Secrets(namespace)
+	existingSecret, err := secretClient.Get(binding.Spec.SecretName, metav1.GetOptions{})
+	if err == nil {
+		if existingSecret.Labels[bindingSecretCopyLabel] != string(binding.UID) {
+			return fmt.Errorf(`Secret "%s/%s" is not a copy managed by ServiceBinding "%s/%s"`, namespace, existingSecret.Name, binding.Namespace, binding.Name)
+		}
+		updatedSecret := existingSecret.DeepCopy()
+		updatedSecret.Data = secretData
+		metadata.apply(&updatedSecret.ObjectMeta)
+		if isSecretUnchanged(existingSecret, updatedSecret) {
+			return nil
+		}
+		if _, err = secretClient.Update(updatedSecret); err != nil {
+			if apierrors.IsConflict(err) {
+				// Conflicting update detected, try again later
+				return fmt.Errorf(`Conflicting Secret "%s/%s" update detected`, namespace, existingSecret.Name)
+			}
+			return secretCopyError(namespace, existingSecret.Name, "updating", err)
+		}
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return secretCopyError(namespace, binding.Spec.SecretName, "getting", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      binding.Spec.SecretName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				bindingSecretCopyLabel: string(binding.UID),
+			},
+		},
+		Data: secretData,
+	}
+	metadata.apply(&secret.ObjectMeta)
+	if _, err = secretClient.Create(secret); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			// Update the secret at the next retry iteration
+			return fmt.Errorf(`Conflicting Secret "%s/%s" creation detected`, namespace, secret.Name)
+		}
+		return secretCopyError(namespace, secret.Name, "creating", err)
+	}
+	return nil
+}
+
+func (c *controller) deleteServiceBindingSecretCopy(binding *v1beta1.ServiceBinding, namespace string) error {
+	pcb := pretty.NewBindingContextBuilder(binding)
+	klog.V(5).Info(pcb.Messagef(`Deleting copy of Secret in "%s/%s"`, namespace, binding.Spec.SecretName))
+
+	secretClient := c.kubeClient.CoreV1().Secrets(namespace)
+	existingSecret, err := secretClient.Get(binding.Spec.SecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return secretCopyError(namespace, binding.Spec.SecretName, "getting", err)
+	}
+	if existingSecret.Labels[bindingSecretCopyLabel] != string(binding.UID) {
+		// Not our copy; leave it alone.
+		return nil
+	}
+	if err := secretClient.Delete(existingSecret.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return secretCopyError(namespace, existingSecret.Name, "deleting", err)
+	}
+	return nil
+}
+
+// secretCopyError returns the error to report when an operation on a copy of
+// a binding's Secret failed. Missing permissions are reported with a
+// dedicated reason, as they require action from the cluster administrator.
+func secretCopyError(namespace, name, action string, err error) error {
+	if apierrors.IsForbidden(err) {
+		return &operationError{
+			reason: v1beta1.ReasonSecretCopyForbidden,
+			message: fmt.Sprintf(
+				`Not permitted to manage Secret "%s/%s"; grant the controller access to Secrets in namespace %q: %v`,
+				namespace, name, namespace, err,
+			),
+		}
+	}
+	return fmt.Errorf(`Unexpected error %s Secret "%s/%s": %v`, action, namespace, name, err)
 }
 
 func (c *controller) transformCredentials(transforms []v1beta1.SecretTransform, credentials map[string]interface{}) error {
@@ -631,6 +1268,99 @@ func (c *controller) transformCredentials(transforms []v1beta1.SecretTransform,
 	return nil
 }
 
+// formatCredentialKeys converts every credential key to the given naming
+// convention. An error is returned if two keys are converted to the same
+// key, as one of the credentials would otherwise be silently dropped.
+func formatCredentialKeys(format v1beta1.SecretKeyFormat, credentials map[string]interface{}) error {
+	var convert func(words []string) string
+	switch format {
+	case "":
+		return nil
+	case v1beta1.SecretKeyFormatUpperSnakeCase:
+		convert = func(words []string) string {
+			return strings.ToUpper(strings.Join(words, "_"))
+		}
+	case v1beta1.SecretKeyFormatCamelCase:
+		convert = func(words []string) string {
+			for i, w := range words {
+				r := []rune(strings.ToLower(w))
+				if i > 0 {
+					r[0] = unicode.ToUpper(r[0])
+				}
+				words[i] = string(r)
+			}
+			return strings.Join(words, "")
+		}
+	default:
+		return fmt.Errorf("unsupported secret key format %q", format)
+	}
+
+	keys := make([]string, 0, len(credentials))
+	for k := range credentials {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	formatted := make(map[string]interface{}, len(credentials))
+	sources := make(map[string]string, len(credentials))
+	for _, k := range keys {
+		newKey := k
+		if words := splitCredentialKey(k); len(words) > 0 {
+			newKey = convert(words)
+		}
+		if source, ok := sources[newKey]; ok {
+			return fmt.Errorf("credential keys %q and %q are both converted to %q", source, k, newKey)
+		}
+		sources[newKey] = k
+		formatted[newKey] = credentials[k]
+	}
+
+	for k := range credentials {
+		delete(credentials, k)
+	}
+	for k, v := range formatted {
+		credentials[k] = v
+	}
+	return nil
+}
+
+// splitCredentialKey splits a credential key into its words. Words are
+// separated by any character other than a letter or digit, and by case
+// changes, so "dbUser", "db_user", "DB-USER" and "DBUser" are all split
+// into "db" and "user" in their original case.
+func splitCredentialKey(key string) []string {
+	var words []string
+	runes := []rune(key)
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start >= 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			// A new word starts at an upper case letter following a lower
+			// case letter or digit, or at the last upper case letter of an
+			// acronym that is followed by a lower case letter.
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
 func evaluateJSONPath(jsonPath string, credentials map[string]interface{}) (string, error) {
 	j := jsonpath.New("expression")
 	buf := new(bytes.Buffer)
@@ -643,6 +1373,74 @@ func evaluateJSONPath(jsonPath string, credentials map[string]interface{}) (stri
 	return buf.String(), nil
 }
 
+// validateRouteServiceURL returns an error if the route service URL returned
+// by the broker is not an absolute https URL. A nil URL is valid, as brokers
+// only return one when the service requires route services.
+func validateRouteServiceURL(routeServiceURL *string) error {
+	if routeServiceURL == nil {
+		return nil
+	}
+	return scv.ValidateServiceBindingRouteServiceURL(*routeServiceURL, field.NewPath("routeServiceURL")).ToAggregate()
+}
+
+// osbVolumeMount is the structure of a volume mount in a bind response, as
+// defined by the Open Service Broker API.
+type osbVolumeMount struct {
+	Driver       string `json:"driver"`
+	ContainerDir string `json:"container_dir"`
+	Mode         string `json:"mode"`
+	DeviceType   string `json:"device_type"`
+	Device       struct {
+		VolumeID    string                 `json:"volume_id"`
+		MountConfig map[string]interface{} `json:"mount_config,omitempty"`
+	} `json:"device"`
+}
+
+// getServiceBindingVolumeMounts converts the volume mounts returned by the
+// broker in a bind response into the form stored in the ServiceBinding's
+// status. An error is returned if the volume mounts do not have the
+// structure defined by the Open Service Broker API.
+func getServiceBindingVolumeMounts(volumeMounts []interface{}) ([]v1beta1.ServiceBindingVolumeMount, error) {
+	if len(volumeMounts) == 0 {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(volumeMounts)
+	if err != nil {
+		return nil, err
+	}
+	var osbVolumeMounts []osbVolumeMount
+	if err := json.Unmarshal(raw, &osbVolumeMounts); err != nil {
+		return nil, err
+	}
+
+	result := make([]v1beta1.ServiceBindingVolumeMount, 0, len(osbVolumeMounts))
+	for i, vm := range osbVolumeMounts {
+		volumeMount := v1beta1.ServiceBindingVolumeMount{
+			Driver:       vm.Driver,
+			ContainerDir: vm.ContainerDir,
+			Mode:         v1beta1.ServiceBindingVolumeMountMode(vm.Mode),
+			DeviceType:   v1beta1.ServiceBindingVolumeDeviceType(vm.DeviceType),
+			Device: v1beta1.ServiceBindingVolumeDevice{
+				VolumeID: vm.Device.VolumeID,
+			},
+		}
+		if err := scv.ValidateServiceBindingVolumeMount(&volumeMount, field.NewPath("volumeMounts").Index(i)).ToAggregate(); err != nil {
+			return nil, err
+		}
+		if vm.Device.MountConfig != nil {
+			mountConfig, err := json.Marshal(vm.Device.MountConfig)
+			if err != nil {
+				return nil, err
+			}
+			volumeMount.Device.MountConfig = &runtime.RawExtension{Raw: mountConfig}
+		}
+		result = append(result, volumeMount)
+	}
+
+	return result, nil
+}
+
 func (c *controller) ejectServiceBinding(binding *v1beta1.ServiceBinding) error {
 	var err error
 	pcb := pretty.NewBindingContextBuilder(binding)
@@ -654,6 +1452,14 @@ func (c *controller) ejectServiceBinding(binding *v1beta1.ServiceBinding) error
 		return err
 	}
 
+	namespaces := sets.NewString(binding.Spec.AdditionalSecretNamespaces...).Union(sets.NewString(binding.Status.SecretCopyNamespaces...))
+	for _, namespace := range namespaces.List() {
+		if err := c.deleteServiceBindingSecretCopy(binding, namespace); err != nil {
+			return err
+		}
+	}
+	binding.Status.SecretCopyNamespaces = nil
+
 	return nil
 }
 
@@ -675,6 +1481,17 @@ func setServiceBindingCondition(toUpdate *v1beta1.ServiceBinding,
 	toUpdate.Status.LastConditionState = getServiceBindingLastConditionState(toUpdate.Status)
 }
 
+// setServiceBindingConditionFrom sets the condition of the type of cond on a
+// Binding's status to cond, including its error code.
+func setServiceBindingConditionFrom(toUpdate *v1beta1.ServiceBinding, cond *v1beta1.ServiceBindingCondition) {
+	setServiceBindingCondition(toUpdate, cond.Type, cond.Status, cond.Reason, cond.Message)
+	for i := range toUpdate.Status.Conditions {
+		if toUpdate.Status.Conditions[i].Type == cond.Type {
+			toUpdate.Status.Conditions[i].ErrorCode = cond.ErrorCode
+		}
+	}
+}
+
 // setServiceBindingConditionInternal is
 // setServiceBindingCondition but allows the time to be parameterized
 // for testing.
@@ -732,6 +1549,57 @@ func setServiceBindingConditionInternal(toUpdate *v1beta1.ServiceBinding,
 	toUpdate.Status.Conditions = append(toUpdate.Status.Conditions, newCondition)
 }
 
+// setServiceBindingCredentialsStaleCondition marks the credentials of a
+// ServiceBinding as stale.
+func setServiceBindingCredentialsStaleCondition(toUpdate *v1beta1.ServiceBinding, message string, t metav1.Time) {
+	setLeadingServiceBindingCondition(toUpdate, v1beta1.ServiceBindingConditionCredentialsStale, v1beta1.ReasonInstancePlanChanged, message, t)
+}
+
+// setServiceBindingPlanNotBindableCondition marks a ServiceBinding whose
+// instance's plan is no longer bindable.
+func setServiceBindingPlanNotBindableCondition(toUpdate *v1beta1.ServiceBinding, message string, t metav1.Time) {
+	setLeadingServiceBindingCondition(toUpdate, v1beta1.ServiceBindingConditionPlanNotBindable, v1beta1.ReasonPlanNotBindable, message, t)
+}
+
+// setLeadingServiceBindingCondition sets a true condition of the given type on
+// a ServiceBinding. The condition is put ahead of the others so that it
+// doesn't replace the binding's Ready state in LastConditionState.
+func setLeadingServiceBindingCondition(toUpdate *v1beta1.ServiceBinding, conditionType v1beta1.ServiceBindingConditionType, reason, message string, t metav1.Time) {
+	newCondition := v1beta1.ServiceBindingCondition{
+		Type:               conditionType,
+		Status:             v1beta1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: t,
+	}
+
+	for i, cond := range toUpdate.Status.Conditions {
+		if cond.Type == conditionType {
+			if cond.Status == newCondition.Status {
+				newCondition.LastTransitionTime = cond.LastTransitionTime
+			}
+			toUpdate.Status.Conditions[i] = newCondition
+			return
+		}
+	}
+
+	toUpdate.Status.Conditions = append([]v1beta1.ServiceBindingCondition{newCondition}, toUpdate.Status.Conditions...)
+}
+
+// removeServiceBindingCondition removes a single condition from a
+// ServiceBinding's status, if it exists.
+func removeServiceBindingCondition(toUpdate *v1beta1.ServiceBinding,
+	conditionType v1beta1.ServiceBindingConditionType) {
+	newStatusConditions := make([]v1beta1.ServiceBindingCondition, 0, len(toUpdate.Status.Conditions))
+	for _, cond := range toUpdate.Status.Conditions {
+		if cond.Type == conditionType {
+			continue
+		}
+		newStatusConditions = append(newStatusConditions, cond)
+	}
+	toUpdate.Status.Conditions = newStatusConditions
+}
+
 func (c *controller) updateServiceBindingStatus(toUpdate *v1beta1.ServiceBinding) (*v1beta1.ServiceBinding, error) {
 	pcb := pretty.NewBindingContextBuilder(toUpdate)
 	klog.V(4).Info(pcb.Message("Updating status"))
@@ -817,9 +1685,11 @@ func (c *controller) recordStartOfServiceBindingOperation(
 	*v1beta1.ServiceBinding, error) {
 
 	currentReconciledGeneration := toUpdate.Status.ReconciledGeneration
+	currentObservedGeneration := toUpdate.Status.ObservedGeneration
 	clearServiceBindingCurrentOperation(toUpdate)
 
 	toUpdate.Status.ReconciledGeneration = currentReconciledGeneration
+	toUpdate.Status.ObservedGeneration = currentObservedGeneration
 	toUpdate.Status.CurrentOperation = operation
 	now := metav1.Now()
 	toUpdate.Status.OperationStartTime = &now
@@ -828,11 +1698,11 @@ func (c *controller) recordStartOfServiceBindingOperation(
 	message := ""
 	switch operation {
 	case v1beta1.ServiceBindingOperationBind:
-		reason = bindingInFlightReason
+		reason = v1beta1.ReasonBindingRequestInFlight
 		message = bindingInFlightMessage
 		toUpdate.Status.UnbindStatus = v1beta1.ServiceBindingUnbindStatusRequired
 	case v1beta1.ServiceBindingOperationUnbind:
-		reason = unbindingInFlightReason
+		reason = v1beta1.ReasonUnbindingRequestInFlight
 		message = unbindingInFlightMessage
 	}
 	setServiceBindingCondition(
@@ -854,18 +1724,22 @@ func clearServiceBindingCurrentOperation(toUpdate *v1beta1.ServiceBinding) {
 	toUpdate.Status.AsyncOpInProgress = false
 	toUpdate.Status.LastOperation = nil
 	toUpdate.Status.ReconciledGeneration = toUpdate.Generation
+	toUpdate.Status.ObservedGeneration = toUpdate.Generation
 	toUpdate.Status.InProgressProperties = nil
 	toUpdate.Status.OrphanMitigationInProgress = false
+	toUpdate.Status.SecretWritePending = false
 }
 
 // rollbackBindingReconciledGenerationOnDeletion resets the ReconciledGeneration
-// if a deletion was performed while an async bind is running.
+// and ObservedGeneration if a deletion was performed while an async bind is
+// running.
 // TODO: rework saving off current generation as the start of the async
 // operation, see PR 1708/Issue 1587.
-func rollbackBindingReconciledGenerationOnDeletion(binding *v1beta1.ServiceBinding, currentReconciledGeneration int64) {
+func rollbackBindingReconciledGenerationOnDeletion(binding *v1beta1.ServiceBinding, currentReconciledGeneration, currentObservedGeneration int64) {
 	if binding.DeletionTimestamp != nil {
 		klog.V(4).Infof("Not updating ReconciledGeneration after async operation because there is a deletion pending.")
 		binding.Status.ReconciledGeneration = currentReconciledGeneration
+		binding.Status.ObservedGeneration = currentObservedGeneration
 	}
 }
 
@@ -908,6 +1782,29 @@ func (c *controller) finishPollingServiceBinding(binding *v1beta1.ServiceBinding
 	return nil
 }
 
+// resetPollingRateLimiterForServiceBinding causes the polling queue's rate
+// limiter to forget the given binding.
+func (c *controller) resetPollingRateLimiterForServiceBinding(binding *v1beta1.ServiceBinding) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(binding)
+	if err != nil {
+		klog.Errorf("Couldn't create a key for object %+v: %v", binding, err)
+		return
+	}
+
+	c.bindingPollingQueue.Forget(key)
+}
+
+// serviceBindingConditionMessage returns the message of the binding's
+// condition of the given type, or "" if the binding has no such condition.
+func serviceBindingConditionMessage(binding *v1beta1.ServiceBinding, conditionType v1beta1.ServiceBindingConditionType) string {
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Message
+		}
+	}
+	return ""
+}
+
 func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 	pcb := pretty.NewBindingContextBuilder(binding)
 	klog.V(4).Infof(pcb.Message("Processing"))
@@ -917,7 +1814,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 	instance, err := c.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
 	if err != nil {
 		msg := fmt.Sprintf(`References a non-existent %s "%s/%s"`, pretty.ServiceInstance, binding.Namespace, binding.Spec.InstanceRef.Name)
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorNonexistentServiceInstanceReason, msg)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonReferencesNonexistentInstance, msg)
 		return c.processServiceBindingOperationError(binding, readyCond)
 	}
 
@@ -957,7 +1854,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		// just need to record an event.
 		s := fmt.Sprintf("Error polling last operation: %v", err)
 		klog.V(4).Info(pcb.Message(s))
-		c.recorder.Event(binding, corev1.EventTypeWarning, errorPollingLastOperationReason, s)
+		c.recorder.Event(binding, corev1.EventTypeWarning, v1beta1.ReasonErrorPollingLastOperation, s)
 
 		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, nil)
@@ -980,14 +1877,19 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 
 		// if the description is non-nil, then update the instance condition with it
 		if response.Description != nil {
-			reason := asyncBindingReason
+			reason := v1beta1.ReasonBinding
 			message := asyncBindingMessage
 			if deleting {
-				reason = asyncUnbindingReason
+				reason = v1beta1.ReasonUnbinding
 				message = asyncUnbindingMessage
 			}
 
 			message = fmt.Sprintf("%s (%s)", message, *response.Description)
+			// A new description means the operation has progressed, so the
+			// polling backoff starts over to notice the next change early.
+			if serviceBindingConditionMessage(binding, v1beta1.ServiceBindingConditionReady) != message {
+				c.resetPollingRateLimiterForServiceBinding(binding)
+			}
 			setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, reason, message)
 			c.recorder.Event(binding, corev1.EventTypeNormal, reason, message)
 
@@ -1018,7 +1920,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		// TODO(mkibbe): Break this logic out so that GET and inject are retried separately on error
 		getBindingResponse, err := brokerClient.GetBinding(getBindingRequest)
 		if err != nil {
-			reason := errorFetchingBindingFailedReason
+			reason := v1beta1.ReasonFetchingBindingFailed
 			msg := fmt.Sprintf("Could not do a GET on binding resource: %v", err)
 			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, reason, msg)
 			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, reason, msg)
@@ -1030,8 +1932,37 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 			return c.finishPollingServiceBinding(binding)
 		}
 
+		volumeMounts, err := getServiceBindingVolumeMounts(getBindingResponse.VolumeMounts)
+		if err != nil {
+			reason := v1beta1.ReasonInvalidVolumeMounts
+			msg := fmt.Sprintf("ServiceBroker returned invalid volume mounts: %v", err)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, reason, msg)
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, reason, msg)
+
+			if err := c.processBindFailure(binding, readyCond, failedCond, false); err != nil {
+				return err
+			}
+
+			return c.finishPollingServiceBinding(binding)
+		}
+		binding.Status.VolumeMounts = volumeMounts
+
+		if err := validateRouteServiceURL(getBindingResponse.RouteServiceURL); err != nil {
+			reason := v1beta1.ReasonInvalidRouteServiceURL
+			msg := fmt.Sprintf("ServiceBroker returned an invalid route service URL: %v", err)
+			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, reason, msg)
+			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, reason, msg)
+
+			if err := c.processBindFailure(binding, readyCond, failedCond, false); err != nil {
+				return err
+			}
+
+			return c.finishPollingServiceBinding(binding)
+		}
+		binding.Status.RouteServiceURL = getBindingResponse.RouteServiceURL
+
 		if err := c.injectServiceBinding(binding, getBindingResponse.Credentials); err != nil {
-			reason := errorInjectingBindResultReason
+			reason := v1beta1.ReasonErrorInjectingBindResult
 			msg := fmt.Sprintf("Error injecting bind results: %v", err)
 
 			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, reason, msg)
@@ -1051,7 +1982,7 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		return c.finishPollingServiceBinding(binding)
 	case osb.StateFailed:
 		if !deleting {
-			reason := errorBindCallReason
+			reason := v1beta1.ReasonBindCallFailed
 			message := "Bind call failed: " + description
 			readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, reason, message)
 			failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, reason, message)
@@ -1062,14 +1993,14 @@ func (c *controller) pollServiceBinding(binding *v1beta1.ServiceBinding) error {
 		}
 
 		msg := "Unbind call failed: " + description
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, errorUnbindCallReason, msg)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, v1beta1.ReasonUnbindCallFailed, msg)
 
 		if c.reconciliationRetryDurationExceeded(binding.Status.OperationStartTime) {
 			return c.processServiceBindingPollingFailureRetryTimeout(binding, readyCond)
 		}
 
-		setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
-		c.recorder.Event(binding, corev1.EventTypeWarning, errorUnbindCallReason, msg)
+		setServiceBindingConditionFrom(binding, readyCond)
+		c.recorder.Event(binding, corev1.EventTypeWarning, v1beta1.ReasonUnbindCallFailed, msg)
 
 		// we must trigger a new unbind attempt entirely (as opposed to
 		// retrying querying the failed operation endpoint). Finish
@@ -1111,11 +2042,11 @@ func (c *controller) processServiceBindingPollingFailureRetryTimeout(binding *v1
 		}
 
 		msg := fmt.Sprintf("The asynchronous %v operation timed out and will not be retried", operation)
-		readyCond = newServiceBindingReadyCondition(status, errorAsyncOpTimeoutReason, msg)
+		readyCond = newServiceBindingReadyCondition(status, v1beta1.ReasonAsyncOperationTimeout, msg)
 	}
 
 	msg := "Stopping reconciliation retries because too much time has elapsed"
-	failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
+	failedCond := newServiceBindingFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonErrorReconciliationRetryTimeout, msg)
 
 	var err error
 	if deleting {
@@ -1183,7 +2114,7 @@ func (c *controller) prepareBindRequest(
 		serviceClass, err := c.getClusterServiceClassForServiceBinding(instance, binding)
 		if err != nil {
 			return nil, nil, &operationError{
-				reason:  errorNonexistentClusterServiceClassReason,
+				reason:  v1beta1.ReasonReferencesNonexistentServiceClass,
 				message: err.Error(),
 			}
 		}
@@ -1191,7 +2122,7 @@ func (c *controller) prepareBindRequest(
 		servicePlan, err := c.getClusterServicePlanForServiceBinding(instance, binding, serviceClass)
 		if err != nil {
 			return nil, nil, &operationError{
-				reason:  errorNonexistentClusterServicePlanReason,
+				reason:  v1beta1.ReasonReferencesNonexistentServicePlan,
 				message: err.Error(),
 			}
 		}
@@ -1205,7 +2136,7 @@ func (c *controller) prepareBindRequest(
 		serviceClass, err := c.getServiceClassForServiceBinding(instance, binding)
 		if err != nil {
 			return nil, nil, &operationError{
-				reason:  errorNonexistentServiceClassReason,
+				reason:  v1beta1.ReasonReferencesNonexistentServiceClass,
 				message: err.Error(),
 			}
 		}
@@ -1213,7 +2144,7 @@ func (c *controller) prepareBindRequest(
 		servicePlan, err := c.getServicePlanForServiceBinding(instance, binding, serviceClass)
 		if err != nil {
 			return nil, nil, &operationError{
-				reason:  errorNonexistentServicePlanReason,
+				reason:  v1beta1.ReasonReferencesNonexistentServicePlan,
 				message: err.Error(),
 			}
 		}
@@ -1226,7 +2157,7 @@ func (c *controller) prepareBindRequest(
 	ns, err := c.kubeClient.CoreV1().Namespaces().Get(instance.Namespace, metav1.GetOptions{})
 	if err != nil {
 		return nil, nil, &operationError{
-			reason:  errorFindingNamespaceServiceInstanceReason,
+			reason:  v1beta1.ReasonErrorFindingNamespaceForInstance,
 			message: fmt.Sprintf(`Failed to get namespace %q during binding: %s`, instance.Namespace, err),
 		}
 	}
@@ -1234,12 +2165,13 @@ func (c *controller) prepareBindRequest(
 	parameters, parametersChecksum, rawParametersWithRedaction, err := prepareInProgressPropertyParameters(
 		c.kubeClient,
 		binding.Namespace,
+		c.parametersFromURLAllowedHosts,
 		binding.Spec.Parameters,
 		binding.Spec.ParametersFrom,
 	)
 	if err != nil {
 		return nil, nil, &operationError{
-			reason:  errorWithParametersReason,
+			reason:  v1beta1.ReasonErrorWithParameters,
 			message: err.Error(),
 		}
 	}
@@ -1254,10 +2186,13 @@ func (c *controller) prepareBindRequest(
 	clusterID := c.getClusterID()
 
 	requestContext := map[string]interface{}{
-		"platform":           ContextProfilePlatformKubernetes,
-		"namespace":          instance.Namespace,
+		"platform":           c.brokerContextPlatform,
+		"namespace":          c.getBrokerContextNamespace(instance.Namespace),
 		clusterIdentifierKey: clusterID,
 	}
+	if binding.Spec.Endpoint != "" {
+		requestContext["endpoint"] = binding.Spec.Endpoint
+	}
 
 	request := &osb.BindRequest{
 		BindingID:    binding.Spec.ExternalID,
@@ -1282,10 +2217,10 @@ func (c *controller) prepareBindRequest(
 	}
 
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
-		originatingIdentity, err := buildOriginatingIdentity(binding.Spec.UserInfo)
+		originatingIdentity, err := c.buildNamespaceOriginatingIdentity(binding.Namespace, binding.Spec.UserInfo)
 		if err != nil {
 			return nil, nil, &operationError{
-				reason:  errorWithOriginatingIdentityReason,
+				reason:  v1beta1.ReasonErrorWithOriginatingIdentity,
 				message: fmt.Sprintf(`Error building originating identity headers for binding: %v`, err),
 			}
 		}
@@ -1347,10 +2282,10 @@ func (c *controller) prepareUnbindRequest(
 	}
 
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
-		originatingIdentity, err := buildOriginatingIdentity(binding.Spec.UserInfo)
+		originatingIdentity, err := c.buildNamespaceOriginatingIdentity(binding.Namespace, binding.Spec.UserInfo)
 		if err != nil {
 			return nil, &operationError{
-				reason:  errorWithOriginatingIdentityReason,
+				reason:  v1beta1.ReasonErrorWithOriginatingIdentity,
 				message: fmt.Sprintf(`Error building originating identity headers for binding: %v`, err),
 			}
 		}
@@ -1411,10 +2346,10 @@ func (c *controller) prepareServiceBindingLastOperationRequest(
 	}
 
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
-		originatingIdentity, err := buildOriginatingIdentity(binding.Spec.UserInfo)
+		originatingIdentity, err := c.buildNamespaceOriginatingIdentity(binding.Namespace, binding.Spec.UserInfo)
 		if err != nil {
 			return nil, &operationError{
-				reason:  errorWithOriginatingIdentityReason,
+				reason:  v1beta1.ReasonErrorWithOriginatingIdentity,
 				message: fmt.Sprintf(`Error building originating identity headers for polling binding last operation: %v`, err),
 			}
 		}
@@ -1428,28 +2363,30 @@ func (c *controller) prepareServiceBindingLastOperationRequest(
 // ServiceBinding that hit a retryable error during reconciliation.
 func (c *controller) processServiceBindingOperationError(binding *v1beta1.ServiceBinding, readyCond *v1beta1.ServiceBindingCondition) error {
 	c.recorder.Event(binding, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
-	setServiceBindingCondition(binding, readyCond.Type, readyCond.Status, readyCond.Reason, readyCond.Message)
+	setServiceBindingConditionFrom(binding, readyCond)
 	if _, err := c.updateServiceBindingStatus(binding); err != nil {
 		return err
 	}
 
-	return fmt.Errorf(readyCond.Message)
+	return reconciliationErrorFor(readyCond.Reason, readyCond.Message)
 }
 
 // processBindSuccess handles the logging and updating of a ServiceBinding that
 // has successfully been created at the broker and has had its credentials
 // injected in the cluster.
 func (c *controller) processBindSuccess(binding *v1beta1.ServiceBinding) error {
-	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionTrue, successInjectedBindResultReason, successInjectedBindResultMessage)
+	removeServiceBindingCondition(binding, v1beta1.ServiceBindingConditionCredentialsStale)
+	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionTrue, v1beta1.ReasonInjectedBindResult, successInjectedBindResultMessage)
 	currentReconciledGeneration := binding.Status.ReconciledGeneration
+	currentObservedGeneration := binding.Status.ObservedGeneration
 	clearServiceBindingCurrentOperation(binding)
-	rollbackBindingReconciledGenerationOnDeletion(binding, currentReconciledGeneration)
+	rollbackBindingReconciledGenerationOnDeletion(binding, currentReconciledGeneration, currentObservedGeneration)
 
 	if _, err := c.updateServiceBindingStatus(binding); err != nil {
 		return err
 	}
 
-	c.recorder.Event(binding, corev1.EventTypeNormal, successInjectedBindResultReason, successInjectedBindResultMessage)
+	c.recorder.Event(binding, corev1.EventTypeNormal, v1beta1.ReasonInjectedBindResult, successInjectedBindResultMessage)
 	return nil
 }
 
@@ -1457,18 +2394,21 @@ func (c *controller) processBindSuccess(binding *v1beta1.ServiceBinding) error {
 // hit a terminal failure during bind reconciliation.
 func (c *controller) processBindFailure(binding *v1beta1.ServiceBinding, readyCond, failedCond *v1beta1.ServiceBindingCondition, shouldMitigateOrphan bool) error {
 	currentReconciledGeneration := binding.Status.ReconciledGeneration
+	currentObservedGeneration := binding.Status.ObservedGeneration
+	c.bindingCredentialsStore.Remove(binding)
+	binding.Status.SecretWritePending = false
 	if readyCond != nil {
 		c.recorder.Event(binding, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
-		setServiceBindingCondition(binding, readyCond.Type, readyCond.Status, readyCond.Reason, readyCond.Message)
+		setServiceBindingConditionFrom(binding, readyCond)
 	}
 
 	c.recorder.Event(binding, corev1.EventTypeWarning, failedCond.Reason, failedCond.Message)
-	setServiceBindingCondition(binding, failedCond.Type, failedCond.Status, failedCond.Reason, failedCond.Message)
+	setServiceBindingConditionFrom(binding, failedCond)
 
 	if shouldMitigateOrphan {
 		msg := "Starting orphan mitigation"
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, errorServiceBindingOrphanMitigation, msg)
-		setServiceBindingCondition(binding, readyCond.Type, readyCond.Status, readyCond.Reason, readyCond.Message)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonServiceBindingNeedsOrphanMitigation, msg)
+		setServiceBindingConditionFrom(binding, readyCond)
 		c.recorder.Event(binding, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
 
 		binding.Status.OrphanMitigationInProgress = true
@@ -1476,7 +2416,7 @@ func (c *controller) processBindFailure(binding *v1beta1.ServiceBinding, readyCo
 		binding.Status.OperationStartTime = nil
 	} else {
 		clearServiceBindingCurrentOperation(binding)
-		rollbackBindingReconciledGenerationOnDeletion(binding, currentReconciledGeneration)
+		rollbackBindingReconciledGenerationOnDeletion(binding, currentReconciledGeneration, currentObservedGeneration)
 	}
 
 	if _, err := c.updateServiceBindingStatus(binding); err != nil {
@@ -1491,14 +2431,14 @@ func (c *controller) processBindFailure(binding *v1beta1.ServiceBinding, readyCo
 // requesting a bind.
 func (c *controller) processBindAsyncResponse(binding *v1beta1.ServiceBinding, response *osb.BindResponse) error {
 	setServiceBindingLastOperation(binding, response.OperationKey)
-	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, asyncBindingReason, asyncBindingMessage)
+	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonBinding, asyncBindingMessage)
 	binding.Status.AsyncOpInProgress = true
 
 	if _, err := c.updateServiceBindingStatus(binding); err != nil {
 		return err
 	}
 
-	c.recorder.Event(binding, corev1.EventTypeNormal, asyncBindingReason, asyncBindingMessage)
+	c.recorder.Event(binding, corev1.EventTypeNormal, v1beta1.ReasonBinding, asyncBindingMessage)
 	return c.beginPollingServiceBinding(binding)
 }
 
@@ -1544,16 +2484,18 @@ func (c *controller) processServiceBindingGracefulDeletionSuccess(binding *v1bet
 func (c *controller) processUnbindSuccess(binding *v1beta1.ServiceBinding) error {
 	mitigatingOrphan := binding.Status.OrphanMitigationInProgress
 
-	reason := successUnboundReason
+	reason := v1beta1.ReasonUnboundSuccessfully
 	msg := "The binding was deleted successfully"
 	if mitigatingOrphan {
-		reason = successOrphanMitigationReason
+		reason = v1beta1.ReasonOrphanMitigationSuccessful
 		msg = successOrphanMitigationMessage
 	}
 
 	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, reason, msg)
 	clearServiceBindingCurrentOperation(binding)
 	binding.Status.ExternalProperties = nil
+	binding.Status.VolumeMounts = nil
+	binding.Status.RouteServiceURL = nil
 	binding.Status.UnbindStatus = v1beta1.ServiceBindingUnbindStatusSucceeded
 
 	if mitigatingOrphan {
@@ -1588,11 +2530,11 @@ func (c *controller) processUnbindFailure(binding *v1beta1.ServiceBinding, ready
 	if binding.Status.OrphanMitigationInProgress {
 		// replace Ready condition with orphan mitigation-related one.
 		msg := "Orphan mitigation failed: " + failedCond.Message
-		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, errorOrphanMitigationFailedReason, msg)
-		setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
+		readyCond := newServiceBindingReadyCondition(v1beta1.ConditionUnknown, v1beta1.ReasonOrphanMitigationFailed, msg)
+		setServiceBindingConditionFrom(binding, readyCond)
 		c.recorder.Event(binding, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
 	} else {
-		setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionFailed, failedCond.Status, failedCond.Reason, failedCond.Message)
+		setServiceBindingConditionFrom(binding, failedCond)
 		c.recorder.Event(binding, corev1.EventTypeWarning, failedCond.Reason, failedCond.Message)
 	}
 
@@ -1611,14 +2553,14 @@ func (c *controller) processUnbindFailure(binding *v1beta1.ServiceBinding, ready
 // requesting an unbind.
 func (c *controller) processUnbindAsyncResponse(binding *v1beta1.ServiceBinding, response *osb.UnbindResponse) error {
 	setServiceBindingLastOperation(binding, response.OperationKey)
-	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, asyncUnbindingReason, asyncUnbindingMessage)
+	setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonUnbinding, asyncUnbindingMessage)
 	binding.Status.AsyncOpInProgress = true
 
 	if _, err := c.updateServiceBindingStatus(binding); err != nil {
 		return err
 	}
 
-	c.recorder.Event(binding, corev1.EventTypeNormal, asyncUnbindingReason, asyncUnbindingMessage)
+	c.recorder.Event(binding, corev1.EventTypeNormal, v1beta1.ReasonUnbinding, asyncUnbindingMessage)
 	return c.beginPollingServiceBinding(binding)
 }
 
@@ -183,7 +183,7 @@ func TestReconcileServiceBindingWithParametersNamespacedRefs(t *testing.T) {
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := normalEventBuilder(successInjectedBindResultReason).msg(successInjectedBindResultMessage)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonInjectedBindResult).msg(successInjectedBindResultMessage)
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -392,7 +392,7 @@ func TestReconcileServiceBindingDeleteNamespacedRefs(t *testing.T) {
 
 			events := getRecordedEvents(testController)
 
-			expectedEvent := normalEventBuilder(successUnboundReason)
+			expectedEvent := normalEventBuilder(v1beta1.ReasonUnboundSuccessfully)
 			if err := checkEventPrefixes(events, expectedEvent.stringArr()); err != nil {
 				t.Fatal(err)
 			}
@@ -464,7 +464,7 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 			validateBrokerActionsFunc:  validatePollBindingLastOperationAction,
 			assertPerfomerdActionsFunc: nil, // does not update resources
 			shouldFinishPolling:        false,
-			expectedEvents:             []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			expectedEvents:             []string{corev1.EventTypeWarning + " " + v1beta1.ReasonErrorPollingLastOperation + " " + "Error polling last operation: random error"},
 		},
 		{
 			// Special test for 410, as it is treated differently in other operations
@@ -476,7 +476,7 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 			validateBrokerActionsFunc:  validatePollBindingLastOperationAction,
 			assertPerfomerdActionsFunc: nil, // does not update resources
 			shouldFinishPolling:        false,
-			expectedEvents:             []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: " + goneError.Error()},
+			expectedEvents:             []string{corev1.EventTypeWarning + " " + v1beta1.ReasonErrorPollingLastOperation + " " + "Error polling last operation: " + goneError.Error()},
 		},
 		{
 			name:    "bind - in progress",
@@ -492,10 +492,10 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationBind, asyncBindingReason, testOperation, originalBinding)
+				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonBinding, testOperation, originalBinding)
 			},
 			shouldFinishPolling: false,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + asyncBindingReason + " " + "The binding is being created asynchronously (testdescr)"},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonBinding + " " + "The binding is being created asynchronously (testdescr)"},
 		},
 		{
 			name:    "bind - failed",
@@ -515,15 +515,15 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 					t,
 					updatedBinding,
 					v1beta1.ServiceBindingOperationBind,
-					errorBindCallReason,
-					errorBindCallReason,
+					v1beta1.ReasonBindCallFailed,
+					v1beta1.ReasonBindCallFailed,
 					originalBinding,
 				)
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorBindCallReason + " " + "Bind call failed: " + lastOperationDescription,
-				corev1.EventTypeWarning + " " + errorBindCallReason + " " + "Bind call failed: " + lastOperationDescription,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonBindCallFailed + " " + "Bind call failed: " + lastOperationDescription,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonBindCallFailed + " " + "Bind call failed: " + lastOperationDescription,
 			},
 		},
 		{
@@ -558,9 +558,9 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Bind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason + " " + "Stopping reconciliation retries because too much time has elapsed",
-				corev1.EventTypeWarning + " " + errorServiceBindingOrphanMitigation + " " + "Starting orphan mitigation",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Bind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout + " " + "Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonServiceBindingNeedsOrphanMitigation + " " + "Starting orphan mitigation",
 			},
 		},
 		{
@@ -581,9 +581,9 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Bind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason + " " + "Stopping reconciliation retries because too much time has elapsed",
-				corev1.EventTypeWarning + " " + errorServiceBindingOrphanMitigation + " " + "Starting orphan mitigation",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Bind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout + " " + "Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonServiceBindingNeedsOrphanMitigation + " " + "Starting orphan mitigation",
 			},
 		},
 		{
@@ -603,13 +603,13 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingAsyncBindErrorAfterStateSucceeded(t, updatedBinding, errorFetchingBindingFailedReason, originalBinding)
+				assertServiceBindingAsyncBindErrorAfterStateSucceeded(t, updatedBinding, v1beta1.ReasonFetchingBindingFailed, originalBinding)
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorFetchingBindingFailedReason + " " + "Could not do a GET on binding resource: some error",
-				corev1.EventTypeWarning + " " + errorFetchingBindingFailedReason + " " + "Could not do a GET on binding resource: some error",
-				corev1.EventTypeWarning + " " + errorServiceBindingOrphanMitigation + " " + "Starting orphan mitigation",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonFetchingBindingFailed + " " + "Could not do a GET on binding resource: some error",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonFetchingBindingFailed + " " + "Could not do a GET on binding resource: some error",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonServiceBindingNeedsOrphanMitigation + " " + "Starting orphan mitigation",
 			},
 		},
 		{
@@ -649,13 +649,13 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingAsyncBindErrorAfterStateSucceeded(t, updatedBinding, errorInjectingBindResultReason, originalBinding)
+				assertServiceBindingAsyncBindErrorAfterStateSucceeded(t, updatedBinding, v1beta1.ReasonErrorInjectingBindResult, originalBinding)
 			},
 			shouldFinishPolling: true, // should not be requeued in polling queue; will drop back to default rate limiting
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorInjectingBindResultReason + " " + `Error injecting bind results: Secret "test-ns/test-binding" is not owned by ServiceBinding, controllerRef: nil`,
-				corev1.EventTypeWarning + " " + errorInjectingBindResultReason + " " + `Error injecting bind results: Secret "test-ns/test-binding" is not owned by ServiceBinding, controllerRef: nil`,
-				corev1.EventTypeWarning + " " + errorServiceBindingOrphanMitigation + " " + "Starting orphan mitigation",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorInjectingBindResult + " " + `Error injecting bind results: Secret "test-ns/test-binding" is not owned by ServiceBinding, controllerRef: nil`,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorInjectingBindResult + " " + `Error injecting bind results: Secret "test-ns/test-binding" is not owned by ServiceBinding, controllerRef: nil`,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonServiceBindingNeedsOrphanMitigation + " " + "Starting orphan mitigation",
 			},
 		},
 		{
@@ -697,7 +697,7 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertServiceBindingOperationSuccess(t, updatedBinding, v1beta1.ServiceBindingOperationBind, originalBinding)
 			},
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + successInjectedBindResultReason + " " + successInjectedBindResultMessage},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonInjectedBindResult + " " + successInjectedBindResultMessage},
 		},
 		// Unbind as part of deletion
 		{
@@ -718,7 +718,7 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertServiceBindingOperationSuccess(t, updatedBinding, v1beta1.ServiceBindingOperationUnbind, originalBinding)
 			},
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + successUnboundReason + " " + "The binding was deleted successfully"},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonUnboundSuccessfully + " " + "The binding was deleted successfully"},
 		},
 		{
 			name:    "unbind - 410 Gone considered succeeded",
@@ -737,7 +737,7 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertServiceBindingOperationSuccess(t, updatedBinding, v1beta1.ServiceBindingOperationUnbind, originalBinding)
 			},
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + successUnboundReason + " " + "The binding was deleted successfully"},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonUnboundSuccessfully + " " + "The binding was deleted successfully"},
 		},
 		{
 			name:    "unbind - in progress",
@@ -753,10 +753,10 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationUnbind, asyncUnbindingReason, testOperation, originalBinding)
+				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationUnbind, v1beta1.ReasonUnbinding, testOperation, originalBinding)
 			},
 			shouldFinishPolling: false,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + asyncUnbindingReason + " " + "The binding is being deleted asynchronously (testdescr)"},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonUnbinding + " " + "The binding is being deleted asynchronously (testdescr)"},
 		},
 		{
 			name:    "unbind - error",
@@ -767,7 +767,7 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 			validateBrokerActionsFunc:  validatePollBindingLastOperationAction,
 			assertPerfomerdActionsFunc: nil, // does not update resources
 			shouldFinishPolling:        false,
-			expectedEvents:             []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			expectedEvents:             []string{corev1.EventTypeWarning + " " + v1beta1.ReasonErrorPollingLastOperation + " " + "Error polling last operation: random error"},
 		},
 		{
 			name:    "unbind - failed (retries)",
@@ -787,13 +787,13 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 					t,
 					updatedBinding,
 					v1beta1.ServiceBindingOperationUnbind,
-					errorUnbindCallReason,
+					v1beta1.ReasonUnbindCallFailed,
 					originalBinding,
 				)
 			},
 			shouldError:         true,
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorUnbindCallReason + " " + "Unbind call failed: " + lastOperationDescription},
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + v1beta1.ReasonUnbindCallFailed + " " + "Unbind call failed: " + lastOperationDescription},
 		},
 		{
 			name:    "unbind - invalid state",
@@ -827,15 +827,15 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 					t,
 					updatedBinding,
 					v1beta1.ServiceBindingOperationUnbind,
-					errorAsyncOpTimeoutReason,
-					errorReconciliationRetryTimeoutReason,
+					v1beta1.ReasonAsyncOperationTimeout,
+					v1beta1.ReasonErrorReconciliationRetryTimeout,
 					originalBinding,
 				)
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Unbind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason + " " + "Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Unbind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout + " " + "Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 		{
@@ -856,15 +856,15 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 					t,
 					updatedBinding,
 					v1beta1.ServiceBindingOperationUnbind,
-					errorAsyncOpTimeoutReason,
-					errorReconciliationRetryTimeoutReason,
+					v1beta1.ReasonAsyncOperationTimeout,
+					v1beta1.ReasonErrorReconciliationRetryTimeout,
 					originalBinding,
 				)
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Unbind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason + " " + "Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Unbind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout + " " + "Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 		{
@@ -885,15 +885,15 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 					t,
 					updatedBinding,
 					v1beta1.ServiceBindingOperationUnbind,
-					errorUnbindCallReason,
-					errorReconciliationRetryTimeoutReason,
+					v1beta1.ReasonUnbindCallFailed,
+					v1beta1.ReasonErrorReconciliationRetryTimeout,
 					originalBinding,
 				)
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorUnbindCallReason + " " + "Unbind call failed: " + lastOperationDescription,
-				corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason + " " + "Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonUnbindCallFailed + " " + "Unbind call failed: " + lastOperationDescription,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout + " " + "Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 		// Unbind as part of orphan mitigation
@@ -914,7 +914,7 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertServiceBindingOrphanMitigationSuccess(t, updatedBinding, originalBinding)
 			},
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + successOrphanMitigationReason + " " + successOrphanMitigationMessage},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonOrphanMitigationSuccessful + " " + successOrphanMitigationMessage},
 		},
 		{
 			name:    "orphan mitigation - 410 Gone considered succeeded",
@@ -932,7 +932,7 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertServiceBindingOrphanMitigationSuccess(t, updatedBinding, originalBinding)
 			},
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + successOrphanMitigationReason + " " + successOrphanMitigationMessage},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonOrphanMitigationSuccessful + " " + successOrphanMitigationMessage},
 		},
 		{
 			name:    "orphan mitigation - in progress",
@@ -948,10 +948,10 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationBind, asyncUnbindingReason, testOperation, originalBinding)
+				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonUnbinding, testOperation, originalBinding)
 			},
 			shouldFinishPolling: false,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + asyncUnbindingReason + " " + "The binding is being deleted asynchronously (testdescr)"},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonUnbinding + " " + "The binding is being deleted asynchronously (testdescr)"},
 		},
 		{
 			name:    "orphan mitigation - error",
@@ -962,7 +962,7 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 			validateBrokerActionsFunc:  validatePollBindingLastOperationAction,
 			assertPerfomerdActionsFunc: nil, // does not update resources
 			shouldFinishPolling:        false,
-			expectedEvents:             []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			expectedEvents:             []string{corev1.EventTypeWarning + " " + v1beta1.ReasonErrorPollingLastOperation + " " + "Error polling last operation: random error"},
 		},
 		{
 			name:    "orphan mitigation - failed (retries)",
@@ -978,11 +978,11 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingRequestRetriableOrphanMitigation(t, updatedBinding, errorUnbindCallReason, originalBinding)
+				assertServiceBindingRequestRetriableOrphanMitigation(t, updatedBinding, v1beta1.ReasonUnbindCallFailed, originalBinding)
 			},
 			shouldError:         true,
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorUnbindCallReason + " " + "Unbind call failed: " + lastOperationDescription},
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + v1beta1.ReasonUnbindCallFailed + " " + "Unbind call failed: " + lastOperationDescription},
 		},
 		{
 			name:    "orphan mitigation - invalid state",
@@ -1016,8 +1016,8 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Unbind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorOrphanMitigationFailedReason + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Unbind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonOrphanMitigationFailed + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 		{
@@ -1038,8 +1038,8 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Unbind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorOrphanMitigationFailedReason + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Unbind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonOrphanMitigationFailed + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 		{
@@ -1060,8 +1060,8 @@ func TestPollServiceBindingNamespacedRefs(t *testing.T) {
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorUnbindCallReason + " " + "Unbind call failed: " + lastOperationDescription,
-				corev1.EventTypeWarning + " " + errorOrphanMitigationFailedReason + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonUnbindCallFailed + " " + "Unbind call failed: " + lastOperationDescription,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonOrphanMitigationFailed + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 	}
@@ -1214,13 +1214,13 @@ func TestReconcileServiceBindingAsynchronousUnbindNamespacedRefs(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
-	assertServiceBindingAsyncInProgress(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, asyncUnbindingReason, testOperation, binding)
+	assertServiceBindingAsyncInProgress(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, v1beta1.ReasonUnbinding, testOperation, binding)
 
 	// Events
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := corev1.EventTypeNormal + " " + asyncUnbindingReason + " " + asyncUnbindingMessage
+	expectedEvent := corev1.EventTypeNormal + " " + v1beta1.ReasonUnbinding + " " + asyncUnbindingMessage
 	if e, a := expectedEvent, events[0]; e != a {
 		t.Fatalf("Received unexpected event, expected %v got %v", e, a)
 	}
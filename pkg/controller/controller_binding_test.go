@@ -38,6 +38,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
@@ -121,13 +122,13 @@ func TestReconcileServiceBindingNonExistingServiceInstance(t *testing.T) {
 
 	// There should only be one action that says it failed because no such instance exists.
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingErrorBeforeRequest(t, updatedServiceBinding, errorNonexistentServiceInstanceReason, binding)
+	assertServiceBindingErrorBeforeRequest(t, updatedServiceBinding, v1beta1.ReasonReferencesNonexistentInstance, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := warningEventBuilder(errorNonexistentServiceInstanceReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesNonexistentInstance).msgf(
 		"References a non-existent ServiceInstance %q",
 		"/"+testNonExistentClusterServiceClassName,
 	)
@@ -186,13 +187,13 @@ func TestReconcileServiceBindingUnresolvedClusterServiceClassReference(t *testin
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingReadyFalse(t, updatedServiceBinding, errorServiceInstanceRefsUnresolved)
+	assertServiceBindingReadyFalse(t, updatedServiceBinding, v1beta1.ReasonErrorInstanceRefsUnresolved)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := warningEventBuilder(errorServiceInstanceRefsUnresolved).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorInstanceRefsUnresolved).msgf(
 		"Binding cannot begin because ClusterServiceClass and ClusterServicePlan references for ServiceInstance \"%s/%s\" have not been resolved yet",
 		binding.Namespace, binding.Spec.InstanceRef.Name,
 	)
@@ -253,13 +254,13 @@ func TestReconcileServiceBindingUnresolvedClusterServicePlanReference(t *testing
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingReadyFalse(t, updatedServiceBinding, errorServiceInstanceRefsUnresolved)
+	assertServiceBindingReadyFalse(t, updatedServiceBinding, v1beta1.ReasonErrorInstanceRefsUnresolved)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := warningEventBuilder(errorServiceInstanceRefsUnresolved).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorInstanceRefsUnresolved).msgf(
 		"Binding cannot begin because ClusterServiceClass and ClusterServicePlan references for ServiceInstance \"%s/%s\" have not been resolved yet",
 		binding.Namespace, binding.Spec.InstanceRef.Name,
 	)
@@ -411,7 +412,7 @@ func TestReconcileServiceBindingWithSecretConflict(t *testing.T) {
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
 
-	assertServiceBindingReadyFalse(t, updatedServiceBinding, errorInjectingBindResultReason)
+	assertServiceBindingReadyFalse(t, updatedServiceBinding, v1beta1.ReasonErrorInjectingBindResult)
 	assertServiceBindingCurrentOperation(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind)
 	assertServiceBindingOperationStartTimeSet(t, updatedServiceBinding, true)
 	assertServiceBindingReconciledGeneration(t, updatedServiceBinding, binding.Status.ReconciledGeneration)
@@ -428,13 +429,1395 @@ func TestReconcileServiceBindingWithSecretConflict(t *testing.T) {
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := warningEventBuilder(errorInjectingBindResultReason)
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorInjectingBindResult)
 
 	if err := checkEventPrefixes(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
 }
 
+// TestReconcileServiceBindingWithSecretWriteFailure tests that when the
+// broker bind succeeds but writing the Secret fails, the next reconciliation
+// writes the Secret using the credentials already received from the broker
+// instead of sending another bind request.
+func TestReconcileServiceBindingWithSecretWriteFailure(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+					"c": "d",
+				},
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+	secretCreateFails := true
+	fakeKubeClient.AddReactor("create", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		if secretCreateFails {
+			return true, nil, errors.New("apiserver unavailable")
+		}
+		return true, action.(clientgotesting.CreateAction).GetObject(), nil
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	startTime := metav1.Now()
+	binding := &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testServiceBindingName,
+			Namespace:  testNamespace,
+			Generation: 1,
+			UID:        testServiceBindingGUID,
+		},
+		Spec: v1beta1.ServiceBindingSpec{
+			InstanceRef: v1beta1.LocalObjectReference{Name: testServiceInstanceName},
+			ExternalID:  testServiceBindingGUID,
+			SecretName:  testServiceBindingSecretName,
+		},
+		Status: v1beta1.ServiceBindingStatus{
+			CurrentOperation:     v1beta1.ServiceBindingOperationBind,
+			OperationStartTime:   &startTime,
+			UnbindStatus:         v1beta1.ServiceBindingUnbindStatusRequired,
+			InProgressProperties: &v1beta1.ServiceBindingPropertiesState{},
+		},
+	}
+
+	if err := reconcileServiceBinding(t, testController, binding); err == nil {
+		t.Fatal("a binding should fail to create a secret")
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	assertServiceBindingReadyFalse(t, updatedServiceBinding, v1beta1.ReasonErrorInjectingBindResult)
+	assertServiceBindingCurrentOperation(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind)
+	assertServiceBindingSecretWritePending(t, updatedServiceBinding, true)
+	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
+
+	fakeCatalogClient.ClearActions()
+	fakeKubeClient.ClearActions()
+	secretCreateFails = false
+
+	if err := reconcileServiceBinding(t, testController, updatedServiceBinding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// no further bind request must have been sent to the broker
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 2)
+	assertActionEquals(t, kubeActions[0], "get", "secrets")
+	action := kubeActions[1].(clientgotesting.CreateAction)
+	assertActionEquals(t, action, "create", "secrets")
+	secret := action.GetObject().(*corev1.Secret)
+	if e, a := "b", string(secret.Data["a"]); e != a {
+		t.Fatalf("Unexpected value of secret key %q; %s", "a", expectedGot(e, a))
+	}
+
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding = assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	assertServiceBindingReadyTrue(t, updatedServiceBinding)
+	assertServiceBindingCurrentOperationClear(t, updatedServiceBinding)
+	assertServiceBindingSecretWritePending(t, updatedServiceBinding, false)
+
+	if _, found := testController.bindingCredentialsStore.Get(binding); found {
+		t.Fatal("credentials should be removed from the store once the secret is written")
+	}
+}
+
+// TestReconcileServiceBindingWithSecretWritePendingAndNoCredentials tests
+// that the bind request is sent again when the binding waits for its Secret
+// but the controller no longer holds the credentials (e.g. after a restart).
+func TestReconcileServiceBindingWithSecretWritePendingAndNoCredentials(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	startTime := metav1.Now()
+	binding := &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testServiceBindingName,
+			Namespace:  testNamespace,
+			Generation: 1,
+		},
+		Spec: v1beta1.ServiceBindingSpec{
+			InstanceRef: v1beta1.LocalObjectReference{Name: testServiceInstanceName},
+			ExternalID:  testServiceBindingGUID,
+			SecretName:  testServiceBindingSecretName,
+		},
+		Status: v1beta1.ServiceBindingStatus{
+			CurrentOperation:     v1beta1.ServiceBindingOperationBind,
+			OperationStartTime:   &startTime,
+			UnbindStatus:         v1beta1.ServiceBindingUnbindStatusRequired,
+			InProgressProperties: &v1beta1.ServiceBindingPropertiesState{},
+			SecretWritePending:   true,
+		},
+	}
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	assertServiceBindingReadyTrue(t, updatedServiceBinding)
+	assertServiceBindingSecretWritePending(t, updatedServiceBinding, false)
+}
+
+// getTestServiceBindingWithInProgressBind returns a binding which has
+// already recorded the start of a Bind operation.
+func getTestServiceBindingWithInProgressBind() *v1beta1.ServiceBinding {
+	startTime := metav1.Now()
+	return &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testServiceBindingName,
+			Namespace:  testNamespace,
+			Generation: 1,
+			UID:        testServiceBindingGUID,
+		},
+		Spec: v1beta1.ServiceBindingSpec{
+			InstanceRef: v1beta1.LocalObjectReference{Name: testServiceInstanceName},
+			ExternalID:  testServiceBindingGUID,
+			SecretName:  testServiceBindingSecretName,
+		},
+		Status: v1beta1.ServiceBindingStatus{
+			CurrentOperation:     v1beta1.ServiceBindingOperationBind,
+			OperationStartTime:   &startTime,
+			UnbindStatus:         v1beta1.ServiceBindingUnbindStatusRequired,
+			InProgressProperties: &v1beta1.ServiceBindingPropertiesState{},
+		},
+	}
+}
+
+// addGetSecretCopiesReaction makes the fake kube client return the given
+// secrets, keyed by namespace, and NotFound for any other namespace.
+func addGetSecretCopiesReaction(fakeKubeClient *clientgofake.Clientset, secrets map[string]*corev1.Secret) {
+	fakeKubeClient.AddReactor("get", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		if secret, ok := secrets[action.GetNamespace()]; ok {
+			return true, secret, nil
+		}
+		return true, nil, apierrors.NewNotFound(action.GetResource().GroupResource(), action.(clientgotesting.GetAction).GetName())
+	})
+}
+
+func getTestServiceBindingSecretCopy(namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testServiceBindingSecretName,
+			Namespace: namespace,
+			Labels:    map[string]string{bindingSecretCopyLabel: testServiceBindingGUID},
+		},
+	}
+}
+
+// TestReconcileServiceBindingWithAdditionalSecretNamespaces tests that the
+// binding's Secret is copied into the additional secret namespaces and that
+// copies in namespaces that are no longer listed are removed.
+func TestReconcileServiceBindingWithAdditionalSecretNamespaces(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretCopiesReaction(fakeKubeClient, map[string]*corev1.Secret{
+		"old-ns": getTestServiceBindingSecretCopy("old-ns"),
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBindingWithInProgressBind()
+	binding.Spec.AdditionalSecretNamespaces = []string{"ns-b", "ns-a"}
+	binding.Status.SecretCopyNamespaces = []string{"old-ns"}
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 9)
+	expectedActions := []struct {
+		verb      string
+		namespace string
+	}{
+		{"get", ""},
+		{"get", testNamespace},
+		{"create", testNamespace},
+		{"get", "ns-a"},
+		{"create", "ns-a"},
+		{"get", "ns-b"},
+		{"create", "ns-b"},
+		{"get", "old-ns"},
+		{"delete", "old-ns"},
+	}
+	for i, e := range expectedActions {
+		if e, a := e.verb, kubeActions[i].GetVerb(); e != a {
+			t.Fatalf("Unexpected verb on action %d; %s", i, expectedGot(e, a))
+		}
+		if e, a := e.namespace, kubeActions[i].GetNamespace(); e != a {
+			t.Fatalf("Unexpected namespace on action %d; %s", i, expectedGot(e, a))
+		}
+	}
+
+	secretCopy := kubeActions[4].(clientgotesting.CreateAction).GetObject().(*corev1.Secret)
+	if e, a := testServiceBindingGUID, secretCopy.Labels[bindingSecretCopyLabel]; e != a {
+		t.Fatalf("Unexpected label on secret copy; %s", expectedGot(e, a))
+	}
+	if e, a := "b", string(secretCopy.Data["a"]); e != a {
+		t.Fatalf("Unexpected value of secret copy key %q; %s", "a", expectedGot(e, a))
+	}
+	if len(secretCopy.OwnerReferences) != 0 {
+		t.Fatalf("Secret copy must not have owner references, got %v", secretCopy.OwnerReferences)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	assertServiceBindingReadyTrue(t, updatedServiceBinding)
+	if e, a := []string{"ns-a", "ns-b"}, updatedServiceBinding.Status.SecretCopyNamespaces; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected secret copy namespaces; %s", expectedGot(e, a))
+	}
+}
+
+// TestReconcileServiceBindingWithForbiddenSecretCopy tests that a binding
+// reports a dedicated condition reason when the controller is not allowed to
+// write a copy of the Secret into an additional namespace.
+func TestReconcileServiceBindingWithForbiddenSecretCopy(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretCopiesReaction(fakeKubeClient, nil)
+	fakeKubeClient.AddReactor("create", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "ns-a" {
+			return true, nil, apierrors.NewForbidden(action.GetResource().GroupResource(), testServiceBindingSecretName, errors.New("RBAC denied"))
+		}
+		return true, action.(clientgotesting.CreateAction).GetObject(), nil
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBindingWithInProgressBind()
+	binding.Spec.AdditionalSecretNamespaces = []string{"ns-a"}
+
+	if err := reconcileServiceBinding(t, testController, binding); err == nil {
+		t.Fatal("expected error writing the secret copy")
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	assertServiceBindingReadyFalse(t, updatedServiceBinding, v1beta1.ReasonSecretCopyForbidden)
+	assertServiceBindingSecretWritePending(t, updatedServiceBinding, true)
+	if e, a := []string{"ns-a"}, updatedServiceBinding.Status.SecretCopyNamespaces; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected secret copy namespaces; %s", expectedGot(e, a))
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := warningEventBuilder(v1beta1.ReasonSecretCopyForbidden)
+	if err := checkEventPrefixes(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceBindingSecretMetadata tests that the labels and
+// annotations of the binding's Secret are set when the Secret is created and
+// brought up to date when an existing Secret is rewritten.
+func TestReconcileServiceBindingSecretMetadata(t *testing.T) {
+	binding := getTestServiceBindingWithInProgressBind()
+	binding.Spec.SecretLabels = map[string]string{"app": "my-app"}
+	binding.Spec.SecretAnnotations = map[string]string{"example.com/owner": "team-a"}
+
+	expectedLabels := map[string]string{"app": "my-app"}
+	expectedAnnotations := map[string]string{
+		"example.com/owner":                       "team-a",
+		bindingSecretInstanceAnnotation:           testServiceInstanceName,
+		bindingSecretClassAnnotation:              "ClusterServiceClass/" + testClusterServiceClassName,
+		bindingSecretPlanAnnotation:               testClusterServicePlanName,
+		bindingSecretManagedLabelsAnnotation:      "app",
+		bindingSecretManagedAnnotationsAnnotation: "example.com/owner",
+	}
+
+	cases := []struct {
+		name                string
+		existingSecret      *corev1.Secret
+		verb                string
+		expectedLabels      map[string]string
+		expectedAnnotations map[string]string
+	}{
+		{
+			name:                "new secret",
+			verb:                "create",
+			expectedLabels:      expectedLabels,
+			expectedAnnotations: expectedAnnotations,
+		},
+		{
+			name: "existing secret",
+			existingSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            testServiceBindingSecretName,
+					Namespace:       testNamespace,
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)},
+					Labels:          map[string]string{"app": "old-app", "other": "label"},
+					Annotations: map[string]string{
+						"example.com/owner":         "team-b",
+						bindingSecretPlanAnnotation: "old-plan",
+					},
+				},
+			},
+			verb:                "update",
+			expectedLabels:      map[string]string{"app": "my-app", "other": "label"},
+			expectedAnnotations: expectedAnnotations,
+		},
+		{
+			name: "keys no longer specified by the binding",
+			existingSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            testServiceBindingSecretName,
+					Namespace:       testNamespace,
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)},
+					Labels:          map[string]string{"app": "my-app", "tier": "backend", "other": "label"},
+					Annotations: map[string]string{
+						"example.com/owner":                       "team-a",
+						"example.com/cost-center":                 "42",
+						"example.com/unmanaged":                   "kept",
+						bindingSecretManagedLabelsAnnotation:      "app,tier",
+						bindingSecretManagedAnnotationsAnnotation: "example.com/cost-center,example.com/owner",
+					},
+				},
+			},
+			verb:           "update",
+			expectedLabels: map[string]string{"app": "my-app", "other": "label"},
+			expectedAnnotations: map[string]string{
+				"example.com/owner":                       "team-a",
+				"example.com/unmanaged":                   "kept",
+				bindingSecretInstanceAnnotation:           testServiceInstanceName,
+				bindingSecretClassAnnotation:              "ClusterServiceClass/" + testClusterServiceClassName,
+				bindingSecretPlanAnnotation:               testClusterServicePlanName,
+				bindingSecretManagedLabelsAnnotation:      "app",
+				bindingSecretManagedAnnotationsAnnotation: "example.com/owner",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, _, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				BindReaction: &fakeosb.BindReaction{
+					Response: &osb.BindResponse{
+						Credentials: map[string]interface{}{
+							"a": "b",
+						},
+					},
+				},
+			})
+
+			addGetNamespaceReaction(fakeKubeClient)
+			secrets := map[string]*corev1.Secret{}
+			if tc.existingSecret != nil {
+				secrets[testNamespace] = tc.existingSecret
+			}
+			addGetSecretCopiesReaction(fakeKubeClient, secrets)
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			if err := reconcileServiceBinding(t, testController, binding.DeepCopy()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			kubeActions := fakeKubeClient.Actions()
+			assertNumberOfActions(t, kubeActions, 3)
+			if e, a := tc.verb, kubeActions[2].GetVerb(); e != a {
+				t.Fatalf("Unexpected verb on secret action; %s", expectedGot(e, a))
+			}
+
+			var secret *corev1.Secret
+			switch action := kubeActions[2].(type) {
+			case clientgotesting.CreateAction:
+				secret = action.GetObject().(*corev1.Secret)
+			case clientgotesting.UpdateAction:
+				secret = action.GetObject().(*corev1.Secret)
+			}
+			if e, a := tc.expectedLabels, secret.Labels; !reflect.DeepEqual(e, a) {
+				t.Fatalf("Unexpected secret labels; %s", expectedGot(e, a))
+			}
+			if _, ok := secret.Annotations[bindingSecretLastRotatedAnnotation]; !ok {
+				t.Fatalf("Expected the secret to be annotated with %q", bindingSecretLastRotatedAnnotation)
+			}
+			delete(secret.Annotations, bindingSecretLastRotatedAnnotation)
+			if e, a := tc.expectedAnnotations, secret.Annotations; !reflect.DeepEqual(e, a) {
+				t.Fatalf("Unexpected secret annotations; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestReconcileServiceBindingSecretLastRotated tests that the rotation time
+// recorded on the binding's Secret is updated when the credentials change and
+// kept when the Secret is rewritten with the same credentials.
+func TestReconcileServiceBindingSecretLastRotated(t *testing.T) {
+	const previousRotation = "2019-01-01T00:00:00Z"
+
+	cases := []struct {
+		name            string
+		existingData    map[string][]byte
+		expectedRotated bool
+	}{
+		{
+			name:            "credentials unchanged",
+			existingData:    map[string][]byte{"a": []byte("b")},
+			expectedRotated: false,
+		},
+		{
+			name:            "credentials changed",
+			existingData:    map[string][]byte{"a": []byte("old")},
+			expectedRotated: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, _, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				BindReaction: &fakeosb.BindReaction{
+					Response: &osb.BindResponse{
+						Credentials: map[string]interface{}{
+							"a": "b",
+						},
+					},
+				},
+			})
+
+			binding := getTestServiceBindingWithInProgressBind()
+
+			addGetNamespaceReaction(fakeKubeClient)
+			addGetSecretCopiesReaction(fakeKubeClient, map[string]*corev1.Secret{
+				testNamespace: {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            testServiceBindingSecretName,
+						Namespace:       testNamespace,
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)},
+						Annotations:     map[string]string{bindingSecretLastRotatedAnnotation: previousRotation},
+					},
+					Data: tc.existingData,
+				},
+			})
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			if err := reconcileServiceBinding(t, testController, binding); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			kubeActions := fakeKubeClient.Actions()
+			assertNumberOfActions(t, kubeActions, 3)
+			assertActionEquals(t, kubeActions[2], "update", "secrets")
+			secret := kubeActions[2].(clientgotesting.UpdateAction).GetObject().(*corev1.Secret)
+
+			lastRotated := secret.Annotations[bindingSecretLastRotatedAnnotation]
+			if rotated := lastRotated != previousRotation; rotated != tc.expectedRotated {
+				t.Fatalf("Unexpected rotation time %q; previous rotation time was %q", lastRotated, previousRotation)
+			}
+			if _, err := time.Parse(time.RFC3339, lastRotated); err != nil {
+				t.Fatalf("Rotation time %q is not an RFC 3339 time: %v", lastRotated, err)
+			}
+		})
+	}
+}
+
+// TestReconcileServiceBindingUnchangedSecret tests that the binding's Secret
+// is not written when it already holds the credentials and metadata the
+// reconcile would set, so that its resourceVersion is not bumped.
+func TestReconcileServiceBindingUnchangedSecret(t *testing.T) {
+	fakeKubeClient, _, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+
+	binding := getTestServiceBindingWithInProgressBind()
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretCopiesReaction(fakeKubeClient, map[string]*corev1.Secret{
+		testNamespace: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            testServiceBindingSecretName,
+				Namespace:       testNamespace,
+				ResourceVersion: "1",
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)},
+				Annotations: map[string]string{
+					bindingSecretInstanceAnnotation:    testServiceInstanceName,
+					bindingSecretClassAnnotation:       "ClusterServiceClass/" + testClusterServiceClassName,
+					bindingSecretPlanAnnotation:        testClusterServicePlanName,
+					bindingSecretLastRotatedAnnotation: "2019-01-01T00:00:00Z",
+				},
+			},
+			Data: map[string][]byte{"a": []byte("b")},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := checkKubeClientActions(fakeKubeClient.Actions(), []kubeClientAction{
+		{verb: "get", resourceName: "namespaces", checkType: checkGetActionType},
+		{verb: "get", resourceName: "secrets", checkType: checkGetActionType},
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestHealServiceBindingSecrets tests that the startup healing pass fetches
+// the ready bindings of brokers advertising bindings_retrievable and rewrites
+// the Secrets which no longer match their credentials.
+func TestHealServiceBindingSecrets(t *testing.T) {
+	fakeKubeClient, _, fakeBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		GetBindingReaction: &fakeosb.GetBindingReaction{
+			Response: &osb.GetBindingResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+
+	binding := getTestServiceBinding()
+	binding.Status.Conditions = []v1beta1.ServiceBindingCondition{{
+		Type:   v1beta1.ServiceBindingConditionReady,
+		Status: v1beta1.ConditionTrue,
+	}}
+
+	addGetSecretCopiesReaction(fakeKubeClient, map[string]*corev1.Secret{
+		testNamespace: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            testServiceBindingSecretName,
+				Namespace:       testNamespace,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)},
+			},
+			Data: map[string][]byte{"a": []byte("modified")},
+		},
+	})
+
+	inProgressBinding := getTestServiceBindingAsyncBinding(testOperation)
+	inProgressBinding.Name = "in-progress-binding"
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestBindingRetrievableClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(inProgressBinding)
+
+	testController.healServiceBindingSecrets(wait.NeverStop)
+
+	brokerActions := fakeBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertGetBinding(t, brokerActions[0], &osb.GetBindingRequest{
+		InstanceID: testServiceInstanceGUID,
+		BindingID:  testServiceBindingGUID,
+	})
+
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 2)
+	assertActionEquals(t, kubeActions[0], "get", "secrets")
+	assertActionEquals(t, kubeActions[1], "update", "secrets")
+	updatedSecret := kubeActions[1].(clientgotesting.UpdateAction).GetObject().(*corev1.Secret)
+	if e, a := "b", string(updatedSecret.Data["a"]); e != a {
+		t.Fatalf("unexpected secret value for key %q; expected %q, got %q", "a", e, a)
+	}
+}
+
+// TestHealServiceBindingSecretsSkipsChangedBindings tests that the startup
+// healing pass skips the bindings whose generation or status changed since
+// they were listed, which are reconciled by the workers instead.
+func TestHealServiceBindingSecretsSkipsChangedBindings(t *testing.T) {
+	cases := []struct {
+		name   string
+		change func(*v1beta1.ServiceBinding)
+	}{
+		{
+			name: "generation changed",
+			change: func(binding *v1beta1.ServiceBinding) {
+				binding.Generation++
+			},
+		},
+		{
+			name: "status changed",
+			change: func(binding *v1beta1.ServiceBinding) {
+				binding.Status.AsyncOpInProgress = true
+			},
+		},
+		{
+			name: "binding deleted",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sharedInformers v1beta1informers.Interface
+			first := getTestServiceBinding()
+			first.Status.Conditions = []v1beta1.ServiceBindingCondition{{
+				Type:   v1beta1.ServiceBindingConditionReady,
+				Status: v1beta1.ConditionTrue,
+			}}
+			second := first.DeepCopy()
+			second.Name = "second-binding"
+
+			fakeKubeClient, _, fakeBrokerClient, testController, informers := newTestController(t, fakeosb.FakeClientConfiguration{
+				GetBindingReaction: fakeosb.DynamicGetBindingReaction(func() (*osb.GetBindingResponse, error) {
+					// the bindings change while the first one listed is
+					// verified, so the other one must be skipped
+					store := sharedInformers.ServiceBindings().Informer().GetStore()
+					for _, obj := range store.List() {
+						if tc.change == nil {
+							store.Delete(obj)
+							continue
+						}
+						changed := obj.(*v1beta1.ServiceBinding).DeepCopy()
+						tc.change(changed)
+						store.Update(changed)
+					}
+					return &osb.GetBindingResponse{Credentials: map[string]interface{}{"a": "b"}}, nil
+				}),
+			})
+			sharedInformers = informers
+
+			addGetSecretCopiesReaction(fakeKubeClient, map[string]*corev1.Secret{
+				testNamespace: {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            testServiceBindingSecretName,
+						Namespace:       testNamespace,
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(first, bindingControllerKind)},
+					},
+					Data: map[string][]byte{"a": []byte("b")},
+				},
+			})
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestBindingRetrievableClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+			sharedInformers.ServiceBindings().Informer().GetStore().Add(first)
+			sharedInformers.ServiceBindings().Informer().GetStore().Add(second)
+
+			testController.healServiceBindingSecrets(wait.NeverStop)
+
+			assertNumberOfBrokerActions(t, fakeBrokerClient.Actions(), 1)
+		})
+	}
+}
+
+// TestReconcileServiceBindingVolumeMounts tests that the volume mounts
+// returned by the broker in a bind response are recorded in the status of the
+// binding, and that invalid volume mounts fail the binding.
+func TestReconcileServiceBindingVolumeMounts(t *testing.T) {
+	cases := []struct {
+		name                 string
+		volumeMounts         []interface{}
+		expectedVolumeMounts []v1beta1.ServiceBindingVolumeMount
+		expectedCondition    v1beta1.ServiceBindingConditionType
+		expectedReason       string
+	}{
+		{
+			name: "valid volume mounts",
+			volumeMounts: []interface{}{
+				map[string]interface{}{
+					"driver":        "cephdriver",
+					"container_dir": "/data/images",
+					"mode":          "r",
+					"device_type":   "shared",
+					"device": map[string]interface{}{
+						"volume_id":    "bc2c1eab-05b9-482d-b0cf-750ee07de311",
+						"mount_config": map[string]interface{}{"key": "value"},
+					},
+				},
+			},
+			expectedVolumeMounts: []v1beta1.ServiceBindingVolumeMount{
+				{
+					Driver:       "cephdriver",
+					ContainerDir: "/data/images",
+					Mode:         v1beta1.ServiceBindingVolumeMountModeReadOnly,
+					DeviceType:   v1beta1.ServiceBindingVolumeDeviceTypeShared,
+					Device: v1beta1.ServiceBindingVolumeDevice{
+						VolumeID:    "bc2c1eab-05b9-482d-b0cf-750ee07de311",
+						MountConfig: &runtime.RawExtension{Raw: []byte(`{"key":"value"}`)},
+					},
+				},
+			},
+			expectedCondition: v1beta1.ServiceBindingConditionReady,
+			expectedReason:    v1beta1.ReasonInjectedBindResult,
+		},
+		{
+			name: "invalid mode",
+			volumeMounts: []interface{}{
+				map[string]interface{}{
+					"driver":        "cephdriver",
+					"container_dir": "/data/images",
+					"mode":          "x",
+					"device_type":   "shared",
+					"device": map[string]interface{}{
+						"volume_id": "bc2c1eab-05b9-482d-b0cf-750ee07de311",
+					},
+				},
+			},
+			expectedCondition: v1beta1.ServiceBindingConditionFailed,
+			expectedReason:    v1beta1.ReasonInvalidVolumeMounts,
+		},
+		{
+			name: "missing volume id",
+			volumeMounts: []interface{}{
+				map[string]interface{}{
+					"driver":        "cephdriver",
+					"container_dir": "/data/images",
+					"mode":          "rw",
+					"device_type":   "shared",
+					"device":        map[string]interface{}{},
+				},
+			},
+			expectedCondition: v1beta1.ServiceBindingConditionFailed,
+			expectedReason:    v1beta1.ReasonInvalidVolumeMounts,
+		},
+		{
+			name:              "malformed volume mount",
+			volumeMounts:      []interface{}{"/data/images"},
+			expectedCondition: v1beta1.ServiceBindingConditionFailed,
+			expectedReason:    v1beta1.ReasonInvalidVolumeMounts,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				BindReaction: &fakeosb.BindReaction{
+					Response: &osb.BindResponse{
+						Credentials: map[string]interface{}{
+							"a": "b",
+						},
+						VolumeMounts: tc.volumeMounts,
+					},
+				},
+			})
+
+			addGetNamespaceReaction(fakeKubeClient)
+			addGetSecretNotFoundReaction(fakeKubeClient)
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			binding := getTestServiceBindingWithInProgressBind()
+			if err := reconcileServiceBinding(t, testController, binding); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			actions := fakeCatalogClient.Actions()
+			updatedObj := assertUpdateStatus(t, actions[len(actions)-1], binding)
+			assertServiceBindingCondition(t, updatedObj, tc.expectedCondition, v1beta1.ConditionTrue, tc.expectedReason)
+
+			updatedServiceBinding := updatedObj.(*v1beta1.ServiceBinding)
+			if e, a := tc.expectedVolumeMounts, updatedServiceBinding.Status.VolumeMounts; !reflect.DeepEqual(e, a) {
+				t.Fatalf("Unexpected volume mounts; %s", expectedGot(e, a))
+			}
+			// an invalid response is a terminal failure, which is not
+			// orphan-mitigated
+			if updatedServiceBinding.Status.OrphanMitigationInProgress {
+				t.Fatal("Expected no orphan mitigation to be started")
+			}
+		})
+	}
+}
+
+// TestReconcileServiceBindingRouteServiceURL tests that the route service URL
+// returned by the broker in a bind response is recorded in the status of the
+// binding, and that an invalid URL fails the binding.
+func TestReconcileServiceBindingRouteServiceURL(t *testing.T) {
+	cases := []struct {
+		name                    string
+		routeServiceURL         *string
+		expectedRouteServiceURL *string
+		expectedCondition       v1beta1.ServiceBindingConditionType
+		expectedReason          string
+	}{
+		{
+			name:              "no route service URL",
+			expectedCondition: v1beta1.ServiceBindingConditionReady,
+			expectedReason:    v1beta1.ReasonInjectedBindResult,
+		},
+		{
+			name:                    "valid route service URL",
+			routeServiceURL:         strPtr("https://route.example.com/proxy"),
+			expectedRouteServiceURL: strPtr("https://route.example.com/proxy"),
+			expectedCondition:       v1beta1.ServiceBindingConditionReady,
+			expectedReason:          v1beta1.ReasonInjectedBindResult,
+		},
+		{
+			name:              "invalid route service URL",
+			routeServiceURL:   strPtr("http://route.example.com/proxy"),
+			expectedCondition: v1beta1.ServiceBindingConditionFailed,
+			expectedReason:    v1beta1.ReasonInvalidRouteServiceURL,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				BindReaction: &fakeosb.BindReaction{
+					Response: &osb.BindResponse{
+						Credentials: map[string]interface{}{
+							"a": "b",
+						},
+						RouteServiceURL: tc.routeServiceURL,
+					},
+				},
+			})
+
+			addGetNamespaceReaction(fakeKubeClient)
+			addGetSecretNotFoundReaction(fakeKubeClient)
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			binding := getTestServiceBindingWithInProgressBind()
+			if err := reconcileServiceBinding(t, testController, binding); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			actions := fakeCatalogClient.Actions()
+			updatedObj := assertUpdateStatus(t, actions[len(actions)-1], binding)
+			assertServiceBindingCondition(t, updatedObj, tc.expectedCondition, v1beta1.ConditionTrue, tc.expectedReason)
+
+			updatedServiceBinding := updatedObj.(*v1beta1.ServiceBinding)
+			if e, a := tc.expectedRouteServiceURL, updatedServiceBinding.Status.RouteServiceURL; !reflect.DeepEqual(e, a) {
+				t.Fatalf("Unexpected route service URL; %s", expectedGot(e, a))
+			}
+			// an invalid response is a terminal failure, which is not
+			// orphan-mitigated
+			if updatedServiceBinding.Status.OrphanMitigationInProgress {
+				t.Fatal("Expected no orphan mitigation to be started")
+			}
+		})
+	}
+}
+
+func TestValidateRouteServiceURL(t *testing.T) {
+	cases := []struct {
+		name            string
+		routeServiceURL *string
+		valid           bool
+	}{
+		{name: "nil", valid: true},
+		{name: "https URL", routeServiceURL: strPtr("https://route.example.com:8443/proxy?x=1"), valid: true},
+		{name: "http URL", routeServiceURL: strPtr("http://route.example.com"), valid: false},
+		{name: "missing host", routeServiceURL: strPtr("https:///proxy"), valid: false},
+		{name: "relative URL", routeServiceURL: strPtr("/proxy"), valid: false},
+		{name: "unparseable URL", routeServiceURL: strPtr("https://route.example.com/%zz"), valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRouteServiceURL(tc.routeServiceURL)
+			if tc.valid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+// TestReconcileServiceBindingDeleteWithSecretCopies tests that the copies of
+// the binding's Secret are deleted together with the Secret on unbind.
+func TestReconcileServiceBindingDeleteWithSecretCopies(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		UnbindReaction: &fakeosb.UnbindReaction{
+			Response: &osb.UnbindResponse{},
+		},
+	})
+
+	addGetSecretCopiesReaction(fakeKubeClient, map[string]*corev1.Secret{
+		"ns-a": getTestServiceBindingSecretCopy("ns-a"),
+		"ns-b": getTestServiceBindingSecretCopy("ns-b"),
+		"ns-c": {ObjectMeta: metav1.ObjectMeta{Name: testServiceBindingSecretName, Namespace: "ns-c"}},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithRefsAndExternalProperties())
+
+	binding := &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              testServiceBindingName,
+			Namespace:         testNamespace,
+			UID:               testServiceBindingGUID,
+			DeletionTimestamp: &metav1.Time{},
+			Finalizers:        []string{v1beta1.FinalizerServiceCatalog},
+			Generation:        2,
+		},
+		Spec: v1beta1.ServiceBindingSpec{
+			InstanceRef:                v1beta1.LocalObjectReference{Name: testServiceInstanceName},
+			ExternalID:                 testServiceBindingGUID,
+			SecretName:                 testServiceBindingSecretName,
+			AdditionalSecretNamespaces: []string{"ns-a", "ns-c"},
+		},
+		Status: v1beta1.ServiceBindingStatus{
+			ReconciledGeneration: 1,
+			ExternalProperties:   &v1beta1.ServiceBindingPropertiesState{},
+			UnbindStatus:         v1beta1.ServiceBindingUnbindStatusRequired,
+			SecretCopyNamespaces: []string{"ns-a", "ns-b"},
+		},
+	}
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	kubeActions := fakeKubeClient.Actions()
+	var deletedNamespaces []string
+	for _, action := range kubeActions {
+		if action.GetVerb() == "delete" {
+			deletedNamespaces = append(deletedNamespaces, action.GetNamespace())
+		}
+	}
+	// the secret in ns-c is not a copy managed by the binding, so it must be kept
+	if e, a := []string{testNamespace, "ns-a", "ns-b"}, deletedNamespaces; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected namespaces of deleted secrets; %s", expectedGot(e, a))
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	if a := updatedServiceBinding.Status.SecretCopyNamespaces; a != nil {
+		t.Fatalf("Expected secret copy namespaces to be cleared, got %v", a)
+	}
+}
+
+// TestReconcileServiceBindingBrokerContextNamespacePrefix tests that the
+// namespace sent to the broker in the bind request context carries the
+// controller's broker context namespace prefix.
+func TestReconcileServiceBindingBrokerContextNamespacePrefix(t *testing.T) {
+	fakeKubeClient, _, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+	testController.brokerContextNamespacePrefix = "cluster-a-"
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	if err := reconcileServiceBinding(t, testController, getTestServiceBindingWithInProgressBind()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertBind(t, brokerActions[0], &osb.BindRequest{
+		BindingID:  testServiceBindingGUID,
+		InstanceID: testServiceInstanceGUID,
+		ServiceID:  testClusterServiceClassGUID,
+		PlanID:     testClusterServicePlanGUID,
+		AppGUID:    strPtr(testNamespaceGUID),
+		BindResource: &osb.BindResource{
+			AppGUID: strPtr(testNamespaceGUID),
+		},
+		Context: map[string]interface{}{
+			"platform":           ContextProfilePlatformKubernetes,
+			"namespace":          "cluster-a-" + testNamespace,
+			clusterIdentifierKey: testClusterID,
+		},
+	})
+}
+
+// TestReconcileServiceBindingBrokerContextPlatform tests that the platform
+// sent to the broker in the bind request context is the controller's broker
+// context platform.
+func TestReconcileServiceBindingBrokerContextPlatform(t *testing.T) {
+	fakeKubeClient, _, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+	testController.brokerContextPlatform = "example-platform"
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	if err := reconcileServiceBinding(t, testController, getTestServiceBindingWithInProgressBind()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertBind(t, brokerActions[0], &osb.BindRequest{
+		BindingID:  testServiceBindingGUID,
+		InstanceID: testServiceInstanceGUID,
+		ServiceID:  testClusterServiceClassGUID,
+		PlanID:     testClusterServicePlanGUID,
+		AppGUID:    strPtr(testNamespaceGUID),
+		BindResource: &osb.BindResource{
+			AppGUID: strPtr(testNamespaceGUID),
+		},
+		Context: map[string]interface{}{
+			"platform":           "example-platform",
+			"namespace":          testNamespace,
+			clusterIdentifierKey: testClusterID,
+		},
+	})
+}
+
+// TestReconcileServiceBindingEndpoint tests that the endpoint selected by a
+// binding is sent to the broker in the bind request context.
+func TestReconcileServiceBindingEndpoint(t *testing.T) {
+	fakeKubeClient, _, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBindingWithInProgressBind()
+	binding.Spec.Endpoint = "read-replica"
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertBind(t, brokerActions[0], &osb.BindRequest{
+		BindingID:  testServiceBindingGUID,
+		InstanceID: testServiceInstanceGUID,
+		ServiceID:  testClusterServiceClassGUID,
+		PlanID:     testClusterServicePlanGUID,
+		AppGUID:    strPtr(testNamespaceGUID),
+		BindResource: &osb.BindResource{
+			AppGUID: strPtr(testNamespaceGUID),
+		},
+		Context: map[string]interface{}{
+			"platform":           ContextProfilePlatformKubernetes,
+			"namespace":          testNamespace,
+			clusterIdentifierKey: testClusterID,
+			"endpoint":           "read-replica",
+		},
+	})
+}
+
+// TestReconcileServiceBindingClearsCredentialsStale tests that binding again
+// a binding whose credentials were marked as stale removes the condition.
+func TestReconcileServiceBindingClearsCredentialsStale(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBindingWithInProgressBind()
+	setServiceBindingCredentialsStaleCondition(binding, "The plan of the instance changed", metav1.Now())
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+
+	actions := fakeCatalogClient.Actions()
+	updatedBinding := assertUpdateStatus(t, actions[len(actions)-1], binding)
+	assertServiceBindingReadyTrue(t, updatedBinding)
+	assertServiceBindingConditionMissing(t, updatedBinding, v1beta1.ServiceBindingConditionCredentialsStale)
+}
+
+// TestReconcileServiceBindingInstancePaused tests that a binding whose
+// instance is paused is not reconciled, and that the pause is only reported
+// once.
+func TestReconcileServiceBindingInstancePaused(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	instance := getTestServiceInstanceWithStatus(v1beta1.ConditionTrue)
+	instance.Annotations = map[string]string{v1beta1.ServiceInstancePausedAnnotation: "true"}
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBinding()
+
+	for i := 0; i < 2; i++ {
+		if err := reconcileServiceBinding(t, testController, binding); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := normalEventBuilder(v1beta1.ReasonInstancePaused).msgf(
+		"ServiceInstance %q is paused; the binding will not be reconciled until it is resumed",
+		testServiceInstanceName,
+	)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceBindingRecreatesDeletedSecret tests that deleting the
+// Secret of a ready binding enqueues the binding, and that reconciling it
+// sends the bind request again and writes a new Secret.
+func TestReconcileServiceBindingRecreatesDeletedSecret(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBindingWithInProgressBind()
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	kubeActions := fakeKubeClient.Actions()
+	secret := kubeActions[len(kubeActions)-1].(clientgotesting.CreateAction).GetObject().(*corev1.Secret)
+	actions := fakeCatalogClient.Actions()
+	readyBinding := assertUpdateStatus(t, actions[len(actions)-1], binding).(*v1beta1.ServiceBinding)
+	assertServiceBindingReadyTrue(t, readyBinding)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(readyBinding)
+
+	getRecordedEvents(testController)
+	fakeCatalogClient.ClearActions()
+	fakeKubeClient.ClearActions()
+
+	// Without the Secret being deleted, there is nothing to do.
+	if err := reconcileServiceBinding(t, testController, readyBinding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	testController.secretDelete(secret)
+	if e, a := 1, testController.bindingQueue.Len(); e != a {
+		t.Fatalf("Unexpected number of queued bindings; %s", expectedGot(e, a))
+	}
+
+	if err := reconcileServiceBinding(t, testController, readyBinding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
+	assertServiceBindingReconciledGeneration(t, updatedBinding, 0)
+
+	events := getRecordedEvents(testController)
+	expectedEvent := warningEventBuilder(v1beta1.ReasonSecretDeleted).msgf(
+		`Secret "%s/%s" has been deleted; recreating it`,
+		testNamespace, testServiceBindingSecretName,
+	)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The bind operation is recorded first and the bind request is sent
+	// on the next reconciliation.
+	for i := 0; i < 2; i++ {
+		fakeCatalogClient.ClearActions()
+		fakeKubeClient.ClearActions()
+
+		if err := reconcileServiceBinding(t, testController, updatedBinding); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		actions = fakeCatalogClient.Actions()
+		updatedBinding = assertUpdateStatus(t, actions[len(actions)-1], binding).(*v1beta1.ServiceBinding)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 2)
+	kubeActions = fakeKubeClient.Actions()
+	assertActionEquals(t, kubeActions[len(kubeActions)-1], "create", "secrets")
+	assertServiceBindingReadyTrue(t, updatedBinding)
+}
+
+// TestReconcileServiceBindingRecreatesDeletedSecretFromRetrievableBinding
+// tests that the deleted Secret of a ready binding whose broker advertises
+// bindings_retrievable is written again with the credentials fetched from
+// the broker, without binding again.
+func TestReconcileServiceBindingRecreatesDeletedSecretFromRetrievableBinding(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		GetBindingReaction: &fakeosb.GetBindingReaction{
+			Response: &osb.GetBindingResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestBindingRetrievableClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := getTestServiceBinding()
+	binding.Status.ReconciledGeneration = binding.Generation
+	binding.Status.Conditions = []v1beta1.ServiceBindingCondition{{
+		Type:   v1beta1.ServiceBindingConditionReady,
+		Status: v1beta1.ConditionTrue,
+	}}
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+
+	testController.secretDelete(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            testServiceBindingSecretName,
+			Namespace:       testNamespace,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(binding, bindingControllerKind)},
+		},
+	})
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertGetBinding(t, brokerActions[0], &osb.GetBindingRequest{
+		InstanceID: testServiceInstanceGUID,
+		BindingID:  testServiceBindingGUID,
+	})
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	kubeActions := fakeKubeClient.Actions()
+	createdSecret := kubeActions[len(kubeActions)-1].(clientgotesting.CreateAction).GetObject().(*corev1.Secret)
+	if e, a := "b", string(createdSecret.Data["a"]); e != a {
+		t.Fatalf("unexpected secret value for key %q; %s", "a", expectedGot(e, a))
+	}
+}
+
 // TestReconcileBindingWithParameters tests reconcileBinding to ensure a
 // binding with parameters will be passed to the broker properly.
 func TestReconcileServiceBindingWithParameters(t *testing.T) {
@@ -576,7 +1959,7 @@ func TestReconcileServiceBindingWithParameters(t *testing.T) {
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := normalEventBuilder(successInjectedBindResultReason).msg(successInjectedBindResultMessage)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonInjectedBindResult).msg(successInjectedBindResultMessage)
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -707,7 +2090,7 @@ func TestReconcileServiceBindingWithSecretTransform(t *testing.T) {
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := normalEventBuilder(successInjectedBindResultReason).msg(successInjectedBindResultMessage)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonInjectedBindResult).msg(successInjectedBindResultMessage)
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -752,13 +2135,13 @@ func TestReconcileServiceBindingNonbindableClusterServiceClass(t *testing.T) {
 
 	// There should only be one action that says binding was created
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingFailedBeforeRequest(t, updatedServiceBinding, errorNonbindableClusterServiceClassReason, binding)
+	assertServiceBindingFailedBeforeRequest(t, updatedServiceBinding, v1beta1.ReasonErrorNonbindableServiceClass, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 	assertServiceBindingReconciledGeneration(t, updatedServiceBinding, binding.Generation)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorNonbindableClusterServiceClassReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorNonbindableServiceClass).msgf(
 		"References a non-bindable ClusterServiceClass (K8S: %q ExternalName: %q) and Plan (%q) combination",
 		"unbindable-clusterserviceclass", "test-unbindable-clusterserviceclass", "test-unbindable-clusterserviceplan",
 	).String()
@@ -768,6 +2151,59 @@ func TestReconcileServiceBindingNonbindableClusterServiceClass(t *testing.T) {
 	}
 }
 
+// TestReconcileServiceBindingNonbindableClusterServiceClassAllowed tests that
+// a bind request is sent for an instance of a non-bindable plan when the
+// controller allows binding to non-bindable plans.
+func TestReconcileServiceBindingNonbindableClusterServiceClassAllowed(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Response: &osb.BindResponse{
+				Credentials: map[string]interface{}{
+					"a": "b",
+				},
+			},
+		},
+	})
+	testController.allowBindToNonBindablePlans = true
+
+	addGetNamespaceReaction(fakeKubeClient)
+	addGetSecretNotFoundReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestNonbindableClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(func() *v1beta1.ServiceInstance {
+		i := getTestNonbindableServiceInstance()
+		i.Status = v1beta1.ServiceInstanceStatus{
+			Conditions: []v1beta1.ServiceInstanceCondition{
+				{
+					Type:   v1beta1.ServiceInstanceConditionReady,
+					Status: v1beta1.ConditionTrue,
+				},
+			},
+		}
+		return i
+	}())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlanNonbindable())
+
+	binding := getTestServiceBinding()
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binding = assertServiceBindingBindInProgressIsTheOnlyCatalogAction(t, fakeCatalogClient, binding)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	if e, a := fakeosb.Bind, brokerActions[0].Type; e != a {
+		t.Fatalf("unexpected broker action; expected %v, got %v", e, a)
+	}
+}
+
 // TestReconcileBindingNonbindableClusterServiceClassBindablePlan tests reconcileBinding
 // to ensure a binding for an instance that references a non-bindable service
 // class and a bindable plan fails as expected.
@@ -928,13 +2364,13 @@ func TestReconcileServiceBindingBindableClusterServiceClassNonbindablePlan(t *te
 
 	// There should only be one action that says binding was created
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingFailedBeforeRequest(t, updatedServiceBinding, errorNonbindableClusterServiceClassReason, binding)
+	assertServiceBindingFailedBeforeRequest(t, updatedServiceBinding, v1beta1.ReasonErrorNonbindableServiceClass, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 2)
 
-	expectedEvent := warningEventBuilder(errorNonbindableClusterServiceClassReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorNonbindableServiceClass).msgf(
 		"References a non-bindable ClusterServiceClass (K8S: %q ExternalName: %q) and Plan (%q) combination",
 		"cscguid", "test-clusterserviceclass", "test-unbindable-clusterserviceplan",
 	).String()
@@ -987,13 +2423,13 @@ func TestReconcileServiceBindingServiceInstanceNotReady(t *testing.T) {
 
 	// There should only be one action that says binding was created
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingErrorBeforeRequest(t, updatedServiceBinding, errorServiceInstanceNotReadyReason, binding)
+	assertServiceBindingErrorBeforeRequest(t, updatedServiceBinding, v1beta1.ReasonErrorInstanceNotReady, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := warningEventBuilder(errorServiceInstanceNotReadyReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorInstanceNotReady).msgf(
 		"Binding cannot begin because referenced ServiceInstance %q is not ready",
 		"test-ns/test-instance",
 	)
@@ -1047,13 +2483,13 @@ func TestReconcileServiceBindingNamespaceError(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingErrorBeforeRequest(t, updatedServiceBinding, errorFindingNamespaceServiceInstanceReason, binding)
+	assertServiceBindingErrorBeforeRequest(t, updatedServiceBinding, v1beta1.ReasonErrorFindingNamespaceForInstance, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := warningEventBuilder(errorFindingNamespaceServiceInstanceReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorFindingNamespaceForInstance).msgf(
 		"Failed to get namespace %q during binding: %s",
 		"test-ns", "No namespace",
 	)
@@ -1201,7 +2637,7 @@ func TestReconcileServiceBindingDelete(t *testing.T) {
 
 			events := getRecordedEvents(testController)
 
-			expectedEvent := normalEventBuilder(successUnboundReason)
+			expectedEvent := normalEventBuilder(v1beta1.ReasonUnboundSuccessfully)
 			if err := checkEventPrefixes(events, expectedEvent.stringArr()); err != nil {
 				t.Fatal(err)
 			}
@@ -1494,7 +2930,7 @@ func TestReconcileServiceBindingDeleteFailedServiceBinding(t *testing.T) {
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := normalEventBuilder(successUnboundReason)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonUnboundSuccessfully)
 	if err := checkEventPrefixes(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -1551,12 +2987,12 @@ func TestReconcileServiceBindingWithClusterServiceBrokerError(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingRequestRetriableError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, errorBindCallReason, binding)
+	assertServiceBindingRequestRetriableError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonBindCallFailed, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorBindCallReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonBindCallFailed).msgf(
 		"Error creating ServiceBinding for ServiceInstance %q of ClusterServiceClass (K8S: %q ExternalName: %q) at ClusterServiceBroker %q:",
 		"test-ns/test-instance", "cscguid", "test-clusterserviceclass", "test-clusterservicebroker",
 	).msg("Unexpected action")
@@ -1617,13 +3053,13 @@ func TestReconcileServiceBindingWithClusterServiceBrokerHTTPError(t *testing.T)
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingRequestFailingError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, errorBindCallReason, "ServiceBindingReturnedFailure", binding)
+	assertServiceBindingRequestFailingError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonBindCallFailed, "ServiceBindingReturnedFailure", binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 
 	expectedEvents := []string{
-		warningEventBuilder(errorBindCallReason).String(),
+		warningEventBuilder(v1beta1.ReasonBindCallFailed).String(),
 		warningEventBuilder("ServiceBindingReturnedFailure").String(),
 	}
 
@@ -1632,6 +3068,83 @@ func TestReconcileServiceBindingWithClusterServiceBrokerHTTPError(t *testing.T)
 	}
 }
 
+// TestReconcileServiceBindingWithRequiresAppError tests reconcileServiceBinding
+// to ensure a RequiresApp error from the broker is reported with its own
+// reason and a message telling the user how to resolve it.
+func TestReconcileServiceBindingWithRequiresAppError(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		BindReaction: &fakeosb.BindReaction{
+			Error: fakeosb.AppGUIDRequiredError(),
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithStatus(v1beta1.ConditionTrue))
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	binding := &v1beta1.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testServiceBindingName,
+			Namespace:  testNamespace,
+			Finalizers: []string{v1beta1.FinalizerServiceCatalog},
+			Generation: 1,
+		},
+		Spec: v1beta1.ServiceBindingSpec{
+			InstanceRef: v1beta1.LocalObjectReference{Name: testServiceInstanceName},
+			ExternalID:  testServiceBindingGUID,
+			SecretName:  testServiceBindingSecretName,
+		},
+		Status: v1beta1.ServiceBindingStatus{
+			UnbindStatus: v1beta1.ServiceBindingUnbindStatusNotRequired,
+		},
+	}
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binding = assertServiceBindingBindInProgressIsTheOnlyCatalogAction(t, fakeCatalogClient, binding)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceBinding(t, testController, binding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
+	assertServiceBindingRequestFailingError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonBindRequiresApp, v1beta1.ReasonBindRequiresApp, binding)
+	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
+
+	var readyCond *v1beta1.ServiceBindingCondition
+	for i, condition := range updatedServiceBinding.(*v1beta1.ServiceBinding).Status.Conditions {
+		if condition.Type == v1beta1.ServiceBindingConditionReady {
+			readyCond = &updatedServiceBinding.(*v1beta1.ServiceBinding).Status.Conditions[i]
+		}
+	}
+	if readyCond == nil {
+		t.Fatal("expected the binding to have a Ready condition")
+	}
+	if e, a := v1beta1.ErrorCodeBrokerRequiresApp, readyCond.ErrorCode; e != a {
+		t.Fatalf("unexpected error code: expected %q, got %q", e, a)
+	}
+	if !strings.Contains(readyCond.Message, "binding parameters") {
+		t.Fatalf("expected the condition message to tell how to supply the application, got %q", readyCond.Message)
+	}
+
+	events := getRecordedEvents(testController)
+
+	expectedEvents := []string{
+		warningEventBuilder(v1beta1.ReasonBindRequiresApp).String(),
+		warningEventBuilder(v1beta1.ReasonBindRequiresApp).String(),
+	}
+
+	if err := checkEventPrefixes(events, expectedEvents); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestReconcileServiceBindingWithFailureCondition tests reconcileServiceBinding to ensure
 // no processing is done on a binding containing a failed status.
 func TestReconcileServiceBindingWithFailureCondition(t *testing.T) {
@@ -1704,8 +3217,9 @@ func TestReconcileServiceBindingWithServiceBindingCallFailure(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingRequestRetriableError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, errorBindCallReason, binding)
+	assertServiceBindingRequestRetriableError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonBindCallFailed, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
+	assertServiceBindingConditionErrorCode(t, updatedServiceBinding, v1beta1.ServiceBindingConditionReady, v1beta1.ErrorCodeBrokerUnreachable)
 
 	brokerActions := fakeClusterServiceBrokerClient.Actions()
 	assertNumberOfBrokerActions(t, brokerActions, 1)
@@ -1723,7 +3237,7 @@ func TestReconcileServiceBindingWithServiceBindingCallFailure(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorBindCallReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonBindCallFailed).msgf(
 		"Error creating ServiceBinding for ServiceInstance %q of ClusterServiceClass (K8S: %q ExternalName: %q) at ClusterServiceBroker %q:",
 		"test-ns/test-instance", "cscguid", "test-clusterserviceclass", "test-clusterservicebroker",
 	).msg("fake creation failure")
@@ -1779,8 +3293,10 @@ func TestReconcileServiceBindingWithServiceBindingFailure(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingRequestFailingError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, errorBindCallReason, "ServiceBindingReturnedFailure", binding)
+	assertServiceBindingRequestFailingError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonBindCallFailed, "ServiceBindingReturnedFailure", binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
+	assertServiceBindingConditionErrorCode(t, updatedServiceBinding, v1beta1.ServiceBindingConditionReady, v1beta1.ErrorCodeBrokerConflict)
+	assertServiceBindingConditionErrorCode(t, updatedServiceBinding, v1beta1.ServiceBindingConditionFailed, v1beta1.ErrorCodeBrokerConflict)
 
 	brokerActions := fakeClusterServiceBrokerClient.Actions()
 	assertNumberOfBrokerActions(t, brokerActions, 1)
@@ -1799,7 +3315,7 @@ func TestReconcileServiceBindingWithServiceBindingFailure(t *testing.T) {
 	events := getRecordedEvents(testController)
 
 	expectedEvents := []string{
-		warningEventBuilder(errorBindCallReason).String(),
+		warningEventBuilder(v1beta1.ReasonBindCallFailed).String(),
 		warningEventBuilder("ServiceBindingReturnedFailure").String(),
 	}
 
@@ -2010,12 +3526,12 @@ func TestReconcileUnbindingWithClusterServiceBrokerError(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingRequestRetriableError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, errorUnbindCallReason, binding)
+	assertServiceBindingRequestRetriableError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, v1beta1.ReasonUnbindCallFailed, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorUnbindCallReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonUnbindCallFailed).msgf(
 		"Error unbinding from ServiceInstance %q of ClusterServiceClass (K8S: %q ExternalName: %q) at ClusterServiceBroker %q:",
 		"test-ns/test-instance", "cscguid", "test-clusterserviceclass", "test-clusterservicebroker",
 	).msg("Unexpected action")
@@ -2078,12 +3594,12 @@ func TestReconcileUnbindingWithClusterServiceBrokerHTTPError(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
-	assertServiceBindingRequestRetriableError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, errorUnbindCallReason, binding)
+	assertServiceBindingRequestRetriableError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, v1beta1.ReasonUnbindCallFailed, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorUnbindCallReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonUnbindCallFailed).msgf(
 		"Error unbinding from ServiceInstance %q of ClusterServiceClass (K8S: %q ExternalName: %q) at ClusterServiceBroker %q:",
 		"test-ns/test-instance", "cscguid", "test-clusterserviceclass", "test-clusterservicebroker",
 	).msg("Status: 410; ErrorMessage: <nil>; Description: <nil>; ResponseError: <nil>")
@@ -2263,7 +3779,7 @@ func TestReconcileBindingSuccessOnFinalRetry(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successInjectedBindResultReason).msg(successInjectedBindResultMessage)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonInjectedBindResult).msg(successInjectedBindResultMessage)
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -2302,14 +3818,14 @@ func TestReconcileBindingFailureOnFinalRetry(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
-	assertServiceBindingRequestFailingError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, errorBindCallReason, errorReconciliationRetryTimeoutReason, binding)
+	assertServiceBindingRequestFailingError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonBindCallFailed, v1beta1.ReasonErrorReconciliationRetryTimeout, binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 
 	expectedEventPrefixes := []string{
-		warningEventBuilder(errorBindCallReason).String(),
-		warningEventBuilder(errorReconciliationRetryTimeoutReason).String(),
+		warningEventBuilder(v1beta1.ReasonBindCallFailed).String(),
+		warningEventBuilder(v1beta1.ReasonErrorReconciliationRetryTimeout).String(),
 	}
 	if err := checkEventPrefixes(events, expectedEventPrefixes); err != nil {
 		t.Fatal(err)
@@ -2385,8 +3901,8 @@ func TestReconcileBindingWithSecretConflictFailedAfterFinalRetry(t *testing.T) {
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
 
-	assertServiceBindingCondition(t, updatedServiceBinding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, errorServiceBindingOrphanMitigation)
-	assertServiceBindingCondition(t, updatedServiceBinding, v1beta1.ServiceBindingConditionFailed, v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason)
+	assertServiceBindingCondition(t, updatedServiceBinding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonServiceBindingNeedsOrphanMitigation)
+	assertServiceBindingCondition(t, updatedServiceBinding, v1beta1.ServiceBindingConditionFailed, v1beta1.ConditionTrue, v1beta1.ReasonErrorReconciliationRetryTimeout)
 	assertServiceBindingStartingOrphanMitigation(t, updatedServiceBinding, binding)
 	assertServiceBindingExternalPropertiesParameters(t, updatedServiceBinding, nil, "")
 
@@ -2398,9 +3914,9 @@ func TestReconcileBindingWithSecretConflictFailedAfterFinalRetry(t *testing.T) {
 	events := getRecordedEvents(testController)
 
 	expectedEventPrefixes := []string{
-		warningEventBuilder(errorInjectingBindResultReason).String(),
-		warningEventBuilder(errorReconciliationRetryTimeoutReason).String(),
-		warningEventBuilder(errorServiceBindingOrphanMitigation).String(),
+		warningEventBuilder(v1beta1.ReasonErrorInjectingBindResult).String(),
+		warningEventBuilder(v1beta1.ReasonErrorReconciliationRetryTimeout).String(),
+		warningEventBuilder(v1beta1.ReasonServiceBindingNeedsOrphanMitigation).String(),
 	}
 	if err := checkEventPrefixes(events, expectedEventPrefixes); err != nil {
 		t.Fatal(err)
@@ -2590,7 +4106,7 @@ func TestReconcileServiceBindingWithSecretParameters(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successInjectedBindResultReason).msg(successInjectedBindResultMessage)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonInjectedBindResult).msg(successInjectedBindResultMessage)
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -2897,14 +4413,14 @@ func TestReconcileBindingWithOrphanMitigationReconciliationRetryTimeOut(t *testi
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
-	assertServiceBindingRequestFailingError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, errorOrphanMitigationFailedReason, "reason-orphan-mitigation-began", binding)
+	assertServiceBindingRequestFailingError(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, v1beta1.ReasonOrphanMitigationFailed, "reason-orphan-mitigation-began", binding)
 	assertServiceBindingOrphanMitigationSet(t, updatedServiceBinding, false)
 
 	events := getRecordedEvents(testController)
 
 	expectedEventPrefixes := []string{
-		warningEventBuilder(errorUnbindCallReason).String(),
-		warningEventBuilder(errorOrphanMitigationFailedReason).String(),
+		warningEventBuilder(v1beta1.ReasonUnbindCallFailed).String(),
+		warningEventBuilder(v1beta1.ReasonOrphanMitigationFailed).String(),
 	}
 
 	if err := checkEventPrefixes(events, expectedEventPrefixes); err != nil {
@@ -3003,7 +4519,7 @@ func TestReconcileServiceBindingDeleteDuringOngoingOperation(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successUnboundReason)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonUnboundSuccessfully)
 	if err := checkEventPrefixes(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -3100,12 +4616,82 @@ func TestReconcileServiceBindingDeleteDuringOrphanMitigation(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successUnboundReason)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonUnboundSuccessfully)
 	if err := checkEventPrefixes(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
 }
 
+// TestPrepareBindAndUnbindRequestAcceptsIncomplete tests that the bind and
+// unbind requests accept asynchronous operations only if the service class
+// advertises that its bindings can be fetched and asynchronous binding
+// operations are enabled.
+func TestPrepareBindAndUnbindRequestAcceptsIncomplete(t *testing.T) {
+	cases := []struct {
+		name                      string
+		bindingRetrievable        bool
+		asyncBindingOperations    bool
+		expectedAcceptsIncomplete bool
+	}{
+		{
+			name:                      "bindings retrievable, async bindings enabled",
+			bindingRetrievable:        true,
+			asyncBindingOperations:    true,
+			expectedAcceptsIncomplete: true,
+		},
+		{
+			name:                      "bindings retrievable, async bindings disabled",
+			bindingRetrievable:        true,
+			asyncBindingOperations:    false,
+			expectedAcceptsIncomplete: false,
+		},
+		{
+			name:                      "bindings not retrievable, async bindings enabled",
+			bindingRetrievable:        false,
+			asyncBindingOperations:    true,
+			expectedAcceptsIncomplete: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=%v", scfeatures.AsyncBindingOperations, tc.asyncBindingOperations))
+			if err != nil {
+				t.Fatalf("Failed to set feature gate: %v", err)
+			}
+			defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.AsyncBindingOperations))
+
+			fakeKubeClient, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+			addGetNamespaceReaction(fakeKubeClient)
+
+			serviceClass := getTestClusterServiceClass()
+			serviceClass.Spec.BindingRetrievable = tc.bindingRetrievable
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(serviceClass)
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithStatus(v1beta1.ConditionTrue)
+			binding := getTestServiceBinding()
+
+			bindRequest, _, err := testController.prepareBindRequest(binding, instance)
+			if err != nil {
+				t.Fatalf("unexpected error preparing bind request: %v", err)
+			}
+			if e, a := tc.expectedAcceptsIncomplete, bindRequest.AcceptsIncomplete; e != a {
+				t.Errorf("Unexpected AcceptsIncomplete on bind request; %s", expectedGot(e, a))
+			}
+
+			unbindRequest, err := testController.prepareUnbindRequest(binding, instance)
+			if err != nil {
+				t.Fatalf("unexpected error preparing unbind request: %v", err)
+			}
+			if e, a := tc.expectedAcceptsIncomplete, unbindRequest.AcceptsIncomplete; e != a {
+				t.Errorf("Unexpected AcceptsIncomplete on unbind request; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
 // TestReconcileServiceBindingAsynchronousBind tests the situation where the
 // controller receives an asynchronous bind response back from the broker when
 // doing a bind call.
@@ -3187,13 +4773,13 @@ func TestReconcileServiceBindingAsynchronousBind(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
-	assertServiceBindingAsyncInProgress(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, asyncBindingReason, testOperation, binding)
+	assertServiceBindingAsyncInProgress(t, updatedServiceBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonBinding, testOperation, binding)
 
 	// Events
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := corev1.EventTypeNormal + " " + asyncBindingReason + " " + asyncBindingMessage
+	expectedEvent := corev1.EventTypeNormal + " " + v1beta1.ReasonBinding + " " + asyncBindingMessage
 	if e, a := expectedEvent, events[0]; e != a {
 		t.Fatalf("Received unexpected event, expected %v got %v", e, a)
 	}
@@ -3270,13 +4856,15 @@ func TestReconcileServiceBindingAsynchronousUnbind(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding).(*v1beta1.ServiceBinding)
-	assertServiceBindingAsyncInProgress(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, asyncUnbindingReason, testOperation, binding)
+	assertServiceBindingAsyncInProgress(t, updatedServiceBinding, v1beta1.ServiceBindingOperationUnbind, v1beta1.ReasonUnbinding, testOperation, binding)
+	// The finalizer is only removed once polling reports the unbind succeeded
+	assertCatalogFinalizerExists(t, updatedServiceBinding)
 
 	// Events
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := corev1.EventTypeNormal + " " + asyncUnbindingReason + " " + asyncUnbindingMessage
+	expectedEvent := corev1.EventTypeNormal + " " + v1beta1.ReasonUnbinding + " " + asyncUnbindingMessage
 	if e, a := expectedEvent, events[0]; e != a {
 		t.Fatalf("Received unexpected event, expected %v got %v", e, a)
 	}
@@ -3344,7 +4932,7 @@ func TestPollServiceBinding(t *testing.T) {
 			validateBrokerActionsFunc:  validatePollBindingLastOperationAction,
 			assertPerformedActionsFunc: nil, // does not update resources
 			shouldFinishPolling:        false,
-			expectedEvents:             []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			expectedEvents:             []string{corev1.EventTypeWarning + " " + v1beta1.ReasonErrorPollingLastOperation + " " + "Error polling last operation: random error"},
 		},
 		{
 			// Special test for 410, as it is treated differently in other operations
@@ -3356,7 +4944,7 @@ func TestPollServiceBinding(t *testing.T) {
 			validateBrokerActionsFunc:  validatePollBindingLastOperationAction,
 			assertPerformedActionsFunc: nil, // does not update resources
 			shouldFinishPolling:        false,
-			expectedEvents:             []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: " + goneError.Error()},
+			expectedEvents:             []string{corev1.EventTypeWarning + " " + v1beta1.ReasonErrorPollingLastOperation + " " + "Error polling last operation: " + goneError.Error()},
 		},
 		{
 			name:    "bind - in progress",
@@ -3372,10 +4960,10 @@ func TestPollServiceBinding(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationBind, asyncBindingReason, testOperation, originalBinding)
+				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonBinding, testOperation, originalBinding)
 			},
 			shouldFinishPolling: false,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + asyncBindingReason + " " + "The binding is being created asynchronously (testdescr)"},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonBinding + " " + "The binding is being created asynchronously (testdescr)"},
 		},
 		{
 			name:    "bind - failed",
@@ -3395,15 +4983,15 @@ func TestPollServiceBinding(t *testing.T) {
 					t,
 					updatedBinding,
 					v1beta1.ServiceBindingOperationBind,
-					errorBindCallReason,
-					errorBindCallReason,
+					v1beta1.ReasonBindCallFailed,
+					v1beta1.ReasonBindCallFailed,
 					originalBinding,
 				)
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorBindCallReason + " " + "Bind call failed: " + lastOperationDescription,
-				corev1.EventTypeWarning + " " + errorBindCallReason + " " + "Bind call failed: " + lastOperationDescription,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonBindCallFailed + " " + "Bind call failed: " + lastOperationDescription,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonBindCallFailed + " " + "Bind call failed: " + lastOperationDescription,
 			},
 		},
 		{
@@ -3438,9 +5026,9 @@ func TestPollServiceBinding(t *testing.T) {
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Bind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason + " " + "Stopping reconciliation retries because too much time has elapsed",
-				corev1.EventTypeWarning + " " + errorServiceBindingOrphanMitigation + " " + "Starting orphan mitigation",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Bind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout + " " + "Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonServiceBindingNeedsOrphanMitigation + " " + "Starting orphan mitigation",
 			},
 		},
 		{
@@ -3461,9 +5049,9 @@ func TestPollServiceBinding(t *testing.T) {
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Bind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason + " " + "Stopping reconciliation retries because too much time has elapsed",
-				corev1.EventTypeWarning + " " + errorServiceBindingOrphanMitigation + " " + "Starting orphan mitigation",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Bind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout + " " + "Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonServiceBindingNeedsOrphanMitigation + " " + "Starting orphan mitigation",
 			},
 		},
 		{
@@ -3483,13 +5071,13 @@ func TestPollServiceBinding(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingAsyncBindErrorAfterStateSucceeded(t, updatedBinding, errorFetchingBindingFailedReason, originalBinding)
+				assertServiceBindingAsyncBindErrorAfterStateSucceeded(t, updatedBinding, v1beta1.ReasonFetchingBindingFailed, originalBinding)
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorFetchingBindingFailedReason + " " + "Could not do a GET on binding resource: some error",
-				corev1.EventTypeWarning + " " + errorFetchingBindingFailedReason + " " + "Could not do a GET on binding resource: some error",
-				corev1.EventTypeWarning + " " + errorServiceBindingOrphanMitigation + " " + "Starting orphan mitigation",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonFetchingBindingFailed + " " + "Could not do a GET on binding resource: some error",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonFetchingBindingFailed + " " + "Could not do a GET on binding resource: some error",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonServiceBindingNeedsOrphanMitigation + " " + "Starting orphan mitigation",
 			},
 		},
 		{
@@ -3529,13 +5117,13 @@ func TestPollServiceBinding(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingAsyncBindErrorAfterStateSucceeded(t, updatedBinding, errorInjectingBindResultReason, originalBinding)
+				assertServiceBindingAsyncBindErrorAfterStateSucceeded(t, updatedBinding, v1beta1.ReasonErrorInjectingBindResult, originalBinding)
 			},
 			shouldFinishPolling: true, // should not be requeued in polling queue; will drop back to default rate limiting
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorInjectingBindResultReason + " " + `Error injecting bind results: Secret "test-ns/test-binding" is not owned by ServiceBinding, controllerRef: nil`,
-				corev1.EventTypeWarning + " " + errorInjectingBindResultReason + " " + `Error injecting bind results: Secret "test-ns/test-binding" is not owned by ServiceBinding, controllerRef: nil`,
-				corev1.EventTypeWarning + " " + errorServiceBindingOrphanMitigation + " " + "Starting orphan mitigation",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorInjectingBindResult + " " + `Error injecting bind results: Secret "test-ns/test-binding" is not owned by ServiceBinding, controllerRef: nil`,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorInjectingBindResult + " " + `Error injecting bind results: Secret "test-ns/test-binding" is not owned by ServiceBinding, controllerRef: nil`,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonServiceBindingNeedsOrphanMitigation + " " + "Starting orphan mitigation",
 			},
 		},
 		{
@@ -3577,7 +5165,7 @@ func TestPollServiceBinding(t *testing.T) {
 				assertServiceBindingOperationSuccess(t, updatedBinding, v1beta1.ServiceBindingOperationBind, originalBinding)
 			},
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + successInjectedBindResultReason + " " + successInjectedBindResultMessage},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonInjectedBindResult + " " + successInjectedBindResultMessage},
 		},
 		// Unbind as part of deletion
 		{
@@ -3598,7 +5186,7 @@ func TestPollServiceBinding(t *testing.T) {
 				assertServiceBindingOperationSuccess(t, updatedBinding, v1beta1.ServiceBindingOperationUnbind, originalBinding)
 			},
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + successUnboundReason + " " + "The binding was deleted successfully"},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonUnboundSuccessfully + " " + "The binding was deleted successfully"},
 		},
 		{
 			name:    "unbind - 410 Gone considered succeeded",
@@ -3617,7 +5205,7 @@ func TestPollServiceBinding(t *testing.T) {
 				assertServiceBindingOperationSuccess(t, updatedBinding, v1beta1.ServiceBindingOperationUnbind, originalBinding)
 			},
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + successUnboundReason + " " + "The binding was deleted successfully"},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonUnboundSuccessfully + " " + "The binding was deleted successfully"},
 		},
 		{
 			name:    "unbind - in progress",
@@ -3633,10 +5221,10 @@ func TestPollServiceBinding(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationUnbind, asyncUnbindingReason, testOperation, originalBinding)
+				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationUnbind, v1beta1.ReasonUnbinding, testOperation, originalBinding)
 			},
 			shouldFinishPolling: false,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + asyncUnbindingReason + " " + "The binding is being deleted asynchronously (testdescr)"},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonUnbinding + " " + "The binding is being deleted asynchronously (testdescr)"},
 		},
 		{
 			name:    "unbind - error",
@@ -3647,7 +5235,7 @@ func TestPollServiceBinding(t *testing.T) {
 			validateBrokerActionsFunc:  validatePollBindingLastOperationAction,
 			assertPerformedActionsFunc: nil, // does not update resources
 			shouldFinishPolling:        false,
-			expectedEvents:             []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			expectedEvents:             []string{corev1.EventTypeWarning + " " + v1beta1.ReasonErrorPollingLastOperation + " " + "Error polling last operation: random error"},
 		},
 		{
 			name:    "unbind - failed (retries)",
@@ -3667,13 +5255,13 @@ func TestPollServiceBinding(t *testing.T) {
 					t,
 					updatedBinding,
 					v1beta1.ServiceBindingOperationUnbind,
-					errorUnbindCallReason,
+					v1beta1.ReasonUnbindCallFailed,
 					originalBinding,
 				)
 			},
 			shouldError:         true,
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorUnbindCallReason + " " + "Unbind call failed: " + lastOperationDescription},
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + v1beta1.ReasonUnbindCallFailed + " " + "Unbind call failed: " + lastOperationDescription},
 		},
 		{
 			name:    "unbind - invalid state",
@@ -3707,15 +5295,15 @@ func TestPollServiceBinding(t *testing.T) {
 					t,
 					updatedBinding,
 					v1beta1.ServiceBindingOperationUnbind,
-					errorAsyncOpTimeoutReason,
-					errorReconciliationRetryTimeoutReason,
+					v1beta1.ReasonAsyncOperationTimeout,
+					v1beta1.ReasonErrorReconciliationRetryTimeout,
 					originalBinding,
 				)
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Unbind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason + " " + "Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Unbind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout + " " + "Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 		{
@@ -3736,15 +5324,15 @@ func TestPollServiceBinding(t *testing.T) {
 					t,
 					updatedBinding,
 					v1beta1.ServiceBindingOperationUnbind,
-					errorAsyncOpTimeoutReason,
-					errorReconciliationRetryTimeoutReason,
+					v1beta1.ReasonAsyncOperationTimeout,
+					v1beta1.ReasonErrorReconciliationRetryTimeout,
 					originalBinding,
 				)
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Unbind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason + " " + "Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Unbind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout + " " + "Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 		{
@@ -3765,15 +5353,15 @@ func TestPollServiceBinding(t *testing.T) {
 					t,
 					updatedBinding,
 					v1beta1.ServiceBindingOperationUnbind,
-					errorUnbindCallReason,
-					errorReconciliationRetryTimeoutReason,
+					v1beta1.ReasonUnbindCallFailed,
+					v1beta1.ReasonErrorReconciliationRetryTimeout,
 					originalBinding,
 				)
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorUnbindCallReason + " " + "Unbind call failed: " + lastOperationDescription,
-				corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason + " " + "Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonUnbindCallFailed + " " + "Unbind call failed: " + lastOperationDescription,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout + " " + "Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 		// Unbind as part of orphan mitigation
@@ -3794,7 +5382,7 @@ func TestPollServiceBinding(t *testing.T) {
 				assertServiceBindingOrphanMitigationSuccess(t, updatedBinding, originalBinding)
 			},
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + successOrphanMitigationReason + " " + successOrphanMitigationMessage},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonOrphanMitigationSuccessful + " " + successOrphanMitigationMessage},
 		},
 		{
 			name:    "orphan mitigation - 410 Gone considered succeeded",
@@ -3812,7 +5400,7 @@ func TestPollServiceBinding(t *testing.T) {
 				assertServiceBindingOrphanMitigationSuccess(t, updatedBinding, originalBinding)
 			},
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + successOrphanMitigationReason + " " + successOrphanMitigationMessage},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonOrphanMitigationSuccessful + " " + successOrphanMitigationMessage},
 		},
 		{
 			name:    "orphan mitigation - in progress",
@@ -3828,10 +5416,10 @@ func TestPollServiceBinding(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationBind, asyncUnbindingReason, testOperation, originalBinding)
+				assertServiceBindingAsyncInProgress(t, updatedBinding, v1beta1.ServiceBindingOperationBind, v1beta1.ReasonUnbinding, testOperation, originalBinding)
 			},
 			shouldFinishPolling: false,
-			expectedEvents:      []string{corev1.EventTypeNormal + " " + asyncUnbindingReason + " " + "The binding is being deleted asynchronously (testdescr)"},
+			expectedEvents:      []string{corev1.EventTypeNormal + " " + v1beta1.ReasonUnbinding + " " + "The binding is being deleted asynchronously (testdescr)"},
 		},
 		{
 			name:    "orphan mitigation - error",
@@ -3842,7 +5430,7 @@ func TestPollServiceBinding(t *testing.T) {
 			validateBrokerActionsFunc:  validatePollBindingLastOperationAction,
 			assertPerformedActionsFunc: nil, // does not update resources
 			shouldFinishPolling:        false,
-			expectedEvents:             []string{corev1.EventTypeWarning + " " + errorPollingLastOperationReason + " " + "Error polling last operation: random error"},
+			expectedEvents:             []string{corev1.EventTypeWarning + " " + v1beta1.ReasonErrorPollingLastOperation + " " + "Error polling last operation: random error"},
 		},
 		{
 			name:    "orphan mitigation - failed (retries)",
@@ -3858,11 +5446,11 @@ func TestPollServiceBinding(t *testing.T) {
 				assertNumberOfActions(t, actions, 1)
 				updatedBinding := assertUpdateStatus(t, actions[0], originalBinding)
 
-				assertServiceBindingRequestRetriableOrphanMitigation(t, updatedBinding, errorUnbindCallReason, originalBinding)
+				assertServiceBindingRequestRetriableOrphanMitigation(t, updatedBinding, v1beta1.ReasonUnbindCallFailed, originalBinding)
 			},
 			shouldError:         true,
 			shouldFinishPolling: true,
-			expectedEvents:      []string{corev1.EventTypeWarning + " " + errorUnbindCallReason + " " + "Unbind call failed: " + lastOperationDescription},
+			expectedEvents:      []string{corev1.EventTypeWarning + " " + v1beta1.ReasonUnbindCallFailed + " " + "Unbind call failed: " + lastOperationDescription},
 		},
 		{
 			name:    "orphan mitigation - invalid state",
@@ -3896,8 +5484,8 @@ func TestPollServiceBinding(t *testing.T) {
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Unbind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorOrphanMitigationFailedReason + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Unbind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonOrphanMitigationFailed + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 		{
@@ -3918,8 +5506,8 @@ func TestPollServiceBinding(t *testing.T) {
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorAsyncOpTimeoutReason + " " + "The asynchronous Unbind operation timed out and will not be retried",
-				corev1.EventTypeWarning + " " + errorOrphanMitigationFailedReason + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonAsyncOperationTimeout + " " + "The asynchronous Unbind operation timed out and will not be retried",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonOrphanMitigationFailed + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 		{
@@ -3940,8 +5528,8 @@ func TestPollServiceBinding(t *testing.T) {
 			},
 			shouldFinishPolling: true,
 			expectedEvents: []string{
-				corev1.EventTypeWarning + " " + errorUnbindCallReason + " " + "Unbind call failed: " + lastOperationDescription,
-				corev1.EventTypeWarning + " " + errorOrphanMitigationFailedReason + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
+				corev1.EventTypeWarning + " " + v1beta1.ReasonUnbindCallFailed + " " + "Unbind call failed: " + lastOperationDescription,
+				corev1.EventTypeWarning + " " + v1beta1.ReasonOrphanMitigationFailed + " " + "Orphan mitigation failed: Stopping reconciliation retries because too much time has elapsed",
 			},
 		},
 	}
@@ -4202,6 +5790,86 @@ func TestTransformSecretData(t *testing.T) {
 	}
 }
 
+func TestFormatCredentialKeys(t *testing.T) {
+	credentials := func() map[string]interface{} {
+		return map[string]interface{}{
+			"dbUser":       "user",
+			"db_password":  "secret",
+			"HOST-NAME":    "example.com",
+			"DBPort":       5432,
+			"uri":          "postgres://example.com",
+			"connection.1": "primary",
+		}
+	}
+	cases := []struct {
+		name     string
+		format   v1beta1.SecretKeyFormat
+		expected map[string]interface{}
+	}{
+		{
+			name:     "no format",
+			format:   "",
+			expected: credentials(),
+		},
+		{
+			name:   "upper snake case",
+			format: v1beta1.SecretKeyFormatUpperSnakeCase,
+			expected: map[string]interface{}{
+				"DB_USER":      "user",
+				"DB_PASSWORD":  "secret",
+				"HOST_NAME":    "example.com",
+				"DB_PORT":      5432,
+				"URI":          "postgres://example.com",
+				"CONNECTION_1": "primary",
+			},
+		},
+		{
+			name:   "camel case",
+			format: v1beta1.SecretKeyFormatCamelCase,
+			expected: map[string]interface{}{
+				"dbUser":      "user",
+				"dbPassword":  "secret",
+				"hostName":    "example.com",
+				"dbPort":      5432,
+				"uri":         "postgres://example.com",
+				"connection1": "primary",
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := credentials()
+			if err := formatCredentialKeys(tc.format, actual); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Fatalf("unexpected credentials: %s", expectedGot(tc.expected, actual))
+			}
+		})
+	}
+}
+
+func TestFormatCredentialKeysCollision(t *testing.T) {
+	for _, format := range []v1beta1.SecretKeyFormat{v1beta1.SecretKeyFormatUpperSnakeCase, v1beta1.SecretKeyFormatCamelCase} {
+		t.Run(string(format), func(t *testing.T) {
+			credentials := map[string]interface{}{
+				"dbUser":  "user",
+				"db_user": "other",
+			}
+			err := formatCredentialKeys(format, credentials)
+			if err == nil {
+				t.Fatal("expected an error for colliding keys")
+			}
+			if e, a := `credential keys "dbUser" and "db_user" are both converted to`, err.Error(); !strings.Contains(a, e) {
+				t.Fatalf("unexpected error: %s", expectedGot(e, a))
+			}
+			if e, a := 2, len(credentials); e != a {
+				t.Fatalf("credentials should be left unchanged: %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
 func assertServiceBindingBindInProgressIsTheOnlyCatalogAction(t *testing.T, fakeCatalogClient *fake.Clientset, binding *v1beta1.ServiceBinding) *v1beta1.ServiceBinding {
 	return assertServiceBindingOperationInProgressIsTheOnlyCatalogAction(t, fakeCatalogClient, binding, v1beta1.ServiceBindingOperationBind)
 }
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -39,27 +40,28 @@ import (
 // the Message strings have a terminating period and space so they can
 // be easily combined with a follow on specific message.
 const (
-	errorListingClusterServiceClassesReason  string = "ErrorListingClusterServiceClasses"
 	errorListingClusterServiceClassesMessage string = "Error listing cluster service classes."
-	errorListingClusterServicePlansReason    string = "ErrorListingClusterServicePlans"
 	errorListingClusterServicePlansMessage   string = "Error listing cluster service plans."
-	errorDeletingClusterServiceClassReason   string = "ErrorDeletingClusterServiceClass"
 	errorDeletingClusterServiceClassMessage  string = "Error deleting cluster service class."
-	errorDeletingClusterServicePlanReason    string = "ErrorDeletingClusterServicePlan"
 	errorDeletingClusterServicePlanMessage   string = "Error deleting cluster service plan."
-	errorAuthCredentialsReason               string = "ErrorGettingAuthCredentials"
 
-	successClusterServiceBrokerDeletedReason  string = "DeletedClusterServiceBrokerSuccessfully"
 	successClusterServiceBrokerDeletedMessage string = "The broker %v was deleted successfully."
 
 	// these reasons are re-used in other controller files.
-	errorFetchingCatalogReason            string = "ErrorFetchingCatalog"
-	errorFetchingCatalogMessage           string = "Error fetching catalog."
-	errorSyncingCatalogReason             string = "ErrorSyncingCatalog"
-	errorSyncingCatalogMessage            string = "Error syncing catalog from ClusterServiceBroker."
-	successFetchedCatalogReason           string = "FetchedCatalog"
-	successFetchedCatalogMessage          string = "Successfully fetched catalog entries from broker."
-	errorReconciliationRetryTimeoutReason string = "ErrorReconciliationRetryTimeout"
+	errorFetchingCatalogMessage  string = "Error fetching catalog."
+	errorSyncingCatalogMessage   string = "Error syncing catalog from ClusterServiceBroker."
+	successFetchedCatalogMessage string = "Successfully fetched catalog entries from broker."
+	catalogTooLargeMessage       string = "Catalog is too large. "
+
+	insecureSkipTLSVerifyMessage           string = "TLS certificate verification is disabled for this broker. This is insecure and must not be used outside of development clusters."
+	insecureSkipTLSVerifyNotAllowedMessage string = "insecureSkipTLSVerify is ignored because the controller does not allow brokers to skip TLS certificate verification."
+	tlsVerificationEnabledMessage          string = "TLS certificate verification is enabled for this broker."
+
+	brokerDeprecatedMessage    string = "The broker announced deprecations in its catalog: "
+	brokerNotDeprecatedMessage string = "The catalog of the broker announces no deprecations."
+
+	malformedCatalogEntriesMessage   string = "Skipped malformed catalog entries: "
+	noMalformedCatalogEntriesMessage string = "The catalog of the broker has no malformed entries."
 )
 
 func (c *controller) clusterServiceBrokerAdd(obj interface{}) {
@@ -69,6 +71,9 @@ func (c *controller) clusterServiceBrokerAdd(obj interface{}) {
 	// confirming the deletion has not yet arrived.
 	// Generally, the key is "namespace/name" for namespaced-scoped resources and
 	// just "name" for cluster scoped resources.
+	if !c.isSelected(obj) {
+		return
+	}
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		klog.Errorf("Couldn't get key for object %+v: %v", obj, err)
@@ -131,19 +136,43 @@ func (c *controller) updateClusterServiceBrokerClient(broker *v1beta1.ClusterSer
 	if err != nil {
 		s := fmt.Sprintf("Error getting broker auth credentials: %s", err)
 		klog.Info(pcb.Message(s))
-		c.recorder.Event(broker, corev1.EventTypeWarning, errorAuthCredentialsReason, s)
-		if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorFetchingCatalogReason, errorFetchingCatalogMessage+s); err != nil {
+		c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorGettingAuthCredentials, s)
+		if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorFetchingCatalog, errorFetchingCatalogMessage+s); err != nil {
 			return nil, err
 		}
 		return nil, err
 	}
-	clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
-	brokerClient, err := c.brokerClientManager.UpdateBrokerClient(NewClusterServiceBrokerKey(broker.Name), clientConfig)
+	clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig, c.allowBrokerInsecureSkipTLSVerify)
+	clientConfig.APIVersion = c.brokerOSBAPIVersion(&broker.Spec.CommonServiceBrokerSpec)
+	c.warnOnInsecureSkipTLSVerify(broker, &broker.Spec.CommonServiceBrokerSpec, clientConfig, pcb)
+	brokerKey := NewClusterServiceBrokerKey(broker.Name)
+	var brokerClient osb.Client
+	if broker.Spec.FallbackAuthInfo != nil {
+		fallbackAuthConfig, err := getFallbackAuthCredentialsFromClusterServiceBroker(c.kubeClient, broker)
+		if err != nil {
+			s := fmt.Sprintf("Error getting broker fallback auth credentials: %s", err)
+			klog.Info(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorGettingAuthCredentials, s)
+			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorFetchingCatalog, errorFetchingCatalogMessage+s); err != nil {
+				return nil, err
+			}
+			return nil, err
+		}
+		fallbackConfig := *clientConfig
+		fallbackConfig.AuthConfig = fallbackAuthConfig
+		brokerClient, err = c.brokerClientManager.UpdateBrokerClientWithFallbackAuth(brokerKey, clientConfig, &fallbackConfig)
+	} else if tokenConfig := getServiceAccountTokenConfigFromClusterServiceBroker(broker); tokenConfig != nil {
+		brokerClient, err = c.brokerClientManager.UpdateBrokerClientWithTokenSource(brokerKey, clientConfig, *tokenConfig, func() TokenSource {
+			return NewServiceAccountTokenSource(c.kubeClient, *tokenConfig)
+		})
+	} else {
+		brokerClient, err = c.brokerClientManager.UpdateBrokerClient(brokerKey, clientConfig)
+	}
 	if err != nil {
 		s := fmt.Sprintf("Error creating client for broker %q: %s", broker.Name, err)
 		klog.Info(pcb.Message(s))
-		c.recorder.Event(broker, corev1.EventTypeWarning, errorAuthCredentialsReason, s)
-		if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorFetchingCatalogReason, errorFetchingCatalogMessage+s); err != nil {
+		c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorGettingAuthCredentials, s)
+		if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorFetchingCatalog, errorFetchingCatalogMessage+s); err != nil {
 			return nil, err
 		}
 		return nil, err
@@ -180,8 +209,8 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker catalog: %s", err)
 			klog.Warning(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorFetchingCatalogReason, s)
-			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorFetchingCatalogReason, errorFetchingCatalogMessage+s); err != nil {
+			c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorFetchingCatalog, s)
+			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorFetchingCatalog, errorFetchingCatalogMessage+s); err != nil {
 				return err
 			}
 			if broker.Status.OperationStartTime == nil {
@@ -194,14 +223,14 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			} else if !time.Now().Before(broker.Status.OperationStartTime.Time.Add(c.reconciliationRetryDuration)) {
 				s := "Stopping reconciliation retries because too much time has elapsed"
 				klog.Info(pcb.Message(s))
-				c.recorder.Event(broker, corev1.EventTypeWarning, errorReconciliationRetryTimeoutReason, s)
+				c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorReconciliationRetryTimeout, s)
 				toUpdate := broker.DeepCopy()
 				toUpdate.Status.OperationStartTime = nil
 				toUpdate.Status.ReconciledGeneration = toUpdate.Generation
 				return c.updateClusterServiceBrokerCondition(toUpdate,
 					v1beta1.ServiceBrokerConditionFailed,
 					v1beta1.ConditionTrue,
-					errorReconciliationRetryTimeoutReason,
+					v1beta1.ReasonErrorReconciliationRetryTimeout,
 					s)
 			}
 			return err
@@ -234,20 +263,44 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 
 		// convert the broker's catalog payload into our API objects
 		klog.V(4).Info(pcb.Message("Converting catalog response into service-catalog API"))
-		payloadServiceClasses, payloadServicePlans, err := convertAndFilterCatalog(brokerCatalog, broker.Spec.CatalogRestrictions, existingServiceClassMap, existingServicePlanMap)
+		// the rewrite rules of the broker apply before its catalog restrictions
+		var payloadServiceClasses []*v1beta1.ClusterServiceClass
+		var payloadServicePlans []*v1beta1.ClusterServicePlan
+		// the malformed entries skipped, if they are not to fail the relist
+		var malformedEntries []string
+		rewrittenCatalog, err := c.catalogRewriteRules.rewriteCatalog("", broker.Name, brokerCatalog)
+		if err == nil {
+			if c.skipMalformedCatalogEntries {
+				var classNames []string
+				rewrittenCatalog, classNames = removeServicesWithoutPlans(rewrittenCatalog)
+				for _, className := range classNames {
+					klog.Warning(pcb.Messagef("Skipping class %q, which has no plans", className))
+					malformedEntries = append(malformedEntries, malformedServiceClassEntry(className))
+				}
+			}
+			payloadServiceClasses, payloadServicePlans, err = convertAndFilterCatalog(rewrittenCatalog, broker.Spec.CatalogRestrictions, existingServiceClassMap, existingServicePlanMap)
+		}
 		if err != nil {
 			s := fmt.Sprintf("Error converting catalog payload for broker %q to service-catalog API: %s", broker.Name, err)
 			klog.Warning(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
+			c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog, errorSyncingCatalogMessage+s); err != nil {
 				return err
 			}
 			return err
 		}
 		klog.V(5).Info(pcb.Message("Successfully converted catalog payload from to service-catalog API"))
 
+		if c.catalogSizeExceeded(len(payloadServiceClasses), len(payloadServicePlans)) {
+			s := catalogSizeExceededMessage(len(payloadServiceClasses), len(payloadServicePlans), c.maxCatalogSize)
+			klog.Warning(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonCatalogTooLarge, s)
+			return c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonCatalogTooLarge, catalogTooLargeMessage+s)
+		}
+
 		// reconcile the serviceClasses that were part of the broker's catalog
 		// payload
+		skippedServiceClasses := sets.NewString()
 		for _, payloadServiceClass := range payloadServiceClasses {
 			existingServiceClass, _ := existingServiceClassMap[payloadServiceClass.Name]
 			delete(existingServiceClassMap, payloadServiceClass.Name)
@@ -258,13 +311,19 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 
 			klog.V(4).Info(pcb.Messagef("Reconciling %s", pretty.ClusterServiceClassName(payloadServiceClass)))
 			if err := c.reconcileClusterServiceClassFromClusterServiceBrokerCatalog(broker, payloadServiceClass, existingServiceClass); err != nil {
+				if c.skipMalformedCatalogEntries && errors.IsInvalid(err) {
+					klog.Warning(pcb.Messagef("Skipping malformed %s: %v", pretty.ClusterServiceClassName(payloadServiceClass), err))
+					malformedEntries = append(malformedEntries, malformedServiceClassEntry(payloadServiceClass.Spec.ExternalName))
+					skippedServiceClasses.Insert(payloadServiceClass.Name)
+					continue
+				}
 				s := fmt.Sprintf(
 					"Error reconciling %s (broker %q): %s",
 					pretty.ClusterServiceClassName(payloadServiceClass), broker.Name, err,
 				)
 				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog,
 					errorSyncingCatalogMessage+s); err != nil {
 					return err
 				}
@@ -295,8 +354,8 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 					pretty.ClusterServiceClassName(existingServiceClass), err,
 				)
 				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog,
 					errorSyncingCatalogMessage+s); err != nil {
 					return err
 				}
@@ -305,6 +364,11 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 		}
 
 		// reconcile the plans that were part of the broker's catalog payload
+		payloadServiceClassNames := make(map[string]string, len(payloadServiceClasses))
+		for _, payloadServiceClass := range payloadServiceClasses {
+			payloadServiceClassNames[payloadServiceClass.Name] = payloadServiceClass.Spec.ExternalName
+		}
+		skippedServicePlans := 0
 		for _, payloadServicePlan := range payloadServicePlans {
 			existingServicePlan, _ := existingServicePlanMap[payloadServicePlan.Name]
 			delete(existingServicePlanMap, payloadServicePlan.Name)
@@ -313,18 +377,30 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 				delete(existingServicePlanMap, payloadServicePlan.Spec.ExternalID)
 			}
 
+			// the plans of a skipped class are skipped along with it
+			if skippedServiceClasses.Has(payloadServicePlan.Spec.ClusterServiceClassRef.Name) {
+				skippedServicePlans++
+				continue
+			}
+
 			klog.V(4).Infof(
 				"ClusterServiceBroker %q: reconciling %s",
 				broker.Name, pretty.ClusterServicePlanName(payloadServicePlan),
 			)
 			if err := c.reconcileClusterServicePlanFromClusterServiceBrokerCatalog(broker, payloadServicePlan, existingServicePlan); err != nil {
+				if c.skipMalformedCatalogEntries && errors.IsInvalid(err) {
+					klog.Warning(pcb.Messagef("Skipping malformed %s: %v", pretty.ClusterServicePlanName(payloadServicePlan), err))
+					malformedEntries = append(malformedEntries, malformedServicePlanEntry(payloadServiceClassNames[payloadServicePlan.Spec.ClusterServiceClassRef.Name], payloadServicePlan.Spec.ExternalName))
+					skippedServicePlans++
+					continue
+				}
 				s := fmt.Sprintf(
 					"Error reconciling %s: %s",
 					pretty.ClusterServicePlanName(payloadServicePlan), err,
 				)
 				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+				c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog,
 					errorSyncingCatalogMessage+s)
 				return err
 			}
@@ -354,8 +430,8 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 					err,
 				)
 				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+				if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog,
 					errorSyncingCatalogMessage+s); err != nil {
 					return err
 				}
@@ -363,17 +439,38 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			}
 		}
 
-		// everything worked correctly; update the broker's ready condition to
-		// status true
-		if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+		// everything worked correctly; record the features advertised in the
+		// catalog, its size and the endpoint which served it, and update the
+		// broker's ready condition to status true
+		classCount := int64(len(payloadServiceClasses) - skippedServiceClasses.Len())
+		planCount := int64(len(payloadServicePlans) - skippedServicePlans)
+		toUpdate := broker.DeepCopy()
+		toUpdate.Status.Features = getServiceBrokerFeatures(brokerCatalog)
+		toUpdate.Status.LastCatalogClassCount = &classCount
+		toUpdate.Status.LastCatalogPlanCount = &planCount
+		toUpdate.Status.LastSuccessfulEndpoint = broker.Spec.URL
+		setServiceBrokerDeprecatedCondition(&toUpdate.Status.CommonServiceBrokerStatus, getServiceBrokerDeprecationNotice(brokerCatalog), time.Now())
+		setServiceBrokerMalformedCatalogEntriesCondition(&toUpdate.Status.CommonServiceBrokerStatus, malformedEntries, time.Now())
+		if err := c.updateClusterServiceBrokerCondition(toUpdate, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, v1beta1.ReasonFetchedCatalog, successFetchedCatalogMessage); err != nil {
 			return err
 		}
 
-		c.recorder.Event(broker, corev1.EventTypeNormal, successFetchedCatalogReason, successFetchedCatalogMessage)
+		if len(malformedEntries) > 0 {
+			c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonMalformedCatalogEntriesSkipped, malformedCatalogEntriesMessage+strings.Join(malformedEntries, ", "))
+		}
+		c.recorder.Event(broker, corev1.EventTypeNormal, v1beta1.ReasonFetchedCatalog, successFetchedCatalogMessage)
+
+		// the broker is reachable; resume the orphan mitigations which
+		// failed while it was not. This is done on every successful relist
+		// rather than when the broker becomes ready, as the broker may have
+		// recovered between two relists while staying ready.
+		if c.resumeOrphanMitigationOnBrokerRecovery {
+			c.resumeOrphanMitigations("", broker.Name)
+		}
 
 		// Update metrics with the number of serviceclass and serviceplans from this broker
-		metrics.BrokerServiceClassCount.WithLabelValues(broker.Name).Set(float64(len(payloadServiceClasses)))
-		metrics.BrokerServicePlanCount.WithLabelValues(broker.Name).Set(float64(len(payloadServicePlans)))
+		metrics.BrokerServiceClassCount.WithLabelValues(broker.Name).Set(float64(classCount))
+		metrics.BrokerServicePlanCount.WithLabelValues(broker.Name).Set(float64(planCount))
 
 		return nil
 	}
@@ -402,9 +499,9 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 					v1beta1.ServiceBrokerConditionReady,
 					v1beta1.ConditionUnknown,
 					errorDeletingClusterServicePlanMessage,
-					errorDeletingClusterServicePlanReason+s,
+					v1beta1.ReasonErrorDeletingClusterServicePlan+s,
 				)
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorDeletingClusterServicePlanReason, "%v %v", errorDeletingClusterServicePlanMessage, s)
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorDeletingClusterServicePlan, "%v %v", errorDeletingClusterServicePlanMessage, s)
 				return err
 			}
 		}
@@ -415,13 +512,13 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			if err != nil && !errors.IsNotFound(err) {
 				s := fmt.Sprintf("Error deleting %s: %s", pretty.ClusterServiceClassName(&svcClass), err)
 				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorDeletingClusterServiceClassReason, "%v %v", errorDeletingClusterServiceClassMessage, s)
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorDeletingClusterServiceClass, "%v %v", errorDeletingClusterServiceClassMessage, s)
 				if err := c.updateClusterServiceBrokerCondition(
 					broker,
 					v1beta1.ServiceBrokerConditionReady,
 					v1beta1.ConditionUnknown,
 					errorDeletingClusterServiceClassMessage,
-					errorDeletingClusterServiceClassReason+s,
+					v1beta1.ReasonErrorDeletingClusterServiceClass+s,
 				); err != nil {
 					return err
 				}
@@ -433,7 +530,7 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 			broker,
 			v1beta1.ServiceBrokerConditionReady,
 			v1beta1.ConditionFalse,
-			successClusterServiceBrokerDeletedReason,
+			v1beta1.ReasonDeletedClusterServiceBrokerSuccessfully,
 			"The broker was deleted successfully",
 		); err != nil {
 			return err
@@ -442,7 +539,7 @@ func (c *controller) reconcileClusterServiceBroker(broker *v1beta1.ClusterServic
 		finalizers.Delete(v1beta1.FinalizerServiceCatalog)
 		c.updateClusterServiceBrokerFinalizers(broker, finalizers.List())
 
-		c.recorder.Eventf(broker, corev1.EventTypeNormal, successClusterServiceBrokerDeletedReason, successClusterServiceBrokerDeletedMessage, broker.Name)
+		c.recorder.Eventf(broker, corev1.EventTypeNormal, v1beta1.ReasonDeletedClusterServiceBrokerSuccessfully, successClusterServiceBrokerDeletedMessage, broker.Name)
 		klog.V(5).Info(pcb.Message("Successfully deleted"))
 
 		// delete the metrics associated with this broker
@@ -533,8 +630,8 @@ func (c *controller) reconcileClusterServiceClassFromClusterServiceBrokerCatalog
 		if err != nil {
 			s := fmt.Sprintf("Error updating status of %s: %v", pretty.ClusterServiceClassName(updatedServiceClass), err)
 			klog.Warning(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
+			c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog, errorSyncingCatalogMessage+s); err != nil {
 				return err
 			}
 			return err
@@ -549,6 +646,7 @@ func (c *controller) reconcileClusterServiceClassFromClusterServiceBrokerCatalog
 func (c *controller) reconcileClusterServicePlanFromClusterServiceBrokerCatalog(broker *v1beta1.ClusterServiceBroker, servicePlan, existingServicePlan *v1beta1.ClusterServicePlan) error {
 	pcb := pretty.NewClusterServiceBrokerContextBuilder(broker)
 	servicePlan.Spec.ClusterServiceBrokerName = broker.Name
+	c.warnOnUnsupportedParameterSchemas(broker, pcb, pretty.ClusterServicePlanName(servicePlan), &servicePlan.Spec.CommonServicePlanSpec)
 
 	if existingServicePlan == nil {
 		otherServicePlan, err := c.clusterServicePlanLister.Get(servicePlan.Name)
@@ -576,11 +674,22 @@ func (c *controller) reconcileClusterServicePlanFromClusterServiceBrokerCatalog(
 
 		// An error returned from a lister Get call means that the object does
 		// not exist.  Create a new ClusterServicePlan.
-		if _, err := c.serviceCatalogClient.ClusterServicePlans().Create(servicePlan); err != nil {
+		createdPlan, err := c.serviceCatalogClient.ClusterServicePlans().Create(servicePlan)
+		if err != nil {
 			klog.Error(pcb.Messagef("Error creating %s: %v", pretty.ClusterServicePlanName(servicePlan), err))
 			return err
 		}
 
+		// The status is not persisted when the plan is created, so the
+		// costs have to be written separately.
+		if len(servicePlan.Status.Costs) > 0 && !reflect.DeepEqual(createdPlan.Status.Costs, servicePlan.Status.Costs) {
+			createdPlan.Status.Costs = servicePlan.Status.Costs
+			if _, err := c.serviceCatalogClient.ClusterServicePlans().UpdateStatus(createdPlan); err != nil {
+				klog.Error(pcb.Messagef("Error updating status of %s: %v", pretty.ClusterServicePlanName(createdPlan), err))
+				return err
+			}
+		}
+
 		return nil
 	}
 
@@ -615,16 +724,27 @@ func (c *controller) reconcileClusterServicePlanFromClusterServiceBrokerCatalog(
 		return err
 	}
 
-	if updatedPlan.Status.RemovedFromBrokerCatalog {
+	if c.revalidateInstancesOnPlanSchemaChange && !reflect.DeepEqual(existingServicePlan.Spec.InstanceCreateParameterSchema, updatedPlan.Spec.InstanceCreateParameterSchema) {
+		if instances, err := c.findServiceInstancesOnClusterServicePlan(updatedPlan); err != nil {
+			klog.Warning(pcb.Messagef("Error listing the instances of %s to check their parameters: %v", pretty.ClusterServicePlanName(updatedPlan), err))
+		} else {
+			c.revalidateServiceInstanceParameters(instances.Items, updatedPlan.Spec.ExternalName, updatedPlan.Spec.InstanceCreateParameterSchema)
+		}
+	}
+
+	if updatedPlan.Status.RemovedFromBrokerCatalog || !reflect.DeepEqual(updatedPlan.Status.Costs, servicePlan.Status.Costs) {
+		if updatedPlan.Status.RemovedFromBrokerCatalog {
+			klog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ClusterServicePlanName(updatedPlan)))
+		}
 		updatedPlan.Status.RemovedFromBrokerCatalog = false
-		klog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ClusterServicePlanName(updatedPlan)))
+		updatedPlan.Status.Costs = servicePlan.Status.Costs
 
 		_, err := c.serviceCatalogClient.ClusterServicePlans().UpdateStatus(updatedPlan)
 		if err != nil {
 			s := fmt.Sprintf("Error updating status of %s: %v", pretty.ClusterServicePlanName(updatedPlan), err)
 			klog.Error(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
+			c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+			if err := c.updateClusterServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog, errorSyncingCatalogMessage+s); err != nil {
 				return err
 			}
 			return err
@@ -653,8 +773,10 @@ func (c *controller) updateClusterServiceBrokerCondition(broker *v1beta1.Cluster
 		newCondition.LastTransitionTime = metav1.NewTime(t)
 		toUpdate.Status.Conditions = []v1beta1.ServiceBrokerCondition{newCondition}
 	} else {
+		found := false
 		for i, cond := range broker.Status.Conditions {
 			if cond.Type == conditionType {
+				found = true
 				if cond.Status != newCondition.Status {
 					klog.Info(pcb.Messagef(
 						"Found status change for condition %q: %q -> %q; setting lastTransitionTime to %v",
@@ -669,8 +791,16 @@ func (c *controller) updateClusterServiceBrokerCondition(broker *v1beta1.Cluster
 				break
 			}
 		}
+		// the conditions may only hold the ones set ahead of the others,
+		// such as InsecureSkipTLSVerify and Deprecated
+		if !found {
+			newCondition.LastTransitionTime = metav1.NewTime(t)
+			toUpdate.Status.Conditions = append(toUpdate.Status.Conditions, newCondition)
+		}
 	}
 
+	setInsecureSkipTLSVerifyCondition(&toUpdate.Status.CommonServiceBrokerStatus, c.isBrokerInsecureSkipTLSVerify(&broker.Spec.CommonServiceBrokerSpec), t)
+
 	// Set status.ReconciledGeneration && status.LastCatalogRetrievalTime if updating ready condition to true
 
 	if conditionType == v1beta1.ServiceBrokerConditionReady && status == v1beta1.ConditionTrue {
@@ -731,12 +861,12 @@ func (c *controller) getCurrentServiceClassesAndPlansForBroker(broker *v1beta1.C
 
 	existingServiceClasses, err := c.serviceCatalogClient.ClusterServiceClasses().List(listOpts)
 	if err != nil {
-		c.recorder.Eventf(broker, corev1.EventTypeWarning, errorListingClusterServiceClassesReason, "%v %v", errorListingClusterServiceClassesMessage, err)
+		c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorListingClusterServiceClasses, "%v %v", errorListingClusterServiceClassesMessage, err)
 		if err := c.updateClusterServiceBrokerCondition(
 			broker,
 			v1beta1.ServiceBrokerConditionReady,
 			v1beta1.ConditionUnknown,
-			errorListingClusterServiceClassesReason,
+			v1beta1.ReasonErrorListingClusterServiceClasses,
 			errorListingClusterServiceClassesMessage,
 		); err != nil {
 			return nil, nil, err
@@ -748,12 +878,12 @@ func (c *controller) getCurrentServiceClassesAndPlansForBroker(broker *v1beta1.C
 
 	existingServicePlans, err := c.serviceCatalogClient.ClusterServicePlans().List(listOpts)
 	if err != nil {
-		c.recorder.Eventf(broker, corev1.EventTypeWarning, errorListingClusterServicePlansReason, "%v %v", errorListingClusterServicePlansMessage, err)
+		c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorListingClusterServicePlans, "%v %v", errorListingClusterServicePlansMessage, err)
 		if err := c.updateClusterServiceBrokerCondition(
 			broker,
 			v1beta1.ServiceBrokerConditionReady,
 			v1beta1.ConditionUnknown,
-			errorListingClusterServicePlansReason,
+			v1beta1.ReasonErrorListingClusterServicePlans,
 			errorListingClusterServicePlansMessage,
 		); err != nil {
 			return nil, nil, err
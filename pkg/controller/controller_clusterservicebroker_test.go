@@ -18,6 +18,9 @@ package controller
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
@@ -28,11 +31,13 @@ import (
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	"github.com/kubernetes-incubator/service-catalog/test/fake"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"strings"
 
@@ -206,6 +211,31 @@ func TestShouldReconcileClusterServiceBroker(t *testing.T) {
 			now:       time.Now(),
 			reconcile: false,
 		},
+		{
+			name: "ready, manual behavior, interval elapsed",
+			broker: func() *v1beta1.ClusterServiceBroker {
+				t := metav1.NewTime(time.Now().Add(-25 * time.Hour))
+				broker := getTestClusterServiceBrokerWithStatusAndTime(v1beta1.ConditionTrue, t, t)
+				broker.Spec.RelistBehavior = v1beta1.ServiceBrokerRelistBehaviorManual
+				broker.Spec.RelistDuration = &metav1.Duration{Duration: time.Hour}
+				return broker
+			}(),
+			now:       time.Now(),
+			reconcile: false,
+		},
+		{
+			name: "ready, manual behavior, relist requested",
+			broker: func() *v1beta1.ClusterServiceBroker {
+				broker := getTestClusterServiceBrokerWithStatus(v1beta1.ConditionTrue)
+				broker.Spec.RelistBehavior = v1beta1.ServiceBrokerRelistBehaviorManual
+				broker.Spec.RelistRequests = 1
+				broker.Generation = 2
+				broker.Status.ReconciledGeneration = 1
+				return broker
+			}(),
+			now:       time.Now(),
+			reconcile: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -297,6 +327,512 @@ func TestReconcileClusterServiceBrokerExistingServiceClassAndServicePlan(t *test
 	}
 }
 
+// TestReconcileClusterServiceBrokerFeatures tests that the optional features
+// advertised in the broker's catalog are recorded in the broker's status.
+func TestReconcileClusterServiceBrokerFeatures(t *testing.T) {
+	catalog := getTestCatalog()
+	catalog.Services[0].BindingsRetrievable = true
+	catalog.Services[0].PlanUpdatable = truePtr()
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{
+			Response: catalog,
+		},
+	})
+
+	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+		t.Fatalf("This should not fail: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[len(actions)-1], getTestClusterServiceBroker())
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+
+	expected := []v1beta1.ServiceBrokerFeature{v1beta1.ServiceBrokerFeatureAsyncBindings, v1beta1.ServiceBrokerFeaturePlanUpdates}
+	if actual := updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status.Features; !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Unexpected broker features; %s", expectedGot(expected, actual))
+	}
+}
+
+// TestReconcileClusterServiceBrokerCatalogCounts tests that the number of
+// classes and plans in the broker's catalog are recorded in its status.
+func TestReconcileClusterServiceBrokerCatalogCounts(t *testing.T) {
+	catalog := getTestCatalog()
+	service := catalog.Services[0]
+	service.ID, service.Name = "second-service-id", "second-service"
+	service.Plans = []osb.Plan{
+		{ID: "second-plan-id", Name: "second-plan", Free: truePtr()},
+	}
+	catalog.Services = append(catalog.Services, service)
+
+	var expectedPlanCount int64
+	for _, s := range catalog.Services {
+		expectedPlanCount += int64(len(s.Plans))
+	}
+
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{
+			Response: catalog,
+		},
+	})
+
+	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+		t.Fatalf("This should not fail: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[len(actions)-1], getTestClusterServiceBroker())
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+
+	status := updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status
+	if status.LastCatalogClassCount == nil || status.LastCatalogPlanCount == nil {
+		t.Fatalf("Expected catalog counts to be set; got classes %v, plans %v", status.LastCatalogClassCount, status.LastCatalogPlanCount)
+	}
+	if e, a := int64(2), *status.LastCatalogClassCount; e != a {
+		t.Fatalf("Unexpected class count; %s", expectedGot(e, a))
+	}
+	if e, a := expectedPlanCount, *status.LastCatalogPlanCount; e != a {
+		t.Fatalf("Unexpected plan count; %s", expectedGot(e, a))
+	}
+}
+
+// TestReconcileClusterServiceBrokerResumesOrphanMitigation tests that a
+// successful relist of a broker, whether or not it was ready before, resumes
+// the orphan mitigation of its instances which ran out of retries, but not of
+// the ones stopped after the maximum number of attempts.
+func TestReconcileClusterServiceBrokerResumesOrphanMitigation(t *testing.T) {
+	// The orphan mitigations are resumed on every successful relist, so that
+	// a broker which recovers between two relists is noticed too.
+	for _, brokerReady := range []v1beta1.ConditionStatus{v1beta1.ConditionFalse, v1beta1.ConditionTrue} {
+		t.Run(fmt.Sprintf("broker ready %v", brokerReady), func(t *testing.T) {
+			_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				CatalogReaction: &fakeosb.CatalogReaction{
+					Response: getTestCatalog(),
+				},
+				DeprovisionReaction: &fakeosb.DeprovisionReaction{
+					Response: &osb.DeprovisionResponse{},
+				},
+			})
+			testController.resumeOrphanMitigationOnBrokerRecovery = true
+			testController.maxOrphanMitigationAttempts = 3
+
+			// the relist interval has elapsed, so that a ready broker is relisted
+			lastRelist := metav1.NewTime(time.Now().Add(-time.Hour))
+			broker := getTestClusterServiceBrokerWithStatusAndTime(brokerReady, lastRelist, lastRelist)
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			failedOrphanMitigation := func(name string, attempts int64) *v1beta1.ServiceInstance {
+				instance := getTestServiceInstanceWithClusterRefs()
+				instance.Name = name
+				instance.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+				instance.Status.OrphanMitigationInProgress = true
+				instance.Status.OrphanMitigationAttempts = attempts
+				instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusFailed
+				instance.Status.InProgressProperties = &v1beta1.ServiceInstancePropertiesState{
+					ClusterServicePlanExternalName: testClusterServicePlanName,
+					ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+				}
+				startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+				instance.Status.OperationStartTime = &startTime
+				setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionOrphanMitigation,
+					v1beta1.ConditionTrue, v1beta1.ReasonStartingInstanceOrphanMitigation, startingInstanceOrphanMitigationMessage)
+				setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady,
+					v1beta1.ConditionUnknown, v1beta1.ReasonOrphanMitigationFailed, "Orphan mitigation failed")
+				return instance
+			}
+			timedOutInstance := failedOrphanMitigation("timed-out-instance", 1)
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(timedOutInstance)
+			sharedInformers.ServiceInstances().Informer().GetStore().Add(failedOrphanMitigation("exhausted-instance", 3))
+
+			if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+				t.Fatalf("This should not fail: %v", err)
+			}
+
+			var instanceActions []clientgotesting.Action
+			for _, action := range fakeCatalogClient.Actions() {
+				if action.GetResource().Resource == "serviceinstances" {
+					instanceActions = append(instanceActions, action)
+				}
+			}
+			assertNumberOfActions(t, instanceActions, 1)
+			instance := assertUpdateStatus(t, instanceActions[0], timedOutInstance).(*v1beta1.ServiceInstance)
+			if e, a := v1beta1.ServiceInstanceDeprovisionStatusRequired, instance.Status.DeprovisionStatus; e != a {
+				t.Fatalf("Unexpected deprovision status; %s", expectedGot(e, a))
+			}
+			if instance.Status.OperationStartTime != nil {
+				t.Fatalf("Expected the operation start time to be reset; got %v", instance.Status.OperationStartTime)
+			}
+			assertServiceInstanceReadyCondition(t, instance, v1beta1.ConditionFalse, v1beta1.ReasonResumingInstanceOrphanMitigation)
+
+			// The resumed instance sends a deprovision request to the broker again,
+			// after the catalog request.
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("Unexpected error resuming orphan mitigation: %v", err)
+			}
+			brokerActions := fakeClusterServiceBrokerClient.Actions()
+			assertNumberOfBrokerActions(t, brokerActions, 2)
+			assertGetCatalog(t, brokerActions[0])
+			assertDeprovision(t, brokerActions[1], &osb.DeprovisionRequest{
+				AcceptsIncomplete: true,
+				InstanceID:        testServiceInstanceGUID,
+				ServiceID:         testClusterServiceClassGUID,
+				PlanID:            testClusterServicePlanGUID,
+			})
+		})
+	}
+}
+
+// TestReconcileClusterServiceBrokerLastSuccessfulEndpoint tests that the
+// endpoint which served the catalog is recorded in the broker's status, and
+// that a failed fetch from a new endpoint leaves the recorded one alone.
+func TestReconcileClusterServiceBrokerLastSuccessfulEndpoint(t *testing.T) {
+	cases := []struct {
+		name             string
+		catalogReaction  *fakeosb.CatalogReaction
+		expectedEndpoint string
+	}{
+		{
+			name:             "catalog fetched",
+			catalogReaction:  &fakeosb.CatalogReaction{Response: getTestCatalog()},
+			expectedEndpoint: "https://new.example.com",
+		},
+		{
+			name:             "error fetching catalog",
+			catalogReaction:  &fakeosb.CatalogReaction{Error: errors.New("ooops")},
+			expectedEndpoint: "https://example.com",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{
+				CatalogReaction: tc.catalogReaction,
+			})
+
+			broker := getTestClusterServiceBroker()
+			broker.Spec.URL = "https://new.example.com"
+			broker.Status.LastSuccessfulEndpoint = "https://example.com"
+
+			reconcileClusterServiceBroker(t, testController, broker)
+
+			actions := fakeCatalogClient.Actions()
+			updated := assertUpdateStatus(t, actions[len(actions)-1], broker).(*v1beta1.ClusterServiceBroker)
+			if e, a := tc.expectedEndpoint, updated.Status.LastSuccessfulEndpoint; e != a {
+				t.Fatalf("Unexpected last successful endpoint; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestReconcileClusterServiceBrokerInsecureSkipTLSVerify tests that TLS
+// verification is only skipped, and a warning condition set, when both the
+// broker asks for it and the controller allows it.
+func TestReconcileClusterServiceBrokerInsecureSkipTLSVerify(t *testing.T) {
+	cases := []struct {
+		name              string
+		skipTLSVerify     bool
+		allowed           bool
+		expectedCondition bool
+		expectedEvents    []string
+	}{
+		{
+			name:              "skip requested and allowed",
+			skipTLSVerify:     true,
+			allowed:           true,
+			expectedCondition: true,
+			expectedEvents:    warningEventBuilder(v1beta1.ReasonInsecureSkipTLSVerify).msg(insecureSkipTLSVerifyMessage).stringArr(),
+		},
+		{
+			name:           "skip requested but not allowed",
+			skipTLSVerify:  true,
+			expectedEvents: warningEventBuilder(v1beta1.ReasonInsecureSkipTLSVerifyNotAllowed).msg(insecureSkipTLSVerifyNotAllowedMessage).stringArr(),
+		},
+		{
+			name:    "skip allowed but not requested",
+			allowed: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, _ := newTestController(t, getTestCatalogConfig())
+			testController.allowBrokerInsecureSkipTLSVerify = tc.allowed
+
+			broker := getTestClusterServiceBroker()
+			broker.Spec.InsecureSkipTLSVerify = tc.skipTLSVerify
+
+			if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+				t.Fatalf("This should not fail: %v", err)
+			}
+
+			actions := fakeCatalogClient.Actions()
+			updatedClusterServiceBroker := assertUpdateStatus(t, actions[len(actions)-1], broker)
+			assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+
+			status := updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status
+			var condition *v1beta1.ServiceBrokerCondition
+			for i := range status.Conditions {
+				if status.Conditions[i].Type == v1beta1.ServiceBrokerConditionInsecureSkipTLSVerify {
+					condition = &status.Conditions[i]
+				}
+			}
+			if tc.expectedCondition {
+				if condition == nil || condition.Status != v1beta1.ConditionTrue {
+					t.Fatalf("Expected a true %v condition; got %+v", v1beta1.ServiceBrokerConditionInsecureSkipTLSVerify, status.Conditions)
+				}
+			} else if condition != nil {
+				t.Fatalf("Unexpected %v condition: %+v", v1beta1.ServiceBrokerConditionInsecureSkipTLSVerify, *condition)
+			}
+			if e, a := "Ready", status.LastConditionState; e != a {
+				t.Fatalf("Unexpected LastConditionState; %s", expectedGot(e, a))
+			}
+
+			expectedEvents := append(tc.expectedEvents, normalEventBuilder(v1beta1.ReasonFetchedCatalog).msg(successFetchedCatalogMessage).String())
+			events := getRecordedEvents(testController)
+			if err := checkEvents(events, expectedEvents); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// TestReconcileClusterServiceBrokerDeprecated tests that the Deprecated
+// condition follows the deprecations announced in the broker catalog without
+// replacing the broker's Ready state.
+func TestReconcileClusterServiceBrokerDeprecated(t *testing.T) {
+	cases := []struct {
+		name              string
+		deprecated        bool
+		existingCondition bool
+		expectedStatus    v1beta1.ConditionStatus
+	}{
+		{
+			name:           "deprecated",
+			deprecated:     true,
+			expectedStatus: v1beta1.ConditionTrue,
+		},
+		{
+			name: "not deprecated",
+		},
+		{
+			name:              "no longer deprecated",
+			existingCondition: true,
+			expectedStatus:    v1beta1.ConditionFalse,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			catalogConfig := getTestCatalogConfig()
+			if tc.deprecated {
+				catalogConfig.CatalogReaction.(*fakeosb.CatalogReaction).Response.Services[0].Metadata = map[string]interface{}{
+					"deprecationNotice": "Use another service instead.",
+					"endOfLife":         "2020-06-30",
+				}
+			}
+			_, fakeCatalogClient, _, testController, _ := newTestController(t, catalogConfig)
+
+			broker := getTestClusterServiceBroker()
+			if tc.existingCondition {
+				broker.Status.Conditions = []v1beta1.ServiceBrokerCondition{{
+					Type:    v1beta1.ServiceBrokerConditionDeprecated,
+					Status:  v1beta1.ConditionTrue,
+					Reason:  v1beta1.ReasonBrokerDeprecated,
+					Message: brokerDeprecatedMessage + "a notice",
+				}}
+			}
+
+			if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+				t.Fatalf("This should not fail: %v", err)
+			}
+
+			actions := fakeCatalogClient.Actions()
+			updatedClusterServiceBroker := assertUpdateStatus(t, actions[len(actions)-1], broker)
+			assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+
+			status := updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status
+			var condition *v1beta1.ServiceBrokerCondition
+			for i := range status.Conditions {
+				if status.Conditions[i].Type == v1beta1.ServiceBrokerConditionDeprecated {
+					condition = &status.Conditions[i]
+				}
+			}
+			switch {
+			case tc.expectedStatus == "" && condition != nil:
+				t.Fatalf("Unexpected %v condition: %+v", v1beta1.ServiceBrokerConditionDeprecated, *condition)
+			case tc.expectedStatus != "" && condition == nil:
+				t.Fatalf("Expected a %v condition; got %+v", v1beta1.ServiceBrokerConditionDeprecated, status.Conditions)
+			case tc.expectedStatus != "" && condition.Status != tc.expectedStatus:
+				t.Fatalf("Unexpected %v condition status; %s", v1beta1.ServiceBrokerConditionDeprecated, expectedGot(tc.expectedStatus, condition.Status))
+			}
+			if tc.deprecated {
+				expectedMessage := brokerDeprecatedMessage + `service "test-clusterserviceclass" reaches its end of life on 2020-06-30: Use another service instead.`
+				if e, a := expectedMessage, condition.Message; e != a {
+					t.Fatalf("Unexpected condition message; %s", expectedGot(e, a))
+				}
+			}
+			if e, a := "Ready", status.LastConditionState; e != a {
+				t.Fatalf("Unexpected LastConditionState; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestReconcileClusterServiceBrokerSkipsMalformedCatalogEntries tests that,
+// when malformed catalog entries are skipped, a class without plans and a
+// class and a plan rejected as invalid are listed in the
+// MalformedCatalogEntries condition while the valid entries are synchronized.
+func TestReconcileClusterServiceBrokerSkipsMalformedCatalogEntries(t *testing.T) {
+	catalog := getTestCatalog()
+	catalog.Services[0].Plans = append(catalog.Services[0].Plans, osb.Plan{
+		Name:        "malformed-plan",
+		ID:          "malformed-plan-id",
+		Description: "a malformed plan",
+	})
+	catalog.Services = append(catalog.Services,
+		osb.Service{
+			Name:        "other-class",
+			ID:          "other-class-id",
+			Description: "another test service",
+			Plans: []osb.Plan{{
+				Name:        "other-plan",
+				ID:          "other-plan-id",
+				Description: "another test plan",
+			}},
+		},
+		osb.Service{
+			Name:        "malformed-class",
+			ID:          "malformed-class-id",
+			Description: "a malformed service",
+			Plans: []osb.Plan{{
+				Name:        "malformed-class-plan",
+				ID:          "malformed-class-plan-id",
+				Description: "a plan of a malformed service",
+			}},
+		},
+		osb.Service{
+			Name:        "class-without-plans",
+			ID:          "class-without-plans-id",
+			Description: "a service without plans",
+		},
+	)
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{Response: catalog},
+	})
+	testController.skipMalformedCatalogEntries = true
+
+	fakeCatalogClient.AddReactor("create", "clusterserviceclasses", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		class := action.(clientgotesting.CreateAction).GetObject().(*v1beta1.ClusterServiceClass)
+		if class.Spec.ExternalName == "malformed-class" {
+			return true, nil, apierrors.NewInvalid(v1beta1.Kind("ClusterServiceClass"), class.Name, field.ErrorList{
+				field.Invalid(field.NewPath("spec", "externalName"), class.Spec.ExternalName, "invalid name"),
+			})
+		}
+		return true, class, nil
+	})
+	fakeCatalogClient.AddReactor("create", "clusterserviceplans", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		plan := action.(clientgotesting.CreateAction).GetObject().(*v1beta1.ClusterServicePlan)
+		if plan.Spec.ExternalName == "malformed-plan" {
+			return true, nil, apierrors.NewInvalid(v1beta1.Kind("ClusterServicePlan"), plan.Name, field.ErrorList{
+				field.Invalid(field.NewPath("spec", "externalName"), plan.Spec.ExternalName, "invalid name"),
+			})
+		}
+		return true, plan, nil
+	})
+
+	broker := getTestClusterServiceBroker()
+	if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("This should not fail: %v", err)
+	}
+
+	var createdClasses, createdPlans []string
+	actions := fakeCatalogClient.Actions()
+	for _, action := range actions {
+		if !action.Matches("create", "clusterserviceclasses") && !action.Matches("create", "clusterserviceplans") {
+			continue
+		}
+		switch obj := action.(clientgotesting.CreateAction).GetObject().(type) {
+		case *v1beta1.ClusterServiceClass:
+			createdClasses = append(createdClasses, obj.Spec.ExternalName)
+		case *v1beta1.ClusterServicePlan:
+			createdPlans = append(createdPlans, obj.Spec.ExternalName)
+		}
+	}
+	// the create requests of the malformed entries are sent, and rejected;
+	// the plans of the malformed class are not sent at all
+	if e, a := []string{testClusterServiceClassName, "other-class", "malformed-class"}, createdClasses; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected created classes; %s", expectedGot(e, a))
+	}
+	if e, a := []string{testClusterServicePlanName, testNonbindableClusterServicePlanName, "malformed-plan", "other-plan"}, createdPlans; !reflect.DeepEqual(e, a) {
+		t.Fatalf("Unexpected created plans; %s", expectedGot(e, a))
+	}
+
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[len(actions)-1], broker)
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+
+	status := updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status
+	condition := status.Conditions[0]
+	if e, a := v1beta1.ServiceBrokerConditionMalformedCatalogEntries, condition.Type; e != a {
+		t.Fatalf("Unexpected first condition; %s", expectedGot(e, a))
+	}
+	if e, a := v1beta1.ConditionTrue, condition.Status; e != a {
+		t.Fatalf("Unexpected condition status; %s", expectedGot(e, a))
+	}
+	expectedMessage := malformedCatalogEntriesMessage + `class "class-without-plans", class "malformed-class", plan "malformed-plan" of class "test-clusterserviceclass"`
+	if e, a := expectedMessage, condition.Message; e != a {
+		t.Fatalf("Unexpected condition message; %s", expectedGot(e, a))
+	}
+	if e, a := int64(2), *status.LastCatalogClassCount; e != a {
+		t.Fatalf("Unexpected class count; %s", expectedGot(e, a))
+	}
+	if e, a := int64(3), *status.LastCatalogPlanCount; e != a {
+		t.Fatalf("Unexpected plan count; %s", expectedGot(e, a))
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := corev1.EventTypeWarning + " " + v1beta1.ReasonMalformedCatalogEntriesSkipped + " " + expectedMessage
+	if e, a := expectedEvent, events[0]; e != a {
+		t.Fatalf("Received unexpected event; %s", expectedGot(e, a))
+	}
+}
+
+// TestReconcileClusterServiceBrokerWarnsOnUnsupportedParameterSchema tests
+// that a plan whose parameter schema declares an unsupported JSON Schema
+// draft is synchronized with a warning instead of failing the relist.
+func TestReconcileClusterServiceBrokerWarnsOnUnsupportedParameterSchema(t *testing.T) {
+	catalog := getTestCatalog()
+	catalog.Services[0].Plans[0].Schemas = &osb.Schemas{
+		ServiceInstance: &osb.ServiceInstanceSchema{
+			Create: &osb.InputParametersSchema{
+				Parameters: map[string]interface{}{
+					"$schema": "http://json-schema.org/draft-03/schema#",
+					"type":    "object",
+				},
+			},
+		},
+	}
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{Response: catalog},
+	})
+
+	broker := getTestClusterServiceBroker()
+	if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("This should not fail: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[len(actions)-1], broker)
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+
+	events := getRecordedEvents(testController)
+	expectedEvent := corev1.EventTypeWarning + " " + v1beta1.ReasonUnsupportedParameterSchema + " " + "The instanceCreateParameterSchema of "
+	if e, a := expectedEvent, events[0]; !strings.HasPrefix(a, e) {
+		t.Fatalf("Received unexpected event; %s", expectedGot(e, a))
+	}
+	if e, a := `declares the unsupported JSON Schema "http://json-schema.org/draft-03/schema#"`, events[0]; !strings.Contains(a, e) {
+		t.Fatalf("Received unexpected event; %s", expectedGot(e, a))
+	}
+}
+
 func TestReconcileClusterServiceBrokerRemovedClusterServiceClass(t *testing.T) {
 	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, getTestCatalogConfig())
 
@@ -516,7 +1052,7 @@ func TestReconcileClusterServiceBrokerExistingClusterServiceClassDifferentBroker
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorSyncingCatalogReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorSyncingCatalog).msgf(
 		"Error reconciling ClusterServiceClass (K8S: %q ExternalName: %q) (broker %q):",
 		testClusterServiceClassGUID, testClusterServiceClassName, testClusterServiceBrokerName,
 	).msgf(
@@ -571,7 +1107,7 @@ func TestReconcileClusterServiceBrokerExistingClusterServicePlanDifferentClass(t
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorSyncingCatalogReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorSyncingCatalog).msgf(
 		"Error reconciling ClusterServicePlan (K8S: %q ExternalName: %q):",
 		testClusterServicePlanGUID, testClusterServicePlanName,
 	).msgf(
@@ -702,7 +1238,7 @@ func TestReconcileClusterServiceBrokerDelete(t *testing.T) {
 
 			events := getRecordedEvents(testController)
 
-			expectedEvent := normalEventBuilder(successClusterServiceBrokerDeletedReason).msg(
+			expectedEvent := normalEventBuilder(v1beta1.ReasonDeletedClusterServiceBrokerSuccessfully).msg(
 				"The broker test-clusterservicebroker was deleted successfully.",
 			)
 			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
@@ -745,12 +1281,180 @@ func TestReconcileClusterServiceBrokerErrorFetchingCatalog(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorFetchingCatalogReason).msg("Error getting broker catalog:").msg("ooops")
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorFetchingCatalog).msg("Error getting broker catalog:").msg("ooops")
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileClusterServiceBrokerCatalogTooLarge tests that the catalog of
+// a broker publishing more classes and plans than the maximum catalog size is
+// rejected without creating any of them.
+func TestReconcileClusterServiceBrokerCatalogTooLarge(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, getTestCatalogConfig())
+	testController.maxCatalogSize = 2
+
+	broker := getTestClusterServiceBroker()
+
+	if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertGetCatalog(t, brokerActions[0])
+
+	listRestrictions := clientgotesting.ListRestrictions{
+		Labels: labels.SelectorFromSet(labels.Set{
+			v1beta1.GroupName + "/" + v1beta1.FilterSpecClusterServiceBrokerName: "test-clusterservicebroker",
+		}),
+		Fields: fields.Everything(),
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 3)
+	assertList(t, actions[0], &v1beta1.ClusterServiceClass{}, listRestrictions)
+	assertList(t, actions[1], &v1beta1.ClusterServicePlan{}, listRestrictions)
+
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[2], broker)
+	assertClusterServiceBrokerReadyFalse(t, updatedClusterServiceBroker)
+	if e, a := v1beta1.ReasonCatalogTooLarge, updatedClusterServiceBroker.(*v1beta1.ClusterServiceBroker).Status.Conditions[0].Reason; e != a {
+		t.Fatalf("unexpected condition reason; expected %v, got %v", e, a)
+	}
+
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := warningEventBuilder(v1beta1.ReasonCatalogTooLarge).msg("The broker publishes 1 classes and 2 plans, more than the maximum of 2 classes and plans per broker")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
 }
 
+// TestReconcileClusterServiceBrokerOSBAPIVersion tests that the OSB API
+// version set on a broker, or else the one preferred by the controller, is
+// sent to the broker in the X-Broker-API-Version header.
+func TestReconcileClusterServiceBrokerOSBAPIVersion(t *testing.T) {
+	cases := []struct {
+		name            string
+		brokerVersion   string
+		expectedVersion string
+	}{
+		{
+			name:            "set on broker",
+			brokerVersion:   "2.11",
+			expectedVersion: "2.11",
+		},
+		{
+			name:            "controller default",
+			expectedVersion: "2.12",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var header string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				header = r.Header.Get(osb.APIVersionHeader)
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"services":[]}`))
+			}))
+			defer server.Close()
+
+			_, _, _, testController, _ := newTestController(t, noFakeActions())
+			testController.brokerClientManager = NewBrokerClientManager(osb.NewClient)
+			testController.OSBAPIPreferredVersion = "2.12"
+
+			broker := getTestClusterServiceBroker()
+			broker.Spec.URL = server.URL
+			broker.Spec.OSBAPIVersion = tc.brokerVersion
+
+			if err := reconcileClusterServiceBroker(t, testController, broker); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if e, a := tc.expectedVersion, header; e != a {
+				t.Fatalf("unexpected %v header; %s", osb.APIVersionHeader, expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// TestReconcileClusterServiceBrokerPlanSchemaTightened tests that when a
+// relist makes the instance create schema of a plan stricter, the existing
+// instances of the plan whose parameters no longer comply are flagged.
+func TestReconcileClusterServiceBrokerPlanSchemaTightened(t *testing.T) {
+	catalog := getTestCatalog()
+	catalog.Services[0].Plans[0].Schemas = &osb.Schemas{
+		ServiceInstance: &osb.ServiceInstanceSchema{
+			Create: &osb.InputParametersSchema{
+				Parameters: map[string]interface{}{
+					"type":     "object",
+					"required": []interface{}{"size"},
+				},
+			},
+		},
+	}
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, fakeosb.FakeClientConfiguration{
+		CatalogReaction: &fakeosb.CatalogReaction{Response: catalog},
+	})
+	testController.revalidateInstancesOnPlanSchemaChange = true
+
+	testClusterServiceClass := getTestClusterServiceClass()
+	testClusterServicePlan := getTestClusterServicePlan()
+	testClusterServicePlan.Spec.InstanceCreateParameterSchema = &runtime.RawExtension{Raw: []byte(`{"type":"object"}`)}
+
+	instance := getTestServiceInstanceWithRefsAndExternalProperties()
+	instance.Status.ExternalProperties.Parameters = &runtime.RawExtension{Raw: []byte(`{"name":"db"}`)}
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, v1beta1.ReasonProvisionedSuccessfully, successProvisionMessage)
+
+	fakeCatalogClient.AddReactor("list", "clusterserviceclasses", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ClusterServiceClassList{Items: []v1beta1.ClusterServiceClass{*testClusterServiceClass}}, nil
+	})
+	fakeCatalogClient.AddReactor("list", "clusterserviceplans", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ClusterServicePlanList{Items: []v1beta1.ClusterServicePlan{*testClusterServicePlan}}, nil
+	})
+	fakeCatalogClient.AddReactor("list", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ServiceInstanceList{Items: []v1beta1.ServiceInstance{*instance}}, nil
+	})
+	fakeCatalogClient.AddReactor("update", "clusterserviceplans", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, action.(clientgotesting.UpdateAction).GetObject(), nil
+	})
+
+	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var updatedInstance *v1beta1.ServiceInstance
+	for _, action := range fakeCatalogClient.Actions() {
+		if action.GetVerb() == "update" && action.GetResource().Resource == "serviceinstances" && action.GetSubresource() == "status" {
+			updatedInstance = action.(clientgotesting.UpdateAction).GetObject().(*v1beta1.ServiceInstance)
+		}
+	}
+	if updatedInstance == nil {
+		t.Fatal("expected the status of the instance to be updated")
+	}
+	assertServiceInstanceCondition(t, updatedInstance, v1beta1.ServiceInstanceConditionNonCompliantParameters, v1beta1.ConditionTrue, v1beta1.ReasonPlanSchemaChanged)
+	// The Ready condition stays last, so that it remains the LastConditionState
+	conditions := updatedInstance.Status.Conditions
+	if e, a := v1beta1.ServiceInstanceConditionReady, conditions[len(conditions)-1].Type; e != a {
+		t.Fatalf("unexpected last condition: %v", expectedGot(e, a))
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := warningEventBuilder(v1beta1.ReasonPlanSchemaChanged).msgf(
+		"The parameters of the instance do not comply with the new parameter schema of plan %q: %s",
+		testClusterServicePlanName, `parameters: "size" is required`,
+	)
+	expectedEvents := []string{
+		expectedEvent.String(),
+		normalEventBuilder(v1beta1.ReasonFetchedCatalog).msg(successFetchedCatalogMessage).String(),
+	}
+	if err := checkEvents(events, expectedEvents); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestReconcileClusterServiceBrokerZeroServices simulates broker reconciliation where
 // OSB client responds with zero services which is valid
 func TestReconcileClusterServiceBrokerZeroServices(t *testing.T) {
@@ -808,7 +1512,7 @@ func TestReconcileClusterServiceBrokerZeroServices(t *testing.T) {
 	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
 
 	events := getRecordedEvents(testController)
-	expectedEvent := corev1.EventTypeNormal + " " + successFetchedCatalogReason + " " + successFetchedCatalogMessage
+	expectedEvent := corev1.EventTypeNormal + " " + v1beta1.ReasonFetchedCatalog + " " + successFetchedCatalogMessage
 	if e, a := expectedEvent, events[0]; !strings.HasPrefix(a, e) {
 		t.Fatalf("Received unexpected event, %s", expectedGot(e, a))
 	}
@@ -938,9 +1642,9 @@ func testReconcileClusterServiceBrokerWithAuth(t *testing.T, authInfo *v1beta1.C
 
 	var expectedEvent string
 	if shouldSucceed {
-		expectedEvent = corev1.EventTypeNormal + " " + successFetchedCatalogReason + " " + successFetchedCatalogMessage
+		expectedEvent = corev1.EventTypeNormal + " " + v1beta1.ReasonFetchedCatalog + " " + successFetchedCatalogMessage
 	} else {
-		expectedEvent = corev1.EventTypeWarning + " " + errorAuthCredentialsReason + " " + `Error getting broker auth credentials`
+		expectedEvent = corev1.EventTypeWarning + " " + v1beta1.ReasonErrorGettingAuthCredentials + " " + `Error getting broker auth credentials`
 	}
 	if e, a := expectedEvent, events[0]; !strings.HasPrefix(a, e) {
 		t.Fatalf("Received unexpected event, %s", expectedGot(e, a))
@@ -997,7 +1701,7 @@ func TestReconcileClusterServiceBrokerWithReconcileError(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorSyncingCatalogReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorSyncingCatalog).msgf(
 		"Error reconciling ClusterServiceClass (K8S: %q ExternalName: %q) (broker %q):",
 		testClusterServiceClassGUID, testClusterServiceClassName, testClusterServiceBrokerName,
 	).msg("error creating serviceclass")
@@ -1097,8 +1801,8 @@ func TestReconcileClusterServiceBrokerFailureOnFinalRetry(t *testing.T) {
 	events := getRecordedEvents(testController)
 
 	expectedEventPrefixes := []string{
-		warningEventBuilder(errorFetchingCatalogReason).String(),
-		warningEventBuilder(errorReconciliationRetryTimeoutReason).String(),
+		warningEventBuilder(v1beta1.ReasonErrorFetchingCatalog).String(),
+		warningEventBuilder(v1beta1.ReasonErrorReconciliationRetryTimeout).String(),
 	}
 
 	if err := checkEventPrefixes(events, expectedEventPrefixes); err != nil {
@@ -1647,3 +2351,39 @@ func TestMarkAsServiceCatalogManagedResource(t *testing.T) {
 		})
 	}
 }
+
+// TestReconcileClusterServiceBrokerCatalogRewriteRules tests that the catalog
+// rewrite rules of the broker are applied to its catalog, so that dropped
+// plans are not created and renamed classes get their new name.
+func TestReconcileClusterServiceBrokerCatalogRewriteRules(t *testing.T) {
+	_, fakeCatalogClient, _, testController, _ := newTestController(t, getTestCatalogConfig())
+	testController.catalogRewriteRules = CatalogRewriteRules{
+		"test-clusterservicebroker": {
+			{Class: testClusterServiceClassName, Rename: "renamed-class"},
+			{Class: testClusterServiceClassName, Plan: testNonbindableClusterServicePlanName, Drop: true},
+		},
+		"other-broker": {
+			{Class: testClusterServiceClassName, Drop: true},
+		},
+	}
+
+	if err := reconcileClusterServiceBroker(t, testController, getTestClusterServiceBroker()); err != nil {
+		t.Fatalf("This should not fail: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 5)
+	createdClass := assertCreate(t, actions[2], getTestClusterServiceClass()).(*v1beta1.ClusterServiceClass)
+	if e, a := "renamed-class", createdClass.Spec.ExternalName; e != a {
+		t.Fatalf("Unexpected class external name; %s", expectedGot(e, a))
+	}
+	if e, a := testClusterServiceClassGUID, createdClass.Spec.ExternalID; e != a {
+		t.Fatalf("Unexpected class external ID; %s", expectedGot(e, a))
+	}
+	createdPlan := assertCreate(t, actions[3], getTestClusterServicePlan()).(*v1beta1.ClusterServicePlan)
+	if e, a := testClusterServicePlanName, createdPlan.Spec.ExternalName; e != a {
+		t.Fatalf("Unexpected plan external name; %s", expectedGot(e, a))
+	}
+	updatedClusterServiceBroker := assertUpdateStatus(t, actions[4], getTestClusterServiceBroker())
+	assertClusterServiceBrokerReadyTrue(t, updatedClusterServiceBroker)
+}
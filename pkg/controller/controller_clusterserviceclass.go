@@ -71,12 +71,18 @@ func (c *controller) reconcileClusterServiceClassKey(key string) error {
 func (c *controller) reconcileClusterServiceClass(serviceClass *v1beta1.ClusterServiceClass) error {
 	klog.Infof("ClusterServiceClass %q (ExternalName: %q): processing", serviceClass.Name, serviceClass.Spec.ExternalName)
 
-	if !serviceClass.Status.RemovedFromBrokerCatalog {
-		return nil
+	if serviceClass.Status.RemovedFromBrokerCatalog {
+		klog.Infof("ClusterServiceClass %q (ExternalName: %q): has been removed from broker catalog; determining whether there are instances remaining", serviceClass.Name, serviceClass.Spec.ExternalName)
+	} else {
+		// A class whose broker is gone is normally deleted along with the
+		// broker, but a crash in between can leave it behind.
+		brokerMissing, err := c.isClusterServiceBrokerMissing(serviceClass.Spec.ClusterServiceBrokerName)
+		if err != nil || !brokerMissing {
+			return err
+		}
+		klog.Infof("ClusterServiceClass %q (ExternalName: %q): its ClusterServiceBroker %q no longer exists; determining whether there are instances remaining", serviceClass.Name, serviceClass.Spec.ExternalName, serviceClass.Spec.ClusterServiceBrokerName)
 	}
 
-	klog.Infof("ClusterServiceClass %q (ExternalName: %q): has been removed from broker catalog; determining whether there are instances remaining", serviceClass.Name, serviceClass.Spec.ExternalName)
-
 	serviceInstances, err := c.findServiceInstancesOnClusterServiceClass(serviceClass)
 	if err != nil {
 		return err
@@ -87,10 +93,30 @@ func (c *controller) reconcileClusterServiceClass(serviceClass *v1beta1.ClusterS
 		return nil
 	}
 
-	klog.Infof("ClusterServiceClass %q (ExternalName: %q): has been removed from broker catalog and has zero instances remaining; deleting", serviceClass.Name, serviceClass.Spec.ExternalName)
+	klog.Infof("ClusterServiceClass %q (ExternalName: %q): has zero instances remaining; deleting", serviceClass.Name, serviceClass.Spec.ExternalName)
 	return c.serviceCatalogClient.ClusterServiceClasses().Delete(serviceClass.Name, &metav1.DeleteOptions{})
 }
 
+// isClusterServiceBrokerMissing returns whether the ClusterServiceBroker with
+// the given name no longer exists. The informer cache may lag behind a newly
+// created broker, so its absence there is confirmed with the API server
+// before reporting the broker as missing.
+func (c *controller) isClusterServiceBrokerMissing(name string) (bool, error) {
+	_, err := c.clusterServiceBrokerLister.Get(name)
+	if err == nil {
+		return false, nil
+	}
+	if !errors.IsNotFound(err) {
+		return false, err
+	}
+
+	_, err = c.serviceCatalogClient.ClusterServiceBrokers().Get(name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return true, nil
+	}
+	return false, err
+}
+
 func (c *controller) findServiceInstancesOnClusterServiceClass(serviceClass *v1beta1.ClusterServiceClass) (*v1beta1.ServiceInstanceList, error) {
 	labelSelector := labels.SelectorFromSet(labels.Set{
 		v1beta1.GroupName + "/" + v1beta1.FilterSpecClusterServiceClassRefName: serviceClass.Name,
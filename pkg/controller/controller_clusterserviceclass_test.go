@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"testing"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -114,7 +115,8 @@ func TestReconcileClusterServiceClassRemovedFromCatalog(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+			_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
 
 			fakeCatalogClient.AddReactor("list", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
 				return true, &v1beta1.ServiceInstanceList{Items: tc.instances}, nil
@@ -144,6 +146,71 @@ func TestReconcileClusterServiceClassRemovedFromCatalog(t *testing.T) {
 	}
 }
 
+func TestReconcileClusterServiceClassBrokerMissing(t *testing.T) {
+	listRestrictions := clientgotesting.ListRestrictions{
+		Labels: labels.SelectorFromSet(labels.Set{
+			v1beta1.GroupName + "/" + v1beta1.FilterSpecClusterServiceClassRefName: "cscguid",
+		}),
+		Fields: fields.Everything(),
+	}
+
+	cases := []struct {
+		name                    string
+		brokerInAPIServer       bool
+		instances               []v1beta1.ServiceInstance
+		catalogActionsCheckFunc func(t *testing.T, actions []clientgotesting.Action)
+	}{
+		{
+			name:              "broker missing from cache only",
+			brokerInAPIServer: true,
+			catalogActionsCheckFunc: func(t *testing.T, actions []clientgotesting.Action) {
+				assertNumberOfActions(t, actions, 1)
+				assertGet(t, actions[0], getTestClusterServiceBroker())
+			},
+		},
+		{
+			name:      "broker missing, instances left",
+			instances: []v1beta1.ServiceInstance{*getTestServiceInstance()},
+			catalogActionsCheckFunc: func(t *testing.T, actions []clientgotesting.Action) {
+				assertNumberOfActions(t, actions, 2)
+				assertGet(t, actions[0], getTestClusterServiceBroker())
+				assertList(t, actions[1], &v1beta1.ServiceInstance{}, listRestrictions)
+			},
+		},
+		{
+			name: "broker missing, no instances left",
+			catalogActionsCheckFunc: func(t *testing.T, actions []clientgotesting.Action) {
+				assertNumberOfActions(t, actions, 3)
+				assertGet(t, actions[0], getTestClusterServiceBroker())
+				assertList(t, actions[1], &v1beta1.ServiceInstance{}, listRestrictions)
+				assertDelete(t, actions[2], getTestClusterServiceClass())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+
+			fakeCatalogClient.AddReactor("get", "clusterservicebrokers", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				if tc.brokerInAPIServer {
+					return true, getTestClusterServiceBroker(), nil
+				}
+				return true, nil, apierrors.NewNotFound(v1beta1.Resource("clusterservicebrokers"), testClusterServiceBrokerName)
+			})
+			fakeCatalogClient.AddReactor("list", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, &v1beta1.ServiceInstanceList{Items: tc.instances}, nil
+			})
+
+			if err := reconcileClusterServiceClass(t, testController, getTestClusterServiceClass()); err != nil {
+				t.Fatalf("unexpected error from method under test: %v", err)
+			}
+
+			tc.catalogActionsCheckFunc(t, fakeCatalogClient.Actions())
+		})
+	}
+}
+
 func reconcileClusterServiceClass(t *testing.T, testController *controller, clusterServiceClass *v1beta1.ClusterServiceClass) error {
 	clone := clusterServiceClass.DeepCopy()
 	err := testController.reconcileClusterServiceClass(clusterServiceClass)
@@ -72,11 +72,21 @@ func (c *controller) reconcileClusterServicePlanKey(key string) error {
 func (c *controller) reconcileClusterServicePlan(clusterServicePlan *v1beta1.ClusterServicePlan) error {
 	klog.Infof("ClusterServicePlan %q (ExternalName: %q): processing", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName)
 
-	if !clusterServicePlan.Status.RemovedFromBrokerCatalog {
-		return nil
+	if err := c.syncClusterServicePlanBindability(clusterServicePlan); err != nil {
+		return err
 	}
 
-	klog.Infof("ClusterServicePlan %q (ExternalName: %q): has been removed from broker catalog; determining whether there are instances remaining", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName)
+	if clusterServicePlan.Status.RemovedFromBrokerCatalog {
+		klog.Infof("ClusterServicePlan %q (ExternalName: %q): has been removed from broker catalog; determining whether there are instances remaining", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName)
+	} else {
+		// A plan whose broker is gone is normally deleted along with the
+		// broker, but a crash in between can leave it behind.
+		brokerMissing, err := c.isClusterServiceBrokerMissing(clusterServicePlan.Spec.ClusterServiceBrokerName)
+		if err != nil || !brokerMissing {
+			return err
+		}
+		klog.Infof("ClusterServicePlan %q (ExternalName: %q): its ClusterServiceBroker %q no longer exists; determining whether there are instances remaining", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName, clusterServicePlan.Spec.ClusterServiceBrokerName)
+	}
 
 	serviceInstances, err := c.findServiceInstancesOnClusterServicePlan(clusterServicePlan)
 	if err != nil {
@@ -88,10 +98,30 @@ func (c *controller) reconcileClusterServicePlan(clusterServicePlan *v1beta1.Clu
 		return nil
 	}
 
-	klog.Infof("ClusterServicePlan %q (ExternalName: %q): has been removed from broker catalog and has zero instances remaining; deleting", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName)
+	klog.Infof("ClusterServicePlan %q (ExternalName: %q): has zero instances remaining; deleting", clusterServicePlan.Name, clusterServicePlan.Spec.ExternalName)
 	return c.serviceCatalogClient.ClusterServicePlans().Delete(clusterServicePlan.Name, &metav1.DeleteOptions{})
 }
 
+// syncClusterServicePlanBindability flags the bindings of the instances of a
+// ClusterServicePlan which a broker relist made non-bindable, and clears the
+// flag once the plan is bindable again.
+func (c *controller) syncClusterServicePlanBindability(clusterServicePlan *v1beta1.ClusterServicePlan) error {
+	serviceClass, err := c.clusterServiceClassLister.Get(clusterServicePlan.Spec.ClusterServiceClassRef.Name)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	instances, err := c.getServiceInstancesOnPlan(clusterServicePlan.Name)
+	if err != nil {
+		return err
+	}
+
+	return c.syncServiceBindingsPlanBindability(instances, clusterServicePlan.Spec.ExternalName, isClusterServicePlanBindable(serviceClass, clusterServicePlan))
+}
+
 func (c *controller) findServiceInstancesOnClusterServicePlan(clusterServicePlan *v1beta1.ClusterServicePlan) (*v1beta1.ServiceInstanceList, error) {
 	labelSelector := labels.SelectorFromSet(labels.Set{
 		v1beta1.GroupName + "/" + v1beta1.FilterSpecClusterServicePlanRefName: clusterServicePlan.Name,
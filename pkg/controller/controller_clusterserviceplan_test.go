@@ -26,6 +26,7 @@ import (
 
 	"reflect"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	clientgotesting "k8s.io/client-go/testing"
@@ -116,7 +117,8 @@ func TestReconcileClusterServicePlanRemovedFromCatalog(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 
-			_, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+			_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
 
 			fakeCatalogClient.AddReactor("list", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
 				return true, &v1beta1.ServiceInstanceList{Items: tc.instances}, nil
@@ -146,6 +148,123 @@ func TestReconcileClusterServicePlanRemovedFromCatalog(t *testing.T) {
 	}
 }
 
+func TestReconcileClusterServicePlanBrokerMissing(t *testing.T) {
+	listRestrictions := clientgotesting.ListRestrictions{
+		Labels: labels.SelectorFromSet(labels.Set{
+			v1beta1.GroupName + "/" + v1beta1.FilterSpecClusterServicePlanRefName: "cspguid",
+		}),
+		Fields: fields.Everything(),
+	}
+
+	cases := []struct {
+		name                    string
+		brokerInAPIServer       bool
+		instances               []v1beta1.ServiceInstance
+		catalogActionsCheckFunc func(t *testing.T, actions []clientgotesting.Action)
+	}{
+		{
+			name:              "broker missing from cache only",
+			brokerInAPIServer: true,
+			catalogActionsCheckFunc: func(t *testing.T, actions []clientgotesting.Action) {
+				assertNumberOfActions(t, actions, 1)
+				assertGet(t, actions[0], getTestClusterServiceBroker())
+			},
+		},
+		{
+			name:      "broker missing, instances left",
+			instances: []v1beta1.ServiceInstance{*getTestServiceInstance()},
+			catalogActionsCheckFunc: func(t *testing.T, actions []clientgotesting.Action) {
+				assertNumberOfActions(t, actions, 2)
+				assertGet(t, actions[0], getTestClusterServiceBroker())
+				assertList(t, actions[1], &v1beta1.ServiceInstance{}, listRestrictions)
+			},
+		},
+		{
+			name: "broker missing, no instances left",
+			catalogActionsCheckFunc: func(t *testing.T, actions []clientgotesting.Action) {
+				assertNumberOfActions(t, actions, 3)
+				assertGet(t, actions[0], getTestClusterServiceBroker())
+				assertList(t, actions[1], &v1beta1.ServiceInstance{}, listRestrictions)
+				assertDelete(t, actions[2], getTestClusterServicePlan())
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+
+			fakeCatalogClient.AddReactor("get", "clusterservicebrokers", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				if tc.brokerInAPIServer {
+					return true, getTestClusterServiceBroker(), nil
+				}
+				return true, nil, apierrors.NewNotFound(v1beta1.Resource("clusterservicebrokers"), testClusterServiceBrokerName)
+			})
+			fakeCatalogClient.AddReactor("list", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, &v1beta1.ServiceInstanceList{Items: tc.instances}, nil
+			})
+
+			if err := reconcileClusterServicePlan(t, testController, getTestClusterServicePlan()); err != nil {
+				t.Fatalf("unexpected error from method under test: %v", err)
+			}
+
+			tc.catalogActionsCheckFunc(t, fakeCatalogClient.Actions())
+		})
+	}
+}
+
+// TestReconcileClusterServicePlanNotBindable tests that the bindings of the
+// instances of a plan which becomes non-bindable are flagged with the
+// PlanNotBindable condition without being deleted, and that the condition is
+// removed once the plan is bindable again.
+func TestReconcileClusterServicePlanNotBindable(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(getTestServiceInstanceWithClusterRefs())
+
+	binding := getTestServiceBinding()
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+
+	// the bindings of the instances of other plans are left alone
+	otherInstance := getTestServiceInstanceWithClusterRefs()
+	otherInstance.Name = "other-instance"
+	otherInstance.Spec.ClusterServicePlanRef = &v1beta1.ClusterObjectReference{Name: "other-plan"}
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(otherInstance)
+	otherBinding := getTestServiceBinding()
+	otherBinding.Name = "other-binding"
+	otherBinding.Spec.InstanceRef.Name = otherInstance.Name
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(otherBinding)
+
+	plan := getTestClusterServicePlan()
+	plan.Spec.Bindable = falsePtr()
+	if err := reconcileClusterServicePlan(t, testController, plan); err != nil {
+		t.Fatalf("unexpected error from method under test: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceBinding := assertUpdateStatus(t, actions[0], binding)
+	assertServiceBindingCondition(t, updatedServiceBinding, v1beta1.ServiceBindingConditionPlanNotBindable, v1beta1.ConditionTrue, v1beta1.ReasonPlanNotBindable)
+
+	events := getRecordedEvents(testController)
+	assertNumEvents(t, events, 1)
+
+	// The plan becomes bindable again.
+	sharedInformers.ServiceBindings().Informer().GetStore().Update(updatedServiceBinding)
+	fakeCatalogClient.ClearActions()
+
+	plan.Spec.Bindable = truePtr()
+	if err := reconcileClusterServicePlan(t, testController, plan); err != nil {
+		t.Fatalf("unexpected error from method under test: %v", err)
+	}
+
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceBinding = assertUpdateStatus(t, actions[0], binding)
+	assertServiceBindingConditionMissing(t, updatedServiceBinding, v1beta1.ServiceBindingConditionPlanNotBindable)
+}
+
 func reconcileClusterServicePlan(t *testing.T, testController *controller, clusterServicePlan *v1beta1.ClusterServicePlan) error {
 	clone := clusterServicePlan.DeepCopy()
 	err := testController.reconcileClusterServicePlan(clusterServicePlan)
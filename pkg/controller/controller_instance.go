@@ -17,14 +17,17 @@ limitations under the License.
 package controller
 
 import (
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"net/url"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
 
@@ -35,6 +38,8 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/cache"
@@ -43,56 +48,23 @@ import (
 )
 
 const (
-	successDeprovisionReason       string = "DeprovisionedSuccessfully"
 	successDeprovisionMessage      string = "The instance was deprovisioned successfully"
-	successUpdateInstanceReason    string = "InstanceUpdatedSuccessfully"
 	successUpdateInstanceMessage   string = "The instance was updated successfully"
-	successProvisionReason         string = "ProvisionedSuccessfully"
 	successProvisionMessage        string = "The instance was provisioned successfully"
-	successOrphanMitigationReason  string = "OrphanMitigationSuccessful"
 	successOrphanMitigationMessage string = "Orphan mitigation was completed successfully"
 
-	errorWithParametersReason                  string = "ErrorWithParameters"
-	errorProvisionCallFailedReason             string = "ProvisionCallFailed"
-	errorErrorCallingProvisionReason           string = "ErrorCallingProvision"
-	errorUpdateInstanceCallFailedReason        string = "UpdateInstanceCallFailed"
-	errorErrorCallingUpdateInstanceReason      string = "ErrorCallingUpdateInstance"
-	errorDeprovisionCallFailedReason           string = "DeprovisionCallFailed"
-	errorDeprovisionBlockedByCredentialsReason string = "DeprovisionBlockedByExistingCredentials"
-	errorPollingLastOperationReason            string = "ErrorPollingLastOperation"
-	errorWithOriginatingIdentityReason         string = "ErrorWithOriginatingIdentity"
-	errorWithOngoingAsyncOperationReason       string = "ErrorAsyncOperationInProgress"
-	errorNonexistentClusterServiceClassReason  string = "ReferencesNonexistentServiceClass"
 	errorNonexistentClusterServiceClassMessage string = "ReferencesNonexistentServiceClass"
-	errorNonexistentClusterServicePlanReason   string = "ReferencesNonexistentServicePlan"
-	errorNonexistentClusterServiceBrokerReason string = "ReferencesNonexistentBroker"
-	errorNonexistentServiceClassReason         string = "ReferencesNonexistentServiceClass"
-	errorNonexistentServicePlanReason          string = "ReferencesNonexistentServicePlan"
-	errorNonexistentServiceBrokerReason        string = "ReferencesNonexistentBroker"
-	errorDeletedClusterServiceClassReason      string = "ReferencesDeletedServiceClass"
-	errorDeletedClusterServicePlanReason       string = "ReferencesDeletedServicePlan"
-	errorDeletedServiceClassReason             string = "ReferencesDeletedServiceClass"
-	errorDeletedServicePlanReason              string = "ReferencesDeletedServicePlan"
-	errorFindingNamespaceServiceInstanceReason string = "ErrorFindingNamespaceForInstance"
-	errorOrphanMitigationFailedReason          string = "OrphanMitigationFailed"
-	errorInvalidDeprovisionStatusReason        string = "InvalidDeprovisionStatus"
 
 	errorAmbiguousPlanReferenceScope string = "couldn't determine if the instance refers to a Cluster or Namespaced ServiceClass/Plan"
 
-	asyncProvisioningReason                 string = "Provisioning"
 	asyncProvisioningMessage                string = "The instance is being provisioned asynchronously"
-	asyncUpdatingInstanceReason             string = "UpdatingInstance"
 	asyncUpdatingInstanceMessage            string = "The instance is being updated asynchronously"
-	asyncDeprovisioningReason               string = "Deprovisioning"
 	asyncDeprovisioningMessage              string = "The instance is being deprovisioned asynchronously"
-	provisioningInFlightReason              string = "ProvisionRequestInFlight"
 	provisioningInFlightMessage             string = "Provision request for ServiceInstance in-flight to Broker"
-	instanceUpdatingInFlightReason          string = "UpdateInstanceRequestInFlight"
 	instanceUpdatingInFlightMessage         string = "Update request for ServiceInstance in-flight to Broker"
-	deprovisioningInFlightReason            string = "DeprovisionRequestInFlight"
 	deprovisioningInFlightMessage           string = "Deprovision request for ServiceInstance in-flight to Broker"
-	startingInstanceOrphanMitigationReason  string = "StartingInstanceOrphanMitigation"
 	startingInstanceOrphanMitigationMessage string = "The instance provision call failed with an ambiguous error; attempting to deprovision the instance in order to mitigate an orphaned resource"
+	resumingInstanceOrphanMitigationMessage string = "The broker is reachable again; resuming the deprovisioning of the instance in order to mitigate an orphaned resource"
 
 	clusterIdentifierKey string = "clusterid"
 
@@ -119,6 +91,9 @@ type instanceOperationBackoff struct {
 
 // enqueueInstance adds the instance key to the work queue
 func (c *controller) enqueueInstance(obj interface{}) {
+	if !c.isSelected(obj) {
+		return
+	}
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		klog.Errorf("Couldn't get key for object %+v: %v", obj, err)
@@ -165,8 +140,32 @@ func (c *controller) instanceUpdate(oldObj, newObj interface{}) {
 		return
 	}
 
+	if c.reconcileInstancesOnlyOnChange && isServiceInstanceResyncOnly(oldObj, instance) {
+		klog.V(eventHandlerLogLevel).Info(pcb.Message("NOT enqueueing instance because it did not change since it was last observed"))
+		return
+	}
+
 	klog.V(eventHandlerLogLevel).Info(pcb.Message("Enqueueing instance"))
 	c.enqueueInstance(newObj)
+
+	// Bindings of a paused instance are skipped by the binding reconciler,
+	// so they have to be picked up again once the instance is resumed.
+	if oldInstance, ok := oldObj.(*v1beta1.ServiceInstance); ok && isServiceInstancePaused(oldInstance) && !isServiceInstancePaused(instance) {
+		c.enqueueServiceInstanceBindings(instance)
+	}
+}
+
+// isServiceInstanceResyncOnly returns whether an UPDATE event of an instance
+// comes from an informer resync of an instance whose spec has been observed and
+// which has no operation in progress.
+func isServiceInstanceResyncOnly(oldObj interface{}, instance *v1beta1.ServiceInstance) bool {
+	oldInstance, ok := oldObj.(*v1beta1.ServiceInstance)
+	if !ok || oldInstance.ResourceVersion != instance.ResourceVersion {
+		return false
+	}
+	return instance.Generation == instance.Status.ObservedGeneration &&
+		instance.Status.CurrentOperation == "" &&
+		!instance.Status.OrphanMitigationInProgress
 }
 
 // instanceDelete handles the ServiceInstance DELETED watch event
@@ -176,6 +175,9 @@ func (c *controller) instanceDelete(obj interface{}) {
 		return
 	}
 
+	c.forgetStuckOperation(instance.UID)
+	c.forgetChangedServiceInstanceParametersSecret(instance)
+
 	if klog.V(eventHandlerLogLevel) {
 		pcb := pretty.NewInstanceContextBuilder(instance)
 		klog.Info(pcb.Messagef("Received DELETE event: %v", toJSON(instance)))
@@ -183,6 +185,127 @@ func (c *controller) instanceDelete(obj interface{}) {
 	}
 }
 
+// secretUpdate enqueues the ServiceInstances whose parametersFrom reference an
+// updated Secret, so that changed parameters are sent to the broker.
+func (c *controller) secretUpdate(oldObj, newObj interface{}) {
+	oldSecret, ok := oldObj.(*corev1.Secret)
+	if !ok {
+		return
+	}
+	secret, ok := newObj.(*corev1.Secret)
+	if !ok {
+		return
+	}
+	if reflect.DeepEqual(oldSecret.Data, secret.Data) {
+		return
+	}
+
+	objs, err := c.instanceIndexer.ByIndex(instanceParametersSecretIndex, secret.Namespace+"/"+secret.Name)
+	if err != nil {
+		klog.Errorf("Couldn't list the ServiceInstances referencing Secret %q in namespace %q: %v", secret.Name, secret.Namespace, err)
+		return
+	}
+	for _, obj := range objs {
+		instance, ok := obj.(*v1beta1.ServiceInstance)
+		if !ok || instance.DeletionTimestamp != nil || !c.isSelected(instance) {
+			continue
+		}
+
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		klog.V(4).Info(pcb.Messagef("Received UPDATE event for parameters Secret %q", secret.Name))
+
+		c.changedParametersSecretInstancesLock.Lock()
+		c.changedParametersSecretInstances[instance.UID] = true
+		c.changedParametersSecretInstancesLock.Unlock()
+
+		c.enqueueInstance(instance)
+	}
+}
+
+// isServiceInstanceParametersSecretChanged returns whether a Secret
+// referenced by the parametersFrom of the instance has changed since the
+// parameters of the instance were last sent to the broker.
+func (c *controller) isServiceInstanceParametersSecretChanged(instance *v1beta1.ServiceInstance) bool {
+	c.changedParametersSecretInstancesLock.Lock()
+	defer c.changedParametersSecretInstancesLock.Unlock()
+
+	return c.changedParametersSecretInstances[instance.UID]
+}
+
+// forgetChangedServiceInstanceParametersSecret forgets about the changes of
+// the Secrets referenced by the parametersFrom of the instance, once its
+// parameters have been sent to the broker.
+func (c *controller) forgetChangedServiceInstanceParametersSecret(instance *v1beta1.ServiceInstance) {
+	c.changedParametersSecretInstancesLock.Lock()
+	defer c.changedParametersSecretInstancesLock.Unlock()
+
+	delete(c.changedParametersSecretInstances, instance.UID)
+}
+
+// instanceParametersSecretIndex is the name of the index of the instance
+// informer which maps a "namespace/name" key of a Secret to the instances
+// whose parametersFrom reference it.
+const instanceParametersSecretIndex = "parametersSecret"
+
+// indexServiceInstanceByParametersSecret returns the "namespace/name" keys of
+// the Secrets the parameters of the instance are read from.
+func indexServiceInstanceByParametersSecret(obj interface{}) ([]string, error) {
+	instance, ok := obj.(*v1beta1.ServiceInstance)
+	if !ok {
+		return nil, nil
+	}
+	names := sets.NewString()
+	for _, parametersFrom := range serviceInstanceParametersFrom(instance) {
+		if parametersFrom.SecretKeyRef != nil {
+			names.Insert(instance.Namespace + "/" + parametersFrom.SecretKeyRef.Name)
+		}
+		if parametersFrom.URLRef != nil && parametersFrom.URLRef.BearerTokenSecretKeyRef != nil {
+			names.Insert(instance.Namespace + "/" + parametersFrom.URLRef.BearerTokenSecretKeyRef.Name)
+		}
+	}
+	return names.List(), nil
+}
+
+// instancePlanIndex is the name of the index of the instance informer which
+// maps the name of a ClusterServicePlan, or the "namespace/name" key of a
+// ServicePlan, to the instances whose plan reference resolves to it. Plan
+// names cannot contain a slash, so the two kinds of keys do not collide.
+const instancePlanIndex = "plan"
+
+// indexServiceInstanceByPlan returns the key of the plan the instance is
+// resolved to, if any.
+func indexServiceInstanceByPlan(obj interface{}) ([]string, error) {
+	instance, ok := obj.(*v1beta1.ServiceInstance)
+	if !ok {
+		return nil, nil
+	}
+	switch {
+	case instance.Spec.ClusterServicePlanRef != nil:
+		return []string{instance.Spec.ClusterServicePlanRef.Name}, nil
+	case instance.Spec.ServicePlanRef != nil:
+		return []string{instance.Namespace + "/" + instance.Spec.ServicePlanRef.Name}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// getServiceInstancesOnPlan returns the instances handled by this controller
+// whose plan reference resolves to the plan of the given instancePlanIndex
+// key.
+func (c *controller) getServiceInstancesOnPlan(planKey string) ([]*v1beta1.ServiceInstance, error) {
+	objs, err := c.instanceIndexer.ByIndex(instancePlanIndex, planKey)
+	if err != nil {
+		return nil, err
+	}
+	var instances []*v1beta1.ServiceInstance
+	for _, obj := range objs {
+		if instance, ok := obj.(*v1beta1.ServiceInstance); ok && c.isSelected(instance) {
+			instances = append(instances, instance)
+		}
+	}
+	return instances, nil
+}
+
 // Async operations on instances have a somewhat convoluted flow in order to
 // ensure that only a single goroutine works on an instance at any given time.
 // The flow is:
@@ -265,6 +388,17 @@ func (c *controller) resetPollingRateLimiterForServiceInstance(instance *v1beta1
 	c.instancePollingQueue.Forget(key)
 }
 
+// serviceInstanceConditionMessage returns the message of the instance's
+// condition of the given type, or "" if the instance has no such condition.
+func serviceInstanceConditionMessage(instance *v1beta1.ServiceInstance, conditionType v1beta1.ServiceInstanceConditionType) string {
+	for _, condition := range instance.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Message
+		}
+	}
+	return ""
+}
+
 // getReconciliationActionForServiceInstance gets the action the reconciler
 // should be taking on the given instance.
 func getReconciliationActionForServiceInstance(instance *v1beta1.ServiceInstance) ReconciliationAction {
@@ -305,6 +439,12 @@ func (c *controller) reconcileServiceInstanceKey(key string) error {
 // error is returned to indicate that the instance has not been fully
 // processed and should be resubmitted at a later time.
 func (c *controller) reconcileServiceInstance(instance *v1beta1.ServiceInstance) error {
+	if isServiceInstancePaused(instance) {
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		klog.V(4).Info(pcb.Message("Not processing event; the instance is paused"))
+		return nil
+	}
+
 	updated, err := c.initObservedGeneration(instance)
 	if err != nil {
 		return err
@@ -314,6 +454,15 @@ func (c *controller) reconcileServiceInstance(instance *v1beta1.ServiceInstance)
 		// and processed again
 		return nil
 	}
+	updated, err = c.syncServiceInstanceBindingCount(instance)
+	if err != nil {
+		return err
+	}
+	if updated {
+		// The updated instance will be automatically added back to the queue
+		// and processed again
+		return nil
+	}
 	updated, err = c.initOrphanMitigationCondition(instance)
 	if err != nil {
 		return err
@@ -323,6 +472,8 @@ func (c *controller) reconcileServiceInstance(instance *v1beta1.ServiceInstance)
 		// and processed again
 		return nil
 	}
+	c.warnIfOperationStuck(instance, instance.UID, string(instance.Status.CurrentOperation), instance.Status.OperationStartTime)
+
 	reconciliationAction := getReconciliationActionForServiceInstance(instance)
 	switch reconciliationAction {
 
@@ -367,6 +518,33 @@ func (c *controller) initObservedGeneration(instance *v1beta1.ServiceInstance) (
 	return false, nil
 }
 
+// syncServiceInstanceBindingCount sets status.bindingCount of the instance to
+// the number of ServiceBindings referring to it. Returns true if the status
+// was updated.
+func (c *controller) syncServiceInstanceBindingCount(instance *v1beta1.ServiceInstance) (bool, error) {
+	bindingList, err := c.bindingLister.ServiceBindings(instance.Namespace).List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+
+	var count int64
+	for _, binding := range bindingList {
+		if binding.Spec.InstanceRef.Name == instance.Name {
+			count++
+		}
+	}
+	if count == instance.Status.BindingCount {
+		return false, nil
+	}
+
+	instance = instance.DeepCopy()
+	instance.Status.BindingCount = count
+	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // initOrphanMitigationCondition implements OrphanMitigation condition initialization
 // based on OrphanMitigationInProgress field for status API migration.
 // Returns true if the status was updated (i.e. the iteration has finished and no
@@ -374,7 +552,7 @@ func (c *controller) initObservedGeneration(instance *v1beta1.ServiceInstance) (
 func (c *controller) initOrphanMitigationCondition(instance *v1beta1.ServiceInstance) (bool, error) {
 	if !isServiceInstanceOrphanMitigation(instance) && instance.Status.OrphanMitigationInProgress {
 		instance := instance.DeepCopy()
-		reason := startingInstanceOrphanMitigationReason
+		reason := v1beta1.ReasonStartingInstanceOrphanMitigation
 		message := startingInstanceOrphanMitigationMessage
 		c.recorder.Event(instance, corev1.EventTypeWarning, reason, message)
 		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionOrphanMitigation,
@@ -450,7 +628,7 @@ func (c *controller) backoffAndRequeueIfRetrying(instance *v1beta1.ServiceInstan
 
 		if delay > 0 {
 			msg := fmt.Sprintf("Delaying %s retry, next attempt will be after %s", operation, retryEntry.calculatedRetryTime)
-			c.recorder.Event(instance, corev1.EventTypeWarning, "RetryBackoff", msg)
+			c.recorder.Event(instance, corev1.EventTypeWarning, v1beta1.ReasonRetryBackoff, msg)
 			klog.V(2).Info(pcb.Messagef("BrokerOpRetry: %s", msg))
 
 			// add back to worker queue to retry at the specified time
@@ -487,6 +665,110 @@ func (c *controller) purgeExpiredRetryEntries() {
 
 }
 
+// healServiceInstanceReferences resolves the class and plan references of the
+// processed instances which name a class and plan but are missing their
+// references, as instances stored before an upgrade of the API server may be.
+// A processed instance is not reconciled again until its spec changes, so
+// nothing else would resolve them. Invoked by a worker on a timer.
+func (c *controller) healServiceInstanceReferences() {
+	instances, err := c.instanceLister.List(labels.Everything())
+	if err != nil {
+		klog.Warningf("Error listing instances to resolve their missing references: %v", err)
+		return
+	}
+
+	for _, instance := range instances {
+		if instance.DeletionTimestamp != nil || !isServiceInstanceMissingReferences(instance) || !isServiceInstanceProcessedAlready(instance) || !c.isSelected(instance) {
+			continue
+		}
+
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		klog.Info(pcb.Message("Resolving missing class and plan references"))
+		if _, err := c.resolveReferences(instance.DeepCopy()); err != nil {
+			klog.Warning(pcb.Messagef("Error resolving missing references: %v", err))
+		}
+	}
+}
+
+// isServiceInstanceMissingReferences returns whether the instance names a
+// class and plan without having both of their references set.
+func isServiceInstanceMissingReferences(instance *v1beta1.ServiceInstance) bool {
+	if instance.Spec.ClusterServiceClassSpecified() && instance.Spec.ClusterServicePlanSpecified() {
+		return instance.Spec.ClusterServiceClassRef == nil || instance.Spec.ClusterServicePlanRef == nil
+	}
+	if instance.Spec.ServiceClassSpecified() && instance.Spec.ServicePlanSpecified() {
+		return instance.Spec.ServiceClassRef == nil || instance.Spec.ServicePlanRef == nil
+	}
+	return false
+}
+
+// resumeOrphanMitigations resumes the failed orphan mitigations of the
+// instances of the given broker, such as the ones which ran out of retries
+// while the broker was down. It is invoked on every successful relist of the
+// broker; the namespace is empty for a ClusterServiceBroker. Orphan mitigations stopped after the maximum number
+// of deprovision requests are left for manual intervention.
+func (c *controller) resumeOrphanMitigations(brokerNamespace, brokerName string) {
+	instances, err := c.instanceLister.ServiceInstances(brokerNamespace).List(labels.Everything())
+	if err != nil {
+		klog.Warningf("Error listing instances to resume their orphan mitigation: %v", err)
+		return
+	}
+
+	for _, instance := range instances {
+		if !instance.Status.OrphanMitigationInProgress || instance.Status.DeprovisionStatus != v1beta1.ServiceInstanceDeprovisionStatusFailed || !c.isSelected(instance) {
+			continue
+		}
+		if c.maxOrphanMitigationAttempts > 0 && instance.Status.OrphanMitigationAttempts >= c.maxOrphanMitigationAttempts {
+			continue
+		}
+
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		instanceBrokerName, err := c.getServiceInstanceBrokerName(instance, brokerNamespace != "")
+		if err != nil {
+			klog.Warning(pcb.Messagef("Error getting the broker to resume orphan mitigation: %v", err))
+			continue
+		}
+		if instanceBrokerName != brokerName {
+			continue
+		}
+
+		klog.Info(pcb.Message("Resuming orphan mitigation because the broker is reachable again"))
+		toUpdate := instance.DeepCopy()
+		// Restart the reconciliation retry duration, which has elapsed
+		toUpdate.Status.OperationStartTime = nil
+		toUpdate.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+		setServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse,
+			v1beta1.ReasonResumingInstanceOrphanMitigation, resumingInstanceOrphanMitigationMessage)
+		if _, err := c.updateServiceInstanceStatus(toUpdate); err != nil {
+			klog.Warning(pcb.Messagef("Error resuming orphan mitigation: %v", err))
+			continue
+		}
+		c.recorder.Event(instance, corev1.EventTypeNormal, v1beta1.ReasonResumingInstanceOrphanMitigation, resumingInstanceOrphanMitigationMessage)
+	}
+}
+
+// getServiceInstanceBrokerName returns the name of the broker offering the
+// class of the instance, or an empty name if the class is not offered by a
+// broker of the given scope.
+func (c *controller) getServiceInstanceBrokerName(instance *v1beta1.ServiceInstance, namespaced bool) (string, error) {
+	switch {
+	case !namespaced && instance.Spec.ClusterServiceClassRef != nil:
+		serviceClass, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return "", err
+		}
+		return serviceClass.Spec.ClusterServiceBrokerName, nil
+	case namespaced && instance.Spec.ServiceClassRef != nil:
+		serviceClass, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+		if err != nil {
+			return "", err
+		}
+		return serviceClass.Spec.ServiceBrokerName, nil
+	default:
+		return "", nil
+	}
+}
+
 // removeInstanceFromRetryMap removes the instance from the retry & ratelimter maps
 func (c *controller) removeInstanceFromRetryMap(instance *v1beta1.ServiceInstance) {
 	pcb := pretty.NewInstanceContextBuilder(instance)
@@ -513,6 +795,9 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 	}
 
 	if isServiceInstanceProcessedAlready(instance) {
+		if isServiceInstanceSubjectToTTLAfterFailure(instance) {
+			return c.processServiceInstanceTTLAfterFailure(instance)
+		}
 		klog.V(4).Info(pcb.Message("Not processing event because status showed there is no work to do"))
 		return nil
 	}
@@ -539,6 +824,9 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		return nil
 	}
 
+	// The external names are stored with the next status update.
+	c.resolveServiceInstanceExternalNames(instance)
+
 	if c.resolveServiceInstanceUserSpecifiedClassAndPlan(instance) {
 		updatedInstance, err := c.updateServiceInstanceStatus(instance)
 		if err != nil {
@@ -547,7 +835,7 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		instance.ResourceVersion = updatedInstance.ResourceVersion
 	}
 
-	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServicePlanDefaults) {
+	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServicePlanDefaults) || len(c.namespaceAnnotationParameters) > 0 {
 		// Apply default provisioning parameters, this must be done after we've resolved the class and plan
 		modified, err = c.applyDefaultProvisioningParameters(instance)
 		if err != nil {
@@ -610,6 +898,7 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		"Provisioning a new ServiceInstance of %s at Broker %q",
 		prettyClass, brokerName,
 	))
+	c.warnOnDeprecatedServiceBroker(instance, brokerName)
 
 	c.setRetryBackoffRequired(instance)
 	response, err := brokerClient.ProvisionInstance(request)
@@ -619,7 +908,8 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 				"Error provisioning ServiceInstance of %s at ClusterServiceBroker %q: %s",
 				prettyClass, brokerName, httpErr,
 			)
-			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorProvisionCallFailedReason, msg)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonProvisionCallFailed, msg)
+			readyCond.ErrorCode = brokerErrorCode(err)
 			// Depending on the specific response, we may need to initiate orphan mitigation.
 			shouldMitigateOrphan := shouldStartOrphanMitigation(httpErr.StatusCode)
 			if isRetriableHTTPStatus(httpErr.StatusCode) {
@@ -627,17 +917,19 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 			}
 			// A failure with a given HTTP response code is treated as a terminal
 			// failure.
-			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, "ClusterServiceBrokerReturnedFailure", msg)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonClusterServiceBrokerReturnedFailure, msg)
+			failedCond.ErrorCode = brokerErrorCode(err)
 			return c.processTerminalProvisionFailure(instance, readyCond, failedCond, shouldMitigateOrphan)
 		}
 
-		reason := errorErrorCallingProvisionReason
+		reason := v1beta1.ReasonErrorCallingProvision
 
 		// A timeout error is considered a retriable error, but we
 		// should initiate orphan mitigation.
 		if urlErr, ok := err.(*url.Error); ok && urlErr.Timeout() {
 			msg := fmt.Sprintf("Communication with the ClusterServiceBroker timed out; operation will be retried: %v", urlErr)
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, msg)
+			readyCond.ErrorCode = brokerErrorCode(err)
 			return c.processTemporaryProvisionFailure(instance, readyCond, true)
 		}
 
@@ -645,10 +937,11 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		// reconciliation retry time limit has passed.
 		msg := fmt.Sprintf("The provision call failed and will be retried: Error communicating with broker for provisioning: %v", err)
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, msg)
+		readyCond.ErrorCode = brokerErrorCode(err)
 
 		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries because too much time has elapsed"
-			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonErrorReconciliationRetryTimeout, msg)
 			return c.processTerminalProvisionFailure(instance, readyCond, failedCond, false)
 		}
 
@@ -659,6 +952,7 @@ func (c *controller) reconcileServiceInstanceAdd(instance *v1beta1.ServiceInstan
 		return c.processProvisionAsyncResponse(instance, response)
 	}
 
+	c.setServiceInstanceExtensionAPIs(instance, response.ExtensionAPIs)
 	return c.processProvisionSuccess(instance, response.DashboardURL)
 }
 
@@ -668,8 +962,24 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 	pcb := pretty.NewInstanceContextBuilder(instance)
 
 	if isServiceInstanceProcessedAlready(instance) {
-		klog.V(4).Info(pcb.Message("Not processing event because status showed there is no work to do"))
-		return nil
+		if isServiceInstanceSubjectToTTLAfterReady(instance) {
+			if expired, err := c.processServiceInstanceTTLAfterReady(instance); err != nil || expired {
+				return err
+			}
+		}
+		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServicePlanDefaults) || len(c.namespaceAnnotationParameters) > 0 {
+			if modified, err := c.syncServiceInstanceDefaultParameters(instance); err != nil || modified {
+				return err
+			}
+		}
+		if c.isServiceInstanceParametersSecretChanged(instance) && c.isServiceInstanceParametersChanged(instance) {
+			klog.V(4).Info(pcb.Message("Parameters changed since the last request to the broker"))
+		} else if c.isServiceInstanceContextChanged(instance) {
+			klog.V(4).Info(pcb.Message("Context changed since the last request to the broker"))
+		} else {
+			klog.V(4).Info(pcb.Message("Not processing event because status showed there is no work to do"))
+			return nil
+		}
 	}
 
 	// don't DOS the broker.  If we already did an update attempt that ended with a non-terminal
@@ -694,6 +1004,9 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 		return nil
 	}
 
+	// The external names are stored with the next status update.
+	c.resolveServiceInstanceExternalNames(instance)
+
 	klog.V(4).Info(pcb.Message("Processing updating event"))
 
 	var brokerClient osb.Client
@@ -790,26 +1103,31 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 
 	c.setRetryBackoffRequired(instance)
 	response, err := brokerClient.UpdateInstance(request)
+	c.forgetChangedServiceInstanceParametersSecret(instance)
 	if err != nil {
 		if httpErr, ok := osb.IsHTTPError(err); ok {
 			if isRetriableHTTPStatus(httpErr.StatusCode) {
 				msg := fmt.Sprintf("ServiceBroker returned a failure for update call; update will be retried: %v", httpErr)
-				readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorUpdateInstanceCallFailedReason, msg)
+				readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonUpdateInstanceCallFailed, msg)
+				readyCond.ErrorCode = brokerErrorCode(err)
 				return c.processTemporaryUpdateServiceInstanceFailure(instance, readyCond)
 			}
 			// A failure with a given HTTP response code is treated as a terminal
 			// failure.
 			msg := fmt.Sprintf("ServiceBroker returned a failure for update call; update will not be retried: %v", httpErr)
-			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorUpdateInstanceCallFailedReason, msg)
-			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorUpdateInstanceCallFailedReason, msg)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonUpdateInstanceCallFailed, msg)
+			readyCond.ErrorCode = brokerErrorCode(err)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonUpdateInstanceCallFailed, msg)
+			failedCond.ErrorCode = brokerErrorCode(err)
 			return c.processTerminalUpdateServiceInstanceFailure(instance, readyCond, failedCond)
 		}
 
-		reason := errorErrorCallingUpdateInstanceReason
+		reason := v1beta1.ReasonErrorCallingUpdateInstance
 
 		if urlErr, ok := err.(*url.Error); ok && urlErr.Timeout() {
 			msg := fmt.Sprintf("Communication with the ServiceBroker timed out; update will be retried: %v", urlErr)
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, msg)
+			readyCond.ErrorCode = brokerErrorCode(err)
 			return c.processTemporaryUpdateServiceInstanceFailure(instance, readyCond)
 		}
 
@@ -822,19 +1140,18 @@ func (c *controller) reconcileServiceInstanceUpdate(instance *v1beta1.ServiceIns
 			c.recorder.Event(instance, corev1.EventTypeWarning, reason, msg)
 
 			msg = "Stopping reconciliation retries because too much time has elapsed"
-			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, errorReconciliationRetryTimeoutReason, msg)
-			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, v1beta1.ReasonErrorReconciliationRetryTimeout, msg)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonErrorReconciliationRetryTimeout, msg)
 			return c.processTerminalUpdateServiceInstanceFailure(instance, readyCond, failedCond)
 		}
 
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, msg)
+		readyCond.ErrorCode = brokerErrorCode(err)
 		return c.processServiceInstanceOperationError(instance, readyCond)
 	}
 
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.UpdateDashboardURL) {
-		if *response.DashboardURL != "" {
-			instance.Status.DashboardURL = response.DashboardURL
-		}
+		c.setServiceInstanceDashboardURL(instance, response.DashboardURL)
 	}
 	if response.Async {
 		return c.processUpdateServiceInstanceAsyncResponse(instance, response)
@@ -885,8 +1202,8 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 	// Set the deprovision status to Failed and bail out.
 	if instance.Status.DeprovisionStatus != v1beta1.ServiceInstanceDeprovisionStatusRequired {
 		msg := fmt.Sprintf("ServiceInstance has invalid DeprovisionStatus field: %v", instance.Status.DeprovisionStatus)
-		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorInvalidDeprovisionStatusReason, msg)
-		failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorInvalidDeprovisionStatusReason, msg)
+		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, v1beta1.ReasonInvalidDeprovisionStatus, msg)
+		failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonInvalidDeprovisionStatus, msg)
 		return c.processDeprovisionFailure(instance, readyCond, failedCond)
 	}
 
@@ -939,6 +1256,7 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 				// from the normal deletion
 				removeServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionOrphanMitigation)
 				instance.Status.OrphanMitigationInProgress = false
+				instance.Status.OrphanMitigationAttempts = 0
 			}
 			updatedInstance, err := c.recordStartOfServiceInstanceOperation(instance, v1beta1.ServiceInstanceOperationDeprovision, inProgressProperties)
 			if err != nil {
@@ -954,6 +1272,18 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 		}
 	}
 
+	if instance.Status.OrphanMitigationInProgress {
+		// Give up on orphan mitigation whose deprovision requests keep
+		// failing, rather than retrying them forever.
+		if c.maxOrphanMitigationAttempts > 0 && instance.Status.OrphanMitigationAttempts >= c.maxOrphanMitigationAttempts {
+			msg := fmt.Sprintf("Stopping orphan mitigation after %d deprovision attempts; the instance requires manual intervention", instance.Status.OrphanMitigationAttempts)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonOrphanMitigationAttemptsExceeded, msg)
+			setServiceInstanceConditionFrom(instance, failedCond)
+			return c.processDeprovisionFailure(instance, nil, failedCond)
+		}
+		instance.Status.OrphanMitigationAttempts++
+	}
+
 	klog.V(4).Info(pcb.Message("Sending deprovision request to broker"))
 	response, err := brokerClient.DeprovisionInstance(request)
 	if err != nil {
@@ -965,11 +1295,12 @@ func (c *controller) reconcileServiceInstanceDelete(instance *v1beta1.ServiceIns
 			msg = fmt.Sprintf("Deprovision call failed; received error response from broker: %v", httpErr)
 		}
 
-		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorDeprovisionCallFailedReason, msg)
+		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, v1beta1.ReasonDeprovisionCallFailed, msg)
+		readyCond.ErrorCode = brokerErrorCode(err)
 
 		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
 			msg := "Stopping reconciliation retries because too much time has elapsed"
-			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
+			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonErrorReconciliationRetryTimeout, msg)
 			return c.processDeprovisionFailure(instance, readyCond, failedCond)
 		}
 
@@ -1025,10 +1356,11 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 			return c.finishPollingServiceInstance(instance)
 		}
 
-		reason := errorPollingLastOperationReason
+		reason := v1beta1.ReasonErrorPollingLastOperation
 		message := fmt.Sprintf("Error polling last operation: %v", err)
 		klog.V(4).Info(pcb.Message(message))
 		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, message)
+		readyCond.ErrorCode = brokerErrorCode(err)
 
 		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
 			return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
@@ -1041,6 +1373,7 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 			// A failure with a given HTTP response code is treated as a terminal
 			// failure.
 			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, reason, message)
+			failedCond.ErrorCode = brokerErrorCode(err)
 			return c.processServiceInstancePollingTerminalFailure(instance, readyCond, failedCond)
 		}
 
@@ -1060,13 +1393,13 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		var reason string
 		switch {
 		case deleting:
-			reason = asyncDeprovisioningReason
+			reason = v1beta1.ReasonDeprovisioning
 			message = asyncDeprovisioningMessage
 		case provisioning:
-			reason = asyncProvisioningReason
+			reason = v1beta1.ReasonProvisioning
 			message = asyncProvisioningMessage
 		default:
-			reason = asyncUpdatingInstanceReason
+			reason = v1beta1.ReasonUpdatingInstance
 			message = asyncUpdatingInstanceMessage
 		}
 
@@ -1081,9 +1414,14 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 
 		// only need to update the resource if there was a description for the operation provided
 		if response.Description != nil {
+			// A new description means the operation has progressed, so the
+			// polling backoff starts over to notice the next change early.
+			if serviceInstanceConditionMessage(instance, v1beta1.ServiceInstanceConditionReady) != readyCond.Message {
+				c.resetPollingRateLimiterForServiceInstance(instance)
+			}
 			c.recorder.Event(instance, corev1.EventTypeNormal, readyCond.Reason, readyCond.Message)
 
-			setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
+			setServiceInstanceConditionFrom(instance, readyCond)
 			if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 				return c.handleServiceInstancePollingError(instance, err)
 			}
@@ -1111,7 +1449,7 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		case deleting:
 			// For deprovisioning only, we should reattempt even on failure
 			msg := "Deprovision call failed: " + description
-			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorDeprovisionCallFailedReason, msg)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, v1beta1.ReasonDeprovisionCallFailed, msg)
 
 			if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
 				return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
@@ -1122,13 +1460,13 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 
 			return c.processServiceInstanceOperationError(instance, readyCond)
 		case provisioning:
-			reason := errorProvisionCallFailedReason
+			reason := v1beta1.ReasonProvisionCallFailed
 			message := "Provision call failed: " + description
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, message)
 			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, reason, message)
 			err = c.processTerminalProvisionFailure(instance, readyCond, failedCond, true)
 		default:
-			reason := errorUpdateInstanceCallFailedReason
+			reason := v1beta1.ReasonUpdateInstanceCallFailed
 			message := "Update call failed: " + description
 			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionFalse, reason, message)
 			failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, reason, message)
@@ -1143,7 +1481,7 @@ func (c *controller) pollServiceInstance(instance *v1beta1.ServiceInstance) erro
 		message := pcb.Messagef("Got invalid state in LastOperationResponse: %q", response.State)
 		klog.Warning(message)
 		if c.reconciliationRetryDurationExceeded(instance.Status.OperationStartTime) {
-			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorPollingLastOperationReason, message)
+			readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, v1beta1.ReasonErrorPollingLastOperation, message)
 			return c.processServiceInstancePollingFailureRetryTimeout(instance, readyCond)
 		}
 
@@ -1171,11 +1509,130 @@ func isServiceInstanceProcessedAlready(instance *v1beta1.ServiceInstance) bool {
 		!instance.Status.OrphanMitigationInProgress
 }
 
+// isServiceInstanceSubjectToTTLAfterFailure returns whether the instance has
+// terminally failed to provision and should be deleted once its
+// TTLSecondsAfterFailure expires. Instances which may have a resource on the
+// broker side are never deleted automatically.
+func isServiceInstanceSubjectToTTLAfterFailure(instance *v1beta1.ServiceInstance) bool {
+	return instance.Spec.TTLSecondsAfterFailure != nil &&
+		instance.ObjectMeta.DeletionTimestamp == nil &&
+		isServiceInstanceFailed(instance) &&
+		instance.Status.ProvisionStatus != v1beta1.ServiceInstanceProvisionStatusProvisioned &&
+		instance.Status.DeprovisionStatus == v1beta1.ServiceInstanceDeprovisionStatusNotRequired
+}
+
+// processServiceInstanceTTLAfterFailure deletes a terminally failed instance
+// whose TTLSecondsAfterFailure has expired, or requeues it for when it
+// expires.
+func (c *controller) processServiceInstanceTTLAfterFailure(instance *v1beta1.ServiceInstance) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+
+	var failedAt time.Time
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionFailed {
+			failedAt = cond.LastTransitionTime.Time
+			break
+		}
+	}
+	if failedAt.IsZero() {
+		// Without the time of the failure, the TTL cannot be honored
+		klog.V(4).Info(pcb.Message("Not deleting failed instance because its Failed condition has no transition time"))
+		return nil
+	}
+	ttl := time.Duration(*instance.Spec.TTLSecondsAfterFailure) * time.Second
+	if remaining := failedAt.Add(ttl).Sub(time.Now()); remaining > 0 {
+		klog.V(4).Info(pcb.Messagef("Failed instance will be deleted in %v", remaining))
+		c.enqueueInstanceAfter(instance, remaining)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Deleting the instance because it has been failed for longer than %v", ttl)
+	klog.V(2).Info(pcb.Message(msg))
+	c.recorder.Event(instance, corev1.EventTypeNormal, v1beta1.ReasonTTLAfterFailureExpired, msg)
+
+	uid := instance.UID
+	err := c.serviceCatalogClient.ServiceInstances(instance.Namespace).Delete(instance.Name, &metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &uid},
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		klog.Error(pcb.Messagef("Error deleting failed instance: %v", err))
+		return err
+	}
+	return nil
+}
+
+// isServiceInstanceSubjectToTTLAfterReady returns whether the instance is
+// ready and should be deleted once its TTLSecondsAfterReady expires.
+func isServiceInstanceSubjectToTTLAfterReady(instance *v1beta1.ServiceInstance) bool {
+	return instance.Spec.TTLSecondsAfterReady != nil &&
+		instance.ObjectMeta.DeletionTimestamp == nil &&
+		isServiceInstanceReady(instance)
+}
+
+// processServiceInstanceTTLAfterReady deletes a ready instance whose
+// TTLSecondsAfterReady has expired, along with its bindings, or requeues it
+// for when it expires. The bindings are deleted first so that the deletion
+// of the instance is not blocked by them; the instance is only deprovisioned
+// once they have been unbound. It returns whether the TTL has expired, in
+// which case there is nothing else to do with the instance.
+func (c *controller) processServiceInstanceTTLAfterReady(instance *v1beta1.ServiceInstance) (bool, error) {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+
+	var readyAt time.Time
+	for _, cond := range instance.Status.Conditions {
+		if cond.Type == v1beta1.ServiceInstanceConditionReady {
+			readyAt = cond.LastTransitionTime.Time
+			break
+		}
+	}
+	if readyAt.IsZero() {
+		// Without the time the instance became ready, the TTL cannot be
+		// honored
+		klog.V(4).Info(pcb.Message("Not deleting instance because its Ready condition has no transition time"))
+		return false, nil
+	}
+	ttl := time.Duration(*instance.Spec.TTLSecondsAfterReady) * time.Second
+	if remaining := readyAt.Add(ttl).Sub(time.Now()); remaining > 0 {
+		klog.V(4).Info(pcb.Messagef("Instance will be deleted in %v", remaining))
+		c.enqueueInstanceAfter(instance, remaining)
+		return false, nil
+	}
+
+	msg := fmt.Sprintf("Deleting the instance and its bindings because it has been ready for longer than %v", ttl)
+	klog.V(2).Info(pcb.Message(msg))
+	c.recorder.Event(instance, corev1.EventTypeNormal, v1beta1.ReasonTTLAfterReadyExpired, msg)
+
+	bindingList, err := c.bindingLister.ServiceBindings(instance.Namespace).List(labels.Everything())
+	if err != nil {
+		return true, err
+	}
+	for _, binding := range bindingList {
+		if binding.Spec.InstanceRef.Name != instance.Name || binding.DeletionTimestamp != nil {
+			continue
+		}
+		err := c.serviceCatalogClient.ServiceBindings(binding.Namespace).Delete(binding.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			klog.Error(pcb.Messagef("Error deleting ServiceBinding %q: %v", binding.Name, err))
+			return true, err
+		}
+	}
+
+	uid := instance.UID
+	err = c.serviceCatalogClient.ServiceInstances(instance.Namespace).Delete(instance.Name, &metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &uid},
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		klog.Error(pcb.Messagef("Error deleting instance: %v", err))
+		return true, err
+	}
+	return true, nil
+}
+
 // processServiceInstancePollingFailureRetryTimeout marks the instance as having
 // failed polling due to its reconciliation retry duration expiring
 func (c *controller) processServiceInstancePollingFailureRetryTimeout(instance *v1beta1.ServiceInstance, readyCond *v1beta1.ServiceInstanceCondition) error {
 	msg := "Stopping reconciliation retries because too much time has elapsed"
-	failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason, msg)
+	failedCond := newServiceInstanceFailedCondition(v1beta1.ConditionTrue, v1beta1.ReasonErrorReconciliationRetryTimeout, msg)
 	return c.processServiceInstancePollingTerminalFailure(instance, readyCond, failedCond)
 }
 
@@ -1210,7 +1667,7 @@ func (c *controller) processServiceInstancePollingTerminalFailure(instance *v1be
 // failed polling with a temporary error
 func (c *controller) processServiceInstancePollingTemporaryFailure(instance *v1beta1.ServiceInstance, readyCond *v1beta1.ServiceInstanceCondition) error {
 	c.recorder.Event(instance, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
-	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
+	setServiceInstanceConditionFrom(instance, readyCond)
 
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return c.handleServiceInstancePollingError(instance, err)
@@ -1254,10 +1711,10 @@ func (c *controller) resolveClusterReferences(instance *v1beta1.ServiceInstance)
 				instance,
 				v1beta1.ServiceInstanceConditionReady,
 				v1beta1.ConditionFalse,
-				errorNonexistentClusterServiceClassReason,
+				v1beta1.ReasonReferencesNonexistentServiceClass,
 				"The instance references a ClusterServiceClass that does not exist. "+err.Error(),
 			)
-			c.recorder.Event(instance, corev1.EventTypeWarning, errorNonexistentClusterServiceClassReason, err.Error())
+			c.recorder.Event(instance, corev1.EventTypeWarning, v1beta1.ReasonReferencesNonexistentServiceClass, err.Error())
 			return updatedInstance.ResourceVersion != instance.ResourceVersion, err
 		}
 	}
@@ -1278,10 +1735,10 @@ func (c *controller) resolveClusterReferences(instance *v1beta1.ServiceInstance)
 				instance,
 				v1beta1.ServiceInstanceConditionReady,
 				v1beta1.ConditionFalse,
-				errorNonexistentClusterServicePlanReason,
+				v1beta1.ReasonReferencesNonexistentServicePlan,
 				"The instance references a ClusterServicePlan that does not exist. "+err.Error(),
 			)
-			c.recorder.Event(instance, corev1.EventTypeWarning, errorNonexistentClusterServicePlanReason, err.Error())
+			c.recorder.Event(instance, corev1.EventTypeWarning, v1beta1.ReasonReferencesNonexistentServicePlan, err.Error())
 			return updatedInstance.ResourceVersion != instance.ResourceVersion, err
 		}
 	}
@@ -1305,10 +1762,10 @@ func (c *controller) resolveNamespacedReferences(instance *v1beta1.ServiceInstan
 				instance,
 				v1beta1.ServiceInstanceConditionReady,
 				v1beta1.ConditionFalse,
-				errorNonexistentServiceClassReason,
+				v1beta1.ReasonReferencesNonexistentServiceClass,
 				"The instance references a ServiceClass that does not exist. "+err.Error(),
 			)
-			c.recorder.Event(instance, corev1.EventTypeWarning, errorNonexistentServiceClassReason, err.Error())
+			c.recorder.Event(instance, corev1.EventTypeWarning, v1beta1.ReasonReferencesNonexistentServiceClass, err.Error())
 			return updatedInstance.ResourceVersion != instance.ResourceVersion, err
 		}
 	}
@@ -1329,10 +1786,10 @@ func (c *controller) resolveNamespacedReferences(instance *v1beta1.ServiceInstan
 				instance,
 				v1beta1.ServiceInstanceConditionReady,
 				v1beta1.ConditionFalse,
-				errorNonexistentServicePlanReason,
+				v1beta1.ReasonReferencesNonexistentServicePlan,
 				"The instance references a ServicePlan that does not exist. "+err.Error(),
 			)
-			c.recorder.Event(instance, corev1.EventTypeWarning, errorNonexistentServicePlanReason, err.Error())
+			c.recorder.Event(instance, corev1.EventTypeWarning, v1beta1.ReasonReferencesNonexistentServicePlan, err.Error())
 			return updatedInstance.ResourceVersion != instance.ResourceVersion, err
 		}
 	}
@@ -1358,6 +1815,12 @@ func (c *controller) resolveClusterServiceClassRef(instance *v1beta1.ServiceInst
 
 		var err error
 		sc, err = c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassName)
+		if err == nil && instance.Spec.ClusterServiceBrokerName != "" && sc.Spec.ClusterServiceBrokerName != instance.Spec.ClusterServiceBrokerName {
+			return nil, fmt.Errorf(
+				"ClusterServiceClass %c is not offered by ClusterServiceBroker %q",
+				instance.Spec.PlanReference, instance.Spec.ClusterServiceBrokerName,
+			)
+		}
 		if err == nil {
 			instance.Spec.ClusterServiceClassRef = &v1beta1.ClusterObjectReference{
 				Name: sc.Name,
@@ -1376,9 +1839,13 @@ func (c *controller) resolveClusterServiceClassRef(instance *v1beta1.ServiceInst
 		filterLabel := instance.Spec.GetClusterServiceClassFilterLabelName()
 		filterValue := instance.Spec.GetSpecifiedClusterServiceClass()
 		klog.V(4).Info(pcb.Messagef("looking up a ClusterServiceClass from %s: %q", filterLabel, filterValue))
-		labelSelector := labels.SelectorFromSet(labels.Set{
+		filterSet := labels.Set{
 			filterLabel: filterValue,
-		}).String()
+		}
+		if instance.Spec.ClusterServiceBrokerName != "" {
+			filterSet[v1beta1.GroupName+"/"+v1beta1.FilterSpecClusterServiceBrokerName] = instance.Spec.ClusterServiceBrokerName
+		}
+		labelSelector := labels.SelectorFromSet(filterSet).String()
 
 		listOpts := metav1.ListOptions{
 			LabelSelector: labelSelector,
@@ -1396,6 +1863,11 @@ func (c *controller) resolveClusterServiceClassRef(instance *v1beta1.ServiceInst
 				"resolved %c to ClusterServiceClass %q",
 				instance.Spec.PlanReference, sc.Name,
 			))
+		} else if err == nil && len(serviceClasses.Items) > 1 && instance.Spec.ClusterServiceBrokerName == "" {
+			return nil, fmt.Errorf(
+				"References ClusterServiceClass %c which is offered by %d brokers, spec.clusterServiceBrokerName must be set",
+				instance.Spec.PlanReference, len(serviceClasses.Items),
+			)
 		} else {
 			return nil, fmt.Errorf(
 				"References a non-existent ClusterServiceClass %c or there is more than one (found: %d)",
@@ -1637,7 +2109,7 @@ func (c *controller) applyDefaultProvisioningParameters(instance *v1beta1.Servic
 	if err != nil {
 		s := fmt.Sprintf("error updating service instance to apply default parameters: %s", err)
 		klog.Warning(pcb.Message(s))
-		c.recorder.Event(instance, corev1.EventTypeWarning, errorWithParametersReason, s)
+		c.recorder.Event(instance, corev1.EventTypeWarning, v1beta1.ReasonErrorWithParameters, s)
 		return false, fmt.Errorf(s)
 	}
 
@@ -1646,7 +2118,128 @@ func (c *controller) applyDefaultProvisioningParameters(instance *v1beta1.Servic
 	return updatedInstance.ResourceVersion != instance.ResourceVersion, err
 }
 
+// syncServiceInstanceDefaultParameters checks whether the default
+// provisioning parameters of a provisioned instance have changed since they
+// were applied to its parameters. If resendParametersOnDefaultsChange is
+// set, the parameters left at their old defaults are moved to the new ones,
+// which makes the next reconciliation send them to the broker. Otherwise
+// the instance is flagged with the DefaultParametersChanged condition,
+// which is removed once the defaults match again. Returns true if the
+// instance was updated, in which case the reconciliation continues in the
+// next iteration.
+func (c *controller) syncServiceInstanceDefaultParameters(instance *v1beta1.ServiceInstance) (bool, error) {
+	if instance.Status.DefaultProvisionParameters == nil {
+		return false, nil
+	}
+
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	defaultParams, err := c.getDefaultProvisioningParameters(instance)
+	if err != nil {
+		return false, err
+	}
+	changed, err := isDefaultParametersChanged(instance.Status.DefaultProvisionParameters, defaultParams)
+	if err != nil {
+		return false, err
+	}
+
+	toUpdate := instance.DeepCopy()
+	if !changed {
+		removeServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionDefaultParametersChanged)
+		if reflect.DeepEqual(toUpdate.Status.Conditions, instance.Status.Conditions) {
+			return false, nil
+		}
+		_, err := c.updateServiceInstanceStatus(toUpdate)
+		return err == nil, err
+	}
+
+	if !c.resendParametersOnDefaultsChange {
+		msg := "The default provisioning parameters have changed since they were applied to the parameters of the instance"
+		setLeadingServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionDefaultParametersChanged, v1beta1.ReasonDefaultParametersChanged, msg)
+		if reflect.DeepEqual(toUpdate.Status.Conditions, instance.Status.Conditions) {
+			return false, nil
+		}
+		if _, err := c.updateServiceInstanceStatus(toUpdate); err != nil {
+			return false, err
+		}
+		c.recorder.Event(toUpdate, corev1.EventTypeWarning, v1beta1.ReasonDefaultParametersChanged, msg)
+		return true, nil
+	}
+
+	finalParams, err := rebaseDefaultParameters(toUpdate.Spec.Parameters, toUpdate.Status.DefaultProvisionParameters, defaultParams)
+	if err != nil {
+		return false, err
+	}
+
+	klog.V(4).Info(pcb.Message("Applying changed default provisioning parameters"))
+	toUpdate.Spec.Parameters = finalParams
+	_, err = c.updateServiceInstanceWithRetries(toUpdate, func(conflictedInstance *v1beta1.ServiceInstance) {
+		conflictedInstance.Spec.Parameters = finalParams
+	})
+	if err != nil {
+		s := fmt.Sprintf("error updating service instance to apply changed default parameters: %s", err)
+		klog.Warning(pcb.Message(s))
+		c.recorder.Event(instance, corev1.EventTypeWarning, v1beta1.ReasonErrorWithParameters, s)
+		return false, fmt.Errorf(s)
+	}
+
+	toUpdate.Status.DefaultProvisionParameters = defaultParams
+	removeServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionDefaultParametersChanged)
+	if _, err := c.updateServiceInstanceStatus(toUpdate); err != nil {
+		return false, err
+	}
+	c.recorder.Event(toUpdate, corev1.EventTypeNormal, v1beta1.ReasonDefaultParametersChanged, "The parameters were updated with the changed default provisioning parameters and are sent to the broker again")
+	return true, nil
+}
+
+// getDefaultProvisioningParameters returns the defaults of the provisioning
+// parameters of an instance. The defaults of its plan take precedence over
+// the ones of its class, which in turn take precedence over the ones taken
+// from the annotations of its namespace.
 func (c *controller) getDefaultProvisioningParameters(instance *v1beta1.ServiceInstance) (*runtime.RawExtension, error) {
+	namespaceDefaults, err := c.getNamespaceAnnotationParameters(instance)
+	if err != nil {
+		return nil, err
+	}
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServicePlanDefaults) {
+		return namespaceDefaults, nil
+	}
+
+	catalogDefaults, err := c.getCatalogDefaultProvisioningParameters(instance)
+	if err != nil {
+		return nil, err
+	}
+	return mergeParameters(catalogDefaults, namespaceDefaults)
+}
+
+// getNamespaceAnnotationParameters returns the provisioning parameters
+// provided by the annotations of the namespace of an instance, as configured
+// by namespaceAnnotationParameters.
+func (c *controller) getNamespaceAnnotationParameters(instance *v1beta1.ServiceInstance) (*runtime.RawExtension, error) {
+	if len(c.namespaceAnnotationParameters) == 0 {
+		return nil, nil
+	}
+
+	ns, err := c.namespaceLister.Get(instance.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]interface{})
+	for annotation, parameter := range c.namespaceAnnotationParameters {
+		if value, ok := ns.Annotations[annotation]; ok {
+			params[parameter] = value
+		}
+	}
+	raw, err := MarshalRawParameters(params)
+	if err != nil || raw == nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// getCatalogDefaultProvisioningParameters returns the default provisioning
+// parameters of the class and plan of an instance.
+func (c *controller) getCatalogDefaultProvisioningParameters(instance *v1beta1.ServiceInstance) (*runtime.RawExtension, error) {
 	var classDefaults, planDefaults *runtime.RawExtension
 
 	if instance.Spec.ClusterServiceClassSpecified() {
@@ -1787,6 +2380,17 @@ func setServiceInstanceCondition(toUpdate *v1beta1.ServiceInstance,
 	setServiceInstanceConditionInternal(toUpdate, conditionType, status, reason, message, metav1.Now())
 }
 
+// setServiceInstanceConditionFrom sets the condition of the type of cond on an
+// Instance's status to cond, including its error code.
+func setServiceInstanceConditionFrom(toUpdate *v1beta1.ServiceInstance, cond *v1beta1.ServiceInstanceCondition) {
+	setServiceInstanceCondition(toUpdate, cond.Type, cond.Status, cond.Reason, cond.Message)
+	for i := range toUpdate.Status.Conditions {
+		if toUpdate.Status.Conditions[i].Type == cond.Type {
+			toUpdate.Status.Conditions[i].ErrorCode = cond.ErrorCode
+		}
+	}
+}
+
 // setServiceInstanceConditionInternal is setServiceInstanceCondition but allows the time to
 // be parameterized for testing.
 func setServiceInstanceConditionInternal(toUpdate *v1beta1.ServiceInstance,
@@ -1844,6 +2448,31 @@ func setServiceInstanceConditionInternal(toUpdate *v1beta1.ServiceInstance,
 	toUpdate.Status.Conditions = append(toUpdate.Status.Conditions, newCondition)
 }
 
+// setLeadingServiceInstanceCondition sets a true condition of the given type
+// on an Instance. The condition is put ahead of the others so that it doesn't
+// replace the instance's Ready state in LastConditionState.
+func setLeadingServiceInstanceCondition(toUpdate *v1beta1.ServiceInstance, conditionType v1beta1.ServiceInstanceConditionType, reason, message string) {
+	newCondition := v1beta1.ServiceInstanceCondition{
+		Type:               conditionType,
+		Status:             v1beta1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	}
+
+	for i, cond := range toUpdate.Status.Conditions {
+		if cond.Type == conditionType {
+			if cond.Status == newCondition.Status {
+				newCondition.LastTransitionTime = cond.LastTransitionTime
+			}
+			toUpdate.Status.Conditions[i] = newCondition
+			return
+		}
+	}
+
+	toUpdate.Status.Conditions = append([]v1beta1.ServiceInstanceCondition{newCondition}, toUpdate.Status.Conditions...)
+}
+
 // updateServiceInstanceReferences updates the refs for the given instance.
 func (c *controller) updateServiceInstanceReferences(toUpdate *v1beta1.ServiceInstance) (*v1beta1.ServiceInstance, error) {
 	pcb := pretty.NewInstanceContextBuilder(toUpdate)
@@ -2038,6 +2667,9 @@ func isServiceInstancePropertiesStateEqual(s1 *v1beta1.ServiceInstanceProperties
 	if s1.ParameterChecksum != s2.ParameterChecksum {
 		return false
 	}
+	if s1.ContextChecksum != s2.ContextChecksum {
+		return false
+	}
 	if s1.UserInfo != nil || s2.UserInfo != nil {
 		u1 := s1.UserInfo
 		u2 := s2.UserInfo
@@ -2073,14 +2705,14 @@ func (c *controller) recordStartOfServiceInstanceOperation(toUpdate *v1beta1.Ser
 	message := ""
 	switch operation {
 	case v1beta1.ServiceInstanceOperationProvision:
-		reason = provisioningInFlightReason
+		reason = v1beta1.ReasonProvisionRequestInFlight
 		message = provisioningInFlightMessage
 		toUpdate.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
 	case v1beta1.ServiceInstanceOperationUpdate:
-		reason = instanceUpdatingInFlightReason
+		reason = v1beta1.ReasonUpdateInstanceRequestInFlight
 		message = instanceUpdatingInFlightMessage
 	case v1beta1.ServiceInstanceOperationDeprovision:
-		reason = deprovisioningInFlightReason
+		reason = v1beta1.ReasonDeprovisionRequestInFlight
 		message = deprovisioningInFlightMessage
 	}
 	setServiceInstanceCondition(
@@ -2125,13 +2757,13 @@ func (c *controller) checkForRemovedClusterClassAndPlan(instance *v1beta1.Servic
 	// At this point we know that plan is being changed
 	if planDeleted {
 		return &operationError{
-			reason:  errorDeletedClusterServicePlanReason,
+			reason:  v1beta1.ReasonReferencesDeletedServicePlan,
 			message: fmt.Sprintf("%s has been deleted; cannot provision.", pretty.ClusterServicePlanName(servicePlan)),
 		}
 	}
 
 	return &operationError{
-		reason:  errorDeletedClusterServiceClassReason,
+		reason:  v1beta1.ReasonReferencesDeletedServiceClass,
 		message: fmt.Sprintf("%s has been deleted; cannot provision.", pretty.ClusterServiceClassName(serviceClass)),
 	}
 }
@@ -2162,13 +2794,13 @@ func (c *controller) checkForRemovedClassAndPlan(instance *v1beta1.ServiceInstan
 	// At this point we know that plan is being changed
 	if planDeleted {
 		return &operationError{
-			reason:  errorDeletedServicePlanReason,
+			reason:  v1beta1.ReasonReferencesDeletedServicePlan,
 			message: fmt.Sprintf("%s has been deleted; cannot provision.", pretty.ServicePlanName(servicePlan)),
 		}
 	}
 
 	return &operationError{
-		reason:  errorDeletedServiceClassReason,
+		reason:  v1beta1.ReasonReferencesDeletedServiceClass,
 		message: fmt.Sprintf("%s has been deleted; cannot provision.", pretty.ServiceClassName(serviceClass)),
 	}
 }
@@ -2184,6 +2816,17 @@ func clearServiceInstanceCurrentOperation(toUpdate *v1beta1.ServiceInstance) {
 	toUpdate.Status.InProgressProperties = nil
 }
 
+// serviceInstanceOperationDuration returns how long the current operation of
+// the instance has been running, or nil if its start time is not recorded.
+// The start time is kept while an asynchronous operation is polled, so on
+// completion this covers the whole operation.
+func serviceInstanceOperationDuration(instance *v1beta1.ServiceInstance) *metav1.Duration {
+	if instance.Status.OperationStartTime == nil {
+		return nil
+	}
+	return &metav1.Duration{Duration: time.Since(instance.Status.OperationStartTime.Time)}
+}
+
 // checkServiceInstanceHasExistingBindings returns true if there are any existing
 // bindings associated with the given ServiceInstance.
 func (c *controller) checkServiceInstanceHasExistingBindings(instance *v1beta1.ServiceInstance) error {
@@ -2201,7 +2844,7 @@ func (c *controller) checkServiceInstanceHasExistingBindings(instance *v1beta1.S
 		// to filter out binding requests that have yet to be sent to the broker.
 		if instance.Name == binding.Spec.InstanceRef.Name {
 			return &operationError{
-				reason:  errorDeprovisionBlockedByCredentialsReason,
+				reason:  v1beta1.ReasonDeprovisionBlockedByExistingCredentials,
 				message: "All associated ServiceBindings must be removed before this ServiceInstance can be deleted",
 			}
 		}
@@ -2210,6 +2853,167 @@ func (c *controller) checkServiceInstanceHasExistingBindings(instance *v1beta1.S
 	return nil
 }
 
+// isServiceInstancePlanChanged returns whether the plan recorded in the
+// current properties of an instance differs from the previous one.
+func isServiceInstancePlanChanged(previous, current *v1beta1.ServiceInstancePropertiesState) bool {
+	if previous == nil || current == nil {
+		return false
+	}
+	return previous.ClusterServicePlanExternalID != current.ClusterServicePlanExternalID ||
+		previous.ServicePlanExternalID != current.ServicePlanExternalID
+}
+
+// getServicePlanExternalName returns the external name of the plan recorded
+// in the given instance properties, whether cluster-scoped or namespaced.
+func getServicePlanExternalName(properties *v1beta1.ServiceInstancePropertiesState) string {
+	if properties.ClusterServicePlanExternalName != "" {
+		return properties.ClusterServicePlanExternalName
+	}
+	return properties.ServicePlanExternalName
+}
+
+// markServiceInstanceBindingsStale flags the bindings of an instance whose
+// plan has changed as having stale credentials, since brokers may issue
+// different credentials for each plan. If
+// refetchBindingCredentialsOnInstancePlanChange is set, the bind requests of
+// the bindings are also sent again. They keep their binding ID, so brokers
+// treating them as idempotent answer with the credentials of the existing
+// bindings rather than issuing new ones. Errors are only logged, as the
+// update of the instance itself has already succeeded.
+func (c *controller) markServiceInstanceBindingsStale(instance *v1beta1.ServiceInstance, previousProperties *v1beta1.ServiceInstancePropertiesState) {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+
+	bindingList, err := c.bindingLister.ServiceBindings(instance.Namespace).List(labels.Everything())
+	if err != nil {
+		klog.Warning(pcb.Messagef("Error listing bindings to mark their credentials as stale: %v", err))
+		return
+	}
+
+	msg := fmt.Sprintf(
+		"The plan of the instance changed from %q to %q; the credentials of the binding may no longer be valid",
+		getServicePlanExternalName(previousProperties), getServicePlanExternalName(instance.Status.ExternalProperties),
+	)
+	for _, binding := range bindingList {
+		if binding.Spec.InstanceRef.Name != instance.Name || binding.DeletionTimestamp != nil {
+			continue
+		}
+
+		toUpdate := binding.DeepCopy()
+		setServiceBindingCredentialsStaleCondition(toUpdate, msg, metav1.Now())
+		if c.refetchBindingCredentialsOnInstancePlanChange && !isServiceBindingFailed(toUpdate) {
+			// Resetting the reconciled generation makes the binding
+			// reconciler send the bind request again, with the same
+			// binding ID; the condition is removed once the credentials
+			// returned by the broker are injected.
+			toUpdate.Status.ReconciledGeneration = 0
+		}
+		if _, err := c.updateServiceBindingStatus(toUpdate); err != nil {
+			klog.Warning(pcb.Messagef("Error marking the credentials of ServiceBinding %q as stale: %v", binding.Name, err))
+			continue
+		}
+		c.recorder.Event(toUpdate, corev1.EventTypeWarning, v1beta1.ReasonInstancePlanChanged, msg)
+	}
+}
+
+// syncServiceBindingsPlanBindability flags the bindings of the given instances
+// of a plan with the PlanNotBindable condition when the plan is not bindable,
+// and removes the condition once it is bindable again. The bindings
+// themselves are left in place.
+func (c *controller) syncServiceBindingsPlanBindability(instances []*v1beta1.ServiceInstance, planExternalName string, bindable bool) error {
+	msg := fmt.Sprintf("The plan %q of the instance is no longer bindable; the binding is left in place", planExternalName)
+	for _, instance := range instances {
+		objs, err := c.bindingIndexer.ByIndex(bindingInstanceIndex, instance.Namespace+"/"+instance.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range objs {
+			binding, ok := obj.(*v1beta1.ServiceBinding)
+			if !ok || binding.DeletionTimestamp != nil {
+				continue
+			}
+			if bindable != isServiceBindingPlanNotBindable(binding) {
+				continue
+			}
+
+			pcb := pretty.NewBindingContextBuilder(binding)
+			toUpdate := binding.DeepCopy()
+			if bindable {
+				klog.V(4).Info(pcb.Messagef("The plan %q is bindable again", planExternalName))
+				removeServiceBindingCondition(toUpdate, v1beta1.ServiceBindingConditionPlanNotBindable)
+				if _, err := c.updateServiceBindingStatus(toUpdate); err != nil {
+					return err
+				}
+				continue
+			}
+
+			klog.Warning(pcb.Message(msg))
+			setServiceBindingPlanNotBindableCondition(toUpdate, msg, metav1.Now())
+			if _, err := c.updateServiceBindingStatus(toUpdate); err != nil {
+				return err
+			}
+			c.recorder.Event(toUpdate, corev1.EventTypeWarning, v1beta1.ReasonPlanNotBindable, msg)
+		}
+	}
+	return nil
+}
+
+// revalidateServiceInstanceParameters checks the parameters last sent to the
+// broker for each of the given instances of a plan against the new instance
+// create schema of the plan. Instances whose parameters do not comply are
+// flagged with the NonCompliantParameters condition, which is removed from
+// those that comply again; the instances are not otherwise modified. Errors
+// are only logged, as the update of the plan has already succeeded.
+func (c *controller) revalidateServiceInstanceParameters(instances []v1beta1.ServiceInstance, planExternalName string, schema *runtime.RawExtension) {
+	var schemaMap map[string]interface{}
+	if schema != nil && len(schema.Raw) > 0 {
+		if err := json.Unmarshal(schema.Raw, &schemaMap); err != nil {
+			klog.Warningf("Error unmarshalling the instance create schema of plan %q: %v", planExternalName, err)
+			return
+		}
+	}
+
+	for i := range instances {
+		instance := &instances[i]
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		if instance.DeletionTimestamp != nil || instance.Status.ExternalProperties == nil || isServiceInstancePaused(instance) {
+			continue
+		}
+
+		var violations []string
+		if schemaMap != nil {
+			parameters := make(map[string]interface{})
+			if raw := instance.Status.ExternalProperties.Parameters; raw != nil && len(raw.Raw) > 0 {
+				if err := json.Unmarshal(raw.Raw, &parameters); err != nil {
+					klog.Warning(pcb.Messagef("Error unmarshalling parameters to check them against the schema of plan %q: %v", planExternalName, err))
+					continue
+				}
+			}
+			violations = validateParametersAgainstSchema(parameters, schemaMap)
+		}
+
+		toUpdate := instance.DeepCopy()
+		var msg string
+		if len(violations) > 0 {
+			msg = fmt.Sprintf("The parameters of the instance do not comply with the new parameter schema of plan %q: %s", planExternalName, strings.Join(violations, "; "))
+			setLeadingServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionNonCompliantParameters, v1beta1.ReasonPlanSchemaChanged, msg)
+		} else {
+			removeServiceInstanceCondition(toUpdate, v1beta1.ServiceInstanceConditionNonCompliantParameters)
+		}
+		if reflect.DeepEqual(toUpdate.Status.Conditions, instance.Status.Conditions) {
+			continue
+		}
+
+		if _, err := c.updateServiceInstanceStatus(toUpdate); err != nil {
+			klog.Warning(pcb.Messagef("Error updating the NonCompliantParameters condition: %v", err))
+			continue
+		}
+		if msg != "" {
+			c.recorder.Event(toUpdate, corev1.EventTypeWarning, v1beta1.ReasonPlanSchemaChanged, msg)
+		}
+	}
+}
+
 // requestHelper is a helper struct with properties common to multiple request
 // types.
 type requestHelper struct {
@@ -2226,10 +3030,10 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 	rh := &requestHelper{}
 
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
-		originatingIdentity, err := buildOriginatingIdentity(instance.Spec.UserInfo)
+		originatingIdentity, err := c.buildNamespaceOriginatingIdentity(instance.Namespace, instance.Spec.UserInfo)
 		if err != nil {
 			return nil, &operationError{
-				reason:  errorWithOriginatingIdentityReason,
+				reason:  v1beta1.ReasonErrorWithOriginatingIdentity,
 				message: fmt.Sprintf("Error building originating identity headers: %v", err),
 			}
 		}
@@ -2245,22 +3049,32 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 	ns, err := c.kubeClient.CoreV1().Namespaces().Get(instance.Namespace, metav1.GetOptions{})
 	if err != nil {
 		return nil, &operationError{
-			reason:  errorFindingNamespaceServiceInstanceReason,
+			reason:  v1beta1.ReasonErrorFindingNamespaceForInstance,
 			message: fmt.Sprintf("Failed to get namespace %q: %s", instance.Namespace, err),
 		}
 	}
 	rh.ns = ns
+	rh.requestContext = c.buildServiceInstanceRequestContext(instance)
 
 	if setInProgressProperties {
+		if instance.Spec.GeneratedParameters != nil {
+			if err := c.ensureGeneratedParameterValues(instance); err != nil {
+				return nil, &operationError{
+					reason:  v1beta1.ReasonErrorWithParameters,
+					message: err.Error(),
+				}
+			}
+		}
 		parameters, parametersChecksum, rawParametersWithRedaction, err := prepareInProgressPropertyParameters(
 			c.kubeClient,
 			instance.Namespace,
+			c.parametersFromURLAllowedHosts,
 			instance.Spec.Parameters,
-			instance.Spec.ParametersFrom,
+			serviceInstanceParametersFrom(instance),
 		)
 		if err != nil {
 			return nil, &operationError{
-				reason:  errorWithParametersReason,
+				reason:  v1beta1.ReasonErrorWithParameters,
 				message: err.Error(),
 			}
 		}
@@ -2279,17 +3093,117 @@ func (c *controller) prepareRequestHelper(instance *v1beta1.ServiceInstance, pla
 			rh.inProgressProperties.ServicePlanExternalName = planName
 			rh.inProgressProperties.ServicePlanExternalID = planID
 		}
+
+		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServiceInstanceContextUpdates) {
+			contextChecksum, err := generateChecksumOfParameters(rh.requestContext)
+			if err != nil {
+				return nil, &operationError{
+					reason:  v1beta1.ReasonErrorWithParameters,
+					message: fmt.Sprintf("Failed to generate the context checksum: %s", err),
+				}
+			}
+			rh.inProgressProperties.ContextChecksum = contextChecksum
+		}
 	}
 
+	return rh, nil
+}
+
+// reservedBrokerContextKeys are the keys of the OSB context set by the
+// controller or by the Kubernetes profile of the OSB API, which instance
+// annotations may not be copied under.
+var reservedBrokerContextKeys = sets.NewString(
+	"platform",
+	"namespace",
+	clusterIdentifierKey,
+	"instance_name",
+	"instance_annotations",
+	"namespace_annotations",
+)
+
+// ParseBrokerContextAnnotations parses a comma separated list of annotations
+// of instances to copy into the OSB context of their requests.
+func ParseBrokerContextAnnotations(value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var annotations []string
+	seen := sets.NewString()
+	for _, annotation := range strings.Split(value, ",") {
+		annotation = strings.TrimSpace(annotation)
+		if errs := validation.IsQualifiedName(annotation); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid annotation %q: %s", annotation, strings.Join(errs, "; "))
+		}
+		if reservedBrokerContextKeys.Has(annotation) {
+			return nil, fmt.Errorf("annotation %q is a reserved context key", annotation)
+		}
+		if seen.Has(annotation) {
+			return nil, fmt.Errorf("duplicate entry for annotation %q", annotation)
+		}
+		seen.Insert(annotation)
+		annotations = append(annotations, annotation)
+	}
+	return annotations, nil
+}
+
+// buildServiceInstanceRequestContext returns the context sent to the broker in
+// provision and update requests of the given instance.
+func (c *controller) buildServiceInstanceRequestContext(instance *v1beta1.ServiceInstance) map[string]interface{} {
 	// osb client handles whether or not to really send this based
 	// on the version of the client.
-	id := c.getClusterID()
-	rh.requestContext = map[string]interface{}{
-		"platform":           ContextProfilePlatformKubernetes,
-		"namespace":          instance.Namespace,
-		clusterIdentifierKey: id,
+	requestContext := map[string]interface{}{
+		"platform":           c.brokerContextPlatform,
+		"namespace":          c.getBrokerContextNamespace(instance.Namespace),
+		clusterIdentifierKey: c.getClusterID(),
 	}
-	return rh, nil
+	for _, annotation := range c.brokerContextAnnotations {
+		if value, ok := instance.Annotations[annotation]; ok {
+			requestContext[annotation] = value
+		}
+	}
+	return requestContext
+}
+
+// isServiceInstanceContextChanged returns whether the context of a ready
+// instance differs from the context last sent to the broker. Instances
+// without a recorded context checksum are not considered changed, so that
+// enabling the feature does not send an update for every existing instance.
+func (c *controller) isServiceInstanceContextChanged(instance *v1beta1.ServiceInstance) bool {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServiceInstanceContextUpdates) {
+		return false
+	}
+	if !isServiceInstanceReady(instance) ||
+		instance.Status.ExternalProperties == nil ||
+		instance.Status.ExternalProperties.ContextChecksum == "" {
+		return false
+	}
+	contextChecksum, err := generateChecksumOfParameters(c.buildServiceInstanceRequestContext(instance))
+	if err != nil {
+		return false
+	}
+	return contextChecksum != instance.Status.ExternalProperties.ContextChecksum
+}
+
+// isServiceInstanceParametersChanged returns whether the parameters built from
+// the current content of the parametersFrom sources of a ready instance differ
+// from the parameters last accepted by the broker.
+func (c *controller) isServiceInstanceParametersChanged(instance *v1beta1.ServiceInstance) bool {
+	if !isServiceInstanceReady(instance) ||
+		instance.Status.ExternalProperties == nil {
+		return false
+	}
+	_, parametersChecksum, _, err := prepareInProgressPropertyParameters(
+		c.kubeClient,
+		instance.Namespace,
+		c.parametersFromURLAllowedHosts,
+		instance.Spec.Parameters,
+		serviceInstanceParametersFrom(instance),
+	)
+	if err != nil {
+		return false
+	}
+	return parametersChecksum != instance.Status.ExternalProperties.ParameterChecksum
 }
 
 // innerPrepareProvisionRequest creates a provision request object to be passed to
@@ -2354,9 +3268,11 @@ func (c *controller) prepareUpdateInstanceRequest(instance *v1beta1.ServiceInsta
 			planID := servicePlan.Spec.ExternalID
 			request.PlanID = &planID
 		}
-		// Only send the parameters if they have changed from what the Broker has
+		// Only send the parameters if they have changed from what the Broker
+		// has, unless they have been requested to be sent again
 		if instance.Status.ExternalProperties == nil ||
-			rh.inProgressProperties.ParameterChecksum != instance.Status.ExternalProperties.ParameterChecksum {
+			rh.inProgressProperties.ParameterChecksum != instance.Status.ExternalProperties.ParameterChecksum ||
+			isServiceInstanceParametersResendRequested(instance) {
 			if rh.parameters != nil {
 				request.Parameters = rh.parameters
 			} else {
@@ -2389,9 +3305,11 @@ func (c *controller) prepareUpdateInstanceRequest(instance *v1beta1.ServiceInsta
 			planID := servicePlan.Spec.ExternalID
 			request.PlanID = &planID
 		}
-		// Only send the parameters if they have changed from what the Broker has
+		// Only send the parameters if they have changed from what the Broker
+		// has, unless they have been requested to be sent again
 		if instance.Status.ExternalProperties == nil ||
-			rh.inProgressProperties.ParameterChecksum != instance.Status.ExternalProperties.ParameterChecksum {
+			rh.inProgressProperties.ParameterChecksum != instance.Status.ExternalProperties.ParameterChecksum ||
+			isServiceInstanceParametersResendRequested(instance) {
 			if rh.parameters != nil {
 				request.Parameters = rh.parameters
 			} else {
@@ -2444,7 +3362,7 @@ func (c *controller) prepareDeprovisionRequest(instance *v1beta1.ServiceInstance
 			servicePlan, err := c.clusterServicePlanLister.Get(instance.Spec.ClusterServicePlanRef.Name)
 			if err != nil {
 				return nil, nil, &operationError{
-					reason: errorNonexistentClusterServicePlanReason,
+					reason: v1beta1.ReasonReferencesNonexistentServicePlan,
 					message: fmt.Sprintf(
 						"The instance references a non-existent ClusterServicePlan %q - %v",
 						instance.Spec.ClusterServicePlanRef.Name, instance.Spec.PlanReference,
@@ -2459,7 +3377,7 @@ func (c *controller) prepareDeprovisionRequest(instance *v1beta1.ServiceInstance
 			servicePlan, err := c.servicePlanLister.ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name)
 			if err != nil {
 				return nil, nil, &operationError{
-					reason: errorNonexistentServicePlanReason,
+					reason: v1beta1.ReasonReferencesNonexistentServicePlan,
 					message: fmt.Sprintf(
 						"The instance references a non-existent ServicePlan %q - %v",
 						instance.Spec.ServicePlanRef.Name, instance.Spec.PlanReference,
@@ -2615,7 +3533,7 @@ func (c *controller) handleServiceInstanceReconciliationError(instance *v1beta1.
 // processServiceInstanceOperationError handles the logging and updating of
 // a ServiceInstance that hit a retryable error during reconciliation.
 func (c *controller) processServiceInstanceOperationError(instance *v1beta1.ServiceInstance, readyCond *v1beta1.ServiceInstanceCondition) error {
-	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
+	setServiceInstanceConditionFrom(instance, readyCond)
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
@@ -2625,15 +3543,18 @@ func (c *controller) processServiceInstanceOperationError(instance *v1beta1.Serv
 	// The result of this function should be directly returned from the
 	// reconciler, so it is necessary to return an error to tell the worker
 	// to retry reconciling the resource.
-	return fmt.Errorf(readyCond.Message)
+	return reconciliationErrorFor(readyCond.Reason, readyCond.Message)
 }
 
 // processProvisionSuccess handles the logging and updating of a
 // ServiceInstance that has successfully been provisioned at the broker.
 func (c *controller) processProvisionSuccess(instance *v1beta1.ServiceInstance, dashboardURL *string) error {
-	setServiceInstanceDashboardURL(instance, dashboardURL)
-	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successProvisionReason, successProvisionMessage)
+	c.setServiceInstanceDashboardURL(instance, dashboardURL)
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, v1beta1.ReasonProvisionedSuccessfully, successProvisionMessage)
 	instance.Status.ExternalProperties = instance.Status.InProgressProperties
+	if duration := serviceInstanceOperationDuration(instance); duration != nil {
+		instance.Status.LastProvisionDuration = duration
+	}
 	clearServiceInstanceCurrentOperation(instance)
 	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
 	instance.Status.ReconciledGeneration = instance.Status.ObservedGeneration
@@ -2643,7 +3564,7 @@ func (c *controller) processProvisionSuccess(instance *v1beta1.ServiceInstance,
 	}
 
 	c.removeInstanceFromRetryMap(instance)
-	c.recorder.Eventf(instance, corev1.EventTypeNormal, successProvisionReason, successProvisionMessage)
+	c.recorder.Eventf(instance, corev1.EventTypeNormal, v1beta1.ReasonProvisionedSuccessfully, successProvisionMessage)
 	return nil
 }
 
@@ -2668,12 +3589,12 @@ func (c *controller) processTemporaryProvisionFailure(instance *v1beta1.ServiceI
 // reconciliation.
 func (c *controller) processProvisionFailure(instance *v1beta1.ServiceInstance, readyCond, failedCond *v1beta1.ServiceInstanceCondition, shouldMitigateOrphan bool) error {
 	c.recorder.Event(instance, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
-	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
+	setServiceInstanceConditionFrom(instance, readyCond)
 
 	var errorMessage error
 	if failedCond != nil {
 		c.recorder.Event(instance, corev1.EventTypeWarning, failedCond.Reason, failedCond.Message)
-		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionFailed, failedCond.Status, failedCond.Reason, failedCond.Message)
+		setServiceInstanceConditionFrom(instance, failedCond)
 		errorMessage = fmt.Errorf(failedCond.Message)
 	} else {
 		errorMessage = fmt.Errorf(readyCond.Message)
@@ -2681,16 +3602,17 @@ func (c *controller) processProvisionFailure(instance *v1beta1.ServiceInstance,
 
 	if shouldMitigateOrphan {
 		// Copy original failure reason/message to a new OrphanMitigation condition
-		c.recorder.Event(instance, corev1.EventTypeWarning, startingInstanceOrphanMitigationReason, startingInstanceOrphanMitigationMessage)
+		c.recorder.Event(instance, corev1.EventTypeWarning, v1beta1.ReasonStartingInstanceOrphanMitigation, startingInstanceOrphanMitigationMessage)
 		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionOrphanMitigation,
 			v1beta1.ConditionTrue, readyCond.Reason, readyCond.Message)
 		// Overwrite Ready condition reason/message with reporting on orphan mitigation
 		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady,
 			v1beta1.ConditionFalse,
-			startingInstanceOrphanMitigationReason,
+			v1beta1.ReasonStartingInstanceOrphanMitigation,
 			startingInstanceOrphanMitigationMessage)
 
 		instance.Status.OrphanMitigationInProgress = true
+		instance.Status.OrphanMitigationAttempts = 0
 	} else {
 		// Deprovisioning is not required for provisioning that has failed with an
 		// error that doesn't require orphan mitigation
@@ -2725,24 +3647,31 @@ func (c *controller) processProvisionFailure(instance *v1beta1.ServiceInstance,
 // of a ServiceInstance that received an asynchronous response from the broker
 // when requesting a provision.
 func (c *controller) processProvisionAsyncResponse(instance *v1beta1.ServiceInstance, response *osb.ProvisionResponse) error {
-	setServiceInstanceDashboardURL(instance, response.DashboardURL)
+	c.setServiceInstanceDashboardURL(instance, response.DashboardURL)
 	setServiceInstanceLastOperation(instance, response.OperationKey)
-	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, asyncProvisioningReason, asyncProvisioningMessage)
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonProvisioning, asyncProvisioningMessage)
 	instance.Status.AsyncOpInProgress = true
 
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
 
-	c.recorder.Event(instance, corev1.EventTypeNormal, asyncProvisioningReason, asyncProvisioningMessage)
+	c.recorder.Event(instance, corev1.EventTypeNormal, v1beta1.ReasonProvisioning, asyncProvisioningMessage)
 	return c.beginPollingServiceInstance(instance)
 }
 
 // processUpdateServiceInstanceSuccess handles the logging and updating of a
 // ServiceInstance that has successfully been updated at the broker.
 func (c *controller) processUpdateServiceInstanceSuccess(instance *v1beta1.ServiceInstance) error {
-	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, successUpdateInstanceReason, successUpdateInstanceMessage)
+	previousProperties := instance.Status.ExternalProperties
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionTrue, v1beta1.ReasonInstanceUpdatedSuccessfully, successUpdateInstanceMessage)
+	// The broker accepted the new parameters, so they are assumed to comply
+	// with the current schema of the plan.
+	removeServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionNonCompliantParameters)
 	instance.Status.ExternalProperties = instance.Status.InProgressProperties
+	if duration := serviceInstanceOperationDuration(instance); duration != nil {
+		instance.Status.LastUpdateDuration = duration
+	}
 	clearServiceInstanceCurrentOperation(instance)
 	instance.Status.ReconciledGeneration = instance.Status.ObservedGeneration
 
@@ -2751,7 +3680,17 @@ func (c *controller) processUpdateServiceInstanceSuccess(instance *v1beta1.Servi
 	}
 
 	c.removeInstanceFromRetryMap(instance)
-	c.recorder.Eventf(instance, corev1.EventTypeNormal, successUpdateInstanceReason, successUpdateInstanceMessage)
+	c.recorder.Eventf(instance, corev1.EventTypeNormal, v1beta1.ReasonInstanceUpdatedSuccessfully, successUpdateInstanceMessage)
+
+	if isServiceInstanceParametersResendRequested(instance) {
+		if err := c.clearServiceInstanceParametersResendRequest(instance); err != nil {
+			return err
+		}
+	}
+
+	if isServiceInstancePlanChanged(previousProperties, instance.Status.ExternalProperties) {
+		c.markServiceInstanceBindingsStale(instance, previousProperties)
+	}
 	return nil
 }
 
@@ -2775,10 +3714,10 @@ func (c *controller) processTemporaryUpdateServiceInstanceFailure(instance *v1be
 // ServiceInstance that hit a terminal failure during update reconciliation.
 func (c *controller) processUpdateServiceInstanceFailure(instance *v1beta1.ServiceInstance, readyCond, failedCond *v1beta1.ServiceInstanceCondition) error {
 	c.recorder.Event(instance, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
-	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
+	setServiceInstanceConditionFrom(instance, readyCond)
 
 	if failedCond != nil {
-		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionFailed, failedCond.Status, failedCond.Reason, failedCond.Message)
+		setServiceInstanceConditionFrom(instance, failedCond)
 		// Reset the current operation if there was a terminal error
 		clearServiceInstanceCurrentOperation(instance)
 	} else {
@@ -2807,14 +3746,14 @@ func (c *controller) processUpdateServiceInstanceFailure(instance *v1beta1.Servi
 // when requesting an instance update.
 func (c *controller) processUpdateServiceInstanceAsyncResponse(instance *v1beta1.ServiceInstance, response *osb.UpdateInstanceResponse) error {
 	setServiceInstanceLastOperation(instance, response.OperationKey)
-	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, asyncUpdatingInstanceReason, asyncUpdatingInstanceMessage)
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonUpdatingInstance, asyncUpdatingInstanceMessage)
 	instance.Status.AsyncOpInProgress = true
 
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
 
-	c.recorder.Event(instance, corev1.EventTypeNormal, asyncUpdatingInstanceReason, asyncUpdatingInstanceMessage)
+	c.recorder.Event(instance, corev1.EventTypeNormal, v1beta1.ReasonUpdatingInstance, asyncUpdatingInstanceMessage)
 	return c.beginPollingServiceInstance(instance)
 }
 
@@ -2823,12 +3762,13 @@ func (c *controller) processUpdateServiceInstanceAsyncResponse(instance *v1beta1
 func (c *controller) processDeprovisionSuccess(instance *v1beta1.ServiceInstance) error {
 	mitigatingOrphan := instance.Status.OrphanMitigationInProgress
 
-	reason := successDeprovisionReason
+	reason := v1beta1.ReasonDeprovisionedSuccessfully
 	msg := successDeprovisionMessage
 	if mitigatingOrphan {
 		removeServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionOrphanMitigation)
 		instance.Status.OrphanMitigationInProgress = false
-		reason = successOrphanMitigationReason
+		instance.Status.OrphanMitigationAttempts = 0
+		reason = v1beta1.ReasonOrphanMitigationSuccessful
 		msg = successOrphanMitigationMessage
 	}
 
@@ -2837,6 +3777,7 @@ func (c *controller) processDeprovisionSuccess(instance *v1beta1.ServiceInstance
 	instance.Status.ExternalProperties = nil
 	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusNotProvisioned
 	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusSucceeded
+	instance.Status.ReconciledGeneration = instance.Status.ObservedGeneration
 
 	if mitigatingOrphan {
 		if _, err := c.updateServiceInstanceStatus(instance); err != nil {
@@ -2865,9 +3806,9 @@ func (c *controller) processDeprovisionFailure(instance *v1beta1.ServiceInstance
 	if instance.Status.OrphanMitigationInProgress {
 		// replace Ready condition with orphan mitigation-related one.
 		msg := "Orphan mitigation failed: " + failedCond.Message
-		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, errorOrphanMitigationFailedReason, msg)
+		readyCond := newServiceInstanceReadyCondition(v1beta1.ConditionUnknown, v1beta1.ReasonOrphanMitigationFailed, msg)
 
-		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, readyCond.Status, readyCond.Reason, readyCond.Message)
+		setServiceInstanceConditionFrom(instance, readyCond)
 		c.recorder.Event(instance, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
 	} else {
 		if readyCond != nil {
@@ -2875,7 +3816,7 @@ func (c *controller) processDeprovisionFailure(instance *v1beta1.ServiceInstance
 			c.recorder.Event(instance, corev1.EventTypeWarning, readyCond.Reason, readyCond.Message)
 		}
 
-		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionFailed, failedCond.Status, failedCond.Reason, failedCond.Message)
+		setServiceInstanceConditionFrom(instance, failedCond)
 		c.recorder.Event(instance, corev1.EventTypeWarning, failedCond.Reason, failedCond.Message)
 	}
 
@@ -2894,14 +3835,14 @@ func (c *controller) processDeprovisionFailure(instance *v1beta1.ServiceInstance
 // the broker when requesting a deprovision.
 func (c *controller) processDeprovisionAsyncResponse(instance *v1beta1.ServiceInstance, response *osb.DeprovisionResponse) error {
 	setServiceInstanceLastOperation(instance, response.OperationKey)
-	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, asyncDeprovisioningReason, asyncDeprovisioningMessage)
+	setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonDeprovisioning, asyncDeprovisioningMessage)
 	instance.Status.AsyncOpInProgress = true
 
 	if _, err := c.updateServiceInstanceStatus(instance); err != nil {
 		return err
 	}
 
-	c.recorder.Event(instance, corev1.EventTypeNormal, asyncDeprovisioningReason, asyncDeprovisioningMessage)
+	c.recorder.Event(instance, corev1.EventTypeNormal, v1beta1.ReasonDeprovisioning, asyncDeprovisioningMessage)
 	return c.beginPollingServiceInstance(instance)
 }
 
@@ -2919,10 +3860,49 @@ func (c *controller) handleServiceInstancePollingError(instance *v1beta1.Service
 }
 
 // setServiceInstanceDashboardURL sets the dashboard URL on the given instance.
-func setServiceInstanceDashboardURL(instance *v1beta1.ServiceInstance, dashboardURL *string) {
-	if dashboardURL != nil && *dashboardURL != "" {
-		url := *dashboardURL
-		instance.Status.DashboardURL = &url
+// A malformed dashboard URL returned by the broker is not stored; a warning
+// event is recorded for the instance instead.
+func (c *controller) setServiceInstanceDashboardURL(instance *v1beta1.ServiceInstance, dashboardURL *string) {
+	if dashboardURL == nil || *dashboardURL == "" {
+		return
+	}
+	if err := validateHTTPURL(*dashboardURL); err != nil {
+		pcb := pretty.NewInstanceContextBuilder(instance)
+		msg := fmt.Sprintf("Ignoring invalid dashboard URL %q returned by the broker: %v", *dashboardURL, err)
+		klog.Warning(pcb.Message(msg))
+		c.recorder.Event(instance, corev1.EventTypeWarning, v1beta1.ReasonInvalidDashboardURL, msg)
+		return
+	}
+	url := *dashboardURL
+	instance.Status.DashboardURL = &url
+}
+
+// validateHTTPURL returns an error if the given URL returned by a broker is
+// not an absolute http or https URL.
+func validateHTTPURL(rawURL string) error {
+	return scv.ValidateHTTPURL(rawURL, field.NewPath("dashboardURL")).ToAggregate()
+}
+
+// setServiceInstanceExtensionAPIs sets the extension APIs returned by the
+// broker on the given instance. Their credentials are not stored, and an
+// invalid extension API is left out with a warning event recorded for the
+// instance.
+func (c *controller) setServiceInstanceExtensionAPIs(instance *v1beta1.ServiceInstance, extensionAPIs []osb.ExtensionAPI) {
+	instance.Status.ExtensionAPIs = nil
+	for _, osbExtensionAPI := range extensionAPIs {
+		extensionAPI := v1beta1.ExtensionAPI{
+			DiscoveryURL: osbExtensionAPI.DiscoveryURL,
+			ServerURL:    osbExtensionAPI.ServerURL,
+			AdheresTo:    osbExtensionAPI.AdheresTo,
+		}
+		if err := scv.ValidateExtensionAPI(&extensionAPI, field.NewPath("extensionAPI")).ToAggregate(); err != nil {
+			pcb := pretty.NewInstanceContextBuilder(instance)
+			msg := fmt.Sprintf("Ignoring invalid extension API %q returned by the broker: %v", extensionAPI.DiscoveryURL, err)
+			klog.Warning(pcb.Message(msg))
+			c.recorder.Event(instance, corev1.EventTypeWarning, v1beta1.ReasonInvalidExtensionAPI, msg)
+			continue
+		}
+		instance.Status.ExtensionAPIs = append(instance.Status.ExtensionAPIs, extensionAPI)
 	}
 }
 
@@ -2959,6 +3939,35 @@ func (c *controller) resolveServiceInstanceUserSpecifiedClassAndPlan(instance *v
 	return true
 }
 
+// resolveServiceInstanceExternalNames sets the external names of the class
+// and plan the instance refers to in its status. The names are left as they
+// are while the class or plan cannot be found.
+func (c *controller) resolveServiceInstanceExternalNames(instance *v1beta1.ServiceInstance) {
+	if instance.Spec.ClusterServiceClassRef != nil && instance.Spec.ClusterServicePlanRef != nil {
+		class, err := c.clusterServiceClassLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return
+		}
+		plan, err := c.clusterServicePlanLister.Get(instance.Spec.ClusterServicePlanRef.Name)
+		if err != nil {
+			return
+		}
+		instance.Status.ResolvedClassExternalName = class.Spec.ExternalName
+		instance.Status.ResolvedPlanExternalName = plan.Spec.ExternalName
+	} else if instance.Spec.ServiceClassRef != nil && instance.Spec.ServicePlanRef != nil {
+		class, err := c.serviceClassLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+		if err != nil {
+			return
+		}
+		plan, err := c.servicePlanLister.ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name)
+		if err != nil {
+			return
+		}
+		instance.Status.ResolvedClassExternalName = class.Spec.ExternalName
+		instance.Status.ResolvedPlanExternalName = plan.Spec.ExternalName
+	}
+}
+
 func getServiceInstanceCommonClassAndPlan(instance v1beta1.ServiceInstance) (string, string) {
 	var class, plan string
 	if instance.Spec.ClusterServiceClassSpecified() && instance.Spec.ClusterServicePlanSpecified() {
@@ -2970,3 +3979,49 @@ func getServiceInstanceCommonClassAndPlan(instance v1beta1.ServiceInstance) (str
 	}
 	return class, plan
 }
+
+// isServiceInstancePaused returns whether the reconciliation of the given
+// instance, and of its bindings, is paused.
+func isServiceInstancePaused(instance *v1beta1.ServiceInstance) bool {
+	return instance.Annotations[v1beta1.ServiceInstancePausedAnnotation] == "true"
+}
+
+// isServiceInstanceParametersResendRequested returns whether the parameters
+// of the given instance are to be sent with every update request, even if the
+// broker already has them.
+func isServiceInstanceParametersResendRequested(instance *v1beta1.ServiceInstance) bool {
+	return instance.Annotations[v1beta1.ServiceInstanceResendParametersAnnotation] == "true"
+}
+
+// clearServiceInstanceParametersResendRequest removes the resend-parameters
+// annotation from the given instance once its parameters have been resent, so
+// that the following update requests only include them when they change.
+func (c *controller) clearServiceInstanceParametersResendRequest(instance *v1beta1.ServiceInstance) error {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+	klog.V(4).Info(pcb.Message("Removing the resend-parameters annotation because the parameters have been resent"))
+
+	toUpdate := instance.DeepCopy()
+	delete(toUpdate.Annotations, v1beta1.ServiceInstanceResendParametersAnnotation)
+	_, err := c.updateServiceInstanceWithRetries(toUpdate, func(conflictedInstance *v1beta1.ServiceInstance) {
+		delete(conflictedInstance.Annotations, v1beta1.ServiceInstanceResendParametersAnnotation)
+	})
+	return err
+}
+
+// enqueueServiceInstanceBindings adds the bindings that refer to the given
+// instance to the binding queue.
+func (c *controller) enqueueServiceInstanceBindings(instance *v1beta1.ServiceInstance) {
+	pcb := pretty.NewInstanceContextBuilder(instance)
+
+	bindingList, err := c.bindingLister.ServiceBindings(instance.Namespace).List(labels.Everything())
+	if err != nil {
+		klog.Warning(pcb.Messagef("Error listing bindings to enqueue: %v", err))
+		return
+	}
+
+	for _, binding := range bindingList {
+		if binding.Spec.InstanceRef.Name == instance.Name {
+			c.bindingAdd(binding)
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
 
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
@@ -109,7 +110,51 @@ func TestReconcileServiceInstanceNamespacedRefs(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successProvisionReason).msg(successProvisionMessage)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonProvisionedSuccessfully).msg(successProvisionMessage)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceFailsWithDeletedNamespacedClass tests that a
+// ServiceInstance is not provisioned, and no request is sent to the broker,
+// if the ServiceClass it references is marked as RemovedFromBrokerCatalog.
+func TestReconcileServiceInstanceFailsWithDeletedNamespacedClass(t *testing.T) {
+	err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.NamespacedServiceBroker))
+	if err != nil {
+		t.Fatalf("Could not enable NamespacedServiceBroker feature flag.")
+	}
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
+
+	fakeKubeClient, fakeCatalogClient, fakeBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	sharedInformers.ServiceBrokers().Informer().GetStore().Add(getTestServiceBroker())
+	sc := getTestServiceClass()
+	sc.Status.RemovedFromBrokerCatalog = true
+	sharedInformers.ServiceClasses().Informer().GetStore().Add(sc)
+	sharedInformers.ServicePlans().Informer().GetStore().Add(getTestServicePlan())
+
+	instance := getTestServiceInstanceWithNamespacedRefs()
+
+	if err := reconcileServiceInstance(t, testController, instance); err == nil {
+		t.Fatalf("This should have failed")
+	}
+
+	assertNumberOfBrokerActions(t, fakeBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, v1beta1.ReasonReferencesDeletedServiceClass)
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesDeletedServiceClass).msgf(
+		"%s has been deleted; cannot provision.", pretty.ServiceClassName(sc),
+	)
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -381,9 +426,9 @@ func TestPollServiceInstanceFailureProvisioningWithOperationNamespacedRefs(t *te
 		t,
 		updatedServiceInstance,
 		v1beta1.ServiceInstanceOperationProvision,
-		startingInstanceOrphanMitigationReason,
-		errorProvisionCallFailedReason,
-		errorProvisionCallFailedReason,
+		v1beta1.ReasonStartingInstanceOrphanMitigation,
+		v1beta1.ReasonProvisionCallFailed,
+		v1beta1.ReasonProvisionCallFailed,
 		instance,
 	)
 }
@@ -464,7 +509,7 @@ func TestReconcileServiceInstanceDeleteWithNamespacedRefs(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -555,7 +600,7 @@ func TestReconcileServiceInstanceDeleteAsynchronousWithNamespacedRefs(t *testing
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(asyncDeprovisioningReason).msg("The instance is being deprovisioned asynchronously")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisioning).msg("The instance is being deprovisioned asynchronously")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -714,7 +759,7 @@ func TestPollServiceInstanceSuccessDeprovisioningWithOperationNoFinalizerNamespa
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -781,7 +826,7 @@ func TestPollServiceInstanceFailureDeprovisioningNamespacedRefs(t *testing.T) {
 		t,
 		updatedServiceInstance,
 		v1beta1.ServiceInstanceOperationDeprovision,
-		errorDeprovisionCallFailedReason,
+		v1beta1.ReasonDeprovisionCallFailed,
 		testServicePlanName,
 		testServicePlanGUID,
 		instance,
@@ -789,7 +834,7 @@ func TestPollServiceInstanceFailureDeprovisioningNamespacedRefs(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorDeprovisionCallFailedReason).msg("Deprovision call failed: (no description provided)")
+	expectedEvent := warningEventBuilder(v1beta1.ReasonDeprovisionCallFailed).msg("Deprovision call failed: (no description provided)")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
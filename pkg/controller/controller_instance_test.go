@@ -44,6 +44,7 @@ import (
 	"github.com/kubernetes-incubator/service-catalog/test/fake"
 	sctestutil "github.com/kubernetes-incubator/service-catalog/test/util"
 	corev1 "k8s.io/api/core/v1"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
 	clientgotesting "k8s.io/client-go/testing"
 )
 
@@ -97,6 +98,23 @@ func TestReconcileServiceInstanceNotInitializedStatus(t *testing.T) {
 	}
 }
 
+// TestReconcileServiceInstancePaused tests that a paused instance is not
+// reconciled.
+func TestReconcileServiceInstancePaused(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, noFakeActions())
+
+	instance := getTestServiceInstance()
+	instance.Annotations = map[string]string{v1beta1.ServiceInstancePausedAnnotation: "true"}
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	assertNumberOfActions(t, fakeKubeClient.Actions(), 0)
+}
+
 // TestReconcileServiceInstanceNonExistentClusterServiceClass tests that reconcileInstance gets a failure when
 // the specified service class is not found
 func TestReconcileServiceInstanceNonExistentClusterServiceClass(t *testing.T) {
@@ -140,11 +158,11 @@ func TestReconcileServiceInstanceNonExistentClusterServiceClass(t *testing.T) {
 
 	// There should be an action that says it failed because no such class exists.
 	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
-	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, errorNonexistentClusterServiceClassReason, instance)
+	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, v1beta1.ReasonReferencesNonexistentServiceClass, instance)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorNonexistentClusterServiceClassReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesNonexistentServiceClass).msgf(
 		"References a non-existent ClusterServiceClass %c or there is more than one (found: 0)",
 		instance.Spec.PlanReference,
 	)
@@ -187,11 +205,11 @@ func TestReconcileServiceInstanceNonExistentClusterServiceClassWithK8SName(t *te
 	assertNumberOfActions(t, actions, 1)
 	// There should be an action that says it failed because no such class exists.
 	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
-	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, errorNonexistentClusterServiceClassReason, instance)
+	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, v1beta1.ReasonReferencesNonexistentServiceClass, instance)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorNonexistentClusterServiceClassReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesNonexistentServiceClass).msgf(
 		"References a non-existent ClusterServiceClass %c",
 		instance.Spec.PlanReference,
 	)
@@ -222,11 +240,11 @@ func TestReconcileServiceInstanceNonExistentClusterServiceBroker(t *testing.T) {
 
 	// There should only be one action that says it failed because no such broker exists.
 	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
-	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, errorNonexistentClusterServiceBrokerReason, instance)
+	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, v1beta1.ReasonReferencesNonexistentBroker, instance)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorNonexistentClusterServiceBrokerReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesNonexistentBroker).msgf(
 		"The instance references a non-existent broker %q",
 		"test-clusterservicebroker",
 	)
@@ -266,11 +284,11 @@ func TestReconcileServiceInstanceWithNotExistingBroker(t *testing.T) {
 
 	// There should only be one action that says it failed fetching auth credentials.
 	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
-	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, errorNonexistentClusterServiceBrokerReason, instance)
+	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, v1beta1.ReasonReferencesNonexistentBroker, instance)
 
 	// verify that one event was emitted
 	events := getRecordedEvents(testController)
-	expectedEvent := warningEventBuilder(errorNonexistentClusterServiceBrokerReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesNonexistentBroker).msgf(
 		"The instance references a non-existent broker %q",
 		"test-clusterservicebroker",
 	)
@@ -333,13 +351,13 @@ func TestReconcileServiceInstanceNonExistentClusterServicePlan(t *testing.T) {
 	assertList(t, actions[0], &v1beta1.ClusterServicePlan{}, listRestrictions)
 
 	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
-	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, errorNonexistentClusterServicePlanReason, instance)
+	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, v1beta1.ReasonReferencesNonexistentServicePlan, instance)
 
 	// check to make sure the only event sent indicated that the instance references a non-existent
 	// service plan
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorNonexistentClusterServicePlanReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesNonexistentServicePlan).msgf(
 		`References a non-existent ClusterServicePlan %b on ClusterServiceClass %s %c or there is more than one (found: 0)`,
 		instance.Spec.PlanReference, instance.Spec.ClusterServiceClassRef.Name, instance.Spec.PlanReference,
 	)
@@ -392,13 +410,13 @@ func TestReconcileServiceInstanceNonExistentClusterServicePlanK8SName(t *testing
 
 	assertNumberOfActions(t, actions, 1)
 	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
-	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, errorNonexistentClusterServicePlanReason, instance)
+	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, v1beta1.ReasonReferencesNonexistentServicePlan, instance)
 
 	// check to make sure the only event sent indicated that the instance references a non-existent
 	// service plan
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorNonexistentClusterServicePlanReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesNonexistentServicePlan).msgf(
 		"References a non-existent ClusterServicePlan %v",
 		instance.Spec.PlanReference,
 	)
@@ -658,6 +676,9 @@ func TestReconcileServiceInstanceWithParameters(t *testing.T) {
 				if err == nil {
 					t.Fatalf("Reconcile expected to fail")
 				}
+				if _, ok := err.(*nonRetryableError); !ok {
+					t.Fatalf("Reconcile expected to fail with a non-retryable error, got %T: %v", err, err)
+				}
 			} else {
 				if err != nil {
 					t.Fatalf("Reconcile not expected to fail : %v", err)
@@ -684,9 +705,9 @@ func TestReconcileServiceInstanceWithParameters(t *testing.T) {
 
 			events := getRecordedEvents(testController)
 			if tc.expectedError {
-				assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, errorWithParametersReason, instance)
+				assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, v1beta1.ReasonErrorWithParameters, instance)
 
-				expectedEvent := warningEventBuilder(errorWithParametersReason).msg("failed to prepare parameters")
+				expectedEvent := warningEventBuilder(v1beta1.ReasonErrorWithParameters).msg("failed to prepare parameters")
 				if err := checkEventPrefixes(events, expectedEvent.stringArr()); err != nil {
 					t.Fatal(err)
 				}
@@ -754,7 +775,7 @@ func TestReconcileServiceInstanceWithParameters(t *testing.T) {
 			}
 
 			events = getRecordedEvents(testController)
-			expectedEvent := normalEventBuilder(successProvisionReason).msg("The instance was provisioned successfully")
+			expectedEvent := normalEventBuilder(v1beta1.ReasonProvisionedSuccessfully).msg("The instance was provisioned successfully")
 			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 				t.Fatal(err)
 			}
@@ -913,6 +934,68 @@ func TestReconcileServiceInstanceAppliesDefaultProvisioningParams(t *testing.T)
 	}
 }
 
+// TestReconcileServiceInstanceAppliesNamespaceAnnotationParameters tests that
+// parameters configured to be taken from the annotations of the namespace
+// are applied beneath the parameters set on the instance.
+func TestReconcileServiceInstanceAppliesNamespaceAnnotationParameters(t *testing.T) {
+	err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ServicePlanDefaults))
+	if err != nil {
+		t.Fatalf("Could not disable ServicePlanDefaults feature flag.")
+	}
+
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{},
+		},
+	})
+	testController.namespaceAnnotationParameters = map[string]string{
+		"example.com/environment": "environment",
+		"example.com/team":        "team",
+	}
+
+	setTestNamespaces(testController, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testNamespace,
+			UID:  testNamespaceGUID,
+			Annotations: map[string]string{
+				"example.com/environment": "prod",
+				"example.com/team":        "payments",
+			},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"team": "platform"}`)}
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 3)
+
+	updatedServiceInstance := assertUpdate(t, actions[1], instance)
+	updateObject, ok := updatedServiceInstance.(*v1beta1.ServiceInstance)
+	if !ok {
+		t.Fatalf("couldn't convert to *v1beta1.ServiceInstance")
+	}
+	wantParams := `{"environment":"prod","team":"platform"}`
+	gotParams := string(updateObject.Spec.Parameters.Raw)
+	if gotParams != wantParams {
+		t.Fatalf("Namespace annotation parameters were not applied to the service instance during reconcile.\n\nWANT: %v\nGOT: %v",
+			wantParams, gotParams)
+	}
+
+	// The annotations are read from the namespace informer cache
+	if e, a := 0, len(fakeKubeClient.Actions()); e != a {
+		t.Fatalf("unexpected number of kube actions: %v", expectedGot(e, a))
+	}
+}
+
 func TestReconcileServiceInstanceRespectsServicePlanDefaultsFeatureGate(t *testing.T) {
 	err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ServicePlanDefaults))
 	if err != nil {
@@ -1094,11 +1177,11 @@ func TestReconcileServiceInstanceWithProvisionCallFailure(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
-	assertServiceInstanceRequestRetriableError(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision, errorErrorCallingProvisionReason, testClusterServicePlanName, testClusterServicePlanGUID, instance)
+	assertServiceInstanceRequestRetriableError(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision, v1beta1.ReasonErrorCallingProvision, testClusterServicePlanName, testClusterServicePlanGUID, instance)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorErrorCallingProvisionReason).msg(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorCallingProvision).msg(
 		"The provision call failed and will be retried:",
 	).msgf(
 		"Error communicating with broker for provisioning:",
@@ -1200,9 +1283,9 @@ func TestReconcileServiceInstanceWithTemporaryProvisionFailure(t *testing.T) {
 		t,
 		updatedServiceInstance,
 		v1beta1.ServiceInstanceOperationProvision,
-		startingInstanceOrphanMitigationReason,
+		v1beta1.ReasonStartingInstanceOrphanMitigation,
 		"",
-		errorProvisionCallFailedReason,
+		v1beta1.ReasonProvisionCallFailed,
 		instance,
 	)
 
@@ -1212,8 +1295,8 @@ func TestReconcileServiceInstanceWithTemporaryProvisionFailure(t *testing.T) {
 		"Error provisioning ServiceInstance of ClusterServiceClass (K8S: %q ExternalName: %q) at ClusterServiceBroker %q: Status: %v; ErrorMessage: %s",
 		"cscguid", "test-clusterserviceclass", "test-clusterservicebroker", 500, "InternalServerError; Description: Something went wrong!; ResponseError: <nil>",
 	)
-	expectedProvisionCallEvent := warningEventBuilder(errorProvisionCallFailedReason).msg(message)
-	expectedOrphanMitigationEvent := warningEventBuilder(startingInstanceOrphanMitigationReason).
+	expectedProvisionCallEvent := warningEventBuilder(v1beta1.ReasonProvisionCallFailed).msg(message)
+	expectedOrphanMitigationEvent := warningEventBuilder(v1beta1.ReasonStartingInstanceOrphanMitigation).
 		msg("The instance provision call failed with an ambiguous error; attempting to deprovision the instance in order to mitigate an orphaned resource")
 	expectedEvents := []string{
 		expectedProvisionCallEvent.String(),
@@ -1283,10 +1366,12 @@ func TestReconcileServiceInstanceWithTerminalProvisionFailure(t *testing.T) {
 		t,
 		updatedServiceInstance,
 		v1beta1.ServiceInstanceOperationProvision,
-		errorProvisionCallFailedReason,
+		v1beta1.ReasonProvisionCallFailed,
 		"ClusterServiceBrokerReturnedFailure",
 		instance,
 	)
+	assertServiceInstanceConditionErrorCode(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionReady, v1beta1.ErrorCodeBrokerBadRequest)
+	assertServiceInstanceConditionErrorCode(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed, v1beta1.ErrorCodeBrokerBadRequest)
 
 	events := getRecordedEvents(testController)
 
@@ -1295,7 +1380,7 @@ func TestReconcileServiceInstanceWithTerminalProvisionFailure(t *testing.T) {
 		"cscguid", "test-clusterserviceclass", "test-clusterservicebroker", 400, "BadRequest; Description: Your parameters are incorrect!; ResponseError: <nil>",
 	)
 	expectedEvents := []string{
-		warningEventBuilder(errorProvisionCallFailedReason).msg(message).String(),
+		warningEventBuilder(v1beta1.ReasonProvisionCallFailed).msg(message).String(),
 		warningEventBuilder("ClusterServiceBrokerReturnedFailure").msg(message).String(),
 	}
 
@@ -1304,6 +1389,81 @@ func TestReconcileServiceInstanceWithTerminalProvisionFailure(t *testing.T) {
 	}
 }
 
+// TestReconcileServiceInstanceProvisionFailureReasons pins the reasons and the
+// error codes of the conditions set when the provision call fails.
+func TestReconcileServiceInstanceProvisionFailureReasons(t *testing.T) {
+	cases := []struct {
+		name         string
+		err          error
+		readyReason  string
+		failedReason string
+		errorCode    string
+	}{
+		{
+			name:        "transport error",
+			err:         errors.New("connection refused"),
+			readyReason: v1beta1.ReasonErrorCallingProvision,
+			errorCode:   v1beta1.ErrorCodeBrokerUnreachable,
+		},
+		{
+			name:        "conflict",
+			err:         osb.HTTPStatusCodeError{StatusCode: http.StatusConflict},
+			readyReason: v1beta1.ReasonProvisionCallFailed,
+			errorCode:   v1beta1.ErrorCodeBrokerConflict,
+		},
+		{
+			name:        "concurrency error",
+			err:         osb.HTTPStatusCodeError{StatusCode: http.StatusUnprocessableEntity, ErrorMessage: strPtr("ConcurrencyError")},
+			readyReason: v1beta1.ReasonProvisionCallFailed,
+			errorCode:   v1beta1.ErrorCodeBrokerConcurrencyError,
+		},
+		{
+			name:         "bad request",
+			err:          osb.HTTPStatusCodeError{StatusCode: http.StatusBadRequest},
+			readyReason:  v1beta1.ReasonProvisionCallFailed,
+			failedReason: v1beta1.ReasonClusterServiceBrokerReturnedFailure,
+			errorCode:    v1beta1.ErrorCodeBrokerBadRequest,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				ProvisionReaction: &fakeosb.ProvisionReaction{
+					Error: tc.err,
+				},
+			})
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithClusterRefs()
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			instance = assertServiceInstanceProvisionInProgressAndUserSpecifiedFieldsClientActions(t, fakeCatalogClient, instance)
+			fakeCatalogClient.ClearActions()
+
+			// Temporary failures are returned to be retried.
+			reconcileServiceInstance(t, testController, instance)
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+
+			assertServiceInstanceReadyFalse(t, updatedServiceInstance, tc.readyReason)
+			assertServiceInstanceConditionErrorCode(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionReady, tc.errorCode)
+			if tc.failedReason == "" {
+				assertServiceInstanceConditionMissing(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed)
+			} else {
+				assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue, tc.failedReason)
+				assertServiceInstanceConditionErrorCode(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed, tc.errorCode)
+			}
+		})
+	}
+}
+
 // TestReconcileServiceInstance tests synchronously provisioning a new service
 func TestReconcileServiceInstance(t *testing.T) {
 	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
@@ -1372,121 +1532,135 @@ func TestReconcileServiceInstance(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successProvisionReason).msg(successProvisionMessage)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonProvisionedSuccessfully).msg(successProvisionMessage)
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
 }
 
-// TestReconcileServiceInstanceFailsWithDeletedPlan tests that a ServiceInstance is not
-// created if the ServicePlan specified is marked as RemovedFromCatalog.
-func TestReconcileServiceInstanceFailsWithDeletedPlan(t *testing.T) {
-	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+// TestReconcileServiceInstanceWarnsOnDeprecatedBroker tests that provisioning
+// an instance at a broker whose Deprecated condition is true records a
+// warning event on the instance.
+func TestReconcileServiceInstanceWarnsOnDeprecatedBroker(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{},
+		},
+	})
 
 	addGetNamespaceReaction(fakeKubeClient)
 
-	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	broker := getTestClusterServiceBroker()
+	deprecatedMessage := brokerDeprecatedMessage + `service "test-clusterserviceclass" is deprecated: Use another service instead.`
+	broker.Status.Conditions = []v1beta1.ServiceBrokerCondition{
+		{
+			Type:    v1beta1.ServiceBrokerConditionDeprecated,
+			Status:  v1beta1.ConditionTrue,
+			Reason:  v1beta1.ReasonBrokerDeprecated,
+			Message: deprecatedMessage,
+		},
+		{
+			Type:   v1beta1.ServiceBrokerConditionReady,
+			Status: v1beta1.ConditionTrue,
+		},
+	}
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(broker)
 	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
-	sp := getTestClusterServicePlan()
-	sp.Status.RemovedFromBrokerCatalog = true
-	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(sp)
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
 
 	instance := getTestServiceInstanceWithClusterRefs()
 
-	if err := reconcileServiceInstance(t, testController, instance); err == nil {
-		t.Fatalf("This should fail")
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
+	instance = assertServiceInstanceProvisionInProgressAndUserSpecifiedFieldsClientActions(t, fakeCatalogClient, instance)
+	fakeCatalogClient.ClearActions()
 
-	brokerActions := fakeClusterServiceBrokerClient.Actions()
-	assertNumberOfBrokerActions(t, brokerActions, 0)
-
-	instanceKey := testNamespace + "/" + testServiceInstanceName
-
-	// Since synchronous operation, must not make it into the polling queue.
-	if testController.instancePollingQueue.NumRequeues(instanceKey) != 0 {
-		t.Fatalf("Expected polling queue to not have any record of test instance")
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
 	}
 
-	actions := fakeCatalogClient.Actions()
-	assertNumberOfActions(t, actions, 2)
-
-	// verify no kube actions
-	kubeActions := fakeKubeClient.Actions()
-	assertNumberOfActions(t, kubeActions, 0)
-
-	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
-	assertServiceInstanceReadyFalse(t, updatedServiceInstance, errorDeletedClusterServicePlanReason)
-
 	events := getRecordedEvents(testController)
-
-	expectedEvent := warningEventBuilder(errorDeletedClusterServicePlanReason).msgf(
-		"ClusterServicePlan (K8S: %q ExternalName: %q) has been deleted; cannot provision.",
-		"cspguid", "test-clusterserviceplan",
-	)
-	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+	expectedEvents := []string{
+		warningEventBuilder(v1beta1.ReasonBrokerDeprecated).msgf("Provisioning at deprecated broker %q:", testClusterServiceBrokerName).msg(deprecatedMessage).String(),
+		normalEventBuilder(v1beta1.ReasonProvisionedSuccessfully).msg(successProvisionMessage).String(),
+	}
+	if err := checkEvents(events, expectedEvents); err != nil {
 		t.Fatal(err)
 	}
 }
 
-// TestReconcileServiceInstanceFailsWithDeletedClass tests that a ServiceInstance is not
-// created if the ServiceClass specified is marked as RemovedFromCatalog.
-func TestReconcileServiceInstanceFailsWithDeletedClass(t *testing.T) {
-	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
-
-	addGetNamespaceReaction(fakeKubeClient)
-
-	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
-	sc := getTestClusterServiceClass()
-	sc.Status.RemovedFromBrokerCatalog = true
-	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(sc)
-	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
-
-	instance := getTestServiceInstanceWithClusterRefs()
-
-	if err := reconcileServiceInstance(t, testController, instance); err == nil {
-		t.Fatalf("This should have failed")
+// TestReconcileServiceInstanceResolvedExternalNames tests that reconciling an
+// instance records the external names of its class and plan in its status,
+// for both cluster and namespaced references.
+func TestReconcileServiceInstanceResolvedExternalNames(t *testing.T) {
+	err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.NamespacedServiceBroker))
+	if err != nil {
+		t.Fatalf("Could not enable NamespacedServiceBroker feature flag.")
 	}
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.NamespacedServiceBroker))
 
-	brokerActions := fakeClusterServiceBrokerClient.Actions()
-	assertNumberOfBrokerActions(t, brokerActions, 0)
-
-	instanceKey := testNamespace + "/" + testServiceInstanceName
-
-	// Since synchronous operation, must not make it into the polling queue.
-	if testController.instancePollingQueue.NumRequeues(instanceKey) != 0 {
-		t.Fatalf("Expected polling queue to not have any record of test instance")
+	cases := []struct {
+		name              string
+		instance          *v1beta1.ServiceInstance
+		expectedClassName string
+		expectedPlanName  string
+	}{
+		{
+			name:              "cluster refs",
+			instance:          getTestServiceInstanceWithClusterRefs(),
+			expectedClassName: testClusterServiceClassName,
+			expectedPlanName:  testClusterServicePlanName,
+		},
+		{
+			name:              "namespaced refs",
+			instance:          getTestServiceInstanceWithNamespacedRefs(),
+			expectedClassName: testServiceClassName,
+			expectedPlanName:  testServicePlanName,
+		},
 	}
 
-	actions := fakeCatalogClient.Actions()
-	assertNumberOfActions(t, actions, 2)
-
-	// verify no kube actions
-	kubeActions := fakeKubeClient.Actions()
-	assertNumberOfActions(t, kubeActions, 0)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeKubeClient, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+			addGetNamespaceReaction(fakeKubeClient)
 
-	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
-	assertServiceInstanceReadyFalse(t, updatedServiceInstance, errorDeletedClusterServiceClassReason)
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+			sharedInformers.ServiceBrokers().Informer().GetStore().Add(getTestServiceBroker())
+			sharedInformers.ServiceClasses().Informer().GetStore().Add(getTestServiceClass())
+			sharedInformers.ServicePlans().Informer().GetStore().Add(getTestServicePlan())
 
-	events := getRecordedEvents(testController)
+			if err := reconcileServiceInstance(t, testController, tc.instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
 
-	expectedEvent := warningEventBuilder(errorDeletedClusterServiceClassReason).msgf(
-		"ClusterServiceClass (K8S: %q ExternalName: %q) has been deleted; cannot provision.",
-		"cscguid", "test-clusterserviceclass",
-	)
-	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
-		t.Fatal(err)
+			actions := fakeCatalogClient.Actions()
+			if len(actions) == 0 {
+				t.Fatal("expected the status of the instance to be updated")
+			}
+			updatedInstance := assertUpdateStatus(t, actions[len(actions)-1], tc.instance).(*v1beta1.ServiceInstance)
+			if e, a := tc.expectedClassName, updatedInstance.Status.ResolvedClassExternalName; e != a {
+				t.Fatalf("unexpected resolved class external name: expected %q, got %q", e, a)
+			}
+			if e, a := tc.expectedPlanName, updatedInstance.Status.ResolvedPlanExternalName; e != a {
+				t.Fatalf("unexpected resolved plan external name: expected %q, got %q", e, a)
+			}
+		})
 	}
 }
 
-// TestReconcileServiceInstance tests synchronously provisioning a new service
-func TestReconcileServiceInstanceSuccessWithK8SNames(t *testing.T) {
+// TestReconcileServiceInstanceBrokerContextNamespacePrefix tests that the
+// namespace sent to the broker in the provision request context carries the
+// controller's broker context namespace prefix.
+func TestReconcileServiceInstanceBrokerContextNamespacePrefix(t *testing.T) {
 	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
 		ProvisionReaction: &fakeosb.ProvisionReaction{
-			Response: &osb.ProvisionResponse{
-				DashboardURL: &testDashboardURL,
-			},
+			Response: &osb.ProvisionResponse{},
 		},
 	})
+	testController.brokerContextNamespacePrefix = "cluster-a-"
 
 	addGetNamespaceReaction(fakeKubeClient)
 
@@ -1494,38 +1668,12 @@ func TestReconcileServiceInstanceSuccessWithK8SNames(t *testing.T) {
 	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
 	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
 
-	instance := getTestServiceInstanceK8SNames()
+	instance := getTestServiceInstanceWithClusterRefs()
 
 	if err := reconcileServiceInstance(t, testController, instance); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-
-	actions := fakeCatalogClient.Actions()
-	assertNumberOfActions(t, actions, 1)
-	updatedServiceInstance := assertUpdate(t, actions[0], instance)
-	updateObject, ok := updatedServiceInstance.(*v1beta1.ServiceInstance)
-	if !ok {
-		t.Fatalf("couldn't convert to *v1beta1.ServiceInstance")
-	}
-	if updateObject.Spec.ClusterServiceClassRef == nil || updateObject.Spec.ClusterServiceClassRef.Name != "cscguid" {
-		t.Fatalf("ClusterServiceClassRef was not resolved correctly during reconcile")
-	}
-	if updateObject.Spec.ClusterServicePlanRef == nil || updateObject.Spec.ClusterServicePlanRef.Name != "cspguid" {
-		t.Fatalf("ClusterServicePlanRef was not resolved correctly during reconcile")
-	}
-
-	instance = updateObject
-
-	fakeCatalogClient.ClearActions()
-	fakeKubeClient.ClearActions()
-
-	if err := reconcileServiceInstance(t, testController, instance); err != nil {
-		t.Fatalf("This should not fail : %v", err)
-	}
-
 	instance = assertServiceInstanceProvisionInProgressAndUserSpecifiedFieldsClientActions(t, fakeCatalogClient, instance)
-	fakeCatalogClient.ClearActions()
-	fakeKubeClient.ClearActions()
 
 	if err := reconcileServiceInstance(t, testController, instance); err != nil {
 		t.Fatalf("This should not fail : %v", err)
@@ -1540,18 +1688,511 @@ func TestReconcileServiceInstanceSuccessWithK8SNames(t *testing.T) {
 		PlanID:            testClusterServicePlanGUID,
 		OrganizationGUID:  testClusterID,
 		SpaceGUID:         testNamespaceGUID,
-		Context:           testContext})
+		Context: map[string]interface{}{
+			"platform":           ContextProfilePlatformKubernetes,
+			"namespace":          "cluster-a-" + testNamespace,
+			clusterIdentifierKey: testClusterID,
+		},
+	})
+}
 
-	instanceKey := testNamespace + "/" + testServiceInstanceName
+// TestReconcileServiceInstanceBrokerContextPlatform tests that the platform
+// sent to the broker in the provision request context is the controller's
+// broker context platform.
+func TestReconcileServiceInstanceBrokerContextPlatform(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{},
+		},
+	})
+	testController.brokerContextPlatform = "example-platform"
 
-	// Since synchronous operation, must not make it into the polling queue.
-	if testController.instancePollingQueue.NumRequeues(instanceKey) != 0 {
-		t.Fatalf("Expected polling queue to not have any record of test instance")
-	}
+	addGetNamespaceReaction(fakeKubeClient)
 
-	// verify no kube resources created.
-	// One single action comes from getting namespace uid
-	kubeActions := fakeKubeClient.Actions()
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	instance = assertServiceInstanceProvisionInProgressAndUserSpecifiedFieldsClientActions(t, fakeCatalogClient, instance)
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertProvision(t, brokerActions[0], &osb.ProvisionRequest{
+		AcceptsIncomplete: true,
+		InstanceID:        testServiceInstanceGUID,
+		ServiceID:         testClusterServiceClassGUID,
+		PlanID:            testClusterServicePlanGUID,
+		OrganizationGUID:  testClusterID,
+		SpaceGUID:         testNamespaceGUID,
+		Context: map[string]interface{}{
+			"platform":           "example-platform",
+			"namespace":          testNamespace,
+			clusterIdentifierKey: testClusterID,
+		},
+	})
+}
+
+// TestReconcileServiceInstanceBrokerContextAnnotations tests that the
+// configured annotations of an instance are copied into the provision request
+// context, and that other annotations are not.
+func TestReconcileServiceInstanceBrokerContextAnnotations(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{},
+		},
+	})
+	testController.brokerContextAnnotations = []string{"example.com/cost-center", "example.com/team"}
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Annotations = map[string]string{
+		"example.com/cost-center": "cc-42",
+		"example.com/other":       "not copied",
+	}
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	instance = assertServiceInstanceProvisionInProgressAndUserSpecifiedFieldsClientActions(t, fakeCatalogClient, instance)
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertProvision(t, brokerActions[0], &osb.ProvisionRequest{
+		AcceptsIncomplete: true,
+		InstanceID:        testServiceInstanceGUID,
+		ServiceID:         testClusterServiceClassGUID,
+		PlanID:            testClusterServicePlanGUID,
+		OrganizationGUID:  testClusterID,
+		SpaceGUID:         testNamespaceGUID,
+		Context: map[string]interface{}{
+			"platform":                ContextProfilePlatformKubernetes,
+			"namespace":               testNamespace,
+			clusterIdentifierKey:      testClusterID,
+			"example.com/cost-center": "cc-42",
+		},
+	})
+}
+
+func TestParseBrokerContextAnnotations(t *testing.T) {
+	cases := []struct {
+		name          string
+		value         string
+		expected      []string
+		shouldSucceed bool
+	}{
+		{
+			name:          "empty",
+			value:         "",
+			expected:      nil,
+			shouldSucceed: true,
+		},
+		{
+			name:          "multiple annotations",
+			value:         "example.com/cost-center, team",
+			expected:      []string{"example.com/cost-center", "team"},
+			shouldSucceed: true,
+		},
+		{
+			name:          "invalid annotation",
+			value:         "not a valid/annotation/key",
+			shouldSucceed: false,
+		},
+		{
+			name:          "reserved context key",
+			value:         "team,namespace",
+			shouldSucceed: false,
+		},
+		{
+			name:          "duplicate annotation",
+			value:         "team,team",
+			shouldSucceed: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			annotations, err := ParseBrokerContextAnnotations(tc.value)
+			if tc.shouldSucceed {
+				if err != nil {
+					t.Fatalf("Failed to parse %q: %v", tc.value, err)
+				}
+				if !reflect.DeepEqual(annotations, tc.expected) {
+					t.Errorf("Unexpected annotations; %s", expectedGot(tc.expected, annotations))
+				}
+			} else if err == nil {
+				t.Errorf("Expected parsing %q to fail", tc.value)
+			}
+		})
+	}
+}
+
+// TestReconcileServiceInstanceInvalidDashboardURL tests that a malformed
+// dashboard URL returned by the broker on provision is not stored in the
+// instance status.
+func TestReconcileServiceInstanceInvalidDashboardURL(t *testing.T) {
+	cases := []struct {
+		name         string
+		dashboardURL string
+		reason       string
+	}{
+		{
+			name:         "unsupported scheme",
+			dashboardURL: "ftp://dashboard",
+			reason:       `dashboardURL: Invalid value: "ftp://dashboard": must be an absolute http or https URL`,
+		},
+		{
+			name:         "relative URL",
+			dashboardURL: "dashboard/path",
+			reason:       `dashboardURL: Invalid value: "dashboard/path": must be an absolute http or https URL`,
+		},
+		{
+			name:         "missing host",
+			dashboardURL: "http:///path",
+			reason:       `dashboardURL: Invalid value: "http:///path": must be an absolute http or https URL`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dashboardURL := tc.dashboardURL
+			fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				ProvisionReaction: &fakeosb.ProvisionReaction{
+					Response: &osb.ProvisionResponse{
+						DashboardURL: &dashboardURL,
+					},
+				},
+			})
+
+			addGetNamespaceReaction(fakeKubeClient)
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := getTestServiceInstanceWithClusterRefs()
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			instance = assertServiceInstanceProvisionInProgressAndUserSpecifiedFieldsClientActions(t, fakeCatalogClient, instance)
+			fakeCatalogClient.ClearActions()
+			fakeKubeClient.ClearActions()
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("This should not fail : %v", err)
+			}
+
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+
+			updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+			assertServiceInstanceOperationSuccess(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision, testClusterServicePlanName, testClusterServicePlanGUID, instance)
+			if url := updatedServiceInstance.(*v1beta1.ServiceInstance).Status.DashboardURL; url != nil {
+				t.Fatalf("Expected DashboardURL to be nil, got %q", *url)
+			}
+
+			events := getRecordedEvents(testController)
+
+			expectedEvents := []string{
+				warningEventBuilder(v1beta1.ReasonInvalidDashboardURL).msgf("Ignoring invalid dashboard URL %q returned by the broker: %v", tc.dashboardURL, tc.reason).String(),
+				normalEventBuilder(v1beta1.ReasonProvisionedSuccessfully).msg(successProvisionMessage).String(),
+			}
+			if err := checkEvents(events, expectedEvents); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// TestReconcileServiceInstanceExtensionAPIs tests that the valid extension APIs
+// of a provision response are recorded on the instance without their
+// credentials, and that invalid ones are left out.
+func TestReconcileServiceInstanceExtensionAPIs(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{
+				ExtensionAPIs: []osb.ExtensionAPI{
+					{
+						DiscoveryURL: "https://broker.example.com/backup/openapi.json",
+						ServerURL:    "https://backup.example.com",
+						Credentials:  map[string]interface{}{"token": "secret"},
+						AdheresTo:    "http://example.com/specs/backup",
+					},
+					{
+						DiscoveryURL: "backup/openapi.json",
+					},
+				},
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	instance = assertServiceInstanceProvisionInProgressAndUserSpecifiedFieldsClientActions(t, fakeCatalogClient, instance)
+	fakeCatalogClient.ClearActions()
+	fakeKubeClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceOperationSuccess(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision, testClusterServicePlanName, testClusterServicePlanGUID, instance)
+
+	expectedExtensionAPIs := []v1beta1.ExtensionAPI{{
+		DiscoveryURL: "https://broker.example.com/backup/openapi.json",
+		ServerURL:    "https://backup.example.com",
+		AdheresTo:    "http://example.com/specs/backup",
+	}}
+	if e, a := expectedExtensionAPIs, updatedServiceInstance.(*v1beta1.ServiceInstance).Status.ExtensionAPIs; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected extension APIs: %v", expectedGot(e, a))
+	}
+
+	events := getRecordedEvents(testController)
+
+	expectedEvents := []string{
+		warningEventBuilder(v1beta1.ReasonInvalidExtensionAPI).msgf("Ignoring invalid extension API %q returned by the broker: extensionAPI.discoveryURL: Invalid value: %q: must be an absolute http or https URL", "backup/openapi.json", "backup/openapi.json").String(),
+		normalEventBuilder(v1beta1.ReasonProvisionedSuccessfully).msg(successProvisionMessage).String(),
+	}
+	if err := checkEvents(events, expectedEvents); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestValidateDashboardURL tests that only absolute http and https URLs are
+// accepted as dashboard URLs.
+func TestValidateDashboardURL(t *testing.T) {
+	cases := []struct {
+		dashboardURL string
+		valid        bool
+	}{
+		{dashboardURL: "http://dashboard", valid: true},
+		{dashboardURL: "https://dashboard.example.com:8443/instances/1?x=y", valid: true},
+		{dashboardURL: "ftp://dashboard", valid: false},
+		{dashboardURL: "dashboard", valid: false},
+		{dashboardURL: "http://", valid: false},
+		{dashboardURL: "http://dash board/%zz", valid: false},
+	}
+
+	for _, tc := range cases {
+		err := validateHTTPURL(tc.dashboardURL)
+		if tc.valid && err != nil {
+			t.Errorf("%q: unexpected error: %v", tc.dashboardURL, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("%q: expected error, got none", tc.dashboardURL)
+		}
+	}
+}
+
+// TestReconcileServiceInstanceFailsWithDeletedPlan tests that a ServiceInstance is not
+// created if the ServicePlan specified is marked as RemovedFromCatalog.
+func TestReconcileServiceInstanceFailsWithDeletedPlan(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sp := getTestClusterServicePlan()
+	sp.Status.RemovedFromBrokerCatalog = true
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(sp)
+
+	instance := getTestServiceInstanceWithClusterRefs()
+
+	if err := reconcileServiceInstance(t, testController, instance); err == nil {
+		t.Fatalf("This should fail")
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 0)
+
+	instanceKey := testNamespace + "/" + testServiceInstanceName
+
+	// Since synchronous operation, must not make it into the polling queue.
+	if testController.instancePollingQueue.NumRequeues(instanceKey) != 0 {
+		t.Fatalf("Expected polling queue to not have any record of test instance")
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+
+	// verify no kube actions
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 0)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, v1beta1.ReasonReferencesDeletedServicePlan)
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesDeletedServicePlan).msgf(
+		"ClusterServicePlan (K8S: %q ExternalName: %q) has been deleted; cannot provision.",
+		"cspguid", "test-clusterserviceplan",
+	)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceFailsWithDeletedClass tests that a ServiceInstance is not
+// created if the ServiceClass specified is marked as RemovedFromCatalog.
+func TestReconcileServiceInstanceFailsWithDeletedClass(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sc := getTestClusterServiceClass()
+	sc.Status.RemovedFromBrokerCatalog = true
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(sc)
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+
+	if err := reconcileServiceInstance(t, testController, instance); err == nil {
+		t.Fatalf("This should have failed")
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 0)
+
+	instanceKey := testNamespace + "/" + testServiceInstanceName
+
+	// Since synchronous operation, must not make it into the polling queue.
+	if testController.instancePollingQueue.NumRequeues(instanceKey) != 0 {
+		t.Fatalf("Expected polling queue to not have any record of test instance")
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+
+	// verify no kube actions
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 0)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
+	assertServiceInstanceReadyFalse(t, updatedServiceInstance, v1beta1.ReasonReferencesDeletedServiceClass)
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesDeletedServiceClass).msgf(
+		"ClusterServiceClass (K8S: %q ExternalName: %q) has been deleted; cannot provision.",
+		"cscguid", "test-clusterserviceclass",
+	)
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstance tests synchronously provisioning a new service
+func TestReconcileServiceInstanceSuccessWithK8SNames(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{
+				DashboardURL: &testDashboardURL,
+			},
+		},
+	})
+
+	addGetNamespaceReaction(fakeKubeClient)
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceK8SNames()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdate(t, actions[0], instance)
+	updateObject, ok := updatedServiceInstance.(*v1beta1.ServiceInstance)
+	if !ok {
+		t.Fatalf("couldn't convert to *v1beta1.ServiceInstance")
+	}
+	if updateObject.Spec.ClusterServiceClassRef == nil || updateObject.Spec.ClusterServiceClassRef.Name != "cscguid" {
+		t.Fatalf("ClusterServiceClassRef was not resolved correctly during reconcile")
+	}
+	if updateObject.Spec.ClusterServicePlanRef == nil || updateObject.Spec.ClusterServicePlanRef.Name != "cspguid" {
+		t.Fatalf("ClusterServicePlanRef was not resolved correctly during reconcile")
+	}
+
+	instance = updateObject
+
+	fakeCatalogClient.ClearActions()
+	fakeKubeClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	instance = assertServiceInstanceProvisionInProgressAndUserSpecifiedFieldsClientActions(t, fakeCatalogClient, instance)
+	fakeCatalogClient.ClearActions()
+	fakeKubeClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertProvision(t, brokerActions[0], &osb.ProvisionRequest{
+		AcceptsIncomplete: true,
+		InstanceID:        testServiceInstanceGUID,
+		ServiceID:         testClusterServiceClassGUID,
+		PlanID:            testClusterServicePlanGUID,
+		OrganizationGUID:  testClusterID,
+		SpaceGUID:         testNamespaceGUID,
+		Context:           testContext})
+
+	instanceKey := testNamespace + "/" + testServiceInstanceName
+
+	// Since synchronous operation, must not make it into the polling queue.
+	if testController.instancePollingQueue.NumRequeues(instanceKey) != 0 {
+		t.Fatalf("Expected polling queue to not have any record of test instance")
+	}
+
+	// verify no kube resources created.
+	// One single action comes from getting namespace uid
+	kubeActions := fakeKubeClient.Actions()
 	if err := checkKubeClientActions(kubeActions, []kubeClientAction{
 		{verb: "get", resourceName: "namespaces", checkType: checkGetActionType},
 	}); err != nil {
@@ -1569,7 +2210,7 @@ func TestReconcileServiceInstanceSuccessWithK8SNames(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successProvisionReason).msg(successProvisionMessage)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonProvisionedSuccessfully).msg(successProvisionMessage)
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -1751,11 +2392,11 @@ func TestReconcileServiceInstanceNamespaceError(t *testing.T) {
 	assertNumberOfActions(t, actions, 2)
 
 	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
-	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, errorFindingNamespaceServiceInstanceReason, instance)
+	assertServiceInstanceErrorBeforeRequest(t, updatedServiceInstance, v1beta1.ReasonErrorFindingNamespaceForInstance, instance)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorFindingNamespaceServiceInstanceReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorFindingNamespaceForInstance).msgf(
 		"Failed to get namespace %q:",
 		"test-ns",
 	).msg("No namespace")
@@ -1833,7 +2474,7 @@ func TestReconcileServiceInstanceDelete(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -1870,6 +2511,7 @@ func TestReconcileServiceInstanceDeleteBlockedByCredentials(t *testing.T) {
 		ClusterServicePlanExternalID:   testClusterServicePlanGUID,
 	}
 	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+	instance.Status.BindingCount = 1
 
 	fakeCatalogClient.AddReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
 		return true, instance, nil
@@ -1894,11 +2536,11 @@ func TestReconcileServiceInstanceDeleteBlockedByCredentials(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updateObject := assertUpdateStatus(t, actions[0], instance)
-	assertServiceInstanceErrorBeforeRequest(t, updateObject, errorDeprovisionBlockedByCredentialsReason, instance)
+	assertServiceInstanceErrorBeforeRequest(t, updateObject, v1beta1.ReasonDeprovisionBlockedByExistingCredentials, instance)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorDeprovisionBlockedByCredentialsReason).msg(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonDeprovisionBlockedByExistingCredentials).msg(
 		"All associated ServiceBindings must be removed before this ServiceInstance can be deleted",
 	)
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
@@ -1910,10 +2552,22 @@ func TestReconcileServiceInstanceDeleteBlockedByCredentials(t *testing.T) {
 	fakeCatalogClient.ClearActions()
 	fakeKubeClient.ClearActions()
 
-	// credentials were removed, verify the next reconcilation removes
-	// the instance
+	// credentials were removed, verify the next reconcilation records that
+	// the instance has no bindings left, and the one after removes the
+	// instance
 
 	instance = updateObject.(*v1beta1.ServiceInstance)
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	instance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	if e, a := int64(0), instance.Status.BindingCount; e != a {
+		t.Fatalf("unexpected binding count; %s", expectedGot(e, a))
+	}
+	fakeCatalogClient.ClearActions()
+
 	if err := reconcileServiceInstance(t, testController, instance); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -1951,7 +2605,7 @@ func TestReconcileServiceInstanceDeleteBlockedByCredentials(t *testing.T) {
 
 	events = getRecordedEvents(testController)
 
-	expectedEvent = normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+	expectedEvent = normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -2036,7 +2690,7 @@ func TestReconcileServiceInstanceDeleteAsynchronous(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(asyncDeprovisioningReason).msg("The instance is being deprovisioned asynchronously")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisioning).msg("The instance is being deprovisioned asynchronously")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -2131,7 +2785,7 @@ func TestReconcileServiceInstanceDeleteFailedProvisionWithRequest(t *testing.T)
 
 			events := getRecordedEvents(testController)
 
-			expectedEvent := normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+			expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 				t.Fatal(err)
 			}
@@ -2354,7 +3008,7 @@ func TestReconcileServiceInstanceDeleteFailedUpdate(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -2390,95 +3044,448 @@ func TestReconcileServiceInstanceDeleteDoesNotInvokeClusterServiceBroker(t *test
 	// without that fake client will return empty ServiceInstances struct
 	fakeCatalogClient.AddReactor(updateObjectReactor("serviceinstances"))
 
-	if err := reconcileServiceInstance(t, testController, instance); err != nil {
-		t.Fatalf("This should not fail : %v", err)
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 0)
+
+	// Verify no core kube actions occurred
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 0)
+
+	actions := fakeCatalogClient.Actions()
+	// The actions should be:
+	// 1. Updating status
+	// 2. Removing the finalizer
+	assertNumberOfActions(t, actions, 2)
+
+	assertUpdateStatus(t, actions[0], instance)
+	updatedServiceInstance := assertUpdate(t, actions[1], instance)
+	assertEmptyFinalizers(t, updatedServiceInstance)
+
+	events := getRecordedEvents(testController)
+	assertNumEvents(t, events, 0)
+}
+
+// TestFinalizerClearedWhen409ConflictEncounteredOnStatusUpdate verifies that the finalizer
+// is removed even when the status update gets back a 409 Conflict from the API server
+// because the controller is working with an old version of the ServiceInstance
+func TestFinalizerClearedWhen409ConflictEncounteredOnStatusUpdate(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.ResourceVersion = "1"
+	instance.ObjectMeta.DeletionTimestamp = &metav1.Time{}
+	instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	instance.Generation = 1
+	instance.Status.ReconciledGeneration = 0
+	instance.Status.ObservedGeneration = 0
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusNotProvisioned
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusNotRequired
+
+	newerInstance := instance.DeepCopy()
+	newerInstance.ResourceVersion = "2"
+
+	fakeCatalogClient.AddReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, newerInstance, nil
+	})
+	fakeCatalogClient.AddReactor("update", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		updateAction := action.(clientgotesting.UpdateAction)
+		object := updateAction.GetObject()
+		instance := object.(*v1beta1.ServiceInstance)
+
+		if instance.ResourceVersion == "1" {
+			return true, nil, apierrors.NewConflict(action.GetResource().GroupResource(), instance.Name, errors.New("object has changed"))
+		}
+		return true, object, nil
+	})
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 0)
+
+	// Verify no core kube actions occurred
+	kubeActions := fakeKubeClient.Actions()
+	assertNumberOfActions(t, kubeActions, 0)
+
+	actions := fakeCatalogClient.Actions()
+	// The actions should be:
+	// 0. Update the instance status; the server responds with 409 Conflict
+	// 1. Fetch the fresh instance
+	// 2. Update instance status with the fresh instance.
+	// 3. Remove the finalizer on the fresh instance.
+	assertNumberOfActions(t, actions, 4)
+
+	assertUpdateStatus(t, actions[0], instance)
+	assertGet(t, actions[1], instance)
+	assertUpdateStatus(t, actions[2], instance)
+	updatedServiceInstance := assertUpdate(t, actions[3], instance)
+	assertEmptyFinalizers(t, updatedServiceInstance)
+
+	events := getRecordedEvents(testController)
+	assertNumEvents(t, events, 0)
+}
+
+// getTestServiceInstanceWithTerminalProvisionFailure returns an instance which
+// terminally failed to provision failedFor ago and has no resource on the
+// broker side.
+func getTestServiceInstanceWithTerminalProvisionFailure(failedFor time.Duration, ttlSeconds int64) *v1beta1.ServiceInstance {
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Generation = 1
+	instance.Spec.TTLSecondsAfterFailure = &ttlSeconds
+	instance.Status = v1beta1.ServiceInstanceStatus{
+		ObservedGeneration: 1,
+		ProvisionStatus:    v1beta1.ServiceInstanceProvisionStatusNotProvisioned,
+		DeprovisionStatus:  v1beta1.ServiceInstanceDeprovisionStatusNotRequired,
+		Conditions: []v1beta1.ServiceInstanceCondition{
+			{
+				Type:               v1beta1.ServiceInstanceConditionReady,
+				Status:             v1beta1.ConditionFalse,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-failedFor)),
+			},
+			{
+				Type:               v1beta1.ServiceInstanceConditionFailed,
+				Status:             v1beta1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-failedFor)),
+			},
+		},
+	}
+	return instance
+}
+
+// TestReconcileServiceInstanceWithExpiredTTLAfterFailure tests that an
+// instance which has been terminally failed for longer than its
+// TTLSecondsAfterFailure is deleted.
+func TestReconcileServiceInstanceWithExpiredTTLAfterFailure(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, noFakeActions())
+
+	instance := getTestServiceInstanceWithTerminalProvisionFailure(2*time.Hour, 3600)
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	assertDelete(t, actions[0], instance)
+	deleteAction := actions[0].(clientgotesting.DeleteAction)
+	if e, a := testServiceInstanceName, deleteAction.GetName(); e != a {
+		t.Fatalf("Unexpected name of deleted instance; %s", expectedGot(e, a))
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonTTLAfterFailureExpired)
+	if err := checkEventPrefixes(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceWithUnexpiredTTLAfterFailure tests that a failed
+// instance is not deleted before its TTLSecondsAfterFailure expires, and that
+// instances which may have a resource on the broker side are never deleted.
+func TestReconcileServiceInstanceWithUnexpiredTTLAfterFailure(t *testing.T) {
+	cases := []struct {
+		name     string
+		instance func() *v1beta1.ServiceInstance
+	}{
+		{
+			name: "ttl not expired",
+			instance: func() *v1beta1.ServiceInstance {
+				return getTestServiceInstanceWithTerminalProvisionFailure(time.Minute, 3600)
+			},
+		},
+		{
+			name: "deprovision required",
+			instance: func() *v1beta1.ServiceInstance {
+				instance := getTestServiceInstanceWithTerminalProvisionFailure(2*time.Hour, 3600)
+				instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+				return instance
+			},
+		},
+		{
+			name: "no ttl",
+			instance: func() *v1beta1.ServiceInstance {
+				instance := getTestServiceInstanceWithTerminalProvisionFailure(2*time.Hour, 3600)
+				instance.Spec.TTLSecondsAfterFailure = nil
+				return instance
+			},
+		},
+		{
+			name: "no failure time",
+			instance: func() *v1beta1.ServiceInstance {
+				instance := getTestServiceInstanceWithTerminalProvisionFailure(2*time.Hour, 3600)
+				for i := range instance.Status.Conditions {
+					instance.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+				}
+				return instance
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, noFakeActions())
+
+			if err := reconcileServiceInstance(t, testController, tc.instance()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+			assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+		})
+	}
+}
+
+// getTestServiceInstanceReadyFor returns a provisioned instance which became
+// ready readyFor ago.
+func getTestServiceInstanceReadyFor(readyFor time.Duration, ttlSeconds int64) *v1beta1.ServiceInstance {
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Generation = 1
+	instance.Spec.TTLSecondsAfterReady = &ttlSeconds
+	instance.Status = v1beta1.ServiceInstanceStatus{
+		ObservedGeneration: 1,
+		ProvisionStatus:    v1beta1.ServiceInstanceProvisionStatusProvisioned,
+		DeprovisionStatus:  v1beta1.ServiceInstanceDeprovisionStatusRequired,
+		Conditions: []v1beta1.ServiceInstanceCondition{
+			{
+				Type:               v1beta1.ServiceInstanceConditionReady,
+				Status:             v1beta1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-readyFor)),
+			},
+		},
+	}
+	return instance
+}
+
+// TestReconcileServiceInstanceWithExpiredTTLAfterReady tests that an instance
+// which has been ready for longer than its TTLSecondsAfterReady is deleted
+// along with its bindings.
+func TestReconcileServiceInstanceWithExpiredTTLAfterReady(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	binding := getTestServiceBinding()
+	otherBinding := getTestServiceBinding()
+	otherBinding.Name = "other-binding"
+	otherBinding.Spec.InstanceRef.Name = "other-instance"
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(otherBinding)
+
+	instance := getTestServiceInstanceReadyFor(2*time.Hour, 3600)
+	instance.Status.BindingCount = 1
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+	assertDelete(t, actions[0], binding)
+	if e, a := testServiceBindingName, actions[0].(clientgotesting.DeleteAction).GetName(); e != a {
+		t.Fatalf("Unexpected name of deleted binding; %s", expectedGot(e, a))
+	}
+	assertDelete(t, actions[1], instance)
+	if e, a := testServiceInstanceName, actions[1].(clientgotesting.DeleteAction).GetName(); e != a {
+		t.Fatalf("Unexpected name of deleted instance; %s", expectedGot(e, a))
+	}
+
+	events := getRecordedEvents(testController)
+	expectedEvent := normalEventBuilder(v1beta1.ReasonTTLAfterReadyExpired)
+	if err := checkEventPrefixes(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceWithUnexpiredTTLAfterReady tests that a ready
+// instance is not deleted before its TTLSecondsAfterReady expires.
+func TestReconcileServiceInstanceWithUnexpiredTTLAfterReady(t *testing.T) {
+	cases := []struct {
+		name     string
+		instance func() *v1beta1.ServiceInstance
+	}{
+		{
+			name: "ttl not expired",
+			instance: func() *v1beta1.ServiceInstance {
+				return getTestServiceInstanceReadyFor(time.Minute, 3600)
+			},
+		},
+		{
+			name: "no ttl",
+			instance: func() *v1beta1.ServiceInstance {
+				instance := getTestServiceInstanceReadyFor(2*time.Hour, 3600)
+				instance.Spec.TTLSecondsAfterReady = nil
+				return instance
+			},
+		},
+		{
+			name: "no ready time",
+			instance: func() *v1beta1.ServiceInstance {
+				instance := getTestServiceInstanceReadyFor(2*time.Hour, 3600)
+				for i := range instance.Status.Conditions {
+					instance.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+				}
+				return instance
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, noFakeActions())
+
+			if err := reconcileServiceInstance(t, testController, tc.instance()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+			assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+		})
 	}
+}
 
-	brokerActions := fakeClusterServiceBrokerClient.Actions()
-	assertNumberOfBrokerActions(t, brokerActions, 0)
+// TestServiceInstanceUpdateOnlyOnChange tests that the resyncs of a steady
+// state instance are not enqueued, and so not sent to the broker, when the
+// controller only reconciles instances on change.
+func TestServiceInstanceUpdateOnlyOnChange(t *testing.T) {
+	steadyInstance := func() *v1beta1.ServiceInstance {
+		instance := getTestServiceInstanceWithClusterRefs()
+		instance.ResourceVersion = "1"
+		instance.Generation = 1
+		instance.Status.ObservedGeneration = 1
+		instance.Status.ReconciledGeneration = 1
+		instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+		instance.Status.Conditions = []v1beta1.ServiceInstanceCondition{{
+			Type:   v1beta1.ServiceInstanceConditionReady,
+			Status: v1beta1.ConditionTrue,
+		}}
+		return instance
+	}
 
-	// Verify no core kube actions occurred
-	kubeActions := fakeKubeClient.Actions()
-	assertNumberOfActions(t, kubeActions, 0)
+	cases := []struct {
+		name           string
+		onlyOnChange   bool
+		update         func(instance *v1beta1.ServiceInstance)
+		expectEnqueued bool
+	}{
+		{
+			name:           "resync enqueued by default",
+			onlyOnChange:   false,
+			update:         func(instance *v1beta1.ServiceInstance) {},
+			expectEnqueued: true,
+		},
+		{
+			name:           "resync skipped",
+			onlyOnChange:   true,
+			update:         func(instance *v1beta1.ServiceInstance) {},
+			expectEnqueued: false,
+		},
+		{
+			name:         "change enqueued",
+			onlyOnChange: true,
+			update: func(instance *v1beta1.ServiceInstance) {
+				instance.ResourceVersion = "2"
+				instance.Generation = 2
+			},
+			expectEnqueued: true,
+		},
+		{
+			name:         "resync of unobserved spec enqueued",
+			onlyOnChange: true,
+			update: func(instance *v1beta1.ServiceInstance) {
+				instance.Generation = 2
+			},
+			expectEnqueued: true,
+		},
+		{
+			name:         "resync with operation in progress enqueued",
+			onlyOnChange: true,
+			update: func(instance *v1beta1.ServiceInstance) {
+				instance.Status.CurrentOperation = v1beta1.ServiceInstanceOperationUpdate
+			},
+			expectEnqueued: true,
+		},
+	}
 
-	actions := fakeCatalogClient.Actions()
-	// The actions should be:
-	// 1. Updating status
-	// 2. Removing the finalizer
-	assertNumberOfActions(t, actions, 2)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, _ := newTestController(t, noFakeActions())
+			testController.reconcileInstancesOnlyOnChange = tc.onlyOnChange
 
-	assertUpdateStatus(t, actions[0], instance)
-	updatedServiceInstance := assertUpdate(t, actions[1], instance)
-	assertEmptyFinalizers(t, updatedServiceInstance)
+			oldInstance := steadyInstance()
+			instance := oldInstance.DeepCopy()
+			tc.update(instance)
+			testController.instanceUpdate(oldInstance, instance)
 
-	events := getRecordedEvents(testController)
-	assertNumEvents(t, events, 0)
+			expectedLen := 0
+			if tc.expectEnqueued {
+				expectedLen = 1
+			}
+			if e, a := expectedLen, testController.instanceQueue.Len(); e != a {
+				t.Fatalf("unexpected number of enqueued instances; %s", expectedGot(e, a))
+			}
+			assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+			assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+		})
+	}
 }
 
-// TestFinalizerClearedWhen409ConflictEncounteredOnStatusUpdate verifies that the finalizer
-// is removed even when the status update gets back a 409 Conflict from the API server
-// because the controller is working with an old version of the ServiceInstance
-func TestFinalizerClearedWhen409ConflictEncounteredOnStatusUpdate(t *testing.T) {
-	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
-
-	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
-	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
-	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
-
-	instance := getTestServiceInstanceWithClusterRefs()
-	instance.ResourceVersion = "1"
-	instance.ObjectMeta.DeletionTimestamp = &metav1.Time{}
-	instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
-	instance.Generation = 1
-	instance.Status.ReconciledGeneration = 0
-	instance.Status.ObservedGeneration = 0
-	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusNotProvisioned
-	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusNotRequired
-
-	newerInstance := instance.DeepCopy()
-	newerInstance.ResourceVersion = "2"
+// TestReconcileServiceInstanceBindingCount tests that creating and deleting a
+// binding enqueues its instance, and that reconciling the instance updates
+// its binding count.
+func TestReconcileServiceInstanceBindingCount(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
 
-	fakeCatalogClient.AddReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
-		return true, newerInstance, nil
-	})
-	fakeCatalogClient.AddReactor("update", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
-		updateAction := action.(clientgotesting.UpdateAction)
-		object := updateAction.GetObject()
-		instance := object.(*v1beta1.ServiceInstance)
+	instance := getTestServiceInstanceReadyFor(time.Minute, 3600)
+	instance.Spec.TTLSecondsAfterReady = nil
+	binding := getTestServiceBinding()
+	instanceKey := testNamespace + "/" + testServiceInstanceName
 
-		if instance.ResourceVersion == "1" {
-			return true, nil, apierrors.NewConflict(action.GetResource().GroupResource(), instance.Name, errors.New("object has changed"))
+	assertInstanceEnqueued := func() {
+		if e, a := 1, testController.instanceQueue.Len(); e != a {
+			t.Fatalf("unexpected number of enqueued instances; %s", expectedGot(e, a))
+		}
+		key, _ := testController.instanceQueue.Get()
+		testController.instanceQueue.Done(key)
+		if e, a := instanceKey, key; e != a {
+			t.Fatalf("unexpected instance enqueued; %s", expectedGot(e, a))
 		}
-		return true, object, nil
-	})
-
-	if err := reconcileServiceInstance(t, testController, instance); err != nil {
-		t.Fatalf("This should not fail : %v", err)
 	}
 
-	brokerActions := fakeClusterServiceBrokerClient.Actions()
-	assertNumberOfBrokerActions(t, brokerActions, 0)
-
-	// Verify no core kube actions occurred
-	kubeActions := fakeKubeClient.Actions()
-	assertNumberOfActions(t, kubeActions, 0)
-
-	actions := fakeCatalogClient.Actions()
-	// The actions should be:
-	// 0. Update the instance status; the server responds with 409 Conflict
-	// 1. Fetch the fresh instance
-	// 2. Update instance status with the fresh instance.
-	// 3. Remove the finalizer on the fresh instance.
-	assertNumberOfActions(t, actions, 4)
+	assertBindingCountUpdate := func(count int64) {
+		if err := reconcileServiceInstance(t, testController, instance); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+		actions := fakeCatalogClient.Actions()
+		assertNumberOfActions(t, actions, 1)
+		instance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+		if e, a := count, instance.Status.BindingCount; e != a {
+			t.Fatalf("unexpected binding count; %s", expectedGot(e, a))
+		}
+		fakeCatalogClient.ClearActions()
+	}
 
-	assertUpdateStatus(t, actions[0], instance)
-	assertGet(t, actions[1], instance)
-	assertUpdateStatus(t, actions[2], instance)
-	updatedServiceInstance := assertUpdate(t, actions[3], instance)
-	assertEmptyFinalizers(t, updatedServiceInstance)
+	sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+	testController.bindingCreate(binding)
+	assertInstanceEnqueued()
+	assertBindingCountUpdate(1)
 
-	events := getRecordedEvents(testController)
-	assertNumEvents(t, events, 0)
+	sharedInformers.ServiceBindings().Informer().GetStore().Delete(binding)
+	testController.bindingDelete(binding)
+	assertInstanceEnqueued()
+	assertBindingCountUpdate(0)
 }
 
 // TestReconcileServiceInstanceWithFailedCondition tests reconciling an instance that
@@ -2544,7 +3551,7 @@ func TestReconcileServiceInstanceWithFailedCondition(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
-	expectedEvent := normalEventBuilder(successProvisionReason).msg("The instance was provisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonProvisionedSuccessfully).msg("The instance was provisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -2607,6 +3614,51 @@ func TestPollServiceInstanceInProgressProvisioningWithOperation(t *testing.T) {
 	assertNumberOfActions(t, kubeActions, 0)
 }
 
+// TestPollServiceInstanceResetsBackoffOnProgress tests that the polling
+// backoff of an instance keeps increasing while the broker reports the same
+// description, and starts over when the description changes.
+func TestPollServiceInstanceResetsBackoffOnProgress(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceAsyncProvisioning(testOperation)
+	instanceKey := testNamespace + "/" + testServiceInstanceName
+
+	for i, tc := range []struct {
+		description      string
+		expectedRequeues int
+	}{
+		{description: "creating the database", expectedRequeues: 1},
+		{description: "creating the database", expectedRequeues: 2},
+		{description: "creating the database", expectedRequeues: 3},
+		{description: "restoring the backup", expectedRequeues: 1},
+		{description: "restoring the backup", expectedRequeues: 2},
+	} {
+		fakeClusterServiceBrokerClient.PollLastOperationReaction = &fakeosb.PollLastOperationReaction{
+			Response: &osb.LastOperationResponse{
+				State:       osb.StateInProgress,
+				Description: strPtr(tc.description),
+			},
+		}
+		fakeCatalogClient.ClearActions()
+
+		if err := testController.pollServiceInstance(instance); err != nil {
+			t.Fatalf("poll %d: pollServiceInstance failed: %s", i, err)
+		}
+
+		if e, a := tc.expectedRequeues, testController.instancePollingQueue.NumRequeues(instanceKey); e != a {
+			t.Fatalf("poll %d: expected the instance to be requeued %d times, got %d", i, e, a)
+		}
+
+		actions := fakeCatalogClient.Actions()
+		assertNumberOfActions(t, actions, 1)
+		instance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	}
+}
+
 // TestPollServiceInstanceSuccessProvisioningWithOperation tests polling an
 // instance that is already in process of provisioning (background/
 // asynchronously) and is found to be ready
@@ -2662,6 +3714,72 @@ func TestPollServiceInstanceSuccessProvisioningWithOperation(t *testing.T) {
 	assertServiceInstanceOperationSuccess(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationProvision, testClusterServicePlanName, testClusterServicePlanGUID, instance)
 }
 
+// TestPollServiceInstanceRecordsOperationDuration tests that completing an
+// asynchronous provision or update records how long the operation took since
+// it was started, not since the last poll.
+func TestPollServiceInstanceRecordsOperationDuration(t *testing.T) {
+	cases := []struct {
+		name     string
+		instance *v1beta1.ServiceInstance
+		duration func(*v1beta1.ServiceInstanceStatus) *metav1.Duration
+		other    func(*v1beta1.ServiceInstanceStatus) *metav1.Duration
+	}{
+		{
+			name:     "provision",
+			instance: getTestServiceInstanceAsyncProvisioning(testOperation),
+			duration: func(s *v1beta1.ServiceInstanceStatus) *metav1.Duration { return s.LastProvisionDuration },
+			other:    func(s *v1beta1.ServiceInstanceStatus) *metav1.Duration { return s.LastUpdateDuration },
+		},
+		{
+			name:     "update",
+			instance: getTestServiceInstanceAsyncUpdating(testOperation),
+			duration: func(s *v1beta1.ServiceInstanceStatus) *metav1.Duration { return s.LastUpdateDuration },
+			other:    func(s *v1beta1.ServiceInstanceStatus) *metav1.Duration { return s.LastProvisionDuration },
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				PollLastOperationReaction: &fakeosb.PollLastOperationReaction{
+					Response: &osb.LastOperationResponse{
+						State: osb.StateSucceeded,
+					},
+				},
+			})
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			instance := tc.instance
+			startTime := metav1.NewTime(time.Now().Add(-90 * time.Second))
+			instance.Status.OperationStartTime = &startTime
+
+			if err := testController.pollServiceInstance(instance); err != nil {
+				t.Fatalf("pollServiceInstance failed: %s", err)
+			}
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 1)
+			updatedServiceInstance, ok := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+			if !ok {
+				t.Fatalf("couldn't convert to *v1beta1.ServiceInstance")
+			}
+
+			duration := tc.duration(&updatedServiceInstance.Status)
+			if duration == nil {
+				t.Fatalf("expected the duration of the operation to be recorded")
+			}
+			if duration.Duration < 90*time.Second || duration.Duration > 120*time.Second {
+				t.Fatalf("unexpected duration of the operation: %v", duration.Duration)
+			}
+			if other := tc.other(&updatedServiceInstance.Status); other != nil {
+				t.Fatalf("unexpected duration recorded for the other operation: %v", other.Duration)
+			}
+		})
+	}
+}
+
 // TestPollServiceInstanceFailureProvisioningWithOperation tests polling an
 // instance where provision was in process asynchronously but has an updated
 // status of failed to provision.
@@ -2717,9 +3835,9 @@ func TestPollServiceInstanceFailureProvisioningWithOperation(t *testing.T) {
 		t,
 		updatedServiceInstance,
 		v1beta1.ServiceInstanceOperationProvision,
-		startingInstanceOrphanMitigationReason,
-		errorProvisionCallFailedReason,
-		errorProvisionCallFailedReason,
+		v1beta1.ReasonStartingInstanceOrphanMitigation,
+		v1beta1.ReasonProvisionCallFailed,
+		v1beta1.ReasonProvisionCallFailed,
 		instance,
 	)
 }
@@ -2865,7 +3983,7 @@ func TestPollServiceInstanceSuccessDeprovisioningWithOperationNoFinalizer(t *tes
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -2926,7 +4044,7 @@ func TestPollServiceInstanceFailureDeprovisioning(t *testing.T) {
 		t,
 		updatedServiceInstance,
 		v1beta1.ServiceInstanceOperationDeprovision,
-		errorDeprovisionCallFailedReason,
+		v1beta1.ReasonDeprovisionCallFailed,
 		testClusterServicePlanName,
 		testClusterServicePlanGUID,
 		instance,
@@ -2934,7 +4052,7 @@ func TestPollServiceInstanceFailureDeprovisioning(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorDeprovisionCallFailedReason).msg("Deprovision call failed: (no description provided)")
+	expectedEvent := warningEventBuilder(v1beta1.ReasonDeprovisionCallFailed).msg("Deprovision call failed: (no description provided)")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -2998,15 +4116,15 @@ func TestPollServiceInstanceFailureDeprovisioningWithReconciliationTimeout(t *te
 		t,
 		updatedServiceInstance,
 		v1beta1.ServiceInstanceOperationDeprovision,
-		errorDeprovisionCallFailedReason,
-		errorReconciliationRetryTimeoutReason,
+		v1beta1.ReasonDeprovisionCallFailed,
+		v1beta1.ReasonErrorReconciliationRetryTimeout,
 		instance,
 	)
 
 	events := getRecordedEvents(testController)
 	expectedEvents := []string{
-		warningEventBuilder(errorDeprovisionCallFailedReason).msg("Deprovision call failed: (no description provided)").String(),
-		warningEventBuilder(errorReconciliationRetryTimeoutReason).msg("Stopping reconciliation retries because too much time has elapsed").String(),
+		warningEventBuilder(v1beta1.ReasonDeprovisionCallFailed).msg("Deprovision call failed: (no description provided)").String(),
+		warningEventBuilder(v1beta1.ReasonErrorReconciliationRetryTimeout).msg("Stopping reconciliation retries because too much time has elapsed").String(),
 	}
 
 	if err := checkEvents(events, expectedEvents); err != nil {
@@ -3074,7 +4192,7 @@ func TestPollServiceInstanceStatusGoneDeprovisioningWithOperationNoFinalizer(t *
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -3134,7 +4252,7 @@ func TestPollServiceInstanceClusterServiceBrokerTemporaryError(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorPollingLastOperationReason).msg(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorPollingLastOperation).msg(
 		"Error polling last operation:",
 	).msg("Status: 403; ErrorMessage: <nil>; Description: <nil>; ResponseError: <nil>")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
@@ -3196,7 +4314,7 @@ func TestPollServiceInstanceClusterServiceBrokerTerminalError(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorPollingLastOperationReason).msg(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorPollingLastOperation).msg(
 		"Error polling last operation:",
 	).msg("Status: 400; ErrorMessage: <nil>; Description: <nil>; ResponseError: <nil>")
 	// Event is sent twice: one for Ready condition and one for Failed
@@ -3270,7 +4388,7 @@ func TestPollServiceInstanceSuccessDeprovisioningWithOperationWithFinalizer(t *t
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -3332,7 +4450,7 @@ func TestReconcileServiceInstanceSuccessOnFinalRetry(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successProvisionReason).msg("The instance was provisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonProvisionedSuccessfully).msg("The instance was provisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -3366,7 +4484,7 @@ func TestReconcileServiceInstanceUpdateInProgressPropertiesOnRetry(t *testing.T)
 		{
 			Type:   v1beta1.ServiceInstanceConditionReady,
 			Status: v1beta1.ConditionFalse,
-			Reason: provisioningInFlightReason,
+			Reason: v1beta1.ReasonProvisionRequestInFlight,
 		},
 	}
 
@@ -3475,16 +4593,16 @@ func TestReconcileServiceInstanceFailureOnFinalRetry(t *testing.T) {
 		t,
 		updatedServiceInstance,
 		v1beta1.ServiceInstanceOperationProvision,
-		errorErrorCallingProvisionReason,
-		errorReconciliationRetryTimeoutReason,
+		v1beta1.ReasonErrorCallingProvision,
+		v1beta1.ReasonErrorReconciliationRetryTimeout,
 		instance,
 	)
 
 	events := getRecordedEvents(testController)
 
 	expectedEventPrefixes := []string{
-		corev1.EventTypeWarning + " " + errorErrorCallingProvisionReason,
-		corev1.EventTypeWarning + " " + errorReconciliationRetryTimeoutReason,
+		corev1.EventTypeWarning + " " + v1beta1.ReasonErrorCallingProvision,
+		corev1.EventTypeWarning + " " + v1beta1.ReasonErrorReconciliationRetryTimeout,
 	}
 
 	if err := checkEventPrefixes(events, expectedEventPrefixes); err != nil {
@@ -3598,9 +4716,9 @@ func TestPollServiceInstanceFailureOnFinalRetry(t *testing.T) {
 		t,
 		updatedServiceInstance,
 		v1beta1.ServiceInstanceOperationProvision,
-		startingInstanceOrphanMitigationReason,
-		errorReconciliationRetryTimeoutReason,
-		asyncProvisioningReason,
+		v1beta1.ReasonStartingInstanceOrphanMitigation,
+		v1beta1.ReasonErrorReconciliationRetryTimeout,
+		v1beta1.ReasonProvisioning,
 		instance,
 	)
 
@@ -4016,6 +5134,62 @@ func TestReconcileInstanceUsingOriginatingIdentity(t *testing.T) {
 	}
 }
 
+// TestReconcileInstanceUsingNamespaceOriginatingIdentity tests that the
+// originating identity of a provision request carries the username set by the
+// annotation of the namespace of the instance, when configured.
+func TestReconcileInstanceUsingNamespaceOriginatingIdentity(t *testing.T) {
+	prevOrigIDEnablement := sctestutil.EnableOriginatingIdentity(t, true)
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=%v", scfeatures.OriginatingIdentity, prevOrigIDEnablement))
+
+	_, fakeCatalogClient, fakeBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{
+				DashboardURL: &testDashboardURL,
+			},
+		},
+	})
+	testController.originatingIdentityNamespaceAnnotation = "example.com/tenant"
+
+	setTestNamespaces(testController, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testNamespace,
+			UID:         testNamespaceGUID,
+			Annotations: map[string]string{"example.com/tenant": "tenant-a"},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Spec.UserInfo = testUserInfo
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+	instance = assertUpdateStatus(t, actions[1], instance).(*v1beta1.ServiceInstance)
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	actualRequest, ok := brokerActions[0].Request.(*osb.ProvisionRequest)
+	if !ok {
+		t.Fatalf("unexpected request type; expected %T, got %T", &osb.ProvisionRequest{}, brokerActions[0].Request)
+	}
+	expectedOriginatingIdentity, err := buildOriginatingIdentity(&v1beta1.UserInfo{Username: "tenant-a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertOriginatingIdentity(t, expectedOriginatingIdentity, actualRequest.OriginatingIdentity)
+}
+
 func TestReconcileInstanceDeleteUsingOriginatingIdentity(t *testing.T) {
 	for _, tc := range originatingIdentityTestCases {
 		func() {
@@ -4259,8 +5433,8 @@ func TestReconcileServiceInstanceTimeoutTriggersOrphanMitigation(t *testing.T) {
 		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceInstance", updatedObject)
 	}
 
-	assertServiceInstanceReadyCondition(t, updatedServiceInstance, v1beta1.ConditionFalse, startingInstanceOrphanMitigationReason)
-	assertServiceInstanceOrphanMitigationTrue(t, updatedServiceInstance, errorErrorCallingProvisionReason)
+	assertServiceInstanceReadyCondition(t, updatedServiceInstance, v1beta1.ConditionFalse, v1beta1.ReasonStartingInstanceOrphanMitigation)
+	assertServiceInstanceOrphanMitigationTrue(t, updatedServiceInstance, v1beta1.ReasonErrorCallingProvision)
 	assertServiceInstanceOrphanMitigationInProgressTrue(t, updatedServiceInstance)
 }
 
@@ -4288,7 +5462,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			},
 			finishedOrphanMitigation:     true,
 			expectedReadyConditionStatus: v1beta1.ConditionFalse,
-			expectedReadyConditionReason: successOrphanMitigationReason,
+			expectedReadyConditionReason: v1beta1.ReasonOrphanMitigationSuccessful,
 		},
 		{
 			name: "sync - 202 accepted",
@@ -4300,7 +5474,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			},
 			finishedOrphanMitigation:     false,
 			expectedReadyConditionStatus: v1beta1.ConditionFalse,
-			expectedReadyConditionReason: asyncDeprovisioningReason,
+			expectedReadyConditionReason: v1beta1.ReasonDeprovisioning,
 		},
 		{
 			name: "sync - http error",
@@ -4310,7 +5484,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			finishedOrphanMitigation:     false,
 			shouldError:                  true,
 			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
-			expectedReadyConditionReason: errorDeprovisionCallFailedReason,
+			expectedReadyConditionReason: v1beta1.ReasonDeprovisionCallFailed,
 		},
 		{
 			name: "sync - http error - retry duration exceeded",
@@ -4320,7 +5494,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			finishedOrphanMitigation:     false,
 			retryDurationExceeded:        true,
 			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
-			expectedReadyConditionReason: errorOrphanMitigationFailedReason,
+			expectedReadyConditionReason: v1beta1.ReasonOrphanMitigationFailed,
 		},
 		{
 			name: "sync - other error",
@@ -4330,7 +5504,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			finishedOrphanMitigation:     false,
 			shouldError:                  true,
 			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
-			expectedReadyConditionReason: errorDeprovisionCallFailedReason,
+			expectedReadyConditionReason: v1beta1.ReasonDeprovisionCallFailed,
 		},
 		{
 			name: "sync - other error - retry duration exceeded",
@@ -4340,7 +5514,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			finishedOrphanMitigation:     false,
 			retryDurationExceeded:        true,
 			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
-			expectedReadyConditionReason: errorOrphanMitigationFailedReason,
+			expectedReadyConditionReason: v1beta1.ReasonOrphanMitigationFailed,
 		},
 		// Asynchronous (Polling)
 		{
@@ -4353,7 +5527,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			async:                        true,
 			finishedOrphanMitigation:     true,
 			expectedReadyConditionStatus: v1beta1.ConditionFalse,
-			expectedReadyConditionReason: successOrphanMitigationReason,
+			expectedReadyConditionReason: v1beta1.ReasonOrphanMitigationSuccessful,
 		},
 		{
 			name: "poll - gone",
@@ -4365,7 +5539,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			async:                        true,
 			finishedOrphanMitigation:     true,
 			expectedReadyConditionStatus: v1beta1.ConditionFalse,
-			expectedReadyConditionReason: successOrphanMitigationReason,
+			expectedReadyConditionReason: v1beta1.ReasonOrphanMitigationSuccessful,
 		},
 		{
 			name: "poll - in progress",
@@ -4378,7 +5552,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			async:                        true,
 			finishedOrphanMitigation:     false,
 			expectedReadyConditionStatus: v1beta1.ConditionFalse,
-			expectedReadyConditionReason: asyncDeprovisioningReason,
+			expectedReadyConditionReason: v1beta1.ReasonDeprovisioning,
 		},
 		{
 			name: "poll - failed",
@@ -4391,7 +5565,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			finishedOrphanMitigation:     false,
 			retryDurationExceeded:        true,
 			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
-			expectedReadyConditionReason: errorOrphanMitigationFailedReason,
+			expectedReadyConditionReason: v1beta1.ReasonOrphanMitigationFailed,
 		},
 		{
 			name: "poll - failed - retry duration exceeded",
@@ -4404,7 +5578,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			finishedOrphanMitigation:     false,
 			retryDurationExceeded:        true,
 			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
-			expectedReadyConditionReason: errorOrphanMitigationFailedReason,
+			expectedReadyConditionReason: v1beta1.ReasonOrphanMitigationFailed,
 		},
 		// TODO (mkibbe): poll - error
 		// TODO (mkibbe): invalid state
@@ -4417,7 +5591,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			finishedOrphanMitigation:     false,
 			retryDurationExceeded:        true,
 			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
-			expectedReadyConditionReason: errorOrphanMitigationFailedReason,
+			expectedReadyConditionReason: v1beta1.ReasonOrphanMitigationFailed,
 		},
 		{
 			name: "poll - in progress - retry duration exceeded",
@@ -4430,7 +5604,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			finishedOrphanMitigation:     false,
 			retryDurationExceeded:        true,
 			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
-			expectedReadyConditionReason: errorOrphanMitigationFailedReason,
+			expectedReadyConditionReason: v1beta1.ReasonOrphanMitigationFailed,
 		},
 		{
 			name: "poll - invalid state - retry duration exceeded",
@@ -4443,7 +5617,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			finishedOrphanMitigation:     false,
 			retryDurationExceeded:        true,
 			expectedReadyConditionStatus: v1beta1.ConditionUnknown,
-			expectedReadyConditionReason: errorOrphanMitigationFailedReason,
+			expectedReadyConditionReason: v1beta1.ReasonOrphanMitigationFailed,
 		},
 	}
 
@@ -4464,7 +5638,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			instance.Status.OrphanMitigationInProgress = true
 			setServiceInstanceCondition(instance,
 				v1beta1.ServiceInstanceConditionOrphanMitigation,
-				v1beta1.ConditionTrue, startingInstanceOrphanMitigationReason, startingInstanceOrphanMitigationMessage)
+				v1beta1.ConditionTrue, v1beta1.ReasonStartingInstanceOrphanMitigation, startingInstanceOrphanMitigationMessage)
 			instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
 			instance.Status.InProgressProperties = &v1beta1.ServiceInstancePropertiesState{
 				ClusterServicePlanExternalName: testClusterServicePlanName,
@@ -4501,7 +5675,7 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 			if tc.finishedOrphanMitigation {
 				assertServiceInstanceOrphanMitigationMissing(t, updatedServiceInstance)
 			} else {
-				assertServiceInstanceOrphanMitigationTrue(t, updatedServiceInstance, startingInstanceOrphanMitigationReason)
+				assertServiceInstanceOrphanMitigationTrue(t, updatedServiceInstance, v1beta1.ReasonStartingInstanceOrphanMitigation)
 			}
 
 			assertServiceInstanceReadyCondition(
@@ -4511,20 +5685,86 @@ func TestReconcileServiceInstanceOrphanMitigation(t *testing.T) {
 				tc.expectedReadyConditionReason,
 			)
 
-			// validate reconciliation error response
-			if tc.shouldError {
-				if err == nil {
-					t.Fatalf("%v: Expected error; this should not be a terminal state", tc.name)
-				}
-			} else {
-				if err != nil {
-					t.Fatalf("%v: Unexpected error; this should be a terminal state", tc.name)
-				}
-			}
+			// validate reconciliation error response
+			if tc.shouldError {
+				if err == nil {
+					t.Fatalf("%v: Expected error; this should not be a terminal state", tc.name)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("%v: Unexpected error; this should be a terminal state", tc.name)
+				}
+			}
+
+			assertCatalogFinalizerExists(t, updatedServiceInstance)
+		})
+	}
+}
+
+// TestReconcileServiceInstanceOrphanMitigationAttemptsExceeded tests that
+// orphan mitigation stops sending deprovision requests once the configured
+// number of attempts is exhausted, leaving the instance in a terminal state.
+func TestReconcileServiceInstanceOrphanMitigationAttemptsExceeded(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		DeprovisionReaction: &fakeosb.DeprovisionReaction{
+			Error: fmt.Errorf("other error"),
+		},
+	})
+	testController.maxOrphanMitigationAttempts = 2
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.ObjectMeta.Finalizers = []string{v1beta1.FinalizerServiceCatalog}
+	instance.Status.CurrentOperation = v1beta1.ServiceInstanceOperationProvision
+	instance.Status.OrphanMitigationInProgress = true
+	setServiceInstanceCondition(instance,
+		v1beta1.ServiceInstanceConditionOrphanMitigation,
+		v1beta1.ConditionTrue, v1beta1.ReasonStartingInstanceOrphanMitigation, startingInstanceOrphanMitigationMessage)
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+	instance.Status.InProgressProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: testClusterServicePlanName,
+		ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+	}
+	startTime := metav1.NewTime(time.Now())
+	instance.Status.OperationStartTime = &startTime
+
+	// Each failed attempt is recorded in the status and retried.
+	for attempt := int64(1); attempt <= testController.maxOrphanMitigationAttempts; attempt++ {
+		fakeCatalogClient.ClearActions()
+		if err := reconcileServiceInstance(t, testController, instance); err == nil {
+			t.Fatalf("attempt %v: expected error; orphan mitigation should be retried", attempt)
+		}
+
+		actions := fakeCatalogClient.Actions()
+		assertNumberOfActions(t, actions, 1)
+		instance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+		if e, a := attempt, instance.Status.OrphanMitigationAttempts; e != a {
+			t.Fatalf("Unexpected number of orphan mitigation attempts; %s", expectedGot(e, a))
+		}
+		assertServiceInstanceReadyCondition(t, instance, v1beta1.ConditionUnknown, v1beta1.ReasonDeprovisionCallFailed)
+	}
 
-			assertCatalogFinalizerExists(t, updatedServiceInstance)
-		})
+	// Once the attempts are exhausted, no more requests are sent to the broker.
+	fakeCatalogClient.ClearActions()
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("Unexpected error; this should be a terminal state: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	if e, a := int(testController.maxOrphanMitigationAttempts), len(brokerActions); e != a {
+		t.Fatalf("Unexpected number of deprovision requests; %s", expectedGot(e, a))
 	}
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
+	assertServiceInstanceReadyCondition(t, updatedServiceInstance, v1beta1.ConditionUnknown, v1beta1.ReasonOrphanMitigationFailed)
+	assertServiceInstanceCondition(t, updatedServiceInstance, v1beta1.ServiceInstanceConditionFailed, v1beta1.ConditionTrue, v1beta1.ReasonOrphanMitigationAttemptsExceeded)
+	assertServiceInstanceDeprovisionStatus(t, updatedServiceInstance, v1beta1.ServiceInstanceDeprovisionStatusFailed)
+	assertCatalogFinalizerExists(t, updatedServiceInstance)
 }
 
 // TestReconcileServiceInstanceWithSecretParameters tests reconciling an instance
@@ -4637,7 +5877,7 @@ func TestReconcileServiceInstanceWithSecretParameters(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successProvisionReason).msg("The instance was provisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonProvisionedSuccessfully).msg("The instance was provisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -4720,7 +5960,7 @@ func TestResolveReferencesNoClusterServiceClass(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorNonexistentClusterServiceClassReason).msg(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesNonexistentServiceClass).msg(
 		fmt.Sprintf(`References a non-existent ClusterServiceClass %c or there is more than one (found: 0)`,
 			instance.Spec.PlanReference))
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
@@ -4784,23 +6024,368 @@ func TestReconcileServiceInstanceUpdateParameters(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	expectedParameters := map[string]interface{}{
-		"args": map[string]interface{}{
-			"first":  "first-arg",
-			"second": "new-second-arg",
-		},
-		"name": "test-param",
+	expectedParameters := map[string]interface{}{
+		"args": map[string]interface{}{
+			"first":  "first-arg",
+			"second": "new-second-arg",
+		},
+		"name": "test-param",
+	}
+	expectedParametersChecksum := generateChecksumOfParametersOrFail(t, expectedParameters)
+
+	instance = assertServiceInstanceOperationInProgressWithParametersIsTheOnlyCatalogClientAction(t, fakeCatalogClient, instance, v1beta1.ServiceInstanceOperationUpdate, testClusterServicePlanName, testClusterServicePlanGUID, expectedParameters, expectedParametersChecksum)
+	fakeCatalogClient.ClearActions()
+	fakeKubeClient.ClearActions()
+
+	if err = reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("This should not fail : %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertUpdateInstance(t, brokerActions[0], &osb.UpdateInstanceRequest{
+		AcceptsIncomplete: true,
+		InstanceID:        testServiceInstanceGUID,
+		ServiceID:         testClusterServiceClassGUID,
+		PlanID:            nil, // no change to plan
+		Context:           testContext,
+		Parameters: map[string]interface{}{
+			"args": map[string]interface{}{
+				"first":  "first-arg",
+				"second": "new-second-arg",
+			},
+			"name": "test-param",
+		},
+	})
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+
+	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
+	assertServiceInstanceOperationSuccessWithParameters(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationUpdate, testClusterServicePlanName, testClusterServicePlanGUID, expectedParameters, expectedParametersChecksum, instance)
+
+	updateObject, ok := updatedServiceInstance.(*v1beta1.ServiceInstance)
+	if !ok {
+		t.Fatalf("couldn't convert to *v1beta1.ServiceInstance")
+	}
+
+	if updateObject.Status.LastConditionState != "Ready" {
+		t.Fatalf("LastConditionState has unexpected value. Expected: %v, got: %v", "Ready", updateObject.Status.LastConditionState)
+	}
+
+	// Verify parameters are what we'd expect them to be, basically name, map with two values in it.
+	if len(updateObject.Spec.Parameters.Raw) == 0 {
+		t.Fatalf("Parameters was unexpectedly empty")
+	}
+
+	// verify no kube resources created
+	// One single action comes from getting namespace uid
+	kubeActions := fakeKubeClient.Actions()
+	if err := checkKubeClientActions(kubeActions, []kubeClientAction{
+		{verb: "get", resourceName: "namespaces", checkType: checkGetActionType},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	events := getRecordedEvents(testController)
+
+	expectedEvent := normalEventBuilder(v1beta1.ReasonInstanceUpdatedSuccessfully).msg("The instance was updated successfully")
+	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReconcileServiceInstanceUpdateResendParameters tests that the
+// parameters of a ServiceInstance are sent again in an update request when
+// requested through its annotation, even though they have not changed.
+func TestReconcileServiceInstanceUpdateResendParameters(t *testing.T) {
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		UpdateInstanceReaction: &fakeosb.UpdateInstanceReaction{
+			Response: &osb.UpdateInstanceResponse{},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	parameters := map[string]interface{}{
+		"name": "test-param",
+	}
+	parametersMarshaled, err := MarshalRawParameters(parameters)
+	if err != nil {
+		t.Fatalf("Failed to marshal parameters: %v", err)
+	}
+
+	// spec.updateRequests has been incremented, leaving the parameters
+	// untouched
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Annotations = map[string]string{v1beta1.ServiceInstanceResendParametersAnnotation: "true"}
+	instance.Generation = 2
+	instance.Spec.UpdateRequests = 1
+	instance.Spec.Parameters = &runtime.RawExtension{Raw: parametersMarshaled}
+	instance.Status.ReconciledGeneration = 1
+	instance.Status.ObservedGeneration = 1
+	instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+	instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+	instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: testClusterServicePlanName,
+		ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+		Parameters:                     &runtime.RawExtension{Raw: parametersMarshaled},
+		ParameterChecksum:              generateChecksumOfParametersOrFail(t, parameters),
+	}
+
+	// The first reconciliation records the start of the update operation
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	instance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertUpdateInstance(t, brokerActions[0], &osb.UpdateInstanceRequest{
+		AcceptsIncomplete: true,
+		InstanceID:        testServiceInstanceGUID,
+		ServiceID:         testClusterServiceClassGUID,
+		PlanID:            nil, // no change to plan
+		Parameters:        parameters,
+		Context:           testContext,
+	})
+
+	// The annotation is removed once the parameters have been resent
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 3)
+	updatedInstance := assertUpdate(t, actions[2], instance).(*v1beta1.ServiceInstance)
+	if _, ok := updatedInstance.Annotations[v1beta1.ServiceInstanceResendParametersAnnotation]; ok {
+		t.Fatalf("expected the %v annotation to be removed", v1beta1.ServiceInstanceResendParametersAnnotation)
+	}
+}
+
+// TestReconcileServiceInstanceUpdateContextWithUnexpiredTTLAfterReady tests
+// that an unexpired TTLSecondsAfterReady does not prevent a change of the
+// context of a ready instance from being sent to the broker.
+func TestReconcileServiceInstanceUpdateContextWithUnexpiredTTLAfterReady(t *testing.T) {
+	err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.ServiceInstanceContextUpdates))
+	if err != nil {
+		t.Fatalf("Failed to enable instance context updates feature: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ServiceInstanceContextUpdates))
+
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceReadyFor(time.Minute, 3600)
+	instance.Status.ReconciledGeneration = 1
+	instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+		ClusterServicePlanExternalName: testClusterServicePlanName,
+		ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+		ContextChecksum: generateChecksumOfParametersOrFail(t, map[string]interface{}{
+			"platform":  ContextProfilePlatformKubernetes,
+			"namespace": "old-namespace",
+		}),
+	}
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	updatedInstance := assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	if e, a := generateChecksumOfParametersOrFail(t, testContext), updatedInstance.Status.InProgressProperties.ContextChecksum; e != a {
+		t.Fatalf("unexpected in progress context checksum: %v", expectedGot(e, a))
+	}
+}
+
+// TestReconcileServiceInstanceUpdateContext tests that a change of the
+// context of a provisioned ServiceInstance is sent to the broker exactly once.
+func TestReconcileServiceInstanceUpdateContext(t *testing.T) {
+	err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.ServiceInstanceContextUpdates))
+	if err != nil {
+		t.Fatalf("Failed to enable instance context updates feature: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ServiceInstanceContextUpdates))
+
+	_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		UpdateInstanceReaction: &fakeosb.UpdateInstanceReaction{
+			Response: &osb.UpdateInstanceResponse{},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	oldContext := map[string]interface{}{
+		"platform":           ContextProfilePlatformKubernetes,
+		"namespace":          testNamespace,
+		clusterIdentifierKey: "old-cluster-id",
+	}
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Status = v1beta1.ServiceInstanceStatus{
+		Conditions: []v1beta1.ServiceInstanceCondition{{
+			Type:   v1beta1.ServiceInstanceConditionReady,
+			Status: v1beta1.ConditionTrue,
+		}},
+		ExternalProperties: &v1beta1.ServiceInstancePropertiesState{
+			ClusterServicePlanExternalName: testClusterServicePlanName,
+			ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+			ContextChecksum:                generateChecksumOfParametersOrFail(t, oldContext),
+		},
+		ReconciledGeneration: 1,
+		ObservedGeneration:   1,
+		ProvisionStatus:      v1beta1.ServiceInstanceProvisionStatusProvisioned,
+		DeprovisionStatus:    v1beta1.ServiceInstanceDeprovisionStatusRequired,
+	}
+	expectedContextChecksum := generateChecksumOfParametersOrFail(t, testContext)
+
+	// The first reconciliation records the start of the update operation
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	instance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
+	if e, a := expectedContextChecksum, instance.Status.InProgressProperties.ContextChecksum; e != a {
+		t.Fatalf("unexpected in progress context checksum: %v", expectedGot(e, a))
+	}
+	fakeCatalogClient.ClearActions()
+
+	// The second reconciliation sends the new context to the broker
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	assertUpdateInstance(t, brokerActions[0], &osb.UpdateInstanceRequest{
+		AcceptsIncomplete: true,
+		InstanceID:        testServiceInstanceGUID,
+		ServiceID:         testClusterServiceClassGUID,
+		PlanID:            nil, // no change to plan
+		Parameters:        nil, // no change to parameters
+		Context:           testContext,
+	})
+
+	actions = fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 2)
+	instance = assertUpdateStatus(t, actions[1], instance).(*v1beta1.ServiceInstance)
+	assertServiceInstanceReadyTrue(t, instance)
+	if e, a := expectedContextChecksum, instance.Status.ExternalProperties.ContextChecksum; e != a {
+		t.Fatalf("unexpected external context checksum: %v", expectedGot(e, a))
+	}
+	fakeCatalogClient.ClearActions()
+
+	// Once the broker knows the context, no further update is sent
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+}
+
+// TestReconcileServiceInstanceParametersSecretUpdated tests that updating a
+// Secret referenced by the parametersFrom of a ready ServiceInstance sends the
+// changed parameters to the broker.
+func TestReconcileServiceInstanceParametersSecretUpdated(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		UpdateInstanceReaction: &fakeosb.UpdateInstanceReaction{
+			Response: &osb.UpdateInstanceResponse{},
+		},
+	})
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	oldParameters := map[string]interface{}{"name": "old-name"}
+	newParameters := map[string]interface{}{"name": "new-name"}
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Spec.ParametersFrom = []v1beta1.ParametersFromSource{{
+		SecretKeyRef: &v1beta1.SecretKeyReference{
+			Name: "parameters-secret",
+			Key:  "parameters",
+		},
+	}}
+	instance.Status = v1beta1.ServiceInstanceStatus{
+		Conditions: []v1beta1.ServiceInstanceCondition{{
+			Type:   v1beta1.ServiceInstanceConditionReady,
+			Status: v1beta1.ConditionTrue,
+		}},
+		ExternalProperties: &v1beta1.ServiceInstancePropertiesState{
+			ClusterServicePlanExternalName: testClusterServicePlanName,
+			ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+			ParameterChecksum:              generateChecksumOfParametersOrFail(t, oldParameters),
+		},
+		ReconciledGeneration: 1,
+		ObservedGeneration:   1,
+		ProvisionStatus:      v1beta1.ServiceInstanceProvisionStatusProvisioned,
+		DeprovisionStatus:    v1beta1.ServiceInstanceDeprovisionStatusRequired,
+	}
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+
+	oldSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "parameters-secret"},
+		Data:       map[string][]byte{"parameters": []byte(`{"name":"old-name"}`)},
+	}
+	currentSecret := oldSecret
+	fakeKubeClient.AddReactor("get", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, currentSecret, nil
+	})
+
+	// Without a Secret update the ready instance is left alone
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	// Updating an unrelated key of the Secret does not change the parameters
+	currentSecret = oldSecret.DeepCopy()
+	currentSecret.Data["unrelated"] = []byte("value")
+	testController.secretUpdate(oldSecret, currentSecret)
+	if e, a := 1, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("unexpected instance queue length: %v", expectedGot(e, a))
+	}
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+
+	newSecret := oldSecret.DeepCopy()
+	newSecret.Data["parameters"] = []byte(`{"name":"new-name"}`)
+	testController.secretUpdate(currentSecret, newSecret)
+	currentSecret = newSecret
+
+	// The first reconciliation records the start of the update operation
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-	expectedParametersChecksum := generateChecksumOfParametersOrFail(t, expectedParameters)
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
 
-	instance = assertServiceInstanceOperationInProgressWithParametersIsTheOnlyCatalogClientAction(t, fakeCatalogClient, instance, v1beta1.ServiceInstanceOperationUpdate, testClusterServicePlanName, testClusterServicePlanGUID, expectedParameters, expectedParametersChecksum)
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 1)
+	instance = assertUpdateStatus(t, actions[0], instance).(*v1beta1.ServiceInstance)
 	fakeCatalogClient.ClearActions()
-	fakeKubeClient.ClearActions()
 
-	if err = reconcileServiceInstance(t, testController, instance); err != nil {
-		t.Fatalf("This should not fail : %v", err)
+	// The second reconciliation sends the new parameters to the broker
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-
 	brokerActions := fakeClusterServiceBrokerClient.Actions()
 	assertNumberOfBrokerActions(t, brokerActions, 1)
 	assertUpdateInstance(t, brokerActions[0], &osb.UpdateInstanceRequest{
@@ -4808,50 +6393,105 @@ func TestReconcileServiceInstanceUpdateParameters(t *testing.T) {
 		InstanceID:        testServiceInstanceGUID,
 		ServiceID:         testClusterServiceClassGUID,
 		PlanID:            nil, // no change to plan
+		Parameters:        newParameters,
 		Context:           testContext,
-		Parameters: map[string]interface{}{
-			"args": map[string]interface{}{
-				"first":  "first-arg",
-				"second": "new-second-arg",
-			},
-			"name": "test-param",
-		},
 	})
 
-	actions := fakeCatalogClient.Actions()
+	actions = fakeCatalogClient.Actions()
 	assertNumberOfActions(t, actions, 2)
+	instance = assertUpdateStatus(t, actions[1], instance).(*v1beta1.ServiceInstance)
+	assertServiceInstanceReadyTrue(t, instance)
+	if e, a := generateChecksumOfParametersOrFail(t, newParameters), instance.Status.ExternalProperties.ParameterChecksum; e != a {
+		t.Fatalf("unexpected external parameter checksum: %v", expectedGot(e, a))
+	}
+}
 
-	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
-	assertServiceInstanceOperationSuccessWithParameters(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationUpdate, testClusterServicePlanName, testClusterServicePlanGUID, expectedParameters, expectedParametersChecksum, instance)
+// TestReconcileServiceInstanceParametersSecretUpdatedWhileNotReady tests that
+// the change of a Secret referenced by the parametersFrom of a ServiceInstance
+// which is not ready is kept until the changed parameters have been sent to
+// the broker.
+func TestReconcileServiceInstanceParametersSecretUpdatedWhileNotReady(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		UpdateInstanceReaction: &fakeosb.UpdateInstanceReaction{
+			Response: &osb.UpdateInstanceResponse{},
+		},
+	})
 
-	updateObject, ok := updatedServiceInstance.(*v1beta1.ServiceInstance)
-	if !ok {
-		t.Fatalf("couldn't convert to *v1beta1.ServiceInstance")
-	}
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
 
-	if updateObject.Status.LastConditionState != "Ready" {
-		t.Fatalf("LastConditionState has unexpected value. Expected: %v, got: %v", "Ready", updateObject.Status.LastConditionState)
-	}
+	oldParameters := map[string]interface{}{"name": "old-name"}
 
-	// Verify parameters are what we'd expect them to be, basically name, map with two values in it.
-	if len(updateObject.Spec.Parameters.Raw) == 0 {
-		t.Fatalf("Parameters was unexpectedly empty")
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Spec.ParametersFrom = []v1beta1.ParametersFromSource{{
+		SecretKeyRef: &v1beta1.SecretKeyReference{
+			Name: "parameters-secret",
+			Key:  "parameters",
+		},
+	}}
+	instance.Status = v1beta1.ServiceInstanceStatus{
+		Conditions: []v1beta1.ServiceInstanceCondition{
+			{
+				Type:   v1beta1.ServiceInstanceConditionReady,
+				Status: v1beta1.ConditionFalse,
+			},
+			{
+				Type:   v1beta1.ServiceInstanceConditionFailed,
+				Status: v1beta1.ConditionTrue,
+			},
+		},
+		ExternalProperties: &v1beta1.ServiceInstancePropertiesState{
+			ClusterServicePlanExternalName: testClusterServicePlanName,
+			ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+			ParameterChecksum:              generateChecksumOfParametersOrFail(t, oldParameters),
+		},
+		ReconciledGeneration: 1,
+		ObservedGeneration:   1,
+		ProvisionStatus:      v1beta1.ServiceInstanceProvisionStatusProvisioned,
+		DeprovisionStatus:    v1beta1.ServiceInstanceDeprovisionStatusRequired,
 	}
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
 
-	// verify no kube resources created
-	// One single action comes from getting namespace uid
-	kubeActions := fakeKubeClient.Actions()
-	if err := checkKubeClientActions(kubeActions, []kubeClientAction{
-		{verb: "get", resourceName: "namespaces", checkType: checkGetActionType},
-	}); err != nil {
-		t.Fatal(err)
+	oldSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "parameters-secret"},
+		Data:       map[string][]byte{"parameters": []byte(`{"name":"old-name"}`)},
 	}
+	newSecret := oldSecret.DeepCopy()
+	newSecret.Data["parameters"] = []byte(`{"name":"new-name"}`)
+	fakeKubeClient.AddReactor("get", "secrets", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, newSecret, nil
+	})
 
-	events := getRecordedEvents(testController)
+	testController.secretUpdate(oldSecret, newSecret)
 
-	expectedEvent := normalEventBuilder(successUpdateInstanceReason).msg("The instance was updated successfully")
-	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
-		t.Fatal(err)
+	// The failed instance is left alone, but the change is not forgotten
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+	assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	if !testController.isServiceInstanceParametersSecretChanged(instance) {
+		t.Fatal("expected the change of the parameters Secret to be kept")
+	}
+
+	// Once the instance is ready again, the new parameters are sent
+	instance = instance.DeepCopy()
+	instance.Status.Conditions = []v1beta1.ServiceInstanceCondition{{
+		Type:   v1beta1.ServiceInstanceConditionReady,
+		Status: v1beta1.ConditionTrue,
+	}}
+	for i := 0; i < 2; i++ {
+		if err := reconcileServiceInstance(t, testController, instance); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		actions := fakeCatalogClient.Actions()
+		instance = assertUpdateStatus(t, actions[len(actions)-1], instance).(*v1beta1.ServiceInstance)
+		fakeCatalogClient.ClearActions()
+	}
+	assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 1)
+	if testController.isServiceInstanceParametersSecretChanged(instance) {
+		t.Fatal("expected the change of the parameters Secret to be forgotten once sent")
 	}
 }
 
@@ -4945,7 +6585,7 @@ func TestReconcileServiceInstanceDeleteParameters(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successUpdateInstanceReason).msg("The instance was updated successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonInstanceUpdatedSuccessfully).msg("The instance was updated successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -5023,7 +6663,7 @@ func TestResolveReferencesNoClusterServicePlan(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorNonexistentClusterServicePlanReason).msgf(
+	expectedEvent := warningEventBuilder(v1beta1.ReasonReferencesNonexistentServicePlan).msgf(
 		`References a non-existent ClusterServicePlan %b on ClusterServiceClass %s %c or there is more than one (found: 0)`,
 		instance.Spec.PlanReference, instance.Spec.ClusterServiceClassRef.Name, instance.Spec.PlanReference,
 	)
@@ -5174,7 +6814,7 @@ func TestReconcileServiceInstanceUpdateDashboardURLResponse(t *testing.T) {
 
 		events := getRecordedEvents(testController)
 
-		expectedEvent := normalEventBuilder(successUpdateInstanceReason).msg("The instance was updated successfully")
+		expectedEvent := normalEventBuilder(v1beta1.ReasonInstanceUpdatedSuccessfully).msg("The instance was updated successfully")
 		if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 			t.Fatal(err)
 		}
@@ -5286,12 +6926,111 @@ func TestReconcileServiceInstanceUpdatePlan(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successUpdateInstanceReason).msg("The instance was updated successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonInstanceUpdatedSuccessfully).msg("The instance was updated successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
 }
 
+// TestReconcileServiceInstanceUpdatePlanMarksBindingsStale tests that a
+// successful change of the plan of an instance marks the credentials of its
+// bindings as stale, and that their bind requests are only sent again when
+// opted in.
+func TestReconcileServiceInstanceUpdatePlanMarksBindingsStale(t *testing.T) {
+	cases := []struct {
+		name                         string
+		refetch                      bool
+		expectedReconciledGeneration int64
+	}{
+		{
+			name:                         "mark stale only",
+			refetch:                      false,
+			expectedReconciledGeneration: 1,
+		},
+		{
+			name:                         "refetch credentials",
+			refetch:                      true,
+			expectedReconciledGeneration: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+				UpdateInstanceReaction: &fakeosb.UpdateInstanceReaction{
+					Response: &osb.UpdateInstanceResponse{},
+				},
+			})
+			testController.refetchBindingCredentialsOnInstancePlanChange = tc.refetch
+
+			sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+			sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+			sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+			binding := getTestServiceBinding()
+			binding.Status.ReconciledGeneration = 1
+			setServiceBindingCondition(binding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionTrue, v1beta1.ReasonInjectedBindResult, successInjectedBindResultMessage)
+			sharedInformers.ServiceBindings().Informer().GetStore().Add(binding)
+
+			otherBinding := getTestServiceBinding()
+			otherBinding.Name = "other-binding"
+			otherBinding.Spec.InstanceRef.Name = "other-instance"
+			sharedInformers.ServiceBindings().Informer().GetStore().Add(otherBinding)
+
+			instance := getTestServiceInstanceWithClusterRefs()
+			instance.Generation = 2
+			instance.Status.ReconciledGeneration = 1
+			instance.Status.ObservedGeneration = 1
+			instance.Status.ProvisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
+			instance.Status.DeprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
+			instance.Status.BindingCount = 1
+			instance.Status.ExternalProperties = &v1beta1.ServiceInstancePropertiesState{
+				ClusterServicePlanExternalName: "old-plan-name",
+				ClusterServicePlanExternalID:   "old-plan-id",
+			}
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			instance = assertUpdateStatus(t, fakeCatalogClient.Actions()[0], instance).(*v1beta1.ServiceInstance)
+			fakeCatalogClient.ClearActions()
+
+			if err := reconcileServiceInstance(t, testController, instance); err != nil {
+				t.Fatalf("This should not fail : %v", err)
+			}
+
+			// The last actions should be:
+			// 1. Updating the status of the instance on success
+			// 2. Updating the status of the binding of the instance
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 3)
+			assertServiceInstanceReadyTrue(t, assertUpdateStatus(t, actions[1], instance))
+
+			updatedBinding := assertUpdateStatus(t, actions[2], binding).(*v1beta1.ServiceBinding)
+			assertServiceBindingCondition(t, updatedBinding, v1beta1.ServiceBindingConditionCredentialsStale, v1beta1.ConditionTrue, v1beta1.ReasonInstancePlanChanged)
+			assertServiceBindingCondition(t, updatedBinding, v1beta1.ServiceBindingConditionReady, v1beta1.ConditionTrue)
+			if e, a := "Ready", updatedBinding.Status.LastConditionState; e != a {
+				t.Fatalf("Unexpected LastConditionState; %s", expectedGot(e, a))
+			}
+			if e, a := tc.expectedReconciledGeneration, updatedBinding.Status.ReconciledGeneration; e != a {
+				t.Fatalf("Unexpected ReconciledGeneration; %s", expectedGot(e, a))
+			}
+
+			events := getRecordedEvents(testController)
+			expectedEvents := []string{
+				normalEventBuilder(v1beta1.ReasonInstanceUpdatedSuccessfully).msg("The instance was updated successfully").String(),
+				warningEventBuilder(v1beta1.ReasonInstancePlanChanged).msgf(
+					"The plan of the instance changed from %q to %q; the credentials of the binding may no longer be valid",
+					"old-plan-name", testClusterServicePlanName,
+				).String(),
+			}
+			if err := checkEvents(events, expectedEvents); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 // TestReconcileServiceInstanceWithUpdateCallFailure tests that when the update
 // call to the broker fails, the ready condition becomes false, and the
 // failure condition is not set.
@@ -5342,11 +7081,11 @@ func TestReconcileServiceInstanceWithUpdateCallFailure(t *testing.T) {
 	assertNumberOfActions(t, actions, 2)
 
 	updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
-	assertServiceInstanceRequestRetriableError(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationUpdate, errorErrorCallingUpdateInstanceReason, testClusterServicePlanName, testClusterServicePlanGUID, instance)
+	assertServiceInstanceRequestRetriableError(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationUpdate, v1beta1.ReasonErrorCallingUpdateInstance, testClusterServicePlanName, testClusterServicePlanGUID, instance)
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := warningEventBuilder(errorErrorCallingUpdateInstanceReason).msg("The update call failed and will be retried:").msg("Error communicating with broker for updating:").msg("fake update failure")
+	expectedEvent := warningEventBuilder(v1beta1.ReasonErrorCallingUpdateInstance).msg("The update call failed and will be retried:").msg("Error communicating with broker for updating:").msg("fake update failure")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -5384,7 +7123,7 @@ func TestReconcileServiceInstanceWithUpdateFailure(t *testing.T) {
 				Description:  strPtr("Something's wrong with the request"),
 			},
 			errorExpected:         false,
-			expectedFailureReason: errorUpdateInstanceCallFailedReason,
+			expectedFailureReason: v1beta1.ReasonUpdateInstanceCallFailed,
 			expectedEventMessage: "ServiceBroker returned a failure for update call; update will not be retried: " +
 				"Status: 400; ErrorMessage: BadRequest; Description: Something's wrong with the request; ResponseError: <nil>",
 		},
@@ -5440,11 +7179,11 @@ func TestReconcileServiceInstanceWithUpdateFailure(t *testing.T) {
 			assertNumberOfActions(t, actions, 2)
 
 			updatedServiceInstance := assertUpdateStatus(t, actions[1], instance)
-			assertServiceInstanceUpdateRequestFailingErrorNoOrphanMitigation(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationUpdate, errorUpdateInstanceCallFailedReason, tc.expectedFailureReason, instance)
+			assertServiceInstanceUpdateRequestFailingErrorNoOrphanMitigation(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationUpdate, v1beta1.ReasonUpdateInstanceCallFailed, tc.expectedFailureReason, instance)
 
 			events := getRecordedEvents(testController)
 
-			expectedEvent := warningEventBuilder(errorUpdateInstanceCallFailedReason).msg(tc.expectedEventMessage)
+			expectedEvent := warningEventBuilder(v1beta1.ReasonUpdateInstanceCallFailed).msg(tc.expectedEventMessage)
 			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 				t.Fatal(err)
 			}
@@ -5527,6 +7266,154 @@ func TestResolveReferencesWorks(t *testing.T) {
 	assertNumEvents(t, events, 0)
 }
 
+// TestHealServiceInstanceReferences tests that the healing pass resolves the
+// missing references of processed instances only.
+func TestHealServiceInstanceReferences(t *testing.T) {
+	_, fakeCatalogClient, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	fakeCatalogClient.AddReactor("list", "clusterserviceclasses", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ClusterServiceClassList{Items: []v1beta1.ClusterServiceClass{*getTestClusterServiceClass()}}, nil
+	})
+	fakeCatalogClient.AddReactor("list", "clusterserviceplans", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		return true, &v1beta1.ClusterServicePlanList{Items: []v1beta1.ClusterServicePlan{*getTestClusterServicePlan()}}, nil
+	})
+
+	instance := getTestServiceInstanceWithStatus(v1beta1.ConditionTrue)
+	instance.Status.ObservedGeneration = instance.Generation
+	instance.Spec.ClusterServiceClassRef = nil
+	instance.Spec.ClusterServicePlanRef = nil
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+
+	resolvedInstance := getTestServiceInstanceWithStatus(v1beta1.ConditionTrue)
+	resolvedInstance.Name = "resolved"
+	resolvedInstance.Status.ObservedGeneration = resolvedInstance.Generation
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(resolvedInstance)
+
+	unprocessedInstance := getTestServiceInstance()
+	unprocessedInstance.Name = "unprocessed"
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(unprocessedInstance)
+
+	testController.healServiceInstanceReferences()
+
+	// We should get the following actions:
+	// list call for ClusterServiceClass
+	// list call for ClusterServicePlan
+	// updating references
+	actions := fakeCatalogClient.Actions()
+	assertNumberOfActions(t, actions, 3)
+
+	updatedServiceInstance := assertUpdate(t, actions[2], instance).(*v1beta1.ServiceInstance)
+	if updatedServiceInstance.Spec.ClusterServiceClassRef == nil || updatedServiceInstance.Spec.ClusterServiceClassRef.Name != testClusterServiceClassGUID {
+		t.Fatalf("ClusterServiceClassRef was not resolved correctly during healing")
+	}
+	if updatedServiceInstance.Spec.ClusterServicePlanRef == nil || updatedServiceInstance.Spec.ClusterServicePlanRef.Name != testClusterServicePlanGUID {
+		t.Fatalf("ClusterServicePlanRef was not resolved correctly during healing")
+	}
+}
+
+// TestResolveReferencesClusterServiceBrokerName tests that resolveReferences
+// requires spec.clusterServiceBrokerName when two brokers offer a class with
+// the same external name, and uses it to pick the right class when it is set.
+func TestResolveReferencesClusterServiceBrokerName(t *testing.T) {
+	const otherBrokerName = "other-clusterservicebroker"
+	const otherClassGUID = "other-cscguid"
+
+	otherClass := getTestClusterServiceClass()
+	otherClass.Name = otherClassGUID
+	otherClass.Spec.ExternalID = otherClassGUID
+	otherClass.Spec.ClusterServiceBrokerName = otherBrokerName
+	otherClass.Labels[v1beta1.GroupName+"/"+v1beta1.FilterSpecClusterServiceClassRefName] = otherClassGUID
+	otherClass.Labels[v1beta1.GroupName+"/"+v1beta1.FilterSpecClusterServiceBrokerName] = otherBrokerName
+
+	otherPlan := getTestClusterServicePlan()
+	otherPlan.Name = "other-cspguid"
+	otherPlan.Spec.ClusterServiceBrokerName = otherBrokerName
+	otherPlan.Spec.ClusterServiceClassRef.Name = otherClassGUID
+	otherPlan.Labels[v1beta1.GroupName+"/"+v1beta1.FilterSpecClusterServiceClassRefName] = otherClassGUID
+	otherPlan.Labels[v1beta1.GroupName+"/"+v1beta1.FilterSpecClusterServiceBrokerName] = otherBrokerName
+
+	cases := []struct {
+		name               string
+		brokerName         string
+		expectedErr        string
+		expectedClassGUID  string
+		expectedPlanBroker string
+	}{
+		{
+			name:        "broker not specified",
+			expectedErr: "spec.clusterServiceBrokerName must be set",
+		},
+		{
+			name:               "broker specified",
+			brokerName:         otherBrokerName,
+			expectedClassGUID:  otherClassGUID,
+			expectedPlanBroker: otherBrokerName,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, fakeCatalogClient, _, testController, _ := newTestController(t, noFakeActions())
+
+			// The fake client filters the listed items by the label selector.
+			fakeCatalogClient.AddReactor("list", "clusterserviceclasses", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, &v1beta1.ClusterServiceClassList{Items: []v1beta1.ClusterServiceClass{*getTestClusterServiceClass(), *otherClass}}, nil
+			})
+			fakeCatalogClient.AddReactor("list", "clusterserviceplans", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, &v1beta1.ClusterServicePlanList{Items: []v1beta1.ClusterServicePlan{*otherPlan}}, nil
+			})
+
+			instance := getTestServiceInstance()
+			instance.Spec.ClusterServiceBrokerName = tc.brokerName
+
+			_, err := testController.resolveReferences(instance)
+			if tc.expectedErr != "" {
+				if err == nil {
+					t.Fatalf("Expected an error containing %q", tc.expectedErr)
+				}
+				if !strings.Contains(err.Error(), tc.expectedErr) {
+					t.Fatalf("Did not get the expected error message %q got %q", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Should not have failed, but failed with: %q", err)
+			}
+
+			actions := fakeCatalogClient.Actions()
+			assertNumberOfActions(t, actions, 3)
+
+			listRestrictions := clientgotesting.ListRestrictions{
+				Labels: labels.SelectorFromSet(labels.Set{
+					v1beta1.GroupName + "/" + v1beta1.FilterSpecExternalName:             instance.Spec.ClusterServiceClassExternalName,
+					v1beta1.GroupName + "/" + v1beta1.FilterSpecClusterServiceBrokerName: tc.brokerName,
+				}),
+				Fields: fields.Everything(),
+			}
+			assertList(t, actions[0], &v1beta1.ClusterServiceClass{}, listRestrictions)
+
+			listRestrictions = clientgotesting.ListRestrictions{
+				Labels: labels.SelectorFromSet(labels.Set{
+					v1beta1.GroupName + "/" + v1beta1.FilterSpecExternalName:               testClusterServicePlanName,
+					v1beta1.GroupName + "/" + v1beta1.FilterSpecClusterServiceBrokerName:   tc.expectedPlanBroker,
+					v1beta1.GroupName + "/" + v1beta1.FilterSpecClusterServiceClassRefName: tc.expectedClassGUID,
+				}),
+				Fields: fields.Everything(),
+			}
+			assertList(t, actions[1], &v1beta1.ClusterServicePlan{}, listRestrictions)
+
+			updatedServiceInstance := assertUpdate(t, actions[2], instance)
+			updateObject, ok := updatedServiceInstance.(*v1beta1.ServiceInstance)
+			if !ok {
+				t.Fatalf("couldn't convert to *v1beta1.ServiceInstance")
+			}
+			if updateObject.Spec.ClusterServiceClassRef == nil || updateObject.Spec.ClusterServiceClassRef.Name != tc.expectedClassGUID {
+				t.Fatalf("ClusterServiceClassRef was not resolved correctly during reconcile")
+			}
+		})
+	}
+}
+
 // TestResolveReferencesForPlanChange tests that resolveReferences updates the
 // ClusterServicePlanRef when the plan is changed.
 func TestResolveReferencesForPlanChange(t *testing.T) {
@@ -5896,7 +7783,7 @@ func TestPollServiceInstanceAsyncFailureUpdating(t *testing.T) {
 	assertNumberOfActions(t, actions, 1)
 
 	updatedServiceInstance := assertUpdateStatus(t, actions[0], instance)
-	assertServiceInstanceUpdateRequestFailingErrorNoOrphanMitigation(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationUpdate, errorUpdateInstanceCallFailedReason, errorUpdateInstanceCallFailedReason, instance)
+	assertServiceInstanceUpdateRequestFailingErrorNoOrphanMitigation(t, updatedServiceInstance, v1beta1.ServiceInstanceOperationUpdate, v1beta1.ReasonUpdateInstanceCallFailed, v1beta1.ReasonUpdateInstanceCallFailed, instance)
 }
 
 func TestCheckClassAndPlanForDeletion(t *testing.T) {
@@ -5922,7 +7809,7 @@ func TestCheckClassAndPlanForDeletion(t *testing.T) {
 			class:          getTestClusterServiceClass(),
 			plan:           getTestMarkedAsRemovedClusterServicePlan(),
 			success:        false,
-			expectedReason: errorDeletedClusterServicePlanReason,
+			expectedReason: v1beta1.ReasonReferencesDeletedServicePlan,
 			expectedErrors: []string{"ClusterServicePlan", "has been deleted"},
 		},
 		{
@@ -5931,7 +7818,7 @@ func TestCheckClassAndPlanForDeletion(t *testing.T) {
 			class:          getTestMarkedAsRemovedClusterServiceClass(),
 			plan:           getTestClusterServicePlan(),
 			success:        false,
-			expectedReason: errorDeletedClusterServiceClassReason,
+			expectedReason: v1beta1.ReasonReferencesDeletedServiceClass,
 			expectedErrors: []string{"ClusterServiceClass", "has been deleted"},
 		},
 		{
@@ -5940,7 +7827,7 @@ func TestCheckClassAndPlanForDeletion(t *testing.T) {
 			class:          getTestClusterServiceClass(),
 			plan:           getTestMarkedAsRemovedClusterServicePlan(),
 			success:        false,
-			expectedReason: errorDeletedClusterServicePlanReason,
+			expectedReason: v1beta1.ReasonReferencesDeletedServicePlan,
 			expectedErrors: []string{"ClusterServicePlan", "has been deleted"},
 		},
 		{
@@ -5949,7 +7836,7 @@ func TestCheckClassAndPlanForDeletion(t *testing.T) {
 			class:          getTestClusterServiceClass(),
 			plan:           getTestMarkedAsRemovedClusterServicePlan(),
 			success:        false,
-			expectedReason: errorDeletedClusterServicePlanReason,
+			expectedReason: v1beta1.ReasonReferencesDeletedServicePlan,
 			expectedErrors: []string{"ClusterServicePlan", "has been deleted"},
 		},
 		{
@@ -6066,7 +7953,7 @@ func TestReconcileServiceInstanceDeleteDuringOngoingOperation(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -6098,7 +7985,7 @@ func TestReconcileServiceInstanceDeleteWithOngoingOperation(t *testing.T) {
 	}
 	setServiceInstanceCondition(instance,
 		v1beta1.ServiceInstanceConditionOrphanMitigation,
-		v1beta1.ConditionTrue, startingInstanceOrphanMitigationReason, startingInstanceOrphanMitigationMessage)
+		v1beta1.ConditionTrue, v1beta1.ReasonStartingInstanceOrphanMitigation, startingInstanceOrphanMitigationMessage)
 
 	fakeCatalogClient.AddReactor("get", "serviceinstances", func(action clientgotesting.Action) (bool, runtime.Object, error) {
 		return true, instance, nil
@@ -6156,7 +8043,7 @@ func TestReconcileServiceInstanceDeleteWithOngoingOperation(t *testing.T) {
 	events := getRecordedEvents(testController)
 	assertNumEvents(t, events, 1)
 
-	expectedEvent := corev1.EventTypeNormal + " " + successDeprovisionReason + " " + "The instance was deprovisioned successfully"
+	expectedEvent := corev1.EventTypeNormal + " " + v1beta1.ReasonDeprovisionedSuccessfully + " " + "The instance was deprovisioned successfully"
 	if e, a := expectedEvent, events[0]; e != a {
 		t.Fatalf("Received unexpected event: %v\nExpected: %v", a, e)
 	}
@@ -6233,7 +8120,7 @@ func TestReconcileServiceInstanceDeleteWithNonExistentPlan(t *testing.T) {
 
 	events := getRecordedEvents(testController)
 
-	expectedEvent := normalEventBuilder(successDeprovisionReason).msg("The instance was deprovisioned successfully")
+	expectedEvent := normalEventBuilder(v1beta1.ReasonDeprovisionedSuccessfully).msg("The instance was deprovisioned successfully")
 	if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
 		t.Fatal(err)
 	}
@@ -6435,3 +8322,150 @@ func assertServiceInstanceOperationInProgressWithParameterAndUserSpecifiedFields
 
 	return updateObject
 }
+
+// TestReconcileServiceInstanceDefaultParametersChanged tests that a change of
+// the default provisioning parameters of a provisioned instance is flagged
+// with the DefaultParametersChanged condition, and that the parameters are
+// only updated when resendParametersOnDefaultsChange is set.
+func TestReconcileServiceInstanceDefaultParametersChanged(t *testing.T) {
+	err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.ServicePlanDefaults))
+	if err != nil {
+		t.Fatalf("Could not enable ServicePlanDefaults feature flag.")
+	}
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ServicePlanDefaults))
+
+	getInstance := func() *v1beta1.ServiceInstance {
+		instance := getTestServiceInstanceWithClusterRefs()
+		instance.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"name":"db","size":"small","zone":"a"}`)}
+		instance.Status = v1beta1.ServiceInstanceStatus{
+			Conditions: []v1beta1.ServiceInstanceCondition{{
+				Type:   v1beta1.ServiceInstanceConditionReady,
+				Status: v1beta1.ConditionTrue,
+			}},
+			ExternalProperties: &v1beta1.ServiceInstancePropertiesState{
+				ClusterServicePlanExternalName: testClusterServicePlanName,
+				ClusterServicePlanExternalID:   testClusterServicePlanGUID,
+			},
+			DefaultProvisionParameters: &runtime.RawExtension{Raw: []byte(`{"size":"small","zone":"b"}`)},
+			ReconciledGeneration:       1,
+			ObservedGeneration:         1,
+			ProvisionStatus:            v1beta1.ServiceInstanceProvisionStatusProvisioned,
+			DeprovisionStatus:          v1beta1.ServiceInstanceDeprovisionStatusRequired,
+		}
+		return instance
+	}
+	setup := func(planDefaults string) (*fake.Clientset, *fakeosb.FakeClient, *controller) {
+		_, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, noFakeActions())
+		sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+		sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+		plan := getTestClusterServicePlan()
+		plan.Spec.DefaultProvisionParameters = &runtime.RawExtension{Raw: []byte(planDefaults)}
+		sharedInformers.ClusterServicePlans().Informer().GetStore().Add(plan)
+		return fakeCatalogClient, fakeClusterServiceBrokerClient, testController
+	}
+
+	t.Run("unchanged defaults", func(t *testing.T) {
+		fakeCatalogClient, fakeClusterServiceBrokerClient, testController := setup(`{"zone":"b","size":"small"}`)
+
+		if err := reconcileServiceInstance(t, testController, getInstance()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+		assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	})
+
+	t.Run("namespace annotation defaults are read from the cache", func(t *testing.T) {
+		fakeCatalogClient, fakeClusterServiceBrokerClient, testController := setup(`{"size":"small"}`)
+		fakeKubeClient := testController.kubeClient.(*clientgofake.Clientset)
+		testController.namespaceAnnotationParameters = map[string]string{"example.com/zone": "zone"}
+		setTestNamespaces(testController, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        testNamespace,
+				Annotations: map[string]string{"example.com/zone": "b"},
+			},
+		})
+
+		if err := reconcileServiceInstance(t, testController, getInstance()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+		assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+		if e, a := 0, len(fakeKubeClient.Actions()); e != a {
+			t.Fatalf("unexpected number of kube actions: %v", expectedGot(e, a))
+		}
+	})
+
+	t.Run("changed defaults are flagged", func(t *testing.T) {
+		fakeCatalogClient, fakeClusterServiceBrokerClient, testController := setup(`{"size":"large","zone":"b"}`)
+		instance := getInstance()
+
+		if err := reconcileServiceInstance(t, testController, instance); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+		actions := fakeCatalogClient.Actions()
+		assertNumberOfActions(t, actions, 1)
+		updatedInstance := assertUpdateStatus(t, actions[0], instance)
+		assertServiceInstanceCondition(t, updatedInstance, v1beta1.ServiceInstanceConditionDefaultParametersChanged, v1beta1.ConditionTrue, v1beta1.ReasonDefaultParametersChanged)
+		// The Ready condition stays last, so that it remains the LastConditionState
+		conditions := updatedInstance.(*v1beta1.ServiceInstance).Status.Conditions
+		if e, a := v1beta1.ServiceInstanceConditionReady, conditions[len(conditions)-1].Type; e != a {
+			t.Fatalf("unexpected last condition: %v", expectedGot(e, a))
+		}
+		if e, a := `{"name":"db","size":"small","zone":"a"}`, string(updatedInstance.(*v1beta1.ServiceInstance).Spec.Parameters.Raw); e != a {
+			t.Fatalf("unexpected parameters: %v", expectedGot(e, a))
+		}
+
+		expectedEvent := warningEventBuilder(v1beta1.ReasonDefaultParametersChanged).msg("The default provisioning parameters have changed since they were applied to the parameters of the instance")
+		if err := checkEvents(getRecordedEvents(testController), expectedEvent.stringArr()); err != nil {
+			t.Fatal(err)
+		}
+
+		// The flagged instance is left alone
+		fakeCatalogClient.ClearActions()
+		if err := reconcileServiceInstance(t, testController, updatedInstance.(*v1beta1.ServiceInstance)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+		assertNumberOfActions(t, fakeCatalogClient.Actions(), 0)
+	})
+
+	t.Run("condition is removed once the defaults match again", func(t *testing.T) {
+		fakeCatalogClient, _, testController := setup(`{"size":"small","zone":"b"}`)
+		instance := getInstance()
+		setServiceInstanceCondition(instance, v1beta1.ServiceInstanceConditionDefaultParametersChanged, v1beta1.ConditionTrue, v1beta1.ReasonDefaultParametersChanged, "changed")
+
+		if err := reconcileServiceInstance(t, testController, instance); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		actions := fakeCatalogClient.Actions()
+		assertNumberOfActions(t, actions, 1)
+		updatedInstance := assertUpdateStatus(t, actions[0], instance)
+		assertServiceInstanceConditionMissing(t, updatedInstance, v1beta1.ServiceInstanceConditionDefaultParametersChanged)
+	})
+
+	t.Run("changed defaults are resent", func(t *testing.T) {
+		fakeCatalogClient, fakeClusterServiceBrokerClient, testController := setup(`{"size":"large","zone":"c"}`)
+		testController.resendParametersOnDefaultsChange = true
+		instance := getInstance()
+
+		if err := reconcileServiceInstance(t, testController, instance); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertNumberOfBrokerActions(t, fakeClusterServiceBrokerClient.Actions(), 0)
+		actions := fakeCatalogClient.Actions()
+		assertNumberOfActions(t, actions, 2)
+
+		// The parameters left at their old defaults take the new ones
+		updatedInstance := assertUpdate(t, actions[0], instance).(*v1beta1.ServiceInstance)
+		if e, a := `{"name":"db","size":"large","zone":"a"}`, string(updatedInstance.Spec.Parameters.Raw); e != a {
+			t.Fatalf("unexpected parameters: %v", expectedGot(e, a))
+		}
+
+		updatedInstance = assertUpdateStatus(t, actions[1], instance).(*v1beta1.ServiceInstance)
+		if e, a := `{"size":"large","zone":"c"}`, string(updatedInstance.Status.DefaultProvisionParameters.Raw); e != a {
+			t.Fatalf("unexpected default parameters: %v", expectedGot(e, a))
+		}
+		assertServiceInstanceConditionMissing(t, updatedInstance, v1beta1.ServiceInstanceConditionDefaultParametersChanged)
+	})
+}
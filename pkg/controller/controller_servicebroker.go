@@ -18,12 +18,15 @@ package controller
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog"
@@ -37,16 +40,11 @@ import (
 // the Message strings have a terminating period and space so they can
 // be easily combined with a follow on specific message.
 const (
-	errorListingServiceClassesReason  string = "ErrorListingServiceClasses"
 	errorListingServiceClassesMessage string = "Error listing service classes."
-	errorListingServicePlansReason    string = "ErrorListingServicePlans"
 	errorListingServicePlansMessage   string = "Error listing service plans."
-	errorDeletingServiceClassReason   string = "ErrorDeletingServiceClass"
 	errorDeletingServiceClassMessage  string = "Error deleting service class."
-	errorDeletingServicePlanReason    string = "ErrorDeletingServicePlan"
 	errorDeletingServicePlanMessage   string = "Error deleting service plan."
 
-	successServiceBrokerDeletedReason  string = "DeletedSuccessfully"
 	successServiceBrokerDeletedMessage string = "The servicebroker %v was deleted successfully."
 )
 
@@ -57,6 +55,9 @@ func (c *controller) serviceBrokerAdd(obj interface{}) {
 	// confirming the deletion has not yet arrived.
 	// Generally, the key is "namespace/name" for namespaced-scoped resources and
 	// just "name" for cluster scoped resources.
+	if !c.isSelected(obj) {
+		return
+	}
 	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 	if err != nil {
 		klog.Errorf("Couldn't get key for object %+v: %v", obj, err)
@@ -119,21 +120,31 @@ func (c *controller) updateServiceBrokerClient(broker *v1beta1.ServiceBroker) (o
 	if err != nil {
 		s := fmt.Sprintf("Error getting broker auth credentials: %s", err)
 		klog.Info(pcb.Message(s))
-		c.recorder.Event(broker, corev1.EventTypeWarning, errorAuthCredentialsReason, s)
-		if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorFetchingCatalogReason, errorFetchingCatalogMessage+s); err != nil {
+		c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorGettingAuthCredentials, s)
+		if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorFetchingCatalog, errorFetchingCatalogMessage+s); err != nil {
 			return nil, err
 		}
 		return nil, err
 	}
 
-	clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig)
+	clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, authConfig, c.allowBrokerInsecureSkipTLSVerify)
+	clientConfig.APIVersion = c.brokerOSBAPIVersion(&broker.Spec.CommonServiceBrokerSpec)
+	c.warnOnInsecureSkipTLSVerify(broker, &broker.Spec.CommonServiceBrokerSpec, clientConfig, pcb)
 
-	brokerClient, err := c.brokerClientManager.UpdateBrokerClient(NewServiceBrokerKey(broker.Namespace, broker.Name), clientConfig)
+	brokerKey := NewServiceBrokerKey(broker.Namespace, broker.Name)
+	var brokerClient osb.Client
+	if tokenConfig := getServiceAccountTokenConfigFromServiceBroker(broker); tokenConfig != nil {
+		brokerClient, err = c.brokerClientManager.UpdateBrokerClientWithTokenSource(brokerKey, clientConfig, *tokenConfig, func() TokenSource {
+			return NewServiceAccountTokenSource(c.kubeClient, *tokenConfig)
+		})
+	} else {
+		brokerClient, err = c.brokerClientManager.UpdateBrokerClient(brokerKey, clientConfig)
+	}
 	if err != nil {
 		s := fmt.Sprintf("Error creating client for broker %q: %s", broker.Name, err)
 		klog.Info(pcb.Message(s))
-		c.recorder.Event(broker, corev1.EventTypeWarning, errorAuthCredentialsReason, s)
-		if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorFetchingCatalogReason, errorFetchingCatalogMessage+s); err != nil {
+		c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorGettingAuthCredentials, s)
+		if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorFetchingCatalog, errorFetchingCatalogMessage+s); err != nil {
 			return nil, err
 		}
 		return nil, err
@@ -171,8 +182,8 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 		if err != nil {
 			s := fmt.Sprintf("Error getting broker catalog: %s", err)
 			klog.Warning(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorFetchingCatalogReason, s)
-			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorFetchingCatalogReason, errorFetchingCatalogMessage+s); err != nil {
+			c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorFetchingCatalog, s)
+			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorFetchingCatalog, errorFetchingCatalogMessage+s); err != nil {
 				return err
 			}
 			if broker.Status.OperationStartTime == nil {
@@ -187,14 +198,14 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			} else if !time.Now().Before(broker.Status.OperationStartTime.Time.Add(c.reconciliationRetryDuration)) {
 				s := "Stopping reconciliation retries because too much time has elapsed"
 				klog.Info(pcb.Message(s))
-				c.recorder.Event(broker, corev1.EventTypeWarning, errorReconciliationRetryTimeoutReason, s)
+				c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorReconciliationRetryTimeout, s)
 				toUpdate := broker.DeepCopy()
 				toUpdate.Status.OperationStartTime = nil
 				toUpdate.Status.ReconciledGeneration = toUpdate.Generation
 				return c.updateServiceBrokerCondition(toUpdate,
 					v1beta1.ServiceBrokerConditionFailed,
 					v1beta1.ConditionTrue,
-					errorReconciliationRetryTimeoutReason,
+					v1beta1.ReasonErrorReconciliationRetryTimeout,
 					s)
 			}
 			return err
@@ -226,12 +237,28 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 		// convert the broker's catalog payload into our API objects
 		klog.V(4).Info(pcb.Message("Converting catalog response into service-catalog API"))
 
-		payloadServiceClasses, payloadServicePlans, err := convertAndFilterCatalogToNamespacedTypes(broker.Namespace, brokerCatalog, broker.Spec.CatalogRestrictions, existingServiceClassMap, existingServicePlanMap)
+		// the rewrite rules of the broker apply before its catalog restrictions
+		var payloadServiceClasses []*v1beta1.ServiceClass
+		var payloadServicePlans []*v1beta1.ServicePlan
+		// the malformed entries skipped, if they are not to fail the relist
+		var malformedEntries []string
+		rewrittenCatalog, err := c.catalogRewriteRules.rewriteCatalog(broker.Namespace, broker.Name, brokerCatalog)
+		if err == nil {
+			if c.skipMalformedCatalogEntries {
+				var classNames []string
+				rewrittenCatalog, classNames = removeServicesWithoutPlans(rewrittenCatalog)
+				for _, className := range classNames {
+					klog.Warning(pcb.Messagef("Skipping class %q, which has no plans", className))
+					malformedEntries = append(malformedEntries, malformedServiceClassEntry(className))
+				}
+			}
+			payloadServiceClasses, payloadServicePlans, err = convertAndFilterCatalogToNamespacedTypes(broker.Namespace, rewrittenCatalog, broker.Spec.CatalogRestrictions, existingServiceClassMap, existingServicePlanMap)
+		}
 		if err != nil {
 			s := fmt.Sprintf("Error converting catalog payload for broker %q to service-catalog API: %s", broker.Name, err)
 			klog.Warning(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
+			c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog, errorSyncingCatalogMessage+s); err != nil {
 				return err
 			}
 			return err
@@ -239,8 +266,16 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 
 		klog.V(5).Info(pcb.Message("Successfully converted catalog payload from to service-catalog API"))
 
+		if c.catalogSizeExceeded(len(payloadServiceClasses), len(payloadServicePlans)) {
+			s := catalogSizeExceededMessage(len(payloadServiceClasses), len(payloadServicePlans), c.maxCatalogSize)
+			klog.Warning(pcb.Message(s))
+			c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonCatalogTooLarge, s)
+			return c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonCatalogTooLarge, catalogTooLargeMessage+s)
+		}
+
 		// reconcile the serviceClasses that were part of the broker's catalog
 		// payload
+		skippedServiceClasses := sets.NewString()
 		for _, payloadServiceClass := range payloadServiceClasses {
 			existingServiceClass, _ := existingServiceClassMap[payloadServiceClass.Name]
 			delete(existingServiceClassMap, payloadServiceClass.Name)
@@ -251,13 +286,19 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 
 			klog.V(4).Info(pcb.Messagef("Reconciling %s", pretty.ServiceClassName(payloadServiceClass)))
 			if err := c.reconcileServiceClassFromServiceBrokerCatalog(broker, payloadServiceClass, existingServiceClass); err != nil {
+				if c.skipMalformedCatalogEntries && errors.IsInvalid(err) {
+					klog.Warning(pcb.Messagef("Skipping malformed %s: %v", pretty.ServiceClassName(payloadServiceClass), err))
+					malformedEntries = append(malformedEntries, malformedServiceClassEntry(payloadServiceClass.Spec.ExternalName))
+					skippedServiceClasses.Insert(payloadServiceClass.Name)
+					continue
+				}
 				s := fmt.Sprintf(
 					"Error reconciling %s (broker %q): %s",
 					pretty.ServiceClassName(payloadServiceClass), broker.Name, err,
 				)
 				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog,
 					errorSyncingCatalogMessage+s); err != nil {
 					return err
 				}
@@ -283,8 +324,8 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 					pretty.ServiceClassName(existingServiceClass), err,
 				)
 				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog,
 					errorSyncingCatalogMessage+s); err != nil {
 					return err
 				}
@@ -293,6 +334,11 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 		}
 
 		// reconcile the plans that were part of the broker's catalog payload
+		payloadServiceClassNames := make(map[string]string, len(payloadServiceClasses))
+		for _, payloadServiceClass := range payloadServiceClasses {
+			payloadServiceClassNames[payloadServiceClass.Name] = payloadServiceClass.Spec.ExternalName
+		}
+		skippedServicePlans := 0
 		for _, payloadServicePlan := range payloadServicePlans {
 			existingServicePlan, _ := existingServicePlanMap[payloadServicePlan.Name]
 			delete(existingServicePlanMap, payloadServicePlan.Name)
@@ -301,18 +347,30 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 				delete(existingServicePlanMap, payloadServicePlan.Spec.ExternalID)
 			}
 
+			// the plans of a skipped class are skipped along with it
+			if skippedServiceClasses.Has(payloadServicePlan.Spec.ServiceClassRef.Name) {
+				skippedServicePlans++
+				continue
+			}
+
 			klog.V(4).Infof(
 				"ServiceBroker %q: reconciling %s",
 				broker.Name, pretty.ServicePlanName(payloadServicePlan),
 			)
 			if err := c.reconcileServicePlanFromServiceBrokerCatalog(broker, payloadServicePlan, existingServicePlan); err != nil {
+				if c.skipMalformedCatalogEntries && errors.IsInvalid(err) {
+					klog.Warning(pcb.Messagef("Skipping malformed %s: %v", pretty.ServicePlanName(payloadServicePlan), err))
+					malformedEntries = append(malformedEntries, malformedServicePlanEntry(payloadServiceClassNames[payloadServicePlan.Spec.ServiceClassRef.Name], payloadServicePlan.Spec.ExternalName))
+					skippedServicePlans++
+					continue
+				}
 				s := fmt.Sprintf(
 					"Error reconciling %s: %s",
 					pretty.ServicePlanName(payloadServicePlan), err,
 				)
 				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+				c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog,
 					errorSyncingCatalogMessage+s)
 				return err
 			}
@@ -336,8 +394,8 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 					err,
 				)
 				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason,
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+				if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog,
 					errorSyncingCatalogMessage+s); err != nil {
 					return err
 				}
@@ -345,17 +403,38 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			}
 		}
 
-		// everything worked correctly; update the broker's ready condition to
-		// status true
-		if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, successFetchedCatalogReason, successFetchedCatalogMessage); err != nil {
+		// everything worked correctly; record the features advertised in the
+		// catalog, its size and the endpoint which served it, and update the
+		// broker's ready condition to status true
+		classCount := int64(len(payloadServiceClasses) - skippedServiceClasses.Len())
+		planCount := int64(len(payloadServicePlans) - skippedServicePlans)
+		toUpdate := broker.DeepCopy()
+		toUpdate.Status.Features = getServiceBrokerFeatures(brokerCatalog)
+		toUpdate.Status.LastCatalogClassCount = &classCount
+		toUpdate.Status.LastCatalogPlanCount = &planCount
+		toUpdate.Status.LastSuccessfulEndpoint = broker.Spec.URL
+		setServiceBrokerDeprecatedCondition(&toUpdate.Status.CommonServiceBrokerStatus, getServiceBrokerDeprecationNotice(brokerCatalog), time.Now())
+		setServiceBrokerMalformedCatalogEntriesCondition(&toUpdate.Status.CommonServiceBrokerStatus, malformedEntries, time.Now())
+		if err := c.updateServiceBrokerCondition(toUpdate, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionTrue, v1beta1.ReasonFetchedCatalog, successFetchedCatalogMessage); err != nil {
 			return err
 		}
 
-		c.recorder.Event(broker, corev1.EventTypeNormal, successFetchedCatalogReason, successFetchedCatalogMessage)
+		if len(malformedEntries) > 0 {
+			c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonMalformedCatalogEntriesSkipped, malformedCatalogEntriesMessage+strings.Join(malformedEntries, ", "))
+		}
+		c.recorder.Event(broker, corev1.EventTypeNormal, v1beta1.ReasonFetchedCatalog, successFetchedCatalogMessage)
+
+		// the broker is reachable; resume the orphan mitigations which
+		// failed while it was not. This is done on every successful relist
+		// rather than when the broker becomes ready, as the broker may have
+		// recovered between two relists while staying ready.
+		if c.resumeOrphanMitigationOnBrokerRecovery {
+			c.resumeOrphanMitigations(broker.Namespace, broker.Name)
+		}
 
 		// Update metrics with the number of serviceclass and serviceplans from this broker
-		metrics.BrokerServiceClassCount.WithLabelValues(broker.Name).Set(float64(len(payloadServiceClasses)))
-		metrics.BrokerServicePlanCount.WithLabelValues(broker.Name).Set(float64(len(payloadServicePlans)))
+		metrics.BrokerServiceClassCount.WithLabelValues(broker.Name).Set(float64(classCount))
+		metrics.BrokerServicePlanCount.WithLabelValues(broker.Name).Set(float64(planCount))
 
 		return nil
 	}
@@ -384,9 +463,9 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 					v1beta1.ServiceBrokerConditionReady,
 					v1beta1.ConditionUnknown,
 					errorDeletingServicePlanMessage,
-					errorDeletingServicePlanReason+s,
+					v1beta1.ReasonErrorDeletingServicePlan+s,
 				)
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorDeletingServicePlanReason, "%v %v", errorDeletingServicePlanMessage, s)
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorDeletingServicePlan, "%v %v", errorDeletingServicePlanMessage, s)
 				return err
 			}
 		}
@@ -397,13 +476,13 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			if err != nil && !errors.IsNotFound(err) {
 				s := fmt.Sprintf("Error deleting %s: %s", pretty.ServiceClassName(&svcClass), err)
 				klog.Warning(pcb.Message(s))
-				c.recorder.Eventf(broker, corev1.EventTypeWarning, errorDeletingServiceClassReason, "%v %v", errorDeletingServiceClassMessage, s)
+				c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorDeletingServiceClass, "%v %v", errorDeletingServiceClassMessage, s)
 				if err := c.updateServiceBrokerCondition(
 					broker,
 					v1beta1.ServiceBrokerConditionReady,
 					v1beta1.ConditionUnknown,
 					errorDeletingServiceClassMessage,
-					errorDeletingServiceClassReason+s,
+					v1beta1.ReasonErrorDeletingServiceClass+s,
 				); err != nil {
 					return err
 				}
@@ -415,7 +494,7 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 			broker,
 			v1beta1.ServiceBrokerConditionReady,
 			v1beta1.ConditionFalse,
-			successServiceBrokerDeletedReason,
+			v1beta1.ReasonDeletedSuccessfully,
 			"The broker was deleted successfully",
 		); err != nil {
 			return err
@@ -424,7 +503,7 @@ func (c *controller) reconcileServiceBroker(broker *v1beta1.ServiceBroker) error
 		finalizers.Delete(v1beta1.FinalizerServiceCatalog)
 		c.updateServiceBrokerFinalizers(broker, finalizers.List())
 
-		c.recorder.Eventf(broker, corev1.EventTypeNormal, successServiceBrokerDeletedReason, successServiceBrokerDeletedMessage, broker.Name)
+		c.recorder.Eventf(broker, corev1.EventTypeNormal, v1beta1.ReasonDeletedSuccessfully, successServiceBrokerDeletedMessage, broker.Name)
 		klog.V(5).Info(pcb.Message("Successfully deleted"))
 
 		// delete the metrics associated with this broker
@@ -511,8 +590,8 @@ func (c *controller) reconcileServiceClassFromServiceBrokerCatalog(broker *v1bet
 		if err != nil {
 			s := fmt.Sprintf("Error updating status of %s: %v", pretty.ServiceClassName(updatedServiceClass), err)
 			klog.Warning(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
+			c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog, errorSyncingCatalogMessage+s); err != nil {
 				return err
 			}
 			return err
@@ -527,6 +606,7 @@ func (c *controller) reconcileServiceClassFromServiceBrokerCatalog(broker *v1bet
 func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta1.ServiceBroker, servicePlan, existingServicePlan *v1beta1.ServicePlan) error {
 	pcb := pretty.NewServiceBrokerContextBuilder(broker)
 	servicePlan.Spec.ServiceBrokerName = broker.Name
+	c.warnOnUnsupportedParameterSchemas(broker, pcb, pretty.ServicePlanName(servicePlan), &servicePlan.Spec.CommonServicePlanSpec)
 
 	if existingServicePlan == nil {
 		otherServicePlan, err := c.servicePlanLister.ServicePlans(broker.Namespace).Get(servicePlan.Name)
@@ -552,11 +632,22 @@ func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta
 
 		// An error returned from a lister Get call means that the object does
 		// not exist.  Create a new ServicePlan.
-		if _, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).Create(servicePlan); err != nil {
+		createdPlan, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).Create(servicePlan)
+		if err != nil {
 			klog.Error(pcb.Messagef("Error creating %s: %v", pretty.ServicePlanName(servicePlan), err))
 			return err
 		}
 
+		// The status is not persisted when the plan is created, so the
+		// costs have to be written separately.
+		if len(servicePlan.Status.Costs) > 0 && !reflect.DeepEqual(createdPlan.Status.Costs, servicePlan.Status.Costs) {
+			createdPlan.Status.Costs = servicePlan.Status.Costs
+			if _, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).UpdateStatus(createdPlan); err != nil {
+				klog.Error(pcb.Messagef("Error updating status of %s: %v", pretty.ServicePlanName(createdPlan), err))
+				return err
+			}
+		}
+
 		return nil
 	}
 
@@ -589,16 +680,27 @@ func (c *controller) reconcileServicePlanFromServiceBrokerCatalog(broker *v1beta
 		return err
 	}
 
-	if updatedPlan.Status.RemovedFromBrokerCatalog {
+	if c.revalidateInstancesOnPlanSchemaChange && !reflect.DeepEqual(existingServicePlan.Spec.InstanceCreateParameterSchema, updatedPlan.Spec.InstanceCreateParameterSchema) {
+		if instances, err := c.findServiceInstancesOnServicePlan(updatedPlan); err != nil {
+			klog.Warning(pcb.Messagef("Error listing the instances of %s to check their parameters: %v", pretty.ServicePlanName(updatedPlan), err))
+		} else {
+			c.revalidateServiceInstanceParameters(instances.Items, updatedPlan.Spec.ExternalName, updatedPlan.Spec.InstanceCreateParameterSchema)
+		}
+	}
+
+	if updatedPlan.Status.RemovedFromBrokerCatalog || !reflect.DeepEqual(updatedPlan.Status.Costs, servicePlan.Status.Costs) {
+		if updatedPlan.Status.RemovedFromBrokerCatalog {
+			klog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ServicePlanName(updatedPlan)))
+		}
 		updatedPlan.Status.RemovedFromBrokerCatalog = false
-		klog.V(4).Info(pcb.Messagef("Resetting RemovedFromBrokerCatalog status on %s", pretty.ServicePlanName(updatedPlan)))
+		updatedPlan.Status.Costs = servicePlan.Status.Costs
 
 		_, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).UpdateStatus(updatedPlan)
 		if err != nil {
 			s := fmt.Sprintf("Error updating status of %s: %v", pretty.ServicePlanName(updatedPlan), err)
 			klog.Error(pcb.Message(s))
-			c.recorder.Eventf(broker, corev1.EventTypeWarning, errorSyncingCatalogReason, s)
-			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, errorSyncingCatalogReason, errorSyncingCatalogMessage+s); err != nil {
+			c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorSyncingCatalog, s)
+			if err := c.updateServiceBrokerCondition(broker, v1beta1.ServiceBrokerConditionReady, v1beta1.ConditionFalse, v1beta1.ReasonErrorSyncingCatalog, errorSyncingCatalogMessage+s); err != nil {
 				return err
 			}
 			return err
@@ -625,8 +727,10 @@ func updateCommonStatusCondition(pcb *pretty.ContextBuilder, meta metav1.ObjectM
 		newCondition.LastTransitionTime = metav1.NewTime(t)
 		commonStatus.Conditions = []v1beta1.ServiceBrokerCondition{newCondition}
 	} else {
+		found := false
 		for i, cond := range commonStatus.Conditions {
 			if cond.Type == conditionType {
+				found = true
 				if cond.Status != newCondition.Status {
 					klog.Info(pcb.Messagef(
 						"Found status change for condition %q: %q -> %q; setting lastTransitionTime to %v",
@@ -641,6 +745,12 @@ func updateCommonStatusCondition(pcb *pretty.ContextBuilder, meta metav1.ObjectM
 				break
 			}
 		}
+		// the conditions may only hold the ones set ahead of the others,
+		// such as InsecureSkipTLSVerify and Deprecated
+		if !found {
+			newCondition.LastTransitionTime = metav1.NewTime(t)
+			commonStatus.Conditions = append(commonStatus.Conditions, newCondition)
+		}
 	}
 
 	// Set status.ReconciledGeneration && status.LastCatalogRetrievalTime if updating ready condition to true
@@ -660,6 +770,7 @@ func (c *controller) updateServiceBrokerCondition(broker *v1beta1.ServiceBroker,
 
 	pcb := pretty.NewServiceBrokerContextBuilder(toUpdate)
 	updateCommonStatusCondition(pcb, toUpdate.ObjectMeta, &toUpdate.Status.CommonServiceBrokerStatus, conditionType, status, reason, message)
+	setInsecureSkipTLSVerifyCondition(&toUpdate.Status.CommonServiceBrokerStatus, c.isBrokerInsecureSkipTLSVerify(&broker.Spec.CommonServiceBrokerSpec), time.Now())
 
 	klog.V(4).Info(pcb.Messagef("Updating ready condition to %v", status))
 	_, err := c.serviceCatalogClient.ServiceBrokers(broker.Namespace).UpdateStatus(toUpdate)
@@ -710,12 +821,12 @@ func (c *controller) getCurrentServiceClassesAndPlansForNamespacedBroker(broker
 	}
 	existingServiceClasses, err := c.serviceCatalogClient.ServiceClasses(broker.Namespace).List(listOpts)
 	if err != nil {
-		c.recorder.Eventf(broker, corev1.EventTypeWarning, errorListingServiceClassesReason, "%v %v", errorListingServiceClassesMessage, err)
+		c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorListingServiceClasses, "%v %v", errorListingServiceClassesMessage, err)
 		if err := c.updateServiceBrokerCondition(
 			broker,
 			v1beta1.ServiceBrokerConditionReady,
 			v1beta1.ConditionUnknown,
-			errorListingServiceClassesReason,
+			v1beta1.ReasonErrorListingServiceClasses,
 			errorListingServiceClassesMessage,
 		); err != nil {
 			return nil, nil, err
@@ -727,12 +838,12 @@ func (c *controller) getCurrentServiceClassesAndPlansForNamespacedBroker(broker
 
 	existingServicePlans, err := c.serviceCatalogClient.ServicePlans(broker.Namespace).List(listOpts)
 	if err != nil {
-		c.recorder.Eventf(broker, corev1.EventTypeWarning, errorListingServicePlansReason, "%v %v", errorListingServicePlansMessage, err)
+		c.recorder.Eventf(broker, corev1.EventTypeWarning, v1beta1.ReasonErrorListingServicePlans, "%v %v", errorListingServicePlansMessage, err)
 		if err := c.updateServiceBrokerCondition(
 			broker,
 			v1beta1.ServiceBrokerConditionReady,
 			v1beta1.ConditionUnknown,
-			errorListingServicePlansReason,
+			v1beta1.ReasonErrorListingServicePlans,
 			errorListingServicePlansMessage,
 		); err != nil {
 			return nil, nil, err
@@ -765,6 +876,185 @@ func convertServicePlanListToMap(list []v1beta1.ServicePlan) map[string]*v1beta1
 	return ret
 }
 
+// isBrokerInsecureSkipTLSVerify returns whether TLS certificate verification is
+// skipped for a broker with the given spec.
+func (c *controller) isBrokerInsecureSkipTLSVerify(spec *v1beta1.CommonServiceBrokerSpec) bool {
+	return c.allowBrokerInsecureSkipTLSVerify && spec.InsecureSkipTLSVerify
+}
+
+// warnOnInsecureSkipTLSVerify records a warning event when the client for a
+// broker skips TLS certificate verification, or when the broker asks to skip
+// it but the controller does not allow it.
+func (c *controller) warnOnInsecureSkipTLSVerify(broker runtime.Object, spec *v1beta1.CommonServiceBrokerSpec, clientConfig *osb.ClientConfiguration, pcb *pretty.ContextBuilder) {
+	switch {
+	case clientConfig.Insecure:
+		klog.Warning(pcb.Message(insecureSkipTLSVerifyMessage))
+		c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonInsecureSkipTLSVerify, insecureSkipTLSVerifyMessage)
+	case spec.InsecureSkipTLSVerify:
+		klog.Warning(pcb.Message(insecureSkipTLSVerifyNotAllowedMessage))
+		c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonInsecureSkipTLSVerifyNotAllowed, insecureSkipTLSVerifyNotAllowedMessage)
+	}
+}
+
+// setInsecureSkipTLSVerifyCondition sets the InsecureSkipTLSVerify condition
+// to true while TLS certificate verification is skipped for the broker, and to
+// false once it is verified again. The condition is put ahead of the others so
+// that it doesn't replace the broker's Ready state in LastConditionState.
+func setInsecureSkipTLSVerifyCondition(commonStatus *v1beta1.CommonServiceBrokerStatus, insecure bool, t time.Time) {
+	newCondition := v1beta1.ServiceBrokerCondition{
+		Type:               v1beta1.ServiceBrokerConditionInsecureSkipTLSVerify,
+		Status:             v1beta1.ConditionFalse,
+		Reason:             v1beta1.ReasonTLSVerificationEnabled,
+		Message:            tlsVerificationEnabledMessage,
+		LastTransitionTime: metav1.NewTime(t),
+	}
+	if insecure {
+		newCondition.Status = v1beta1.ConditionTrue
+		newCondition.Reason = v1beta1.ReasonInsecureSkipTLSVerify
+		newCondition.Message = insecureSkipTLSVerifyMessage
+	}
+
+	for i, cond := range commonStatus.Conditions {
+		if cond.Type == v1beta1.ServiceBrokerConditionInsecureSkipTLSVerify {
+			if cond.Status == newCondition.Status {
+				newCondition.LastTransitionTime = cond.LastTransitionTime
+			}
+			commonStatus.Conditions[i] = newCondition
+			return
+		}
+	}
+
+	if insecure {
+		commonStatus.Conditions = append([]v1beta1.ServiceBrokerCondition{newCondition}, commonStatus.Conditions...)
+	}
+}
+
+// isServiceBrokerReady returns whether the broker has a ready condition with
+// status true.
+func isServiceBrokerReady(commonStatus *v1beta1.CommonServiceBrokerStatus) bool {
+	for _, condition := range commonStatus.Conditions {
+		if condition.Type == v1beta1.ServiceBrokerConditionReady {
+			return condition.Status == v1beta1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// setServiceBrokerDeprecatedCondition sets the Deprecated condition to true
+// with the given notice while the catalog of the broker announces
+// deprecations, and to false once it no longer does. Like the
+// InsecureSkipTLSVerify condition, it is put ahead of the others.
+func setServiceBrokerDeprecatedCondition(commonStatus *v1beta1.CommonServiceBrokerStatus, notice string, t time.Time) {
+	newCondition := v1beta1.ServiceBrokerCondition{
+		Type:               v1beta1.ServiceBrokerConditionDeprecated,
+		Status:             v1beta1.ConditionFalse,
+		Reason:             v1beta1.ReasonBrokerNotDeprecated,
+		Message:            brokerNotDeprecatedMessage,
+		LastTransitionTime: metav1.NewTime(t),
+	}
+	if notice != "" {
+		newCondition.Status = v1beta1.ConditionTrue
+		newCondition.Reason = v1beta1.ReasonBrokerDeprecated
+		newCondition.Message = brokerDeprecatedMessage + notice
+	}
+
+	for i, cond := range commonStatus.Conditions {
+		if cond.Type == v1beta1.ServiceBrokerConditionDeprecated {
+			if cond.Status == newCondition.Status {
+				newCondition.LastTransitionTime = cond.LastTransitionTime
+			}
+			commonStatus.Conditions[i] = newCondition
+			return
+		}
+	}
+
+	if notice != "" {
+		commonStatus.Conditions = append([]v1beta1.ServiceBrokerCondition{newCondition}, commonStatus.Conditions...)
+	}
+}
+
+// setServiceBrokerMalformedCatalogEntriesCondition sets the
+// MalformedCatalogEntries condition to true, listing the given entries, while
+// malformed entries of the catalog of the broker are skipped, and to false
+// once there are none. Like the Deprecated condition, it is put ahead of the
+// others.
+func setServiceBrokerMalformedCatalogEntriesCondition(commonStatus *v1beta1.CommonServiceBrokerStatus, entries []string, t time.Time) {
+	newCondition := v1beta1.ServiceBrokerCondition{
+		Type:               v1beta1.ServiceBrokerConditionMalformedCatalogEntries,
+		Status:             v1beta1.ConditionFalse,
+		Reason:             v1beta1.ReasonNoMalformedCatalogEntries,
+		Message:            noMalformedCatalogEntriesMessage,
+		LastTransitionTime: metav1.NewTime(t),
+	}
+	if len(entries) > 0 {
+		newCondition.Status = v1beta1.ConditionTrue
+		newCondition.Reason = v1beta1.ReasonMalformedCatalogEntriesSkipped
+		newCondition.Message = malformedCatalogEntriesMessage + strings.Join(entries, ", ")
+	}
+
+	for i, cond := range commonStatus.Conditions {
+		if cond.Type == v1beta1.ServiceBrokerConditionMalformedCatalogEntries {
+			if cond.Status == newCondition.Status {
+				newCondition.LastTransitionTime = cond.LastTransitionTime
+			}
+			commonStatus.Conditions[i] = newCondition
+			return
+		}
+	}
+
+	if len(entries) > 0 {
+		commonStatus.Conditions = append([]v1beta1.ServiceBrokerCondition{newCondition}, commonStatus.Conditions...)
+	}
+}
+
+// warnOnUnsupportedParameterSchemas records a warning event on a broker for
+// each parameter schema of one of its plans declaring a JSON Schema draft
+// which is not supported. The plan is synchronized nonetheless, so that a
+// single plan does not fail the relist of the whole catalog.
+func (c *controller) warnOnUnsupportedParameterSchemas(broker runtime.Object, pcb *pretty.ContextBuilder, planName string, spec *v1beta1.CommonServicePlanSpec) {
+	schemas := []struct {
+		field  string
+		schema *runtime.RawExtension
+	}{
+		{"instanceCreateParameterSchema", spec.InstanceCreateParameterSchema},
+		{"instanceUpdateParameterSchema", spec.InstanceUpdateParameterSchema},
+		{"serviceBindingCreateParameterSchema", spec.ServiceBindingCreateParameterSchema},
+	}
+	for _, s := range schemas {
+		if draft, unsupported := getUnsupportedParameterSchemaDraft(s.schema); unsupported {
+			msg := fmt.Sprintf("The %s of %s declares the unsupported JSON Schema %q; supported drafts are %v", s.field, planName, draft, supportedParameterSchemaURIs.List())
+			klog.Warning(pcb.Message(msg))
+			c.recorder.Event(broker, corev1.EventTypeWarning, v1beta1.ReasonUnsupportedParameterSchema, msg)
+		}
+	}
+}
+
+// warnOnDeprecatedServiceBroker records a warning event on an instance being
+// provisioned at a broker whose Deprecated condition is true.
+func (c *controller) warnOnDeprecatedServiceBroker(instance *v1beta1.ServiceInstance, brokerName string) {
+	var status v1beta1.CommonServiceBrokerStatus
+	if instance.Spec.ClusterServiceClassSpecified() {
+		broker, err := c.clusterServiceBrokerLister.Get(brokerName)
+		if err != nil {
+			return
+		}
+		status = broker.Status.CommonServiceBrokerStatus
+	} else {
+		broker, err := c.serviceBrokerLister.ServiceBrokers(instance.Namespace).Get(brokerName)
+		if err != nil {
+			return
+		}
+		status = broker.Status.CommonServiceBrokerStatus
+	}
+
+	for _, cond := range status.Conditions {
+		if cond.Type == v1beta1.ServiceBrokerConditionDeprecated && cond.Status == v1beta1.ConditionTrue {
+			c.recorder.Eventf(instance, corev1.EventTypeWarning, v1beta1.ReasonBrokerDeprecated, "Provisioning at deprecated broker %q: %s", brokerName, cond.Message)
+			return
+		}
+	}
+}
+
 func getServiceBrokerLastConditionState(status v1beta1.CommonServiceBrokerStatus) string {
 	if len(status.Conditions) > 0 {
 		condition := status.Conditions[len(status.Conditions)-1]
@@ -188,7 +188,7 @@ func TestReconcileServiceBrokerDelete(t *testing.T) {
 
 			events := getRecordedEvents(testController)
 
-			expectedEvent := normalEventBuilder(successServiceBrokerDeletedReason).msg(
+			expectedEvent := normalEventBuilder(v1beta1.ReasonDeletedSuccessfully).msg(
 				"The servicebroker test-servicebroker was deleted successfully.",
 			)
 			if err := checkEvents(events, expectedEvent.stringArr()); err != nil {
@@ -462,4 +462,10 @@ func TestReconcileServiceBrokerExistingServiceClassAndServicePlan(t *testing.T)
 	if updateObject.Status.LastConditionState != "Ready" {
 		t.Fatalf("LastConditionState has unexpected value. Expected: %v, got: %v", "Ready", updateObject.Status.LastConditionState)
 	}
+	if c := updateObject.Status.LastCatalogClassCount; c == nil || *c != 1 {
+		t.Fatalf("LastCatalogClassCount has unexpected value. Expected: 1, got: %v", c)
+	}
+	if e, a := getTestServiceBroker().Spec.URL, updateObject.Status.LastSuccessfulEndpoint; e != a {
+		t.Fatalf("LastSuccessfulEndpoint has unexpected value. %s", expectedGot(e, a))
+	}
 }
@@ -21,9 +21,11 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog"
 
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	"github.com/kubernetes-incubator/service-catalog/pkg/pretty"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -78,6 +80,10 @@ func (c *controller) reconcileServicePlan(servicePlan *v1beta1.ServicePlan) erro
 	pcb := pretty.NewContextBuilder(pretty.ServicePlan, servicePlan.Namespace, servicePlan.Name, "")
 	klog.Infof("ServicePlan %q (ExternalName: %q): processing", servicePlan.Name, servicePlan.Spec.ExternalName)
 
+	if err := c.syncServicePlanBindability(servicePlan); err != nil {
+		return err
+	}
+
 	if !servicePlan.Status.RemovedFromBrokerCatalog {
 		return nil
 	}
@@ -98,6 +104,30 @@ func (c *controller) reconcileServicePlan(servicePlan *v1beta1.ServicePlan) erro
 	return c.serviceCatalogClient.ServicePlans(servicePlan.Namespace).Delete(servicePlan.Name, &metav1.DeleteOptions{})
 }
 
+// syncServicePlanBindability flags the bindings of the instances of a
+// ServicePlan which a broker relist made non-bindable, and clears the flag
+// once the plan is bindable again.
+func (c *controller) syncServicePlanBindability(servicePlan *v1beta1.ServicePlan) error {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.NamespacedServiceBroker) {
+		return nil
+	}
+
+	serviceClass, err := c.serviceClassLister.ServiceClasses(servicePlan.Namespace).Get(servicePlan.Spec.ServiceClassRef.Name)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	instances, err := c.getServiceInstancesOnPlan(servicePlan.Namespace + "/" + servicePlan.Name)
+	if err != nil {
+		return err
+	}
+
+	return c.syncServiceBindingsPlanBindability(instances, servicePlan.Spec.ExternalName, isServicePlanBindable(serviceClass, servicePlan))
+}
+
 func (c *controller) findServiceInstancesOnServicePlan(servicePlan *v1beta1.ServicePlan) (*v1beta1.ServiceInstanceList, error) {
 	labelSelector := labels.SelectorFromSet(labels.Set{
 		v1beta1.GroupName + "/" + v1beta1.FilterSpecServicePlanRefName: servicePlan.Name,
@@ -18,9 +18,13 @@ package controller
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"reflect"
 	"runtime/debug"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,6 +39,7 @@ import (
 	servicecatalogclientset "github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/diff"
@@ -45,9 +50,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/util/sets"
+	kubeinformers "k8s.io/client-go/informers"
 	clientgofake "k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 )
 
 // NOTE:
@@ -1386,6 +1395,65 @@ type bindingParameters struct {
 	Args []string `json:"args"`
 }
 
+// TestWorkerNonRetryableError tests that a resource failing with a
+// nonRetryableError is dropped rather than being retried on a tight loop by
+// the queue.
+func TestWorkerNonRetryableError(t *testing.T) {
+	cases := []struct {
+		name          string
+		err           error
+		expectRequeue bool
+	}{
+		{
+			name:          "retryable error",
+			err:           fmt.Errorf("broker unavailable"),
+			expectRequeue: true,
+		},
+		{
+			name:          "non-retryable error",
+			err:           &nonRetryableError{message: "invalid parameters"},
+			expectRequeue: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			queue := workqueue.NewRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Millisecond, time.Millisecond))
+
+			calls := make(chan string, 100)
+			reconciler := func(key string) error {
+				calls <- key
+				return tc.err
+			}
+
+			queue.Add("test-ns/test-instance")
+			done := make(chan struct{})
+			go func() {
+				worker(queue, "ServiceInstance", maxRetries, true, reconciler)()
+				close(done)
+			}()
+
+			<-calls
+			time.Sleep(100 * time.Millisecond)
+			queue.ShutDown()
+			<-done
+
+			requeued := len(calls) > 0
+			if e, a := tc.expectRequeue, requeued; e != a {
+				t.Fatalf("unexpected requeue on a tight loop; %s", expectedGot(e, a))
+			}
+			if !tc.expectRequeue {
+				if e, a := 0, queue.NumRequeues("test-ns/test-instance"); e != a {
+					t.Fatalf("unexpected number of requeues; %s", expectedGot(e, a))
+				}
+				if e, a := 0, queue.Len(); e != a {
+					t.Fatalf("unexpected queue length; %s", expectedGot(e, a))
+				}
+			}
+		})
+	}
+}
+
 func TestEmptyCatalogConversion(t *testing.T) {
 	serviceClasses, servicePlans, err := convertAndFilterCatalog(&osb.CatalogResponse{}, nil, emptyServiceClasses, emptyServicePlans)
 	if err != nil {
@@ -1662,6 +1730,105 @@ func TestCatalogConversionMultipleClusterServiceClasses(t *testing.T) {
 
 }
 
+func TestGetServiceBrokerFeatures(t *testing.T) {
+	cases := []struct {
+		name     string
+		services []osb.Service
+		expected []v1beta1.ServiceBrokerFeature
+	}{
+		{
+			name:     "no services",
+			expected: nil,
+		},
+		{
+			name:     "no optional features",
+			services: []osb.Service{{ID: "a"}, {ID: "b", PlanUpdatable: falsePtr()}},
+			expected: nil,
+		},
+		{
+			name:     "bindings retrievable",
+			services: []osb.Service{{ID: "a"}, {ID: "b", BindingsRetrievable: true}},
+			expected: []v1beta1.ServiceBrokerFeature{v1beta1.ServiceBrokerFeatureAsyncBindings},
+		},
+		{
+			name:     "plan updatable",
+			services: []osb.Service{{ID: "a", PlanUpdatable: truePtr()}},
+			expected: []v1beta1.ServiceBrokerFeature{v1beta1.ServiceBrokerFeaturePlanUpdates},
+		},
+		{
+			name:     "all features",
+			services: []osb.Service{{ID: "a", PlanUpdatable: truePtr()}, {ID: "b", BindingsRetrievable: true}},
+			expected: []v1beta1.ServiceBrokerFeature{v1beta1.ServiceBrokerFeatureAsyncBindings, v1beta1.ServiceBrokerFeaturePlanUpdates},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			features := getServiceBrokerFeatures(&osb.CatalogResponse{Services: tc.services})
+			if !reflect.DeepEqual(tc.expected, features) {
+				t.Errorf("Unexpected broker features; %s", expectedGot(tc.expected, features))
+			}
+		})
+	}
+}
+
+func TestGetServiceBrokerDeprecationNotice(t *testing.T) {
+	cases := []struct {
+		name     string
+		services []osb.Service
+		expected string
+	}{
+		{
+			name: "no services",
+		},
+		{
+			name:     "no deprecation metadata",
+			services: []osb.Service{{Name: "a"}, {Name: "b", Metadata: map[string]interface{}{"displayName": "B"}}},
+		},
+		{
+			name:     "deprecation notice",
+			services: []osb.Service{{Name: "a", Metadata: map[string]interface{}{"deprecationNotice": " Use service b instead. "}}},
+			expected: `service "a" is deprecated: Use service b instead.`,
+		},
+		{
+			name:     "end of life",
+			services: []osb.Service{{Name: "a", Metadata: map[string]interface{}{"endOfLife": "2020-06-30"}}},
+			expected: `service "a" reaches its end of life on 2020-06-30`,
+		},
+		{
+			name: "deprecation notice and end of life",
+			services: []osb.Service{{Name: "a", Metadata: map[string]interface{}{
+				"deprecationNotice": "Use service b instead.",
+				"endOfLife":         "2020-06-30",
+			}}},
+			expected: `service "a" reaches its end of life on 2020-06-30: Use service b instead.`,
+		},
+		{
+			name: "several services",
+			services: []osb.Service{
+				{Name: "a", Metadata: map[string]interface{}{"deprecationNotice": "Use service c instead."}},
+				{Name: "b"},
+				{Name: "c", Metadata: map[string]interface{}{"endOfLife": "2021-01-01"}},
+			},
+			expected: `service "a" is deprecated: Use service c instead.; service "c" reaches its end of life on 2021-01-01`,
+		},
+		{
+			name: "invalid values are ignored",
+			services: []osb.Service{{Name: "a", Metadata: map[string]interface{}{
+				"deprecationNotice": true,
+				"endOfLife":         "next year",
+			}}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			notice := getServiceBrokerDeprecationNotice(&osb.CatalogResponse{Services: tc.services})
+			if e, a := tc.expected, notice; e != a {
+				t.Errorf("Unexpected deprecation notice; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
 func TestConvertAndFilterCatalog(t *testing.T) {
 	cases := []struct {
 		name         string
@@ -1931,6 +2098,86 @@ func strPtr(s string) *string {
 	return &s
 }
 
+func TestParseServicePlanCosts(t *testing.T) {
+	cases := []struct {
+		name     string
+		metadata string
+		expected []v1beta1.ServicePlanCost
+	}{
+		{
+			name:     "no metadata",
+			metadata: `null`,
+		},
+		{
+			name:     "no costs",
+			metadata: `{"bullets": ["fast"]}`,
+		},
+		{
+			name:     "costs of an unexpected type",
+			metadata: `{"costs": "free"}`,
+		},
+		{
+			name:     "single cost",
+			metadata: `{"costs": [{"amount": {"usd": 99.5}, "unit": "MONTHLY"}]}`,
+			expected: []v1beta1.ServicePlanCost{
+				{Amount: map[string]string{"usd": "99.5"}, Unit: "MONTHLY"},
+			},
+		},
+		{
+			name:     "several costs and currencies",
+			metadata: `{"costs": [{"amount": {"USD": 649, "eur": 600}, "unit": "MONTHLY"}, {"amount": {"usd": 0.02}, "unit": "PER 1GB"}]}`,
+			expected: []v1beta1.ServicePlanCost{
+				{Amount: map[string]string{"usd": "649", "eur": "600"}, Unit: "MONTHLY"},
+				{Amount: map[string]string{"usd": "0.02"}, Unit: "PER 1GB"},
+			},
+		},
+		{
+			name:     "malformed costs are skipped",
+			metadata: `{"costs": [{"amount": {"usd": "ten"}, "unit": "MONTHLY"}, {"amount": {"usd": 1}}, {"unit": "MONTHLY"}, "free", {"amount": {"usd": 1}, "unit": "DAILY"}]}`,
+			expected: []v1beta1.ServicePlanCost{
+				{Amount: map[string]string{"usd": "1"}, Unit: "DAILY"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var metadata map[string]interface{}
+			if err := json.Unmarshal([]byte(tc.metadata), &metadata); err != nil {
+				t.Fatalf("Failed to unmarshal metadata: %v", err)
+			}
+			costs := parseServicePlanCosts(metadata)
+			if !reflect.DeepEqual(tc.expected, costs) {
+				t.Errorf("Unexpected costs; %s", expectedGot(tc.expected, costs))
+			}
+		})
+	}
+}
+
+func TestCatalogConversionClusterServicePlanCosts(t *testing.T) {
+	catalog := &osb.CatalogResponse{}
+	err := json.Unmarshal([]byte(`{"services": [{"name": "db", "id": "db-id", "bindable": true, "plans": [
+		{"name": "small", "id": "small-id", "metadata": {"costs": [{"amount": {"usd": 10}, "unit": "MONTHLY"}]}},
+		{"name": "free", "id": "free-id", "free": true}
+	]}]}`), &catalog)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal test catalog: %v", err)
+	}
+
+	_, plans, err := convertAndFilterCatalog(catalog, nil, emptyServiceClasses, emptyServicePlans)
+	if err != nil {
+		t.Fatalf("Failed to convertAndFilterCatalog: %v", err)
+	}
+
+	expected := []v1beta1.ServicePlanCost{{Amount: map[string]string{"usd": "10"}, Unit: "MONTHLY"}}
+	if e, a := expected, plans[0].Status.Costs; !reflect.DeepEqual(e, a) {
+		t.Errorf("Unexpected costs of plan %q; %s", plans[0].Spec.ExternalName, expectedGot(e, a))
+	}
+	if a := plans[1].Status.Costs; a != nil {
+		t.Errorf("Unexpected costs of plan %q; %s", plans[1].Spec.ExternalName, expectedGot(nil, a))
+	}
+}
+
 func TestCatalogConversionClusterServicePlanBindable(t *testing.T) {
 	catalog := &osb.CatalogResponse{}
 	err := json.Unmarshal([]byte(testCatalogForClusterServicePlanBindableOverride), &catalog)
@@ -2331,6 +2578,255 @@ func TestIsClusterServiceBrokerReady(t *testing.T) {
 	}
 }
 
+// TestNewClientConfigurationForBrokerInsecureSkipTLSVerify tests that TLS
+// verification is only skipped when both the broker and the controller allow it.
+func TestNewClientConfigurationForBrokerInsecureSkipTLSVerify(t *testing.T) {
+	cases := []struct {
+		name          string
+		skipTLSVerify bool
+		allowed       bool
+		insecure      bool
+	}{
+		{name: "neither set"},
+		{name: "only broker set", skipTLSVerify: true},
+		{name: "only controller set", allowed: true},
+		{name: "both set", skipTLSVerify: true, allowed: true, insecure: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			broker := getTestClusterServiceBroker()
+			broker.Spec.InsecureSkipTLSVerify = tc.skipTLSVerify
+
+			clientConfig := NewClientConfigurationForBroker(broker.ObjectMeta, &broker.Spec.CommonServiceBrokerSpec, nil, tc.allowed)
+			if e, a := tc.insecure, clientConfig.Insecure; e != a {
+				t.Fatalf("Unexpected Insecure; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+// timeoutError is a net.Error that timed out.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+// TestWarnIfOperationStuck tests that an operation in progress for longer than
+// the stuck operation warning interval is reminded of at most once per
+// interval, however often it is reconciled.
+func TestWarnIfOperationStuck(t *testing.T) {
+	_, _, _, testController, _ := newTestController(t, noFakeActions())
+	testController.stuckOperationWarningInterval = time.Hour
+
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Status.CurrentOperation = v1beta1.ServiceInstanceOperationProvision
+	startTime := metav1.NewTime(time.Now().Add(-90 * time.Minute))
+	instance.Status.OperationStartTime = &startTime
+
+	warn := func() {
+		testController.warnIfOperationStuck(instance, instance.UID, string(instance.Status.CurrentOperation), instance.Status.OperationStartTime)
+	}
+	assertWarnings := func(expected int) {
+		events := getRecordedEvents(testController)
+		if e, a := expected, len(events); e != a {
+			t.Fatalf("unexpected number of events: %v: %v", expectedGot(e, a), events)
+		}
+		for _, event := range events {
+			if !strings.HasPrefix(event, corev1.EventTypeWarning+" "+v1beta1.ReasonOperationStuck+" The Provision operation has been in progress for 1h30m") {
+				t.Fatalf("unexpected event: %q", event)
+			}
+		}
+	}
+
+	// The first reconciliation past the interval is reminded of
+	warn()
+	assertWarnings(1)
+
+	// Further reconciliations within the interval are not
+	warn()
+	warn()
+	assertWarnings(0)
+
+	// Once the interval has passed since the last reminder, it is repeated
+	warning := testController.stuckOperationWarnings[instance.UID]
+	warning.lastWarningTime = warning.lastWarningTime.Add(-time.Hour)
+	testController.stuckOperationWarnings[instance.UID] = warning
+	warn()
+	warn()
+	assertWarnings(1)
+
+	// A finished operation is forgotten
+	instance.Status.CurrentOperation = ""
+	instance.Status.OperationStartTime = nil
+	warn()
+	assertWarnings(0)
+	if _, ok := testController.stuckOperationWarnings[instance.UID]; ok {
+		t.Fatalf("expected the reminders of the finished operation to be forgotten")
+	}
+
+	// A recent operation is not reminded of
+	recentStartTime := metav1.NewTime(time.Now().Add(-time.Minute))
+	instance.Status.CurrentOperation = v1beta1.ServiceInstanceOperationUpdate
+	instance.Status.OperationStartTime = &recentStartTime
+	warn()
+	assertWarnings(0)
+}
+
+// TestSelectorFiltersEnqueuedObjects tests that the brokers, instances and
+// bindings not matching the selector of the controller are not enqueued,
+// while they remain visible through its listers.
+func TestSelectorFiltersEnqueuedObjects(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+	testController.selector = labels.SelectorFromSet(labels.Set{"shard": "a"})
+
+	selectedInstance := getTestServiceInstance()
+	selectedInstance.Labels = map[string]string{"shard": "a"}
+	otherInstance := getTestServiceInstance()
+	otherInstance.Name = "other-instance"
+	otherInstance.Labels = map[string]string{"shard": "b"}
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(selectedInstance)
+	sharedInformers.ServiceInstances().Informer().GetStore().Add(otherInstance)
+
+	testController.instanceAdd(otherInstance)
+	if e, a := 0, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("unexpected number of enqueued instances; %s", expectedGot(e, a))
+	}
+	testController.instanceAdd(selectedInstance)
+	if e, a := 1, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("unexpected number of enqueued instances; %s", expectedGot(e, a))
+	}
+
+	instances, err := testController.instanceLister.List(labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error listing instances: %v", err)
+	}
+	if e, a := 2, len(instances); e != a {
+		t.Fatalf("expected the lister not to be filtered; %s", expectedGot(e, a))
+	}
+
+	// A binding of the other shard enqueues neither itself nor its instance
+	otherBinding := getTestServiceBinding()
+	otherBinding.Labels = map[string]string{"shard": "b"}
+	otherBinding.Spec.InstanceRef.Name = otherInstance.Name
+	testController.bindingCreate(otherBinding)
+	if e, a := 0, testController.bindingQueue.Len(); e != a {
+		t.Fatalf("unexpected number of enqueued bindings; %s", expectedGot(e, a))
+	}
+	if e, a := 1, testController.instanceQueue.Len(); e != a {
+		t.Fatalf("unexpected number of enqueued instances; %s", expectedGot(e, a))
+	}
+
+	otherBroker := getTestClusterServiceBroker()
+	testController.clusterServiceBrokerAdd(otherBroker)
+	if e, a := 0, testController.clusterServiceBrokerQueue.Len(); e != a {
+		t.Fatalf("unexpected number of enqueued brokers; %s", expectedGot(e, a))
+	}
+	selectedBroker := getTestClusterServiceBroker()
+	selectedBroker.Labels = map[string]string{"shard": "a"}
+	testController.clusterServiceBrokerAdd(selectedBroker)
+	if e, a := 1, testController.clusterServiceBrokerQueue.Len(); e != a {
+		t.Fatalf("unexpected number of enqueued brokers; %s", expectedGot(e, a))
+	}
+}
+
+// TestOperationPollingRateLimiter tests that the interval between polls of an
+// operation doubles with every poll up to the maximum interval, and starts
+// over once the operation is forgotten.
+func TestOperationPollingRateLimiter(t *testing.T) {
+	rateLimiter := newOperationPollingRateLimiter(5*time.Second, time.Minute)
+
+	for i, expected := range []time.Duration{
+		5 * time.Second,
+		10 * time.Second,
+		20 * time.Second,
+		40 * time.Second,
+		time.Minute,
+		time.Minute,
+	} {
+		if actual := rateLimiter.When("test-ns/test-instance"); actual != expected {
+			t.Fatalf("poll %d: expected an interval of %v, got %v", i, expected, actual)
+		}
+	}
+
+	rateLimiter.Forget("test-ns/test-instance")
+	if actual := rateLimiter.When("test-ns/test-instance"); actual != 5*time.Second {
+		t.Fatalf("expected the interval to start over after the operation progressed, got %v", actual)
+	}
+}
+
+func TestBrokerErrorCode(t *testing.T) {
+	cases := []struct {
+		name      string
+		err       error
+		errorCode string
+	}{
+		{
+			name: "no error",
+		},
+		{
+			name:      "timeout",
+			err:       &url.Error{Op: "Put", URL: "http://broker", Err: timeoutError{}},
+			errorCode: v1beta1.ErrorCodeBrokerTimeout,
+		},
+		{
+			name:      "transport error",
+			err:       errors.New("connection refused"),
+			errorCode: v1beta1.ErrorCodeBrokerUnreachable,
+		},
+		{
+			name:      "bad request",
+			err:       osb.HTTPStatusCodeError{StatusCode: http.StatusBadRequest},
+			errorCode: v1beta1.ErrorCodeBrokerBadRequest,
+		},
+		{
+			name:      "conflict",
+			err:       osb.HTTPStatusCodeError{StatusCode: http.StatusConflict},
+			errorCode: v1beta1.ErrorCodeBrokerConflict,
+		},
+		{
+			name:      "gone",
+			err:       osb.HTTPStatusCodeError{StatusCode: http.StatusGone},
+			errorCode: v1beta1.ErrorCodeBrokerGone,
+		},
+		{
+			name:      "unprocessable entity",
+			err:       osb.HTTPStatusCodeError{StatusCode: http.StatusUnprocessableEntity},
+			errorCode: v1beta1.ErrorCodeBrokerUnprocessableEntity,
+		},
+		{
+			name:      "async required",
+			err:       osb.HTTPStatusCodeError{StatusCode: http.StatusUnprocessableEntity, ErrorMessage: strPtr(osb.AsyncErrorMessage)},
+			errorCode: v1beta1.ErrorCodeBrokerAsyncRequired,
+		},
+		{
+			name:      "concurrency error",
+			err:       osb.HTTPStatusCodeError{StatusCode: http.StatusUnprocessableEntity, ErrorMessage: strPtr("ConcurrencyError")},
+			errorCode: v1beta1.ErrorCodeBrokerConcurrencyError,
+		},
+		{
+			name:      "requires app",
+			err:       osb.HTTPStatusCodeError{StatusCode: http.StatusUnprocessableEntity, ErrorMessage: strPtr(osb.AppGUIDRequiredErrorMessage)},
+			errorCode: v1beta1.ErrorCodeBrokerRequiresApp,
+		},
+		{
+			name:      "server error",
+			err:       osb.HTTPStatusCodeError{StatusCode: http.StatusServiceUnavailable},
+			errorCode: v1beta1.ErrorCodeBrokerServerError,
+		},
+		{
+			name:      "other HTTP error",
+			err:       osb.HTTPStatusCodeError{StatusCode: http.StatusForbidden},
+			errorCode: v1beta1.ErrorCodeBrokerHTTPError,
+		},
+	}
+	for _, tc := range cases {
+		if e, a := tc.errorCode, brokerErrorCode(tc.err); e != a {
+			t.Errorf("%v: unexpected error code; expected %q, got %q", tc.name, e, a)
+		}
+	}
+}
+
 func TestIsPlanBindable(t *testing.T) {
 	serviceClass := func(bindable bool) *v1beta1.ClusterServiceClass {
 		serviceClass := getTestClusterServiceClass()
@@ -2443,6 +2939,8 @@ func newTestController(t *testing.T, config fakeosb.FakeClientConfiguration) (
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0).Core().V1().Secrets(),
+		kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0).Core().V1().Namespaces(),
 		brokerClFunc,
 		24*time.Hour,
 		osb.LatestAPIVersion().HeaderValue(),
@@ -2451,6 +2949,10 @@ func newTestController(t *testing.T, config fakeosb.FakeClientConfiguration) (
 		7*24*time.Hour,
 		DefaultClusterIDConfigMapName,
 		DefaultClusterIDConfigMapNamespace,
+		Options{
+			OperationPollingInitialInterval: time.Second,
+			BrokerContextPlatform:           ContextProfilePlatformKubernetes,
+		},
 	)
 
 	if err != nil {
@@ -2475,10 +2977,26 @@ func newTestController(t *testing.T, config fakeosb.FakeClientConfiguration) (
 			},
 		}, nil
 	})
+	setTestNamespaces(testController.(*controller), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testNamespace,
+			UID:  testNamespaceGUID,
+		},
+	})
 
 	return fakeKubeClient, fakeCatalogClient, fakeOSBClient, testController.(*controller), serviceCatalogSharedInformers
 }
 
+// setTestNamespaces makes the namespace lister of the test controller list
+// the given namespaces.
+func setTestNamespaces(testController *controller, namespaces ...*corev1.Namespace) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, ns := range namespaces {
+		indexer.Add(ns)
+	}
+	testController.namespaceLister = corelisters.NewNamespaceLister(indexer)
+}
+
 func getRecordedEvents(testController *controller) []string {
 	source := testController.recorder.(*record.FakeRecorder).Events
 	done := false
@@ -2784,6 +3302,23 @@ func assertServiceInstanceCondition(t *testing.T, obj runtime.Object, conditionT
 	}
 }
 
+func assertServiceInstanceConditionErrorCode(t *testing.T, obj runtime.Object, conditionType v1beta1.ServiceInstanceConditionType, errorCode string) {
+	instance, ok := obj.(*v1beta1.ServiceInstance)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceInstance", obj)
+	}
+
+	for _, condition := range instance.Status.Conditions {
+		if condition.Type == conditionType {
+			if condition.ErrorCode != errorCode {
+				fatalf(t, "%v condition had unexpected error code; expected %q, got %q", conditionType, errorCode, condition.ErrorCode)
+			}
+			return
+		}
+	}
+	fatalf(t, "%v condition not found", conditionType)
+}
+
 func assertServiceInstanceOrphanMitigationMissing(t *testing.T, obj runtime.Object, reason ...string) {
 	assertServiceInstanceConditionMissing(t, obj, v1beta1.ServiceInstanceConditionOrphanMitigation)
 }
@@ -2975,13 +3510,13 @@ func assertServiceInstanceOperationInProgressWithParameters(t *testing.T, obj ru
 	var expectedObservedGeneration int64
 	switch operation {
 	case v1beta1.ServiceInstanceOperationProvision:
-		reason = provisioningInFlightReason
+		reason = v1beta1.ReasonProvisionRequestInFlight
 		expectedObservedGeneration = originalInstance.Generation
 	case v1beta1.ServiceInstanceOperationUpdate:
-		reason = instanceUpdatingInFlightReason
+		reason = v1beta1.ReasonUpdateInstanceRequestInFlight
 		expectedObservedGeneration = originalInstance.Generation
 	case v1beta1.ServiceInstanceOperationDeprovision:
-		reason = deprovisioningInFlightReason
+		reason = v1beta1.ReasonDeprovisionRequestInFlight
 		if isServiceInstanceOrphanMitigation(originalInstance) {
 			expectedObservedGeneration = originalInstance.Status.ObservedGeneration
 		} else {
@@ -3004,12 +3539,12 @@ func assertServiceInstanceOperationInProgressWithParameters(t *testing.T, obj ru
 
 func assertServiceInstanceStartingOrphanMitigation(t *testing.T, obj runtime.Object, originalInstance *v1beta1.ServiceInstance) {
 	assertServiceInstanceCurrentOperation(t, obj, v1beta1.ServiceInstanceOperationProvision)
-	assertServiceInstanceReadyFalse(t, obj, startingInstanceOrphanMitigationReason)
+	assertServiceInstanceReadyFalse(t, obj, v1beta1.ReasonStartingInstanceOrphanMitigation)
 	assertServiceInstanceOperationStartTimeSet(t, obj, true)
 	assertServiceInstanceReconciledGeneration(t, obj, originalInstance.Status.ReconciledGeneration)
 	assertServiceInstanceObservedGeneration(t, obj, originalInstance.Generation)
 	assertServiceInstanceProvisioned(t, obj, originalInstance.Status.ProvisionStatus)
-	assertServiceInstanceOrphanMitigationTrue(t, obj, errorProvisionCallFailedReason)
+	assertServiceInstanceOrphanMitigationTrue(t, obj, v1beta1.ReasonProvisionCallFailed)
 	assertServiceInstanceOrphanMitigationInProgressTrue(t, obj)
 	assertServiceInstanceDeprovisionStatus(t, obj, v1beta1.ServiceInstanceDeprovisionStatusRequired)
 }
@@ -3030,21 +3565,21 @@ func assertServiceInstanceOperationSuccessWithParameters(t *testing.T, obj runti
 	switch operation {
 	case v1beta1.ServiceInstanceOperationProvision:
 		provisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
-		reason = successProvisionReason
+		reason = v1beta1.ReasonProvisionedSuccessfully
 		readyStatus = v1beta1.ConditionTrue
 		deprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
 		observedGeneration = originalInstance.Generation
 		reconciledGeneration = observedGeneration
 	case v1beta1.ServiceInstanceOperationUpdate:
 		provisionStatus = v1beta1.ServiceInstanceProvisionStatusProvisioned
-		reason = successUpdateInstanceReason
+		reason = v1beta1.ReasonInstanceUpdatedSuccessfully
 		readyStatus = v1beta1.ConditionTrue
 		deprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusRequired
 		observedGeneration = originalInstance.Generation
 		reconciledGeneration = observedGeneration
 	case v1beta1.ServiceInstanceOperationDeprovision:
 		provisionStatus = v1beta1.ServiceInstanceProvisionStatusNotProvisioned
-		reason = successDeprovisionReason
+		reason = v1beta1.ReasonDeprovisionedSuccessfully
 		readyStatus = v1beta1.ConditionFalse
 		deprovisionStatus = v1beta1.ServiceInstanceDeprovisionStatusSucceeded
 		if isServiceInstanceOrphanMitigation(originalInstance) {
@@ -3052,7 +3587,7 @@ func assertServiceInstanceOperationSuccessWithParameters(t *testing.T, obj runti
 		} else {
 			observedGeneration = originalInstance.Generation
 		}
-		reconciledGeneration = originalInstance.Status.ReconciledGeneration
+		reconciledGeneration = observedGeneration
 	}
 	assertServiceInstanceReadyCondition(t, obj, readyStatus, reason)
 	assertServiceInstanceCurrentOperationClear(t, obj)
@@ -3193,11 +3728,11 @@ func assertServiceInstanceAsyncStartInProgress(t *testing.T, obj runtime.Object,
 	reason := ""
 	switch operation {
 	case v1beta1.ServiceInstanceOperationProvision:
-		reason = asyncProvisioningReason
+		reason = v1beta1.ReasonProvisioning
 	case v1beta1.ServiceInstanceOperationUpdate:
-		reason = asyncUpdatingInstanceReason
+		reason = v1beta1.ReasonUpdatingInstance
 	case v1beta1.ServiceInstanceOperationDeprovision:
-		reason = asyncDeprovisioningReason
+		reason = v1beta1.ReasonDeprovisioning
 	}
 	assertServiceInstanceReadyFalse(t, obj, reason)
 	assertServiceInstanceLastOperation(t, obj, operationKey)
@@ -3216,11 +3751,11 @@ func assertServiceInstanceAsyncStillInProgress(t *testing.T, obj runtime.Object,
 	reason := ""
 	switch operation {
 	case v1beta1.ServiceInstanceOperationProvision:
-		reason = asyncProvisioningReason
+		reason = v1beta1.ReasonProvisioning
 	case v1beta1.ServiceInstanceOperationUpdate:
-		reason = asyncUpdatingInstanceReason
+		reason = v1beta1.ReasonUpdatingInstance
 	case v1beta1.ServiceInstanceOperationDeprovision:
-		reason = asyncDeprovisioningReason
+		reason = v1beta1.ReasonDeprovisioning
 	}
 	assertServiceInstanceReadyFalse(t, obj, reason)
 	assertServiceInstanceLastOperation(t, obj, operationKey)
@@ -3434,6 +3969,37 @@ func assertServiceBindingCondition(t *testing.T, obj runtime.Object, conditionTy
 	}
 }
 
+func assertServiceBindingConditionErrorCode(t *testing.T, obj runtime.Object, conditionType v1beta1.ServiceBindingConditionType, errorCode string) {
+	binding, ok := obj.(*v1beta1.ServiceBinding)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceBinding", obj)
+	}
+
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == conditionType {
+			if condition.ErrorCode != errorCode {
+				fatalf(t, "%v condition had unexpected error code; expected %q, got %q", conditionType, errorCode, condition.ErrorCode)
+			}
+			return
+		}
+	}
+	fatalf(t, "unfound %v condition", conditionType)
+}
+
+func assertServiceBindingConditionMissing(t *testing.T, obj runtime.Object, conditionType v1beta1.ServiceBindingConditionType) {
+	binding, ok := obj.(*v1beta1.ServiceBinding)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceBinding", obj)
+	}
+
+	for _, condition := range binding.Status.Conditions {
+		if condition.Type == conditionType {
+			fatalf(t, "%v condition expected to be missing, but was present with the reason %q and message %q", conditionType, condition.Reason, condition.Message)
+			return
+		}
+	}
+}
+
 func assertServiceBindingReconciledGeneration(t *testing.T, obj runtime.Object, reconciledGeneration int64) {
 	binding, ok := obj.(*v1beta1.ServiceBinding)
 	if !ok {
@@ -3445,6 +4011,17 @@ func assertServiceBindingReconciledGeneration(t *testing.T, obj runtime.Object,
 	}
 }
 
+func assertServiceBindingObservedGeneration(t *testing.T, obj runtime.Object, observedGeneration int64) {
+	binding, ok := obj.(*v1beta1.ServiceBinding)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceBinding", obj)
+	}
+
+	if e, a := observedGeneration, binding.Status.ObservedGeneration; e != a {
+		fatalf(t, "unexpected observed generation: expected %v, got %v", e, a)
+	}
+}
+
 func assertServiceBindingReconciliationNotComplete(t *testing.T, obj runtime.Object) {
 	binding, ok := obj.(*v1beta1.ServiceBinding)
 	if !ok {
@@ -3490,6 +4067,7 @@ func assertServiceBindingErrorBeforeRequest(t *testing.T, obj runtime.Object, re
 	assertServiceBindingCurrentOperationClear(t, obj)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Status.ObservedGeneration)
 	assertServiceBindingInProgressPropertiesNil(t, obj)
 	assertServiceBindingExternalPropertiesUnchanged(t, obj, originalBinding)
 	assertServiceBindingUnbindStatus(t, obj, v1beta1.ServiceBindingUnbindStatusNotRequired)
@@ -3500,6 +4078,7 @@ func assertServiceBindingFailedBeforeRequest(t *testing.T, obj runtime.Object, r
 	assertServiceBindingCurrentOperationClear(t, obj)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Generation)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Generation)
 	assertServiceBindingInProgressPropertiesNil(t, obj)
 	assertServiceBindingExternalPropertiesUnchanged(t, obj, originalBinding)
 	assertServiceBindingUnbindStatus(t, obj, v1beta1.ServiceBindingUnbindStatusNotRequired)
@@ -3513,14 +4092,15 @@ func assertServiceBindingOperationInProgressWithParameters(t *testing.T, obj run
 	reason := ""
 	switch operation {
 	case v1beta1.ServiceBindingOperationBind:
-		reason = bindingInFlightReason
+		reason = v1beta1.ReasonBindingRequestInFlight
 	case v1beta1.ServiceBindingOperationUnbind:
-		reason = unbindingInFlightReason
+		reason = v1beta1.ReasonUnbindingRequestInFlight
 	}
 	assertServiceBindingReadyFalse(t, obj, reason)
 	assertServiceBindingCurrentOperation(t, obj, operation)
 	assertServiceBindingOperationStartTimeSet(t, obj, true)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Status.ObservedGeneration)
 	switch operation {
 	case v1beta1.ServiceBindingOperationBind:
 		assertServiceBindingInProgressPropertiesParameters(t, obj, inProgressParameters, inProgressParametersChecksum)
@@ -3533,9 +4113,10 @@ func assertServiceBindingOperationInProgressWithParameters(t *testing.T, obj run
 
 func assertServiceBindingStartingOrphanMitigation(t *testing.T, obj runtime.Object, originalBinding *v1beta1.ServiceBinding) {
 	assertServiceBindingCurrentOperation(t, obj, v1beta1.ServiceBindingOperationBind)
-	assertServiceBindingReadyFalse(t, obj, errorServiceBindingOrphanMitigation)
+	assertServiceBindingReadyFalse(t, obj, v1beta1.ReasonServiceBindingNeedsOrphanMitigation)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Status.ObservedGeneration)
 	assertServiceBindingOrphanMitigationSet(t, obj, true)
 	assertServiceBindingInProgressPropertiesParameters(t, obj, nil, "")
 	assertServiceBindingUnbindStatus(t, obj, v1beta1.ServiceBindingUnbindStatusRequired)
@@ -3553,11 +4134,11 @@ func assertServiceBindingOperationSuccessWithParameters(t *testing.T, obj runtim
 	)
 	switch operation {
 	case v1beta1.ServiceBindingOperationBind:
-		reason = successInjectedBindResultReason
+		reason = v1beta1.ReasonInjectedBindResult
 		readyStatus = v1beta1.ConditionTrue
 		unbindStatus = v1beta1.ServiceBindingUnbindStatusRequired
 	case v1beta1.ServiceBindingOperationUnbind:
-		reason = successUnboundReason
+		reason = v1beta1.ReasonUnboundSuccessfully
 		readyStatus = v1beta1.ConditionFalse
 		unbindStatus = v1beta1.ServiceBindingUnbindStatusSucceeded
 	}
@@ -3565,6 +4146,7 @@ func assertServiceBindingOperationSuccessWithParameters(t *testing.T, obj runtim
 	assertServiceBindingCurrentOperationClear(t, obj)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Generation)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Generation)
 	if operation == v1beta1.ServiceBindingOperationUnbind {
 		assertEmptyFinalizers(t, obj)
 	} else {
@@ -3598,6 +4180,7 @@ func assertServiceBindingRequestFailingError(t *testing.T, obj runtime.Object, o
 	assertServiceBindingCurrentOperationClear(t, obj)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Generation)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Generation)
 	assertServiceBindingInProgressPropertiesNil(t, obj)
 	assertServiceBindingExternalPropertiesUnchanged(t, obj, originalBinding)
 	assertServiceBindingUnbindStatus(t, obj, unbindStatus)
@@ -3605,11 +4188,12 @@ func assertServiceBindingRequestFailingError(t *testing.T, obj runtime.Object, o
 }
 
 func assertServiceBindingAsyncBindRetryDurationExceeded(t *testing.T, obj runtime.Object, originalBinding *v1beta1.ServiceBinding) {
-	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionFalse, errorServiceBindingOrphanMitigation)
-	assertServiceBindingCondition(t, obj, v1beta1.ServiceBindingConditionFailed, v1beta1.ConditionTrue, errorReconciliationRetryTimeoutReason)
+	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionFalse, v1beta1.ReasonServiceBindingNeedsOrphanMitigation)
+	assertServiceBindingCondition(t, obj, v1beta1.ServiceBindingConditionFailed, v1beta1.ConditionTrue, v1beta1.ReasonErrorReconciliationRetryTimeout)
 	assertServiceBindingCurrentOperation(t, obj, v1beta1.ServiceBindingOperationBind)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Status.ObservedGeneration)
 	assertServiceBindingInProgressPropertiesParameters(t, obj, nil, "")
 	assertServiceBindingExternalPropertiesNil(t, obj)
 	assertServiceBindingUnbindStatus(t, obj, v1beta1.ServiceBindingUnbindStatusRequired)
@@ -3617,11 +4201,12 @@ func assertServiceBindingAsyncBindRetryDurationExceeded(t *testing.T, obj runtim
 }
 
 func assertServiceBindingAsyncBindErrorAfterStateSucceeded(t *testing.T, obj runtime.Object, failureReason string, originalBinding *v1beta1.ServiceBinding) {
-	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionFalse, errorServiceBindingOrphanMitigation)
+	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionFalse, v1beta1.ReasonServiceBindingNeedsOrphanMitigation)
 	assertServiceBindingCondition(t, obj, v1beta1.ServiceBindingConditionFailed, v1beta1.ConditionTrue, failureReason)
 	assertServiceBindingCurrentOperation(t, obj, v1beta1.ServiceBindingOperationBind)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Status.ObservedGeneration)
 	assertServiceBindingExternalPropertiesParameters(t, obj, nil, "")
 	assertServiceBindingUnbindStatus(t, obj, v1beta1.ServiceBindingUnbindStatusRequired)
 	assertServiceBindingInProgressPropertiesParameters(t, obj, nil, "")
@@ -3634,6 +4219,7 @@ func assertServiceBindingAsyncUnbindRetryDurationExceeded(t *testing.T, obj runt
 	assertServiceBindingCurrentOperationClear(t, obj)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Generation)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Generation)
 	assertServiceBindingInProgressPropertiesNil(t, obj)
 	assertServiceBindingExternalPropertiesUnchanged(t, obj, originalBinding)
 	assertServiceBindingUnbindStatus(t, obj, v1beta1.ServiceBindingUnbindStatusFailed)
@@ -3641,10 +4227,11 @@ func assertServiceBindingAsyncUnbindRetryDurationExceeded(t *testing.T, obj runt
 }
 
 func assertServiceBindingAsyncOrphanMitigationRetryDurationExceeded(t *testing.T, obj runtime.Object, originalBinding *v1beta1.ServiceBinding) {
-	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionUnknown, errorOrphanMitigationFailedReason)
+	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionUnknown, v1beta1.ReasonOrphanMitigationFailed)
 	assertServiceBindingCurrentOperationClear(t, obj)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Generation)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Generation)
 	assertServiceBindingInProgressPropertiesNil(t, obj)
 	assertServiceBindingExternalPropertiesNil(t, obj)
 	assertServiceBindingUnbindStatus(t, obj, v1beta1.ServiceBindingUnbindStatusFailed)
@@ -3652,10 +4239,11 @@ func assertServiceBindingAsyncOrphanMitigationRetryDurationExceeded(t *testing.T
 }
 
 func assertServiceBindingErrorFetchingBinding(t *testing.T, obj runtime.Object, originalBinding *v1beta1.ServiceBinding) {
-	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionFalse, errorFetchingBindingFailedReason)
+	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionFalse, v1beta1.ReasonFetchingBindingFailed)
 	assertServiceBindingCurrentOperation(t, obj, v1beta1.ServiceBindingOperationBind)
 	assertServiceBindingOperationStartTimeSet(t, obj, true)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Status.ObservedGeneration)
 	assertServiceBindingInProgressPropertiesNil(t, obj)
 	assertServiceBindingExternalPropertiesParameters(t, obj, nil, "")
 	assertServiceBindingAsyncOpInProgressTrue(t, obj)
@@ -3665,10 +4253,11 @@ func assertServiceBindingErrorFetchingBinding(t *testing.T, obj runtime.Object,
 }
 
 func assertServiceBindingErrorInjectingCredentials(t *testing.T, obj runtime.Object, originalBinding *v1beta1.ServiceBinding) {
-	assertServiceBindingReadyFalse(t, obj, errorInjectingBindResultReason)
+	assertServiceBindingReadyFalse(t, obj, v1beta1.ReasonErrorInjectingBindResult)
 	assertServiceBindingCurrentOperation(t, obj, v1beta1.ServiceBindingOperationBind)
 	assertServiceBindingOperationStartTimeSet(t, obj, true)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Status.ObservedGeneration)
 	assertServiceBindingInProgressPropertiesNil(t, obj)
 	// External properties are updated because the bind request with the Broker was successful
 	assertServiceBindingExternalPropertiesParameters(t, obj, nil, "")
@@ -3694,6 +4283,7 @@ func assertServiceBindingRequestRetriableErrorWithParameters(t *testing.T, obj r
 	assertServiceBindingCurrentOperation(t, obj, operation)
 	assertServiceBindingOperationStartTimeSet(t, obj, true)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Status.ObservedGeneration)
 	switch operation {
 	case v1beta1.ServiceBindingOperationBind:
 		assertServiceBindingInProgressPropertiesParameters(t, obj, inProgressParameters, inProgressParametersChecksum)
@@ -3709,6 +4299,7 @@ func assertServiceBindingRequestRetriableOrphanMitigation(t *testing.T, obj runt
 	assertServiceBindingCurrentOperation(t, obj, v1beta1.ServiceBindingOperationBind)
 	assertServiceBindingOperationStartTimeSet(t, obj, true)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Status.ObservedGeneration)
 	assertServiceBindingInProgressPropertiesParameters(t, obj, nil, "")
 	assertServiceBindingExternalPropertiesUnchanged(t, obj, originalBinding)
 	assertServiceBindingUnbindStatus(t, obj, v1beta1.ServiceBindingUnbindStatusRequired)
@@ -3720,6 +4311,7 @@ func assertServiceBindingAsyncInProgress(t *testing.T, obj runtime.Object, opera
 	assertServiceBindingCurrentOperation(t, obj, operation)
 	assertServiceBindingOperationStartTimeSet(t, obj, true)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Status.ReconciledGeneration)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Status.ObservedGeneration)
 	switch operation {
 	case v1beta1.ServiceBindingOperationBind:
 		assertServiceBindingInProgressPropertiesParameters(t, obj, nil, "")
@@ -3732,10 +4324,11 @@ func assertServiceBindingAsyncInProgress(t *testing.T, obj runtime.Object, opera
 }
 
 func assertServiceBindingOrphanMitigationSuccess(t *testing.T, obj runtime.Object, originalBinding *v1beta1.ServiceBinding) {
-	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionFalse, successOrphanMitigationReason)
+	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionFalse, v1beta1.ReasonOrphanMitigationSuccessful)
 	assertServiceBindingCurrentOperationClear(t, obj)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Generation)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Generation)
 	assertServiceBindingInProgressPropertiesNil(t, obj)
 	assertServiceBindingExternalPropertiesNil(t, obj)
 	assertServiceBindingUnbindStatus(t, obj, v1beta1.ServiceBindingUnbindStatusSucceeded)
@@ -3743,10 +4336,11 @@ func assertServiceBindingOrphanMitigationSuccess(t *testing.T, obj runtime.Objec
 }
 
 func assertServiceBindingOrphanMitigationFailure(t *testing.T, obj runtime.Object, originalBinding *v1beta1.ServiceBinding) {
-	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionUnknown, errorOrphanMitigationFailedReason)
+	assertServiceBindingReadyCondition(t, obj, v1beta1.ConditionUnknown, v1beta1.ReasonOrphanMitigationFailed)
 	assertServiceBindingCurrentOperationClear(t, obj)
 	assertServiceBindingOperationStartTimeSet(t, obj, false)
 	assertServiceBindingReconciledGeneration(t, obj, originalBinding.Generation)
+	assertServiceBindingObservedGeneration(t, obj, originalBinding.Generation)
 	assertServiceBindingInProgressPropertiesNil(t, obj)
 	assertServiceBindingExternalPropertiesNil(t, obj)
 	assertServiceBindingUnbindStatus(t, obj, v1beta1.ServiceBindingUnbindStatusFailed)
@@ -3879,6 +4473,17 @@ func assertServiceBindingOrphanMitigationSet(t *testing.T, obj runtime.Object, i
 	}
 }
 
+func assertServiceBindingSecretWritePending(t *testing.T, obj runtime.Object, pending bool) {
+	binding, ok := obj.(*v1beta1.ServiceBinding)
+	if !ok {
+		fatalf(t, "Couldn't convert object %+v into a *v1beta1.ServiceBinding", obj)
+	}
+
+	if e, a := pending, binding.Status.SecretWritePending; e != a {
+		fatalf(t, "expected SecretWritePending to be %v, but was %v", e, a)
+	}
+}
+
 func assertServiceBindingUnbindStatus(t *testing.T, obj runtime.Object, unbindStatus v1beta1.ServiceBindingUnbindStatus) {
 	binding, ok := obj.(*v1beta1.ServiceBinding)
 	if !ok {
@@ -0,0 +1,136 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	"k8s.io/klog"
+)
+
+// fallbackAuthClient is an osb.Client which sends every request with the
+// primary credentials of a broker first, and sends it again with the fallback
+// credentials when the broker rejects the primary ones with a 401
+// Unauthorized response. A broker rejecting the credentials does not act on
+// the request, so sending it again is safe.
+type fallbackAuthClient struct {
+	name     string
+	primary  osb.Client
+	fallback osb.Client
+}
+
+// newFallbackAuthClient creates a fallbackAuthClient for the broker with the
+// given client configurations, which only differ by their auth config.
+func newFallbackAuthClient(createFunc osb.CreateFunc, config, fallbackConfig *osb.ClientConfiguration) (osb.Client, error) {
+	primary, err := createFunc(config)
+	if err != nil {
+		return nil, err
+	}
+	fallback, err := createFunc(fallbackConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &fallbackAuthClient{
+		name:     config.Name,
+		primary:  primary,
+		fallback: fallback,
+	}, nil
+}
+
+// useFallback returns whether the error returned for a request sent with the
+// primary credentials calls for sending it again with the fallback ones.
+func (c *fallbackAuthClient) useFallback(err error) bool {
+	httpErr, ok := osb.IsHTTPError(err)
+	if !ok || httpErr.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	klog.V(4).Infof("Broker %q rejected the primary credentials, retrying with the fallback credentials", c.name)
+	return true
+}
+
+func (c *fallbackAuthClient) GetCatalog() (*osb.CatalogResponse, error) {
+	response, err := c.primary.GetCatalog()
+	if c.useFallback(err) {
+		return c.fallback.GetCatalog()
+	}
+	return response, err
+}
+
+func (c *fallbackAuthClient) ProvisionInstance(r *osb.ProvisionRequest) (*osb.ProvisionResponse, error) {
+	response, err := c.primary.ProvisionInstance(r)
+	if c.useFallback(err) {
+		return c.fallback.ProvisionInstance(r)
+	}
+	return response, err
+}
+
+func (c *fallbackAuthClient) UpdateInstance(r *osb.UpdateInstanceRequest) (*osb.UpdateInstanceResponse, error) {
+	response, err := c.primary.UpdateInstance(r)
+	if c.useFallback(err) {
+		return c.fallback.UpdateInstance(r)
+	}
+	return response, err
+}
+
+func (c *fallbackAuthClient) DeprovisionInstance(r *osb.DeprovisionRequest) (*osb.DeprovisionResponse, error) {
+	response, err := c.primary.DeprovisionInstance(r)
+	if c.useFallback(err) {
+		return c.fallback.DeprovisionInstance(r)
+	}
+	return response, err
+}
+
+func (c *fallbackAuthClient) PollLastOperation(r *osb.LastOperationRequest) (*osb.LastOperationResponse, error) {
+	response, err := c.primary.PollLastOperation(r)
+	if c.useFallback(err) {
+		return c.fallback.PollLastOperation(r)
+	}
+	return response, err
+}
+
+func (c *fallbackAuthClient) PollBindingLastOperation(r *osb.BindingLastOperationRequest) (*osb.LastOperationResponse, error) {
+	response, err := c.primary.PollBindingLastOperation(r)
+	if c.useFallback(err) {
+		return c.fallback.PollBindingLastOperation(r)
+	}
+	return response, err
+}
+
+func (c *fallbackAuthClient) Bind(r *osb.BindRequest) (*osb.BindResponse, error) {
+	response, err := c.primary.Bind(r)
+	if c.useFallback(err) {
+		return c.fallback.Bind(r)
+	}
+	return response, err
+}
+
+func (c *fallbackAuthClient) Unbind(r *osb.UnbindRequest) (*osb.UnbindResponse, error) {
+	response, err := c.primary.Unbind(r)
+	if c.useFallback(err) {
+		return c.fallback.Unbind(r)
+	}
+	return response, err
+}
+
+func (c *fallbackAuthClient) GetBinding(r *osb.GetBindingRequest) (*osb.GetBindingResponse, error) {
+	response, err := c.primary.GetBinding(r)
+	if c.useFallback(err) {
+		return c.fallback.GetBinding(r)
+	}
+	return response, err
+}
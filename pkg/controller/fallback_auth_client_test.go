@@ -0,0 +1,91 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/controller"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+)
+
+func testOsbConfigWithBasicAuth(name, username string) *osb.ClientConfiguration {
+	config := testOsbConfig(name)
+	config.AuthConfig = &osb.AuthConfig{
+		BasicAuthConfig: &osb.BasicAuthConfig{Username: username, Password: "password"},
+	}
+	return config
+}
+
+func TestBrokerClientManager_UpdateBrokerClientWithFallbackAuth(t *testing.T) {
+	// GIVEN
+	clients := map[string]*fakeosb.FakeClient{}
+	brokerClientFunc := func(config *osb.ClientConfiguration) (osb.Client, error) {
+		var client *fakeosb.FakeClient
+		switch username := config.AuthConfig.BasicAuthConfig.Username; username {
+		case "new":
+			client = fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+				CatalogReaction: &fakeosb.CatalogReaction{Error: osb.HTTPStatusCodeError{StatusCode: http.StatusUnauthorized}},
+				BindReaction:    &fakeosb.BindReaction{Error: osb.HTTPStatusCodeError{StatusCode: http.StatusConflict}},
+			})
+		default:
+			client = fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+				CatalogReaction: &fakeosb.CatalogReaction{Response: &osb.CatalogResponse{}},
+				BindReaction:    &fakeosb.BindReaction{Response: &osb.BindResponse{}},
+			})
+		}
+		clients[config.AuthConfig.BasicAuthConfig.Username] = client
+		return client, nil
+	}
+	manager := controller.NewBrokerClientManager(brokerClientFunc)
+
+	// WHEN
+	client, err := manager.UpdateBrokerClientWithFallbackAuth(controller.NewClusterServiceBrokerKey("broker1"), testOsbConfigWithBasicAuth("osb-1", "new"), testOsbConfigWithBasicAuth("osb-1", "old"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sameClient, _ := manager.UpdateBrokerClientWithFallbackAuth(controller.NewClusterServiceBrokerKey("broker1"), testOsbConfigWithBasicAuth("osb-1", "new"), testOsbConfigWithBasicAuth("osb-1", "old"))
+	_, catalogErr := client.GetCatalog()
+	_, bindErr := client.Bind(&osb.BindRequest{})
+
+	// THEN
+	if client != sameClient {
+		t.Fatal("Broker client must not be recreated when the configuration is unchanged")
+	}
+	if catalogErr != nil {
+		t.Fatalf("Expected the catalog to be fetched with the fallback auth, got error: %v", catalogErr)
+	}
+	if e, a := 1, len(clients["old"].Actions()); e != a {
+		t.Fatalf("Expected %d request with the fallback auth, got %d", e, a)
+	}
+	if httpErr, ok := osb.IsHTTPError(bindErr); !ok || httpErr.StatusCode != http.StatusConflict {
+		t.Fatalf("Expected the error of the primary auth for requests not rejected with 401, got %v", bindErr)
+	}
+	if e, a := 2, len(clients["new"].Actions()); e != a {
+		t.Fatalf("Expected %d requests with the primary auth, got %d", e, a)
+	}
+
+	// WHEN
+	otherClient, _ := manager.UpdateBrokerClientWithFallbackAuth(controller.NewClusterServiceBrokerKey("broker1"), testOsbConfigWithBasicAuth("osb-1", "new"), testOsbConfigWithBasicAuth("osb-1", "older"))
+
+	// THEN
+	if otherClient == client {
+		t.Fatal("Broker client must be recreated when the fallback configuration changes")
+	}
+}
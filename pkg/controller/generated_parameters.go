@@ -0,0 +1,150 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// defaultGeneratedParameterLength is the length of the generated values
+	// of parameters that do not specify one.
+	defaultGeneratedParameterLength = 32
+	// defaultGeneratedParameterCharset is the set of characters of the
+	// generated values of parameters that do not specify one.
+	defaultGeneratedParameterCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// instanceControllerKind contains the schema.GroupVersionKind of the owner of
+// the Secrets of generated parameters.
+var instanceControllerKind = v1beta1.SchemeGroupVersion.WithKind("ServiceInstance")
+
+// ensureGeneratedParameterValues makes the Secret of the generated parameters
+// of the instance hold a value for each of them. Only the missing values are
+// generated, so that a value is generated once and then sent to the broker
+// in every request.
+func (c *controller) ensureGeneratedParameterValues(instance *v1beta1.ServiceInstance) error {
+	generated := instance.Spec.GeneratedParameters
+	secretClient := c.kubeClient.CoreV1().Secrets(instance.Namespace)
+
+	secret, err := secretClient.Get(generated.SecretName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf(`failed to get Secret "%s/%s" of the generated parameters: %v`, instance.Namespace, generated.SecretName, err)
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      generated.SecretName,
+				Namespace: instance.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(instance, instanceControllerKind),
+				},
+			},
+		}
+		if _, err := generateMissingParameterValues(secret, generated.Parameters); err != nil {
+			return err
+		}
+		if _, err := secretClient.Create(secret); err != nil {
+			return fmt.Errorf(`failed to create Secret "%s/%s" of the generated parameters: %v`, instance.Namespace, secret.Name, err)
+		}
+		return nil
+	}
+
+	if !metav1.IsControlledBy(secret, instance) {
+		return fmt.Errorf(`Secret "%s/%s" of the generated parameters is not owned by the ServiceInstance, controllerRef: %v`, instance.Namespace, secret.Name, metav1.GetControllerOf(secret))
+	}
+	modified, err := generateMissingParameterValues(secret, generated.Parameters)
+	if err != nil || !modified {
+		return err
+	}
+	if _, err := secretClient.Update(secret); err != nil {
+		return fmt.Errorf(`failed to update Secret "%s/%s" of the generated parameters: %v`, instance.Namespace, secret.Name, err)
+	}
+	return nil
+}
+
+// generateMissingParameterValues sets a generated value for the parameters
+// the secret holds no value for, and returns whether it set any.
+func generateMissingParameterValues(secret *corev1.Secret, parameters []v1beta1.GeneratedParameter) (bool, error) {
+	modified := false
+	for _, param := range parameters {
+		if _, ok := secret.Data[param.Name]; ok {
+			continue
+		}
+		value, err := generateParameterValue(param)
+		if err != nil {
+			return false, fmt.Errorf("failed to generate a value for parameter %q: %v", param.Name, err)
+		}
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[param.Name] = []byte(value)
+		modified = true
+	}
+	return modified, nil
+}
+
+// generateParameterValue returns a random value for the parameter.
+func generateParameterValue(param v1beta1.GeneratedParameter) (string, error) {
+	length := int(param.Length)
+	if length == 0 {
+		length = defaultGeneratedParameterLength
+	}
+	charset := []rune(param.Charset)
+	if len(charset) == 0 {
+		charset = []rune(defaultGeneratedParameterCharset)
+	}
+
+	value := make([]rune, length)
+	max := big.NewInt(int64(len(charset)))
+	for i := range value {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		value[i] = charset[n.Int64()]
+	}
+	return string(value), nil
+}
+
+// serviceInstanceParametersFrom returns the sources of the parameters of the
+// instance, including the Secret of its generated parameters.
+func serviceInstanceParametersFrom(instance *v1beta1.ServiceInstance) []v1beta1.ParametersFromSource {
+	generated := instance.Spec.GeneratedParameters
+	if generated == nil {
+		return instance.Spec.ParametersFrom
+	}
+	parametersFrom := make([]v1beta1.ParametersFromSource, 0, len(instance.Spec.ParametersFrom)+len(generated.Parameters))
+	parametersFrom = append(parametersFrom, instance.Spec.ParametersFrom...)
+	for _, param := range generated.Parameters {
+		parametersFrom = append(parametersFrom, v1beta1.ParametersFromSource{
+			SecretKeyRef: &v1beta1.SecretKeyReference{
+				Name: generated.SecretName,
+				Key:  param.Name,
+			},
+			ParameterName: param.Name,
+		})
+	}
+	return parametersFrom
+}
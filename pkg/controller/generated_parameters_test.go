@@ -0,0 +1,195 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+const testGeneratedParametersSecretName = "test-generated-parameters"
+
+func getTestServiceInstanceWithGeneratedParameters() *v1beta1.ServiceInstance {
+	instance := getTestServiceInstanceWithClusterRefs()
+	instance.Spec.GeneratedParameters = &v1beta1.GeneratedParameters{
+		SecretName: testGeneratedParametersSecretName,
+		Parameters: []v1beta1.GeneratedParameter{
+			{Name: "password"},
+			{Name: "pin", Length: 6, Charset: "0123456789"},
+		},
+	}
+	return instance
+}
+
+func getGeneratedParametersSecret(t *testing.T, kubeClient *clientgofake.Clientset) *corev1.Secret {
+	secret, err := kubeClient.CoreV1().Secrets(testNamespace).Get(testGeneratedParametersSecretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error getting the Secret of the generated parameters: %v", err)
+	}
+	return secret
+}
+
+func TestEnsureGeneratedParameterValues(t *testing.T) {
+	kubeClient := clientgofake.NewSimpleClientset()
+	c := &controller{kubeClient: kubeClient}
+	instance := getTestServiceInstanceWithGeneratedParameters()
+
+	if err := c.ensureGeneratedParameterValues(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secret := getGeneratedParametersSecret(t, kubeClient)
+	if !metav1.IsControlledBy(secret, instance) {
+		t.Fatalf("expected the Secret to be owned by the instance, got controllerRef %v", metav1.GetControllerOf(secret))
+	}
+	password := string(secret.Data["password"])
+	if len(password) != defaultGeneratedParameterLength {
+		t.Fatalf("unexpected length of the password; expected %v, got %v", defaultGeneratedParameterLength, len(password))
+	}
+	pin := string(secret.Data["pin"])
+	if len(pin) != 6 || strings.Trim(pin, "0123456789") != "" {
+		t.Fatalf("unexpected pin %q; expected 6 digits", pin)
+	}
+
+	// The values are generated once: reconciling again keeps them, and only
+	// the values of added parameters are generated.
+	instance.Spec.GeneratedParameters.Parameters = append(instance.Spec.GeneratedParameters.Parameters, v1beta1.GeneratedParameter{Name: "token"})
+	for i := 0; i < 2; i++ {
+		if err := c.ensureGeneratedParameterValues(instance); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	secret = getGeneratedParametersSecret(t, kubeClient)
+	if e, a := password, string(secret.Data["password"]); e != a {
+		t.Fatalf("the password was generated again; expected %q, got %q", e, a)
+	}
+	if e, a := pin, string(secret.Data["pin"]); e != a {
+		t.Fatalf("the pin was generated again; expected %q, got %q", e, a)
+	}
+	if len(secret.Data["token"]) != defaultGeneratedParameterLength {
+		t.Fatalf("expected a value to be generated for the added parameter, got %q", secret.Data["token"])
+	}
+
+	updates := 0
+	for _, action := range kubeClient.Actions() {
+		if action.Matches("update", "secrets") {
+			updates++
+		}
+	}
+	if updates != 1 {
+		t.Fatalf("expected the Secret to be updated once, got %v updates", updates)
+	}
+}
+
+func TestEnsureGeneratedParameterValuesSecretNotOwned(t *testing.T) {
+	kubeClient := clientgofake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testGeneratedParametersSecretName,
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{"password": []byte("user-supplied")},
+	})
+	c := &controller{kubeClient: kubeClient}
+
+	if err := c.ensureGeneratedParameterValues(getTestServiceInstanceWithGeneratedParameters()); err == nil {
+		t.Fatal("expected an error for a Secret not owned by the instance")
+	}
+	if e, a := "user-supplied", string(getGeneratedParametersSecret(t, kubeClient).Data["password"]); e != a {
+		t.Fatalf("the Secret was modified; expected %q, got %q", e, a)
+	}
+}
+
+// TestReconcileServiceInstanceWithGeneratedParameters tests that the generated
+// values are sent to the broker, and redacted from the status of the instance.
+func TestReconcileServiceInstanceWithGeneratedParameters(t *testing.T) {
+	fakeKubeClient, fakeCatalogClient, fakeClusterServiceBrokerClient, testController, sharedInformers := newTestController(t, fakeosb.FakeClientConfiguration{
+		ProvisionReaction: &fakeosb.ProvisionReaction{
+			Response: &osb.ProvisionResponse{},
+		},
+	})
+	addGetNamespaceReaction(fakeKubeClient)
+	secrets := clientgotesting.NewObjectTracker(scheme.Scheme, scheme.Codecs.UniversalDecoder())
+	fakeKubeClient.AddReactor("*", "secrets", clientgotesting.ObjectReaction(secrets))
+
+	sharedInformers.ClusterServiceBrokers().Informer().GetStore().Add(getTestClusterServiceBroker())
+	sharedInformers.ClusterServiceClasses().Informer().GetStore().Add(getTestClusterServiceClass())
+	sharedInformers.ClusterServicePlans().Informer().GetStore().Add(getTestClusterServicePlan())
+
+	instance := getTestServiceInstanceWithGeneratedParameters()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secret := getGeneratedParametersSecret(t, fakeKubeClient)
+	parameters := map[string]interface{}{
+		"password": string(secret.Data["password"]),
+		"pin":      string(secret.Data["pin"]),
+	}
+	checksum, err := generateChecksumOfParameters(parameters)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	instance = assertServiceInstanceOperationInProgressWithParameterAndUserSpecifiedFieldsClientActions(
+		t,
+		fakeCatalogClient,
+		instance,
+		v1beta1.ServiceInstanceOperationProvision,
+		testClusterServicePlanName,
+		testClusterServicePlanGUID,
+		map[string]interface{}{
+			"password": redactedParameterValue,
+			"pin":      redactedParameterValue,
+		},
+		checksum,
+	)
+	fakeCatalogClient.ClearActions()
+
+	if err := reconcileServiceInstance(t, testController, instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	brokerActions := fakeClusterServiceBrokerClient.Actions()
+	assertNumberOfBrokerActions(t, brokerActions, 1)
+	request, ok := brokerActions[0].Request.(*osb.ProvisionRequest)
+	if !ok {
+		t.Fatalf("unexpected broker request: %+v", brokerActions[0].Request)
+	}
+	if e, a := parameters, request.Parameters; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected parameters; expected %v, got %v", e, a)
+	}
+
+	// The values generated by the first reconciliation were sent, and the
+	// Secret was not written again.
+	writes := 0
+	for _, action := range fakeKubeClient.Actions() {
+		if action.Matches("create", "secrets") || action.Matches("update", "secrets") {
+			writes++
+		}
+	}
+	if writes != 1 {
+		t.Fatalf("expected the Secret to be written once, got %v writes", writes)
+	}
+}
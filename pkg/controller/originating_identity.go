@@ -18,6 +18,7 @@ package controller
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
 	osb "github.com/pmorie/go-open-service-broker-client/v2"
@@ -41,3 +42,21 @@ func buildOriginatingIdentity(userInfo *v1beta1.UserInfo) (*osb.OriginatingIdent
 	}
 	return oi, nil
 }
+
+// buildNamespaceOriginatingIdentity builds the originating identity of a
+// request for a resource in the given namespace. If the namespace carries the
+// originatingIdentityNamespaceAnnotation, its value is sent as the username in
+// place of the user who made the change. Whoever may annotate the namespace
+// is therefore trusted to choose the identity the brokers see.
+func (c *controller) buildNamespaceOriginatingIdentity(namespace string, userInfo *v1beta1.UserInfo) (*osb.OriginatingIdentity, error) {
+	if c.originatingIdentityNamespaceAnnotation != "" {
+		ns, err := c.namespaceLister.Get(namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get namespace %q: %v", namespace, err)
+		}
+		if username, ok := ns.Annotations[c.originatingIdentityNamespaceAnnotation]; ok {
+			userInfo = &v1beta1.UserInfo{Username: username}
+		}
+	}
+	return buildOriginatingIdentity(userInfo)
+}
@@ -20,15 +20,48 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	"github.com/peterbourgon/mergemap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/yaml"
 )
 
+// redactedParameterValue replaces the values of parameters sourced from
+// secrets in the parameters recorded in the status of instances and bindings.
+const redactedParameterValue = "<redacted>"
+
+const (
+	// maxParametersFromURLSize is the maximum size, in bytes, of a document
+	// of parameters fetched from a URL.
+	maxParametersFromURLSize = 1024 * 1024
+	// parametersFromURLTimeout bounds the time taken to fetch a document of
+	// parameters from a URL.
+	parametersFromURLTimeout = 10 * time.Second
+	// maxParametersFromURLRedirects is the maximum number of redirects
+	// followed when fetching a document of parameters from a URL.
+	maxParametersFromURLRedirects = 10
+)
+
+// parametersHTTPClient fetches the documents of parameters referenced by URL.
+var parametersHTTPClient = &http.Client{Timeout: parametersFromURLTimeout}
+
 // buildParameters generates the parameters JSON structure to be passed
 // to the broker.
 // The first return value is a map of parameters to send to the Broker, including
@@ -36,12 +69,13 @@ import (
 // The second return value is a map of parameters with secret values redacted,
 // replaced with "<redacted>".
 // The third return value is any error that caused the function to fail.
-func buildParameters(kubeClient kubernetes.Interface, namespace string, parametersFrom []v1beta1.ParametersFromSource, parameters *runtime.RawExtension) (map[string]interface{}, map[string]interface{}, error) {
+// Parameters are only fetched from URLs whose host is in allowedURLHosts.
+func buildParameters(kubeClient kubernetes.Interface, namespace string, allowedURLHosts sets.String, parametersFrom []v1beta1.ParametersFromSource, parameters *runtime.RawExtension) (map[string]interface{}, map[string]interface{}, error) {
 	params := make(map[string]interface{})
 	paramsWithSecretsRedacted := make(map[string]interface{})
 	if parametersFrom != nil {
 		for _, p := range parametersFrom {
-			fps, err := fetchParametersFromSource(kubeClient, namespace, &p)
+			fps, err := fetchParametersFromSource(kubeClient, namespace, allowedURLHosts, &p)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -50,7 +84,7 @@ func buildParameters(kubeClient kubernetes.Interface, namespace string, paramete
 					return nil, nil, fmt.Errorf("conflict: duplicate entry for parameter %q", k)
 				}
 				params[k] = v
-				paramsWithSecretsRedacted[k] = "<redacted>"
+				paramsWithSecretsRedacted[k] = redactedParameterValue
 			}
 		}
 	}
@@ -80,13 +114,22 @@ func buildParameters(kubeClient kubernetes.Interface, namespace string, paramete
 
 // fetchParametersFromSource fetches data from a specified external source and
 // represents it in the parameters map format
-func fetchParametersFromSource(kubeClient kubernetes.Interface, namespace string, parametersFrom *v1beta1.ParametersFromSource) (map[string]interface{}, error) {
+func fetchParametersFromSource(kubeClient kubernetes.Interface, namespace string, allowedURLHosts sets.String, parametersFrom *v1beta1.ParametersFromSource) (map[string]interface{}, error) {
 	var params map[string]interface{}
-	if parametersFrom.SecretKeyRef != nil {
-		data, err := fetchSecretKeyValue(kubeClient, namespace, parametersFrom.SecretKeyRef)
+	if parametersFrom.SecretKeyRef != nil || parametersFrom.URLRef != nil {
+		var data []byte
+		var err error
+		if parametersFrom.SecretKeyRef != nil {
+			data, err = fetchSecretKeyValue(kubeClient, namespace, parametersFrom.SecretKeyRef)
+		} else {
+			data, err = fetchURLValue(kubeClient, namespace, allowedURLHosts, parametersFrom.URLRef)
+		}
 		if err != nil {
 			return nil, err
 		}
+		if parametersFrom.ParameterName != "" {
+			return map[string]interface{}{parametersFrom.ParameterName: string(data)}, nil
+		}
 		p, err := unmarshalJSON(data)
 		if err != nil {
 			return nil, err
@@ -134,6 +177,68 @@ func fetchSecretKeyValue(kubeClient kubernetes.Interface, namespace string, secr
 	return secret.Data[secretKeyRef.Key], nil
 }
 
+// fetchURLValue requests and returns the document at the given URL, sending
+// the bearer token from the referenced secret key if any. The URL, and every
+// URL it redirects to, must have one of the allowed hosts.
+func fetchURLValue(kubeClient kubernetes.Interface, namespace string, allowedURLHosts sets.String, urlRef *v1beta1.URLReference) ([]byte, error) {
+	if !utilfeature.DefaultFeatureGate.Enabled(scfeatures.ParametersFromURL) {
+		return nil, fmt.Errorf("failed to fetch parameters from %q: the %s feature is disabled", urlRef.URL, scfeatures.ParametersFromURL)
+	}
+
+	request, err := http.NewRequest(http.MethodGet, urlRef.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	sendsToken := urlRef.BearerTokenSecretKeyRef != nil
+	if err := checkParametersURL(request.URL, allowedURLHosts, sendsToken); err != nil {
+		return nil, fmt.Errorf("failed to fetch parameters from %q: %v", urlRef.URL, err)
+	}
+	if sendsToken {
+		token, err := fetchSecretKeyValue(kubeClient, namespace, urlRef.BearerTokenSecretKeyRef)
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set("Authorization", "Bearer "+string(token))
+	}
+
+	client := *parametersHTTPClient
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxParametersFromURLRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxParametersFromURLRedirects)
+		}
+		return checkParametersURL(req.URL, allowedURLHosts, sendsToken)
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch parameters from %q: %v", urlRef.URL, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch parameters from %q: unexpected status code %d", urlRef.URL, response.StatusCode)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(response.Body, maxParametersFromURLSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch parameters from %q: %v", urlRef.URL, err)
+	}
+	if len(data) > maxParametersFromURLSize {
+		return nil, fmt.Errorf("failed to fetch parameters from %q: the document exceeds %d bytes", urlRef.URL, maxParametersFromURLSize)
+	}
+	return data, nil
+}
+
+// checkParametersURL checks that parameters may be fetched from the given URL:
+// its host must be allowed, and it must use https when a bearer token is sent.
+func checkParametersURL(u *url.URL, allowedURLHosts sets.String, sendsToken bool) error {
+	if host := strings.ToLower(u.Hostname()); !allowedURLHosts.Has(host) {
+		return fmt.Errorf("host %q is not allowed", host)
+	}
+	if sendsToken && u.Scheme != "https" {
+		return fmt.Errorf("a bearer token is only sent over https")
+	}
+	return nil
+}
+
 // generateChecksumOfParameters generates a checksum for the map of parameters.
 // This checksum is used to determine if parameters have changed.
 func generateChecksumOfParameters(params map[string]interface{}) (string, error) {
@@ -155,8 +260,8 @@ func generateChecksumOfParameters(params map[string]interface{}) (string, error)
 // 2 - a checksum for the map of parameters. This checksum is used to determine if parameters have changed.
 // 3 - the map of parameters marshaled into JSON as a RawExtension
 // 4 - any error that caused the function to fail.
-func prepareInProgressPropertyParameters(kubeClient kubernetes.Interface, namespace string, specParameters *runtime.RawExtension, specParametersFrom []v1beta1.ParametersFromSource) (map[string]interface{}, string, *runtime.RawExtension, error) {
-	parameters, parametersWithSecretsRedacted, err := buildParameters(kubeClient, namespace, specParametersFrom, specParameters)
+func prepareInProgressPropertyParameters(kubeClient kubernetes.Interface, namespace string, allowedURLHosts sets.String, specParameters *runtime.RawExtension, specParametersFrom []v1beta1.ParametersFromSource) (map[string]interface{}, string, *runtime.RawExtension, error) {
+	parameters, parametersWithSecretsRedacted, err := buildParameters(kubeClient, namespace, allowedURLHosts, specParametersFrom, specParameters)
 	if err != nil {
 		return nil, "", nil, fmt.Errorf(
 			"failed to prepare parameters %s: %s",
@@ -218,3 +323,252 @@ func mergeParameters(params *runtime.RawExtension, defaultParams *runtime.RawExt
 
 	return &runtime.RawExtension{Raw: result}, nil
 }
+
+// isDefaultParametersChanged returns whether the current default
+// provisioning parameters differ from the ones which were applied. The
+// parameters are compared as JSON objects, so a differently serialized but
+// equivalent set of defaults is not considered a change.
+func isDefaultParametersChanged(applied, current *runtime.RawExtension) (bool, error) {
+	appliedMap, err := rawExtensionToMap(applied)
+	if err != nil {
+		return false, fmt.Errorf("could not unmarshal applied default parameters: %v", err)
+	}
+	currentMap, err := rawExtensionToMap(current)
+	if err != nil {
+		return false, fmt.Errorf("could not unmarshal default parameters: %v", err)
+	}
+	return !reflect.DeepEqual(appliedMap, currentMap), nil
+}
+
+// rebaseDefaultParameters moves parameters from their old defaults to the
+// new ones. Top-level parameters whose value still equals their old default
+// are dropped before the new defaults are merged in, so they take the new
+// default or disappear along with it. Parameters the user changed from
+// their default are kept.
+func rebaseDefaultParameters(params, oldDefaults, newDefaults *runtime.RawExtension) (*runtime.RawExtension, error) {
+	paramsMap, err := rawExtensionToMap(params)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal parameters %v: %s", string(params.Raw), err)
+	}
+	oldDefaultsMap, err := rawExtensionToMap(oldDefaults)
+	if err != nil {
+		return nil, fmt.Errorf("could not unmarshal default parameters %v: %s", string(oldDefaults.Raw), err)
+	}
+	for k, v := range oldDefaultsMap {
+		if p, ok := paramsMap[k]; ok && reflect.DeepEqual(p, v) {
+			delete(paramsMap, k)
+		}
+	}
+
+	raw, err := MarshalRawParameters(paramsMap)
+	if err != nil {
+		return nil, err
+	}
+	var rebased *runtime.RawExtension
+	if raw != nil {
+		rebased = &runtime.RawExtension{Raw: raw}
+	}
+	return mergeParameters(rebased, newDefaults)
+}
+
+// rawExtensionToMap unmarshals the JSON object of a RawExtension, treating
+// a nil or empty RawExtension as an empty object.
+func rawExtensionToMap(raw *runtime.RawExtension) (map[string]interface{}, error) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	return unmarshalJSON(raw.Raw)
+}
+
+// ParseNamespaceAnnotationParameters parses a comma separated list of
+// annotation=parameter pairs into a map from namespace annotation to the
+// provisioning parameter it provides the default for.
+func ParseNamespaceAnnotationParameters(value string) (map[string]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	mapping := make(map[string]string)
+	parameters := make(map[string]bool)
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%q is not of the form annotation=parameter", pair)
+		}
+		annotation, parameter := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if errs := validation.IsQualifiedName(annotation); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid annotation %q: %s", annotation, strings.Join(errs, "; "))
+		}
+		if parameter == "" {
+			return nil, fmt.Errorf("no parameter given for annotation %q", annotation)
+		}
+		if _, ok := mapping[annotation]; ok {
+			return nil, fmt.Errorf("duplicate entry for annotation %q", annotation)
+		}
+		if parameters[parameter] {
+			return nil, fmt.Errorf("duplicate entry for parameter %q", parameter)
+		}
+		mapping[annotation] = parameter
+		parameters[parameter] = true
+	}
+	return mapping, nil
+}
+
+// ParseParametersFromURLAllowedHosts parses a comma separated list of the
+// hosts parameters may be fetched from by URL.
+func ParseParametersFromURLAllowedHosts(value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var hosts []string
+	seen := sets.NewString()
+	for _, host := range strings.Split(value, ",") {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if net.ParseIP(host) == nil {
+			if errs := validation.IsDNS1123Subdomain(host); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid host %q: %s", host, strings.Join(errs, "; "))
+			}
+		}
+		if seen.Has(host) {
+			return nil, fmt.Errorf("duplicate entry for host %q", host)
+		}
+		seen.Insert(host)
+		hosts = append(hosts, host)
+	}
+	return hosts, nil
+}
+
+// supportedParameterSchemaURIs lists the meta-schema URIs, without the
+// trailing "#", of the JSON Schema drafts the parameter schemas of plans may
+// declare in their `$schema` keyword.
+var supportedParameterSchemaURIs = sets.NewString(
+	"http://json-schema.org/draft-04/schema",
+	"http://json-schema.org/draft-06/schema",
+	"http://json-schema.org/draft-07/schema",
+)
+
+// getUnsupportedParameterSchemaDraft returns the `$schema` of a parameter
+// schema and true if it declares a JSON Schema draft which is not supported.
+// Schemas without `$schema` are draft-04, which the Open Service Broker API
+// mandates for plan schemas.
+func getUnsupportedParameterSchemaDraft(schema *runtime.RawExtension) (string, bool) {
+	if schema == nil || len(schema.Raw) == 0 {
+		return "", false
+	}
+	var root struct {
+		Schema string `json:"$schema"`
+	}
+	// Malformed schemas are rejected by validation
+	if err := json.Unmarshal(schema.Raw, &root); err != nil || root.Schema == "" {
+		return "", false
+	}
+	normalized := strings.TrimSuffix(root.Schema, "#")
+	normalized = strings.Replace(normalized, "https://", "http://", 1)
+	return root.Schema, !supportedParameterSchemaURIs.Has(normalized)
+}
+
+// validateParametersAgainstSchema checks parameters against a JSON schema and
+// returns a description of each violation found. Only the keywords commonly
+// used in the parameter schemas of plans are checked: type, enum, required,
+// properties and additionalProperties. Values sourced from secrets, which are
+// redacted in the parameters recorded in the status of an instance, are only
+// checked for presence.
+func validateParametersAgainstSchema(parameters map[string]interface{}, schema map[string]interface{}) []string {
+	var violations []string
+	validateValueAgainstSchema("parameters", parameters, schema, &violations)
+	return violations
+}
+
+func validateValueAgainstSchema(path string, value interface{}, schema map[string]interface{}, violations *[]string) {
+	if value == redactedParameterValue {
+		return
+	}
+
+	if schemaType, ok := schema["type"]; ok && !matchesSchemaType(value, schemaType) {
+		*violations = append(*violations, fmt.Sprintf("%s: must be of type %v", path, schemaType))
+		return
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, allowed := range enum {
+			if reflect.DeepEqual(value, allowed) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			*violations = append(*violations, fmt.Sprintf("%s: must be one of %v", path, enum))
+		}
+	}
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, present := object[name]; !present {
+					*violations = append(*violations, fmt.Sprintf("%s: %q is required", path, name))
+				}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if propertySchema, ok := properties[name].(map[string]interface{}); ok {
+			validateValueAgainstSchema(path+"."+name, object[name], propertySchema, violations)
+		} else if additional, ok := schema["additionalProperties"].(bool); ok && !additional {
+			*violations = append(*violations, fmt.Sprintf("%s: %q is not allowed", path, name))
+		}
+	}
+}
+
+// matchesSchemaType returns whether a value decoded from JSON is of the given
+// JSON schema type, or of one of the given types.
+func matchesSchemaType(value interface{}, schemaType interface{}) bool {
+	switch t := schemaType.(type) {
+	case string:
+		switch t {
+		case "object":
+			_, ok := value.(map[string]interface{})
+			return ok
+		case "array":
+			_, ok := value.([]interface{})
+			return ok
+		case "string":
+			_, ok := value.(string)
+			return ok
+		case "number":
+			_, ok := value.(float64)
+			return ok
+		case "integer":
+			n, ok := value.(float64)
+			return ok && n == math.Trunc(n)
+		case "boolean":
+			_, ok := value.(bool)
+			return ok
+		case "null":
+			return value == nil
+		}
+		// Unknown types are not checked.
+		return true
+	case []interface{}:
+		for _, alternative := range t {
+			if matchesSchemaType(value, alternative) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
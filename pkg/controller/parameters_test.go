@@ -17,13 +17,20 @@ limitations under the License.
 package controller
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	clientgofake "k8s.io/client-go/kubernetes/fake"
 )
 
@@ -98,6 +105,66 @@ func TestBuildParameters(t *testing.T) {
 			secret:        secret,
 			shouldSucceed: false,
 		},
+		{
+			name: "parametersFrom: secretKey with scalar",
+			parametersFrom: []v1beta1.ParametersFromSource{
+				{
+					SecretKeyRef: &v1beta1.SecretKeyReference{
+						Name: "secret",
+						Key:  "string-key",
+					},
+					ParameterName: "password",
+				},
+			},
+			secret: secret,
+			expectedParameters: map[string]interface{}{
+				"password": "textFromSecret",
+			},
+			expectedParametersWithSecretsRedacted: map[string]interface{}{
+				"password": "<redacted>",
+			},
+			shouldSucceed: true,
+		},
+		{
+			name: "parametersFrom: secretKey with blob as scalar",
+			parametersFrom: []v1beta1.ParametersFromSource{
+				{
+					SecretKeyRef: &v1beta1.SecretKeyReference{
+						Name: "secret",
+						Key:  "json-key",
+					},
+					ParameterName: "config",
+				},
+			},
+			secret: secret,
+			expectedParameters: map[string]interface{}{
+				"config": "{ \"json\": true }",
+			},
+			expectedParametersWithSecretsRedacted: map[string]interface{}{
+				"config": "<redacted>",
+			},
+			shouldSucceed: true,
+		},
+		{
+			name: "parametersFrom: secretKey with scalar conflicting with blob",
+			parametersFrom: []v1beta1.ParametersFromSource{
+				{
+					SecretKeyRef: &v1beta1.SecretKeyReference{
+						Name: "secret",
+						Key:  "json-key",
+					},
+				},
+				{
+					SecretKeyRef: &v1beta1.SecretKeyReference{
+						Name: "secret",
+						Key:  "string-key",
+					},
+					ParameterName: "json",
+				},
+			},
+			secret:        secret,
+			shouldSucceed: false,
+		},
 		{
 			name: "parametersFrom + parameters: normal",
 			parametersFrom: []v1beta1.ParametersFromSource{
@@ -142,12 +209,180 @@ func TestBuildParameters(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			testBuildParameters(t, tc.parametersFrom, tc.parameters, tc.secret, tc.expectedParameters, tc.expectedParametersWithSecretsRedacted, tc.shouldSucceed)
+			testBuildParameters(t, nil, tc.parametersFrom, tc.parameters, tc.secret, tc.expectedParameters, tc.expectedParametersWithSecretsRedacted, tc.shouldSucceed)
 		})
 	}
 }
 
-func testBuildParameters(t *testing.T, parametersFrom []v1beta1.ParametersFromSource, parameters *runtime.RawExtension, secret *corev1.Secret, expected map[string]interface{}, expectedWithSecretsRdacted map[string]interface{}, shouldSucceed bool) {
+func TestBuildParametersFromURL(t *testing.T) {
+	if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=true", scfeatures.ParametersFromURL)); err != nil {
+		t.Fatalf("Failed to enable parameters from URL feature: %v", err)
+	}
+	defer utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ParametersFromURL))
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/parameters.json":
+			w.Write([]byte(`{ "fromURL": true }`))
+		case "/invalid.json":
+			w.Write([]byte("not a JSON"))
+		case "/large.json":
+			w.Write([]byte(`{ "large": "` + strings.Repeat("x", maxParametersFromURLSize) + `" }`))
+		case "/redirect.json":
+			http.Redirect(w, r, "https://example.com/parameters.json", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	defaultHTTPClient := parametersHTTPClient
+	parametersHTTPClient = server.Client()
+	defer func() { parametersHTTPClient = defaultHTTPClient }()
+
+	allowedURLHosts := sets.NewString("127.0.0.1")
+
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			"token": []byte("s3cr3t"),
+		},
+	}
+	urlSource := func(path string) []v1beta1.ParametersFromSource {
+		return []v1beta1.ParametersFromSource{
+			{
+				URLRef: &v1beta1.URLReference{
+					URL: server.URL + path,
+					BearerTokenSecretKeyRef: &v1beta1.SecretKeyReference{
+						Name: "secret",
+						Key:  "token",
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		name                                  string
+		parametersFrom                        []v1beta1.ParametersFromSource
+		parameters                            *runtime.RawExtension
+		secret                                *corev1.Secret
+		expectedParameters                    map[string]interface{}
+		expectedParametersWithSecretsRedacted map[string]interface{}
+		shouldSucceed                         bool
+	}{
+		{
+			name:           "parametersFrom: URL with blob",
+			parametersFrom: urlSource("/parameters.json"),
+			parameters: &runtime.RawExtension{
+				Raw: []byte(`{ "p1": "v1" }`),
+			},
+			secret: secret,
+			expectedParameters: map[string]interface{}{
+				"fromURL": true,
+				"p1":      "v1",
+			},
+			expectedParametersWithSecretsRedacted: map[string]interface{}{
+				"fromURL": "<redacted>",
+				"p1":      "v1",
+			},
+			shouldSucceed: true,
+		},
+		{
+			name: "parametersFrom: URL with parameter name",
+			parametersFrom: func() []v1beta1.ParametersFromSource {
+				p := urlSource("/parameters.json")
+				p[0].ParameterName = "document"
+				return p
+			}(),
+			secret: secret,
+			expectedParameters: map[string]interface{}{
+				"document": `{ "fromURL": true }`,
+			},
+			expectedParametersWithSecretsRedacted: map[string]interface{}{
+				"document": "<redacted>",
+			},
+			shouldSucceed: true,
+		},
+		{
+			name:           "parametersFrom: URL with invalid blob",
+			parametersFrom: urlSource("/invalid.json"),
+			secret:         secret,
+			shouldSucceed:  false,
+		},
+		{
+			name:           "parametersFrom: URL with too large blob",
+			parametersFrom: urlSource("/large.json"),
+			secret:         secret,
+			shouldSucceed:  false,
+		},
+		{
+			name:           "parametersFrom: URL not found",
+			parametersFrom: urlSource("/missing.json"),
+			secret:         secret,
+			shouldSucceed:  false,
+		},
+		{
+			name:           "parametersFrom: URL with wrong token",
+			parametersFrom: urlSource("/parameters.json"),
+			secret: &corev1.Secret{
+				Data: map[string][]byte{
+					"token": []byte("wrong"),
+				},
+			},
+			shouldSucceed: false,
+		},
+		{
+			name:           "parametersFrom: URL with missing token secret",
+			parametersFrom: urlSource("/parameters.json"),
+			shouldSucceed:  false,
+		},
+		{
+			name: "parametersFrom: URL with host not allowed",
+			parametersFrom: func() []v1beta1.ParametersFromSource {
+				p := urlSource("/parameters.json")
+				p[0].URLRef.URL = strings.Replace(p[0].URLRef.URL, "127.0.0.1", "localhost", 1)
+				return p
+			}(),
+			secret:        secret,
+			shouldSucceed: false,
+		},
+		{
+			name:           "parametersFrom: URL redirecting to host not allowed",
+			parametersFrom: urlSource("/redirect.json"),
+			secret:         secret,
+			shouldSucceed:  false,
+		},
+		{
+			name: "parametersFrom: http URL with token",
+			parametersFrom: func() []v1beta1.ParametersFromSource {
+				p := urlSource("/parameters.json")
+				p[0].URLRef.URL = strings.Replace(p[0].URLRef.URL, "https://", "http://", 1)
+				return p
+			}(),
+			secret:        secret,
+			shouldSucceed: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			testBuildParameters(t, allowedURLHosts, tc.parametersFrom, tc.parameters, tc.secret, tc.expectedParameters, tc.expectedParametersWithSecretsRedacted, tc.shouldSucceed)
+		})
+	}
+
+	t.Run("parametersFrom: URL with feature disabled", func(t *testing.T) {
+		if err := utilfeature.DefaultFeatureGate.Set(fmt.Sprintf("%v=false", scfeatures.ParametersFromURL)); err != nil {
+			t.Fatalf("Failed to disable parameters from URL feature: %v", err)
+		}
+		testBuildParameters(t, allowedURLHosts, urlSource("/parameters.json"), nil, secret, nil, nil, false)
+	})
+}
+
+func testBuildParameters(t *testing.T, allowedURLHosts sets.String, parametersFrom []v1beta1.ParametersFromSource, parameters *runtime.RawExtension, secret *corev1.Secret, expected map[string]interface{}, expectedWithSecretsRdacted map[string]interface{}, shouldSucceed bool) {
 	// create a fake kube client
 	fakeKubeClient := &clientgofake.Clientset{}
 	if secret != nil {
@@ -156,7 +391,7 @@ func testBuildParameters(t *testing.T, parametersFrom []v1beta1.ParametersFromSo
 		addGetSecretNotFoundReaction(fakeKubeClient)
 	}
 
-	actual, actualWithSecretsRedacted, err := buildParameters(fakeKubeClient, "test-ns", parametersFrom, parameters)
+	actual, actualWithSecretsRedacted, err := buildParameters(fakeKubeClient, "test-ns", allowedURLHosts, parametersFrom, parameters)
 	if shouldSucceed {
 		if err != nil {
 			t.Fatalf("Failed to build parameters: %v", err)
@@ -286,3 +521,306 @@ func TestMergeParameters(t *testing.T) {
 func stringPtr(val string) *string {
 	return &val
 }
+
+func TestIsDefaultParametersChanged(t *testing.T) {
+	testcases := []struct {
+		name    string
+		applied *string
+		current *string
+		want    bool
+	}{
+		{name: "unchanged defaults", applied: stringPtr(`{"a":1,"d":{"e":5}}`), current: stringPtr(`{"a":1,"d":{"e":5}}`), want: false},
+		{name: "differently serialized defaults", applied: stringPtr(`{"a":1,"d":{"e":5}}`), current: stringPtr(`{ "d": {"e": 5}, "a": 1 }`), want: false},
+		{name: "no defaults and empty defaults", applied: stringPtr(`{}`), current: nil, want: false},
+		{name: "changed default value", applied: stringPtr(`{"a":1}`), current: stringPtr(`{"a":2}`), want: true},
+		{name: "changed nested default value", applied: stringPtr(`{"d":{"e":5}}`), current: stringPtr(`{"d":{"e":6}}`), want: true},
+		{name: "added default", applied: stringPtr(`{"a":1}`), current: stringPtr(`{"a":1,"b":2}`), want: true},
+		{name: "removed defaults", applied: stringPtr(`{"a":1}`), current: nil, want: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var applied, current *runtime.RawExtension
+			if tc.applied != nil {
+				applied = &runtime.RawExtension{Raw: []byte(*tc.applied)}
+			}
+			if tc.current != nil {
+				current = &runtime.RawExtension{Raw: []byte(*tc.current)}
+			}
+
+			got, err := isDefaultParametersChanged(applied, current)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Fatalf("WANT:\t%v\nGOT:\t%v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRebaseDefaultParameters(t *testing.T) {
+	oldDefaults := `{"a":1,"b":2,"d":{"e":5}}`
+	testcases := []struct {
+		name        string
+		params      string
+		newDefaults *string
+		want        *string
+	}{
+		{name: "parameters at their defaults take the new defaults", params: `{"a":1,"b":2,"d":{"e":5}}`, newDefaults: stringPtr(`{"a":10,"b":20,"d":{"e":50}}`), want: stringPtr(`{"a":10,"b":20,"d":{"e":50}}`)},
+		{name: "changed parameters are kept", params: `{"a":3,"b":2,"c":4,"d":{"e":5}}`, newDefaults: stringPtr(`{"a":10,"b":20,"d":{"e":5}}`), want: stringPtr(`{"a":3,"b":20,"c":4,"d":{"e":5}}`)},
+		{name: "removed defaults are dropped", params: `{"a":1,"b":2,"c":4,"d":{"e":5}}`, newDefaults: stringPtr(`{"a":1}`), want: stringPtr(`{"a":1,"c":4}`)},
+		{name: "no new defaults", params: `{"a":1,"b":2,"d":{"e":5}}`, newDefaults: nil, want: nil},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var newDefaults *runtime.RawExtension
+			if tc.newDefaults != nil {
+				newDefaults = &runtime.RawExtension{Raw: []byte(*tc.newDefaults)}
+			}
+
+			got, err := rebaseDefaultParameters(&runtime.RawExtension{Raw: []byte(tc.params)}, &runtime.RawExtension{Raw: []byte(oldDefaults)}, newDefaults)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			wantPretty := "nil"
+			if tc.want != nil {
+				wantPretty = *tc.want
+			}
+			gotPretty := "nil"
+			if got != nil {
+				gotPretty = string(got.Raw)
+			}
+			if wantPretty != gotPretty {
+				t.Fatalf("WANT:\t%v\nGOT:\t%v", wantPretty, gotPretty)
+			}
+		})
+	}
+}
+
+func TestParseParametersFromURLAllowedHosts(t *testing.T) {
+	cases := []struct {
+		name          string
+		value         string
+		expected      []string
+		shouldSucceed bool
+	}{
+		{
+			name:          "empty",
+			value:         "",
+			expected:      nil,
+			shouldSucceed: true,
+		},
+		{
+			name:          "multiple hosts",
+			value:         "Config.Example.com, 10.0.0.1",
+			expected:      []string{"config.example.com", "10.0.0.1"},
+			shouldSucceed: true,
+		},
+		{
+			name:          "invalid host",
+			value:         "example.com:443",
+			shouldSucceed: false,
+		},
+		{
+			name:          "empty host",
+			value:         "example.com,",
+			shouldSucceed: false,
+		},
+		{
+			name:          "duplicate host",
+			value:         "example.com,EXAMPLE.com",
+			shouldSucceed: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			hosts, err := ParseParametersFromURLAllowedHosts(tc.value)
+			if tc.shouldSucceed {
+				if err != nil {
+					t.Fatalf("Failed to parse %q: %v", tc.value, err)
+				}
+				if !reflect.DeepEqual(hosts, tc.expected) {
+					t.Errorf("Unexpected hosts; %s", expectedGot(tc.expected, hosts))
+				}
+			} else if err == nil {
+				t.Errorf("Expected parsing %q to fail", tc.value)
+			}
+		})
+	}
+}
+
+func TestParseNamespaceAnnotationParameters(t *testing.T) {
+	cases := []struct {
+		name          string
+		value         string
+		expected      map[string]string
+		shouldSucceed bool
+	}{
+		{
+			name:          "empty",
+			value:         "",
+			expected:      nil,
+			shouldSucceed: true,
+		},
+		{
+			name:  "multiple pairs",
+			value: "example.com/environment=environment, team=owner",
+			expected: map[string]string{
+				"example.com/environment": "environment",
+				"team":                    "owner",
+			},
+			shouldSucceed: true,
+		},
+		{
+			name:          "missing parameter",
+			value:         "team",
+			shouldSucceed: false,
+		},
+		{
+			name:          "empty parameter",
+			value:         "team=",
+			shouldSucceed: false,
+		},
+		{
+			name:          "invalid annotation",
+			value:         "not a valid/annotation/key=owner",
+			shouldSucceed: false,
+		},
+		{
+			name:          "duplicate annotation",
+			value:         "team=owner,team=group",
+			shouldSucceed: false,
+		},
+		{
+			name:          "duplicate parameter",
+			value:         "team=owner,example.com/team=owner",
+			shouldSucceed: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mapping, err := ParseNamespaceAnnotationParameters(tc.value)
+			if tc.shouldSucceed {
+				if err != nil {
+					t.Fatalf("Failed to parse %q: %v", tc.value, err)
+				}
+				if !reflect.DeepEqual(mapping, tc.expected) {
+					t.Errorf("Unexpected mapping: %s", diff.ObjectReflectDiff(tc.expected, mapping))
+				}
+			} else if err == nil {
+				t.Errorf("Expected parsing %q to fail", tc.value)
+			}
+		})
+	}
+}
+
+func TestGetUnsupportedParameterSchemaDraft(t *testing.T) {
+	cases := []struct {
+		name        string
+		schema      string
+		draft       string
+		unsupported bool
+	}{
+		{
+			name:   "no $schema",
+			schema: `{"type": "object"}`,
+		},
+		{
+			name:   "draft-04",
+			schema: `{"$schema": "http://json-schema.org/draft-04/schema#", "type": "object"}`,
+		},
+		{
+			name:   "draft-07 over https without trailing hash",
+			schema: `{"$schema": "https://json-schema.org/draft-07/schema", "type": "object"}`,
+		},
+		{
+			name:        "draft-03",
+			schema:      `{"$schema": "http://json-schema.org/draft-03/schema#", "type": "object"}`,
+			draft:       "http://json-schema.org/draft-03/schema#",
+			unsupported: true,
+		},
+		{
+			name:        "unknown meta-schema",
+			schema:      `{"$schema": "https://json-schema.org/draft/2019-09/schema"}`,
+			draft:       "https://json-schema.org/draft/2019-09/schema",
+			unsupported: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			draft, unsupported := getUnsupportedParameterSchemaDraft(&runtime.RawExtension{Raw: []byte(tc.schema)})
+			if e, a := tc.unsupported, unsupported; e != a {
+				t.Fatalf("Unexpected result; %s", expectedGot(e, a))
+			}
+			if e, a := tc.draft, draft; unsupported && e != a {
+				t.Fatalf("Unexpected draft; %s", expectedGot(e, a))
+			}
+		})
+	}
+}
+
+func TestValidateParametersAgainstSchema(t *testing.T) {
+	schema := map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"name"},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+			"size": map[string]interface{}{"type": "integer"},
+			"tier": map[string]interface{}{"enum": []interface{}{"basic", "premium"}},
+			"network": map[string]interface{}{
+				"type":                 "object",
+				"properties":           map[string]interface{}{"vpc": map[string]interface{}{"type": "string"}},
+				"additionalProperties": false,
+			},
+		},
+	}
+
+	cases := []struct {
+		name       string
+		parameters map[string]interface{}
+		violations []string
+	}{
+		{
+			name:       "compliant",
+			parameters: map[string]interface{}{"name": "db", "size": float64(3), "tier": "basic"},
+		},
+		{
+			name:       "missing required property",
+			parameters: map[string]interface{}{"size": float64(3)},
+			violations: []string{`parameters: "name" is required`},
+		},
+		{
+			name:       "wrong type",
+			parameters: map[string]interface{}{"name": "db", "size": float64(1.5)},
+			violations: []string{"parameters.size: must be of type integer"},
+		},
+		{
+			name:       "value not in enum",
+			parameters: map[string]interface{}{"name": "db", "tier": "gold"},
+			violations: []string{"parameters.tier: must be one of [basic premium]"},
+		},
+		{
+			name:       "nested additional property",
+			parameters: map[string]interface{}{"name": "db", "network": map[string]interface{}{"vpc": "a", "subnet": "b"}},
+			violations: []string{`parameters.network: "subnet" is not allowed`},
+		},
+		{
+			name:       "redacted value",
+			parameters: map[string]interface{}{"name": redactedParameterValue},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			violations := validateParametersAgainstSchema(tc.parameters, schema)
+			if !reflect.DeepEqual(violations, tc.violations) {
+				t.Errorf("Unexpected violations: %s", diff.ObjectReflectDiff(tc.violations, violations))
+			}
+		})
+	}
+}
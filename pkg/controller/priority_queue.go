@@ -0,0 +1,178 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// priorityQueue is a rate limiting queue handing out the waiting item with
+// the highest priority first, and the earliest added one among the items of
+// the same priority.
+//
+// It takes the items from a regular rate limiting queue as soon as they are
+// ready, and only reorders them: the regular queue still deduplicates,
+// delays and rate limits the items, and reports the metrics of the queue. An
+// item waiting in the priority queue is being processed as far as the
+// regular queue is concerned, so adding it again makes it be processed once
+// more after it is done, as when it is actually being processed. The items
+// waiting in the priority queue are therefore added to the depth metric of
+// the regular queue, and the time they wait is reported separately.
+type priorityQueue struct {
+	workqueue.RateLimitingInterface
+
+	// priority returns the priority of an item taken from the queue.
+	priority func(item interface{}) int32
+
+	// depth is the depth metric of the regular queue, and latency reports
+	// how long the items wait in the priority queue.
+	depth   workqueue.GaugeMetric
+	latency workqueue.SummaryMetric
+
+	startOnce sync.Once
+	cond      *sync.Cond
+	items     priorityItems
+	added     int64
+	// shutDown is set once the regular queue is shut down and empty.
+	shutDown bool
+}
+
+// newPriorityQueue returns a priority queue ordering the items of queue,
+// whose metrics are reported under name.
+func newPriorityQueue(queue workqueue.RateLimitingInterface, name string, priority func(item interface{}) int32) *priorityQueue {
+	return &priorityQueue{
+		RateLimitingInterface: queue,
+		priority:              priority,
+		depth:                 metrics.WorkqueueDepth.WithLabelValues(name),
+		latency:               metrics.WorkqueuePriorityLatency.WithLabelValues(name),
+		cond:                  sync.NewCond(&sync.Mutex{}),
+	}
+}
+
+// Get blocks until an item is ready, and returns the ready item with the
+// highest priority.
+func (q *priorityQueue) Get() (interface{}, bool) {
+	q.startOnce.Do(func() {
+		go q.takeItems()
+	})
+
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	// Wait for the items ready in the regular queue as well, so that they
+	// are ordered with the others.
+	for (len(q.items) == 0 || q.RateLimitingInterface.Len() > 0) && !q.shutDown {
+		q.cond.Wait()
+	}
+	if len(q.items) == 0 {
+		return nil, true
+	}
+	item := heap.Pop(&q.items).(*priorityItem)
+	q.depth.Dec()
+	q.latency.Observe(float64(time.Since(item.taken) / time.Microsecond))
+	return item.item, false
+}
+
+// Len returns the number of items waiting in the queue.
+func (q *priorityQueue) Len() int {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return len(q.items) + q.RateLimitingInterface.Len()
+}
+
+// takeItems moves the items from the regular queue to the priority queue as
+// soon as they are ready, until the regular queue is shut down.
+func (q *priorityQueue) takeItems() {
+	for {
+		item, shutDown := q.RateLimitingInterface.Get()
+
+		q.cond.L.Lock()
+		if shutDown {
+			q.shutDown = true
+		} else {
+			heap.Push(&q.items, &priorityItem{
+				item:     item,
+				priority: q.priority(item),
+				added:    q.added,
+				taken:    time.Now(),
+			})
+			q.added++
+			q.depth.Inc()
+		}
+		q.cond.Broadcast()
+		q.cond.L.Unlock()
+
+		if shutDown {
+			return
+		}
+	}
+}
+
+// priorityItem is an item waiting in a priority queue.
+type priorityItem struct {
+	item     interface{}
+	priority int32
+	added    int64
+	// taken is when the item was taken from the regular queue.
+	taken time.Time
+}
+
+// priorityItems implements heap.Interface, the first item having the highest
+// priority.
+type priorityItems []*priorityItem
+
+func (items priorityItems) Len() int { return len(items) }
+
+func (items priorityItems) Less(i, j int) bool {
+	if items[i].priority != items[j].priority {
+		return items[i].priority > items[j].priority
+	}
+	return items[i].added < items[j].added
+}
+
+func (items priorityItems) Swap(i, j int) { items[i], items[j] = items[j], items[i] }
+
+func (items *priorityItems) Push(x interface{}) {
+	*items = append(*items, x.(*priorityItem))
+}
+
+func (items *priorityItems) Pop() interface{} {
+	old := *items
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	*items = old[:len(old)-1]
+	return item
+}
+
+// getServiceInstancePriority returns the reconcile priority of the instance
+// with the given key, or 0 if the instance is not found.
+func (c *controller) getServiceInstancePriority(key interface{}) int32 {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key.(string))
+	if err != nil {
+		return 0
+	}
+	instance, err := c.instanceLister.ServiceInstances(namespace).Get(name)
+	if err != nil {
+		return 0
+	}
+	return instance.ReconcilePriority()
+}
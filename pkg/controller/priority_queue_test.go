@@ -0,0 +1,153 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/metrics"
+	dto "github.com/prometheus/client_model/go"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// TestPriorityQueueOrder tests that the items waiting in the queue are handed
+// out by decreasing priority, and in the order they were added among the items
+// of the same priority.
+func TestPriorityQueueOrder(t *testing.T) {
+	priorities := map[string]int32{
+		"low":       -1,
+		"default-1": 0,
+		"default-2": 0,
+		"high-1":    10,
+		"high-2":    10,
+		"highest":   100,
+	}
+	queue := newPriorityQueue(workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()), "", func(item interface{}) int32 {
+		return priorities[item.(string)]
+	})
+	for _, item := range []string{"default-1", "high-1", "low", "highest", "default-2", "high-2", "high-1"} {
+		queue.Add(item)
+	}
+	if e, a := 6, queue.Len(); e != a {
+		t.Fatalf("unexpected length of the queue; expected %v, got %v", e, a)
+	}
+
+	var order []string
+	for queue.Len() > 0 {
+		item, shutDown := queue.Get()
+		if shutDown {
+			t.Fatal("unexpected shutdown of the queue")
+		}
+		order = append(order, item.(string))
+		queue.Done(item)
+	}
+	if e, a := []string{"highest", "high-1", "high-2", "default-1", "default-2", "low"}, order; !reflect.DeepEqual(e, a) {
+		t.Fatalf("unexpected order; expected %v, got %v", e, a)
+	}
+}
+
+// TestPriorityQueueDepthMetric tests that the depth metric of the queue counts
+// the items waiting to be handed out by priority.
+func TestPriorityQueueDepthMetric(t *testing.T) {
+	const name = "test-priority-queue"
+	queue := newPriorityQueue(workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name), name, func(item interface{}) int32 {
+		return 0
+	})
+	defer queue.ShutDown()
+	depth := func() float64 {
+		m := &dto.Metric{}
+		if err := metrics.WorkqueueDepth.WithLabelValues(name).Write(m); err != nil {
+			t.Fatalf("unexpected error reading the depth metric: %v", err)
+		}
+		return m.GetGauge().GetValue()
+	}
+
+	for _, item := range []string{"a", "b", "c"} {
+		queue.Add(item)
+	}
+	if e, a := 3.0, depth(); e != a {
+		t.Fatalf("unexpected depth after queueing items; expected %v, got %v", e, a)
+	}
+
+	item, _ := queue.Get()
+	if e, a := 2.0, depth(); e != a {
+		t.Fatalf("unexpected depth after getting an item; expected %v, got %v", e, a)
+	}
+	queue.Done(item)
+	for queue.Len() > 0 {
+		item, _ := queue.Get()
+		queue.Done(item)
+	}
+	if e, a := 0.0, depth(); e != a {
+		t.Fatalf("unexpected depth after getting all the items; expected %v, got %v", e, a)
+	}
+}
+
+// TestPriorityQueueShutDown tests that the items waiting in the queue are
+// still handed out after it is shut down.
+func TestPriorityQueueShutDown(t *testing.T) {
+	queue := newPriorityQueue(workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()), "", func(item interface{}) int32 {
+		return 0
+	})
+	queue.Add("key")
+	queue.ShutDown()
+
+	item, shutDown := queue.Get()
+	if shutDown || item != "key" {
+		t.Fatalf("expected the item added before the shutdown, got %v (shutdown %v)", item, shutDown)
+	}
+	queue.Done(item)
+	if _, shutDown := queue.Get(); !shutDown {
+		t.Fatal("expected the queue to be shut down")
+	}
+}
+
+// TestServiceInstanceQueuePriority tests that the instance queue hands out the
+// instances by their reconcile priority.
+func TestServiceInstanceQueuePriority(t *testing.T) {
+	_, _, _, testController, sharedInformers := newTestController(t, noFakeActions())
+
+	for name, priority := range map[string]string{
+		"urgent":  "5",
+		"regular": "",
+		"invalid": "high",
+	} {
+		instance := getTestServiceInstance()
+		instance.Name = name
+		if priority != "" {
+			instance.Annotations = map[string]string{v1beta1.ServiceInstanceReconcilePriorityAnnotation: priority}
+		}
+		sharedInformers.ServiceInstances().Informer().GetStore().Add(instance)
+	}
+	for _, name := range []string{"regular", "invalid", "urgent"} {
+		testController.instanceQueue.Add(testNamespace + "/" + name)
+	}
+
+	var order []string
+	for testController.instanceQueue.Len() > 0 {
+		key, _ := testController.instanceQueue.Get()
+		order = append(order, key.(string))
+		testController.instanceQueue.Done(key)
+	}
+	expected := []string{testNamespace + "/urgent", testNamespace + "/regular", testNamespace + "/invalid"}
+	if !reflect.DeepEqual(expected, order) {
+		t.Fatalf("unexpected order; expected %v, got %v", expected, order)
+	}
+	testController.instanceQueue.ShutDown()
+}
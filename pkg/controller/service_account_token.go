@@ -0,0 +1,230 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog"
+)
+
+const (
+	// defaultServiceAccountTokenExpirationSeconds is the lifetime requested
+	// for broker tokens when the broker does not specify one.
+	defaultServiceAccountTokenExpirationSeconds int64 = 60 * 60
+
+	// serviceAccountTokenRefreshRatio is the fraction of a token's lifetime
+	// after which a new token is requested.
+	serviceAccountTokenRefreshRatio = 0.8
+)
+
+// TokenSource provides the bearer token used to authenticate to a broker.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// ServiceAccountTokenConfig identifies the service account token a broker
+// client authenticates with.
+type ServiceAccountTokenConfig struct {
+	Namespace         string
+	Name              string
+	Audience          string
+	ExpirationSeconds *int64
+}
+
+// ServiceAccountTokenSource obtains service account tokens with the
+// TokenRequest API and caches them until most of their lifetime has passed.
+type ServiceAccountTokenSource struct {
+	client kubernetes.Interface
+	config ServiceAccountTokenConfig
+
+	mu        sync.Mutex
+	token     string
+	refreshAt time.Time
+}
+
+// NewServiceAccountTokenSource creates ServiceAccountTokenSource instance
+func NewServiceAccountTokenSource(client kubernetes.Interface, config ServiceAccountTokenConfig) *ServiceAccountTokenSource {
+	return &ServiceAccountTokenSource{
+		client: client,
+		config: config,
+	}
+}
+
+// Token returns the cached token, requesting a new one if there is none yet
+// or the cached one is close to its expiry.
+func (s *ServiceAccountTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.token != "" && now.Before(s.refreshAt) {
+		return s.token, nil
+	}
+
+	expirationSeconds := defaultServiceAccountTokenExpirationSeconds
+	if s.config.ExpirationSeconds != nil {
+		expirationSeconds = *s.config.ExpirationSeconds
+	}
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         []string{s.config.Audience},
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+	klog.V(4).Infof("Requesting token for ServiceAccount %q in namespace %q", s.config.Name, s.config.Namespace)
+	result, err := s.client.CoreV1().ServiceAccounts(s.config.Namespace).CreateToken(s.config.Name, tokenRequest)
+	if err != nil {
+		return "", fmt.Errorf("failed to request token for ServiceAccount %q in namespace %q: %v", s.config.Name, s.config.Namespace, err)
+	}
+
+	lifetime := result.Status.ExpirationTimestamp.Time.Sub(now)
+	s.token = result.Status.Token
+	s.refreshAt = now.Add(time.Duration(float64(lifetime) * serviceAccountTokenRefreshRatio))
+	return s.token, nil
+}
+
+// tokenRefreshingClient is an osb.Client which authenticates with a bearer
+// token from a TokenSource. The underlying client is recreated whenever the
+// token source hands out a new token.
+type tokenRefreshingClient struct {
+	createFunc  osb.CreateFunc
+	config      osb.ClientConfiguration
+	tokenSource TokenSource
+
+	mu     sync.Mutex
+	token  string
+	client osb.Client
+}
+
+// newTokenRefreshingClient creates a tokenRefreshingClient. The first token is
+// requested immediately, so that a misconfigured token source is reported
+// when the client is created.
+func newTokenRefreshingClient(createFunc osb.CreateFunc, config *osb.ClientConfiguration, tokenSource TokenSource) (osb.Client, error) {
+	c := &tokenRefreshingClient{
+		createFunc:  createFunc,
+		config:      *config,
+		tokenSource: tokenSource,
+	}
+	if _, err := c.currentClient(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// currentClient returns a client which authenticates with the current token.
+func (c *tokenRefreshingClient) currentClient() (osb.Client, error) {
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil && token == c.token {
+		return c.client, nil
+	}
+	config := c.config
+	config.AuthConfig = &osb.AuthConfig{
+		BearerConfig: &osb.BearerConfig{Token: token},
+	}
+	client, err := c.createFunc(&config)
+	if err != nil {
+		return nil, err
+	}
+	c.token = token
+	c.client = client
+	return client, nil
+}
+
+func (c *tokenRefreshingClient) GetCatalog() (*osb.CatalogResponse, error) {
+	client, err := c.currentClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCatalog()
+}
+
+func (c *tokenRefreshingClient) ProvisionInstance(r *osb.ProvisionRequest) (*osb.ProvisionResponse, error) {
+	client, err := c.currentClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.ProvisionInstance(r)
+}
+
+func (c *tokenRefreshingClient) UpdateInstance(r *osb.UpdateInstanceRequest) (*osb.UpdateInstanceResponse, error) {
+	client, err := c.currentClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateInstance(r)
+}
+
+func (c *tokenRefreshingClient) DeprovisionInstance(r *osb.DeprovisionRequest) (*osb.DeprovisionResponse, error) {
+	client, err := c.currentClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.DeprovisionInstance(r)
+}
+
+func (c *tokenRefreshingClient) PollLastOperation(r *osb.LastOperationRequest) (*osb.LastOperationResponse, error) {
+	client, err := c.currentClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.PollLastOperation(r)
+}
+
+func (c *tokenRefreshingClient) PollBindingLastOperation(r *osb.BindingLastOperationRequest) (*osb.LastOperationResponse, error) {
+	client, err := c.currentClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.PollBindingLastOperation(r)
+}
+
+func (c *tokenRefreshingClient) Bind(r *osb.BindRequest) (*osb.BindResponse, error) {
+	client, err := c.currentClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.Bind(r)
+}
+
+func (c *tokenRefreshingClient) Unbind(r *osb.UnbindRequest) (*osb.UnbindResponse, error) {
+	client, err := c.currentClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.Unbind(r)
+}
+
+func (c *tokenRefreshingClient) GetBinding(r *osb.GetBindingRequest) (*osb.GetBindingResponse, error) {
+	client, err := c.currentClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBinding(r)
+}
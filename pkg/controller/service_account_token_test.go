@@ -0,0 +1,165 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/controller"
+	osb "github.com/pmorie/go-open-service-broker-client/v2"
+	fakeosb "github.com/pmorie/go-open-service-broker-client/v2/fake"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgofake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+func TestServiceAccountTokenSource(t *testing.T) {
+	// GIVEN
+	var requests []*authenticationv1.TokenRequest
+	expiresIn := time.Hour
+	fakeKubeClient := &clientgofake.Clientset{}
+	fakeKubeClient.AddReactor("create", "serviceaccounts", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		request := action.(clientgotesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+		requests = append(requests, request)
+		request.Status = authenticationv1.TokenRequestStatus{
+			Token:               fmt.Sprintf("token-%d", len(requests)),
+			ExpirationTimestamp: metav1.NewTime(time.Now().Add(expiresIn)),
+		}
+		return true, request, nil
+	})
+	source := controller.NewServiceAccountTokenSource(fakeKubeClient, controller.ServiceAccountTokenConfig{
+		Namespace: "test-ns",
+		Name:      "test-sa",
+		Audience:  "test-audience",
+	})
+
+	// WHEN
+	token1, err1 := source.Token()
+	token2, err2 := source.Token()
+
+	// THEN
+	if err1 != nil || err2 != nil {
+		t.Fatalf("Unexpected errors: %v, %v", err1, err2)
+	}
+	if token1 != "token-1" || token2 != "token-1" {
+		t.Fatalf("Expected the first token to be cached, got %q and %q", token1, token2)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("Expected a single token request, got %d", len(requests))
+	}
+	if e, a := []string{"test-audience"}, requests[0].Spec.Audiences; len(a) != 1 || a[0] != e[0] {
+		t.Fatalf("Unexpected audiences: expected %v, got %v", e, a)
+	}
+	if e, a := int64(3600), *requests[0].Spec.ExpirationSeconds; e != a {
+		t.Fatalf("Unexpected expiration: expected %v, got %v", e, a)
+	}
+	actions := fakeKubeClient.Actions()
+	if e, a := "token", actions[0].GetSubresource(); e != a {
+		t.Fatalf("Unexpected subresource: expected %v, got %v", e, a)
+	}
+	if e, a := "test-ns", actions[0].GetNamespace(); e != a {
+		t.Fatalf("Unexpected namespace: expected %v, got %v", e, a)
+	}
+
+	// WHEN
+	source = controller.NewServiceAccountTokenSource(fakeKubeClient, controller.ServiceAccountTokenConfig{
+		Namespace: "test-ns",
+		Name:      "test-sa",
+		Audience:  "test-audience",
+	})
+	expiresIn = time.Second
+	token3, _ := source.Token()
+	time.Sleep(time.Second)
+	token4, _ := source.Token()
+
+	// THEN
+	if token3 != "token-2" || token4 != "token-3" {
+		t.Fatalf("Expected the token to be refreshed before expiry, got %q and %q", token3, token4)
+	}
+}
+
+func TestBrokerClientManager_UpdateBrokerClientWithTokenSource(t *testing.T) {
+	// GIVEN
+	var configs []*osb.ClientConfiguration
+	brokerClientFunc := func(config *osb.ClientConfiguration) (osb.Client, error) {
+		configs = append(configs, config)
+		return fakeosb.NewFakeClient(fakeosb.FakeClientConfiguration{
+			CatalogReaction: &fakeosb.CatalogReaction{Response: &osb.CatalogResponse{}},
+		}), nil
+	}
+	manager := controller.NewBrokerClientManager(brokerClientFunc)
+	tokenSource := &fakeTokenSource{tokens: []string{"token-1", "token-1", "token-2"}}
+	tokenConfig := controller.ServiceAccountTokenConfig{Namespace: "test-ns", Name: "test-sa", Audience: "test-audience"}
+	tokenSourcesCreated := 0
+	newTokenSource := func() controller.TokenSource {
+		tokenSourcesCreated++
+		return tokenSource
+	}
+
+	// WHEN
+	client, err := manager.UpdateBrokerClientWithTokenSource(controller.NewClusterServiceBrokerKey("broker1"), testOsbConfig("osb-1"), tokenConfig, newTokenSource)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sameClient, _ := manager.UpdateBrokerClientWithTokenSource(controller.NewClusterServiceBrokerKey("broker1"), testOsbConfig("osb-1"), tokenConfig, newTokenSource)
+	client.GetCatalog()
+	client.GetCatalog()
+
+	// THEN
+	if client != sameClient {
+		t.Fatal("Broker client must not be recreated when the configuration is unchanged")
+	}
+	if tokenSourcesCreated != 1 {
+		t.Fatalf("Expected a single token source, got %d", tokenSourcesCreated)
+	}
+	if len(configs) != 2 {
+		t.Fatalf("Expected the underlying client to be recreated once the token changed, got %d clients", len(configs))
+	}
+	for i, expected := range []string{"token-1", "token-2"} {
+		if got := configs[i].AuthConfig.BearerConfig.Token; got != expected {
+			t.Fatalf("Unexpected token of client %d: expected %q, got %q", i, expected, got)
+		}
+	}
+
+	// WHEN
+	tokenConfig.Audience = "other-audience"
+	otherClient, _ := manager.UpdateBrokerClientWithTokenSource(controller.NewClusterServiceBrokerKey("broker1"), testOsbConfig("osb-1"), tokenConfig, newTokenSource)
+
+	// THEN
+	if otherClient == client {
+		t.Fatal("Broker client must be recreated when the token configuration changes")
+	}
+	if tokenSourcesCreated != 2 {
+		t.Fatalf("Expected a new token source, got %d", tokenSourcesCreated)
+	}
+}
+
+type fakeTokenSource struct {
+	tokens []string
+}
+
+func (s *fakeTokenSource) Token() (string, error) {
+	token := s.tokens[0]
+	if len(s.tokens) > 1 {
+		s.tokens = s.tokens[1:]
+	}
+	return token, nil
+}
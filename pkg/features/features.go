@@ -77,6 +77,16 @@ const (
 	// owner: @carolynvs
 	// alpha: v0.1.32
 	ServicePlanDefaults utilfeature.Feature = "ServicePlanDefaults"
+
+	// ServiceInstanceContextUpdates enables sending update service instance
+	// requests to brokers when only the context of an instance has changed.
+	// alpha: v0.1.42
+	ServiceInstanceContextUpdates utilfeature.Feature = "ServiceInstanceContextUpdates"
+
+	// ParametersFromURL enables the urlRef source of parametersFrom, fetching
+	// parameters over HTTP(S) from the hosts allowed by the controller.
+	// alpha: v0.1.43
+	ParametersFromURL utilfeature.Feature = "ParametersFromURL"
 )
 
 func init() {
@@ -87,12 +97,14 @@ func init() {
 // To add a new feature, define a key for it above and add it here. The features will be
 // available throughout service catalog binaries.
 var defaultServiceCatalogFeatureGates = map[utilfeature.Feature]utilfeature.FeatureSpec{
-	PodPreset:                  {Default: false, PreRelease: utilfeature.Alpha},
-	OriginatingIdentity:        {Default: true, PreRelease: utilfeature.GA},
-	AsyncBindingOperations:     {Default: false, PreRelease: utilfeature.Alpha},
-	NamespacedServiceBroker:    {Default: true, PreRelease: utilfeature.Alpha},
-	ResponseSchema:             {Default: false, PreRelease: utilfeature.Alpha},
-	UpdateDashboardURL:         {Default: false, PreRelease: utilfeature.Alpha},
-	OriginatingIdentityLocking: {Default: true, PreRelease: utilfeature.Alpha},
-	ServicePlanDefaults:        {Default: false, PreRelease: utilfeature.Alpha},
+	PodPreset:                     {Default: false, PreRelease: utilfeature.Alpha},
+	OriginatingIdentity:           {Default: true, PreRelease: utilfeature.GA},
+	AsyncBindingOperations:        {Default: false, PreRelease: utilfeature.Alpha},
+	NamespacedServiceBroker:       {Default: true, PreRelease: utilfeature.Alpha},
+	ResponseSchema:                {Default: false, PreRelease: utilfeature.Alpha},
+	UpdateDashboardURL:            {Default: false, PreRelease: utilfeature.Alpha},
+	OriginatingIdentityLocking:    {Default: true, PreRelease: utilfeature.Alpha},
+	ServicePlanDefaults:           {Default: false, PreRelease: utilfeature.Alpha},
+	ServiceInstanceContextUpdates: {Default: false, PreRelease: utilfeature.Alpha},
+	ParametersFromURL:             {Default: false, PreRelease: utilfeature.Alpha},
 }
@@ -72,6 +72,19 @@ var (
 		},
 		[]string{"broker", "method", "status"},
 	)
+
+	// LeaderElectionMasterStatus exposes whether this controller manager
+	// replica currently holds the leader election lock: 1 while it is the
+	// leader, 0 otherwise. The metric is labeled with the replica's leader
+	// election identity.
+	LeaderElectionMasterStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Name:      "leader_election_master_status",
+			Help:      "Whether the controller manager replica is the leader (1) or not (0), by leader election identity.",
+		},
+		[]string{"identity"},
+	)
 )
 
 func register(registry *prometheus.Registry) {
@@ -79,6 +92,15 @@ func register(registry *prometheus.Registry) {
 		registry.MustRegister(BrokerServiceClassCount)
 		registry.MustRegister(BrokerServicePlanCount)
 		registry.MustRegister(OSBRequestCount)
+		registry.MustRegister(LeaderElectionMasterStatus)
+		registry.MustRegister(WorkqueueDepth)
+		registry.MustRegister(WorkqueueAdds)
+		registry.MustRegister(WorkqueueRetries)
+		registry.MustRegister(WorkqueueLatency)
+		registry.MustRegister(WorkqueuePriorityLatency)
+		registry.MustRegister(WorkqueueWorkDuration)
+		registry.MustRegister(WorkqueueUnfinishedWork)
+		registry.MustRegister(WorkqueueLongestRunningProcessor)
 	})
 }
 
@@ -0,0 +1,165 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const workqueueSubsystem = "workqueue"
+
+var (
+	// The work queue metrics are labeled with the name of the queue, e.g.
+	// "service-instance", "service-binding" or "cluster-service-broker".
+	// Only named queues report metrics.
+
+	// WorkqueueDepth exposes the current number of items waiting in each
+	// work queue.
+	WorkqueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "depth",
+			Help:      "Current depth of the work queue, by queue name.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueAdds exposes the number of items added to each work queue.
+	WorkqueueAdds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "adds_total",
+			Help:      "Cumulative number of items added to the work queue, by queue name.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueRetries exposes the number of items requeued with rate
+	// limiting, i.e. retried after a failed reconcile, for each work queue.
+	WorkqueueRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "retries_total",
+			Help:      "Cumulative number of retries handled by the work queue, by queue name.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueLatency exposes how long items wait in each work queue before
+	// being processed.
+	WorkqueueLatency = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "queue_latency_microseconds",
+			Help:      "How long an item stays in the work queue before being processed, by queue name.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueuePriorityLatency exposes how long the items taken from a work
+	// queue ordered by priority wait to be handed out by priority. For those
+	// queues it adds to the queue latency, which ends when an item is taken
+	// from the underlying queue.
+	WorkqueuePriorityLatency = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "priority_latency_microseconds",
+			Help:      "How long an item taken from a work queue ordered by priority waits to be handed out, by queue name.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueWorkDuration exposes how long processing an item from each
+	// work queue takes.
+	WorkqueueWorkDuration = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "work_duration_microseconds",
+			Help:      "How long processing an item from the work queue takes, by queue name.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueUnfinishedWork exposes how long the items of each work queue
+	// that are still being processed have been in progress.
+	WorkqueueUnfinishedWork = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "unfinished_work_seconds",
+			Help:      "Seconds of work in progress that has not been observed by work_duration, by queue name.",
+		},
+		[]string{"name"},
+	)
+
+	// WorkqueueLongestRunningProcessor exposes how long the longest running
+	// processor of each work queue has been running.
+	WorkqueueLongestRunningProcessor = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: catalogNamespace,
+			Subsystem: workqueueSubsystem,
+			Name:      "longest_running_processor_microseconds",
+			Help:      "How long the longest running processor of the work queue has been running, by queue name.",
+		},
+		[]string{"name"},
+	)
+)
+
+func init() {
+	// Work queues pick their metrics provider up when they are created, so
+	// it has to be set before the controllers build their queues.
+	workqueue.SetProvider(workqueueMetricsProvider{})
+}
+
+// workqueueMetricsProvider implements workqueue.MetricsProvider on top of the
+// Service Catalog Prometheus metrics.
+type workqueueMetricsProvider struct{}
+
+func (workqueueMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return WorkqueueDepth.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	return WorkqueueAdds.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewLatencyMetric(name string) workqueue.SummaryMetric {
+	return WorkqueueLatency.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewWorkDurationMetric(name string) workqueue.SummaryMetric {
+	return WorkqueueWorkDuration.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return WorkqueueUnfinishedWork.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewLongestRunningProcessorMicrosecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return WorkqueueLongestRunningProcessor.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return WorkqueueRetries.WithLabelValues(name)
+}
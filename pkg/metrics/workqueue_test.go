@@ -0,0 +1,68 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func metricValue(t *testing.T, metric prometheus.Metric) float64 {
+	m := &dto.Metric{}
+	if err := metric.Write(m); err != nil {
+		t.Fatalf("unexpected error reading metric: %v", err)
+	}
+	if m.Gauge != nil {
+		return m.GetGauge().GetValue()
+	}
+	return m.GetCounter().GetValue()
+}
+
+func TestWorkqueueMetrics(t *testing.T) {
+	const name = "test-queue"
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Millisecond, time.Millisecond), name)
+	defer queue.ShutDown()
+
+	queue.Add("a")
+	queue.Add("b")
+	if e, a := 2.0, metricValue(t, WorkqueueDepth.WithLabelValues(name)); e != a {
+		t.Fatalf("unexpected depth after queueing items; expected %v, got %v", e, a)
+	}
+	if e, a := 2.0, metricValue(t, WorkqueueAdds.WithLabelValues(name)); e != a {
+		t.Fatalf("unexpected adds after queueing items; expected %v, got %v", e, a)
+	}
+
+	item, _ := queue.Get()
+	if e, a := 1.0, metricValue(t, WorkqueueDepth.WithLabelValues(name)); e != a {
+		t.Fatalf("unexpected depth after getting an item; expected %v, got %v", e, a)
+	}
+
+	queue.AddRateLimited(item)
+	queue.Done(item)
+	if e, a := 1.0, metricValue(t, WorkqueueRetries.WithLabelValues(name)); e != a {
+		t.Fatalf("unexpected retries after requeueing an item; expected %v, got %v", e, a)
+	}
+
+	// Unnamed queues do not report metrics.
+	if e, a := 0.0, metricValue(t, WorkqueueAdds.WithLabelValues("")); e != a {
+		t.Fatalf("unexpected adds for unnamed queues; expected %v, got %v", e, a)
+	}
+}
@@ -31,72 +31,81 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeyTransform":                schema_pkg_apis_servicecatalog_v1beta1_AddKeyTransform(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeysFromTransform":           schema_pkg_apis_servicecatalog_v1beta1_AddKeysFromTransform(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BasicAuthConfig":                schema_pkg_apis_servicecatalog_v1beta1_BasicAuthConfig(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig":          schema_pkg_apis_servicecatalog_v1beta1_BearerTokenAuthConfig(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions":            schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBasicAuthConfig":         schema_pkg_apis_servicecatalog_v1beta1_ClusterBasicAuthConfig(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenAuthConfig":   schema_pkg_apis_servicecatalog_v1beta1_ClusterBearerTokenAuthConfig(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference":         schema_pkg_apis_servicecatalog_v1beta1_ClusterObjectReference(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBroker":           schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBroker(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo":   schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerAuthInfo(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerList":       schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerSpec":       schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerStatus":     schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClass":            schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClass(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassList":        schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassSpec":        schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassStatus":      schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlan":             schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlan(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanList":         schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanSpec":         schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanStatus":       schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerSpec":        schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerStatus":      schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassSpec":         schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassStatus":       schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanSpec":          schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanStatus":        schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference":           schema_pkg_apis_servicecatalog_v1beta1_LocalObjectReference(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ObjectReference":                schema_pkg_apis_servicecatalog_v1beta1_ObjectReference(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource":           schema_pkg_apis_servicecatalog_v1beta1_ParametersFromSource(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.PlanReference":                  schema_pkg_apis_servicecatalog_v1beta1_PlanReference(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.RemoveKeyTransform":             schema_pkg_apis_servicecatalog_v1beta1_RemoveKeyTransform(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.RenameKeyTransform":             schema_pkg_apis_servicecatalog_v1beta1_RenameKeyTransform(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference":             schema_pkg_apis_servicecatalog_v1beta1_SecretKeyReference(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform":                schema_pkg_apis_servicecatalog_v1beta1_SecretTransform(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBinding":                 schema_pkg_apis_servicecatalog_v1beta1_ServiceBinding(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingCondition":        schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingCondition(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingList":             schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState":  schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingPropertiesState(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingSpec":             schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingStatus":           schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBroker":                  schema_pkg_apis_servicecatalog_v1beta1_ServiceBroker(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo":          schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerAuthInfo(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition":         schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerCondition(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerList":              schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSpec":              schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerStatus":            schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClass":                   schema_pkg_apis_servicecatalog_v1beta1_ServiceClass(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassList":               schema_pkg_apis_servicecatalog_v1beta1_ServiceClassList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassSpec":               schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassStatus":             schema_pkg_apis_servicecatalog_v1beta1_ServiceClassStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstance":                schema_pkg_apis_servicecatalog_v1beta1_ServiceInstance(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition":       schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceCondition(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceList":            schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState": schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceSpec":            schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceStatus":          schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlan":                    schema_pkg_apis_servicecatalog_v1beta1_ServicePlan(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanList":                schema_pkg_apis_servicecatalog_v1beta1_ServicePlanList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanSpec":                schema_pkg_apis_servicecatalog_v1beta1_ServicePlanSpec(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanStatus":              schema_pkg_apis_servicecatalog_v1beta1_ServicePlanStatus(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo":                       schema_pkg_apis_servicecatalog_v1beta1_UserInfo(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPreset":                           schema_pkg_apis_settings_v1alpha1_PodPreset(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPresetList":                       schema_pkg_apis_settings_v1alpha1_PodPresetList(ref),
-		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPresetSpec":                       schema_pkg_apis_settings_v1alpha1_PodPresetSpec(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                                            schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeyTransform":                                schema_pkg_apis_servicecatalog_v1beta1_AddKeyTransform(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.AddKeysFromTransform":                           schema_pkg_apis_servicecatalog_v1beta1_AddKeysFromTransform(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BasicAuthConfig":                                schema_pkg_apis_servicecatalog_v1beta1_BasicAuthConfig(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig":                          schema_pkg_apis_servicecatalog_v1beta1_BearerTokenAuthConfig(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenFromServiceAccountAuthConfig":        schema_pkg_apis_servicecatalog_v1beta1_BearerTokenFromServiceAccountAuthConfig(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CatalogRestrictions":                            schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBasicAuthConfig":                         schema_pkg_apis_servicecatalog_v1beta1_ClusterBasicAuthConfig(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenAuthConfig":                   schema_pkg_apis_servicecatalog_v1beta1_ClusterBearerTokenAuthConfig(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenFromServiceAccountAuthConfig": schema_pkg_apis_servicecatalog_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference":                         schema_pkg_apis_servicecatalog_v1beta1_ClusterObjectReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBroker":                           schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBroker(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo":                   schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerAuthInfo(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerList":                       schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerSpec":                       schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerStatus":                     schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClass":                            schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClass(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassList":                        schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassSpec":                        schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceClassStatus":                      schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceClassStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlan":                             schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlan(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanList":                         schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanSpec":                         schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServicePlanStatus":                       schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerSpec":                        schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceBrokerStatus":                      schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassSpec":                         schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServiceClassStatus":                       schema_pkg_apis_servicecatalog_v1beta1_CommonServiceClassStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanSpec":                          schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.CommonServicePlanStatus":                        schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ExtensionAPI":                                   schema_pkg_apis_servicecatalog_v1beta1_ExtensionAPI(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.GeneratedParameter":                             schema_pkg_apis_servicecatalog_v1beta1_GeneratedParameter(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.GeneratedParameters":                            schema_pkg_apis_servicecatalog_v1beta1_GeneratedParameters(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference":                           schema_pkg_apis_servicecatalog_v1beta1_LocalObjectReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ObjectReference":                                schema_pkg_apis_servicecatalog_v1beta1_ObjectReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource":                           schema_pkg_apis_servicecatalog_v1beta1_ParametersFromSource(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.PlanReference":                                  schema_pkg_apis_servicecatalog_v1beta1_PlanReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.RemoveKeyTransform":                             schema_pkg_apis_servicecatalog_v1beta1_RemoveKeyTransform(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.RenameKeyTransform":                             schema_pkg_apis_servicecatalog_v1beta1_RenameKeyTransform(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference":                             schema_pkg_apis_servicecatalog_v1beta1_SecretKeyReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretTransform":                                schema_pkg_apis_servicecatalog_v1beta1_SecretTransform(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBinding":                                 schema_pkg_apis_servicecatalog_v1beta1_ServiceBinding(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingCondition":                        schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingCondition(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingList":                             schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState":                  schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingPropertiesState(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingSpec":                             schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingStatus":                           schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingVolumeDevice":                     schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingVolumeDevice(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingVolumeMount":                      schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingVolumeMount(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBroker":                                  schema_pkg_apis_servicecatalog_v1beta1_ServiceBroker(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerAuthInfo":                          schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerAuthInfo(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerCondition":                         schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerCondition(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerList":                              schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerSpec":                              schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBrokerStatus":                            schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClass":                                   schema_pkg_apis_servicecatalog_v1beta1_ServiceClass(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassList":                               schema_pkg_apis_servicecatalog_v1beta1_ServiceClassList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassSpec":                               schema_pkg_apis_servicecatalog_v1beta1_ServiceClassSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceClassStatus":                             schema_pkg_apis_servicecatalog_v1beta1_ServiceClassStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstance":                                schema_pkg_apis_servicecatalog_v1beta1_ServiceInstance(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition":                       schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceCondition(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceList":                            schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState":                 schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceSpec":                            schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceStatus":                          schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlan":                                    schema_pkg_apis_servicecatalog_v1beta1_ServicePlan(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCost":                                schema_pkg_apis_servicecatalog_v1beta1_ServicePlanCost(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanList":                                schema_pkg_apis_servicecatalog_v1beta1_ServicePlanList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanSpec":                                schema_pkg_apis_servicecatalog_v1beta1_ServicePlanSpec(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanStatus":                              schema_pkg_apis_servicecatalog_v1beta1_ServicePlanStatus(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.URLReference":                                   schema_pkg_apis_servicecatalog_v1beta1_URLReference(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo":                                       schema_pkg_apis_servicecatalog_v1beta1_UserInfo(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPreset":                                           schema_pkg_apis_settings_v1alpha1_PodPreset(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPresetList":                                       schema_pkg_apis_settings_v1alpha1_PodPresetList(ref),
+		"github.com/kubernetes-incubator/service-catalog/pkg/apis/settings/v1alpha1.PodPresetSpec":                                       schema_pkg_apis_settings_v1alpha1_PodPresetSpec(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                                                            schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
 		"k8s.io/api/core/v1.Affinity":                                    schema_k8sio_api_core_v1_Affinity(ref),
 		"k8s.io/api/core/v1.AttachedVolume":                              schema_k8sio_api_core_v1_AttachedVolume(ref),
 		"k8s.io/api/core/v1.AvoidPods":                                   schema_k8sio_api_core_v1_AvoidPods(ref),
@@ -441,6 +450,40 @@ func schema_pkg_apis_servicecatalog_v1beta1_BearerTokenAuthConfig(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_BearerTokenFromServiceAccountAuthConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BearerTokenFromServiceAccountAuthConfig provides config for the bearer token authentication of namespace scoped brokers with service account tokens. The tokens are requested for the given audience and refreshed before they expire.",
+				Properties: map[string]spec.Schema{
+					"serviceAccountRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountRef is a reference to the ServiceAccount, in the namespace of the ServiceBroker, whose token the catalog should use to authenticate to this ServiceBroker.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference"),
+						},
+					},
+					"audience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Audience is the intended audience of the token. The broker is expected to reject tokens issued for any other audience.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationSeconds is the requested lifetime of the token. Defaults to one hour and must be at least 10 minutes.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_CatalogRestrictions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -522,6 +565,40 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterBearerTokenAuthConfig(ref com
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_ClusterBearerTokenFromServiceAccountAuthConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterBearerTokenFromServiceAccountAuthConfig provides config for the bearer token authentication of cluster scoped brokers with service account tokens. The tokens are requested for the given audience and refreshed before they expire.",
+				Properties: map[string]spec.Schema{
+					"serviceAccountRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountRef is a reference to the ServiceAccount whose token the catalog should use to authenticate to this ClusterServiceBroker.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ObjectReference"),
+						},
+					},
+					"audience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Audience is the intended audience of the token. The broker is expected to reject tokens issued for any other audience.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationSeconds is the requested lifetime of the token. Defaults to one hour and must be at least 10 minutes.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ObjectReference"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_ClusterObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -611,11 +688,17 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerAuthInfo(ref com
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenAuthConfig"),
 						},
 					},
+					"bearerTokenFromServiceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterBearerTokenFromServiceAccountAuthConfig provides configuration to send a token of a service account, obtained with the TokenRequest API, as a bearer token.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenFromServiceAccountAuthConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBasicAuthConfig", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenAuthConfig"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBasicAuthConfig", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenAuthConfig", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterBearerTokenFromServiceAccountAuthConfig"},
 	}
 }
 
@@ -692,6 +775,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref common.
 							Format:      "byte",
 						},
 					},
+					"osbApiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion is the version of the Open Service Broker API used to communicate with the Broker, sent in the X-Broker-API-Version header. One of \"2.11\", \"2.12\" or \"2.13\". Defaults to the version preferred by the controller.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"relistBehavior": {
 						SchemaProps: spec.SchemaProps{
 							Description: "RelistBehavior specifies the type of relist behavior the catalog should exhibit when relisting ServiceClasses available from a broker.",
@@ -724,6 +814,12 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerSpec(ref common.
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo"),
 						},
 					},
+					"fallbackAuthInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FallbackAuthInfo contains the data that the service catalog should use to authenticate with the ClusterServiceBroker when the broker rejects the credentials of AuthInfo with a 401 Unauthorized response, for example while the credentials of the broker are being rotated. Only basic and bearer authentication are supported, and AuthInfo must be set to one of them as well.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterServiceBrokerAuthInfo"),
+						},
+					},
 				},
 				Required: []string{"url"},
 			},
@@ -777,6 +873,41 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServiceBrokerStatus(ref commo
 							Format:      "",
 						},
 					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Features is the list of optional Open Service Broker API features advertised in the broker's catalog when it was last fetched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"lastCatalogClassCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCatalogClassCount is the number of classes in the broker's catalog that passed its catalog restrictions when it was last fetched.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastCatalogPlanCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCatalogPlanCount is the number of plans in the broker's catalog that passed its catalog restrictions when it was last fetched.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSuccessfulEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSuccessfulEndpoint is the URL of the broker endpoint which served the last successful catalog fetch. It lags behind the spec when the broker's URL is changed to an endpoint that cannot be reached.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"conditions", "reconciledGeneration", "lastConditionState"},
 			},
@@ -1217,11 +1348,25 @@ func schema_pkg_apis_servicecatalog_v1beta1_ClusterServicePlanStatus(ref common.
 							Format:      "",
 						},
 					},
+					"costs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Costs are the costs of the plan, as reported by the broker in the \"costs\" field of the plan's external metadata.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCost"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCost"},
 	}
 }
 
@@ -1252,6 +1397,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerSpec(ref common.R
 							Format:      "byte",
 						},
 					},
+					"osbApiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion is the version of the Open Service Broker API used to communicate with the Broker, sent in the X-Broker-API-Version header. One of \"2.11\", \"2.12\" or \"2.13\". Defaults to the version preferred by the controller.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"relistBehavior": {
 						SchemaProps: spec.SchemaProps{
 							Description: "RelistBehavior specifies the type of relist behavior the catalog should exhibit when relisting ServiceClasses available from a broker.",
@@ -1331,6 +1483,41 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServiceBrokerStatus(ref common
 							Format:      "",
 						},
 					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Features is the list of optional Open Service Broker API features advertised in the broker's catalog when it was last fetched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"lastCatalogClassCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCatalogClassCount is the number of classes in the broker's catalog that passed its catalog restrictions when it was last fetched.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastCatalogPlanCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCatalogPlanCount is the number of plans in the broker's catalog that passed its catalog restrictions when it was last fetched.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSuccessfulEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSuccessfulEndpoint is the URL of the broker endpoint which served the last successful catalog fetch. It lags behind the spec when the broker's URL is changed to an endpoint that cannot be reached.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"conditions", "reconciledGeneration", "lastConditionState"},
 			},
@@ -1557,14 +1744,133 @@ func schema_pkg_apis_servicecatalog_v1beta1_CommonServicePlanStatus(ref common.R
 							Format:      "",
 						},
 					},
+					"costs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Costs are the costs of the plan, as reported by the broker in the \"costs\" field of the plan's external metadata.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCost"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCost"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ExtensionAPI(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExtensionAPI describes an API, beyond the Open Service Broker API, that a broker offers for a ServiceInstance. The credentials the broker may return for the API are not recorded.",
+				Properties: map[string]spec.Schema{
+					"discoveryURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiscoveryURL is the URL of an OpenAPI document describing the API.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serverURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerURL is the base URL of the paths described by the OpenAPI document. If empty, the paths are relative to the URL of the broker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"adheresTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdheresTo is the URI of a specification the OpenAPI document adheres to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"discoveryURL"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_GeneratedParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratedParameter describes a parameter whose value is a random string.",
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the top-level parameter, and of the key of the Secret storing its value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"length": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Length is the number of characters of the value. Defaults to 32.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"charset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Charset is the set of characters the value is made of. Defaults to the ASCII letters and digits.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
 		Dependencies: []string{},
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_GeneratedParameters(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratedParameters describes the parameters of a ServiceInstance whose values are generated by the controller.",
+				Properties: map[string]spec.Schema{
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of the Secret, in the namespace of the ServiceInstance, storing the generated values keyed by parameter name. The controller creates the Secret, owned by the ServiceInstance, and only generates the values missing from it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters are the parameters to generate a value for.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.GeneratedParameter"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"secretName", "parameters"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.GeneratedParameter"},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_LocalObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1620,15 +1926,28 @@ func schema_pkg_apis_servicecatalog_v1beta1_ParametersFromSource(ref common.Refe
 				Properties: map[string]spec.Schema{
 					"secretKeyRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Secret key to select from. The value must be a JSON object.",
+							Description: "The Secret key to select from. The value must be a JSON object, unless ParameterName is set.",
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"),
 						},
 					},
+					"urlRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URLRef references a document fetched over HTTP(S) whenever the parameters are sent to the broker. The document must be a JSON object, unless ParameterName is set.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.URLReference"),
+						},
+					},
+					"parameterName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParameterName is the name of the parameter the value of the source is assigned to. If set, the value is passed as a single string parameter instead of being parsed as a JSON object of parameters.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.URLReference"},
 	}
 }
 
@@ -1935,6 +2254,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingCondition(ref common.R
 							Format:      "",
 						},
 					},
+					"errorCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorCode is a machine readable classification of the failed request to the broker the condition reports, one of the ErrorCode constants. It is empty when the condition does not report a failed request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"type", "status", "lastTransitionTime", "reason", "message"},
 			},
@@ -2054,6 +2380,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref common.Refere
 							},
 						},
 					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the name of the endpoint of the ServiceInstance the binding is for, when the instance exposes several of them. It is sent to the broker in the \"endpoint\" field of the bind request's context.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"secretName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "SecretName is the name of the secret to create in the ServiceBinding's namespace that will hold the credentials associated with the ServiceBinding.",
@@ -2074,18 +2407,67 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingSpec(ref common.Refere
 							},
 						},
 					},
-					"externalID": {
+					"secretKeyFormat": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExternalID is the identity of this object for use with the OSB API.\n\nImmutable.",
+							Description: "SecretKeyFormat is the naming convention all credential keys are converted to after the SecretTransforms have been applied. Keys that collide after the conversion cause the binding to fail. When empty, the keys are written to the Secret unchanged.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"userInfo": {
+					"additionalSecretNamespaces": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nUserInfo contains information about the user that last modified this ServiceBinding. This field is set by the API server and not settable by the end-user. User-provided values for this field are not saved.",
-							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo"),
-						},
+							Description: "AdditionalSecretNamespaces is a list of namespaces, other than the ServiceBinding's namespace, into which a copy of the Secret holding the credentials should be written. The copies are kept in sync with the Secret and are deleted when the ServiceBinding is unbound.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"secretLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretLabels are labels to set on the Secret holding the credentials and on its copies. The labels are updated every time the Secret is written.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"secretAnnotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretAnnotations are annotations to set on the Secret holding the credentials and on its copies, in addition to the annotations describing the instance, class and plan of the ServiceBinding. The annotations are updated every time the Secret is written.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"externalID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalID is the identity of this object for use with the OSB API.\n\nImmutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Currently, this field is ALPHA: it may change or disappear at any time and its data will not be migrated.\n\nUserInfo contains information about the user that last modified this ServiceBinding. This field is set by the API server and not settable by the end-user. User-provided values for this field are not saved.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo"),
+						},
 					},
 				},
 				Required: []string{"instanceRef"},
@@ -2142,6 +2524,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref common.Refe
 							Format:      "int64",
 						},
 					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the 'Generation' of the ServiceBindingSpec that was last processed by the controller. The observed generation is updated once an operation on the binding has finished, regardless of its result.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"operationStartTime": {
 						SchemaProps: spec.SchemaProps{
 							Description: "OperationStartTime is the time at which the current operation began.",
@@ -2167,6 +2556,47 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref common.Refe
 							Format:      "",
 						},
 					},
+					"secretWritePending": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretWritePending is set to true when the broker has successfully processed the current Bind operation but the credentials it returned have not been written to the Secret yet. While it is set, the controller retries writing the Secret without sending another bind request to the broker.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"secretCopyNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretCopyNamespaces is the list of namespaces into which a copy of the Secret holding the credentials may have been written. It is used to clean up the copies on unbind or when a namespace is removed from AdditionalSecretNamespaces.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"routeServiceURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RouteServiceURL is the URL returned by the broker to which the platform must proxy requests for the application the ServiceBinding is for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeMounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts is the list of volume mounts returned by the broker for the ServiceBinding.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingVolumeMount"),
+									},
+								},
+							},
+						},
+					},
 					"unbindStatus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "UnbindStatus describes what has been done to unbind the ServiceBinding.",
@@ -2182,11 +2612,88 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingStatus(ref common.Refe
 						},
 					},
 				},
-				Required: []string{"conditions", "asyncOpInProgress", "reconciledGeneration", "orphanMitigationInProgress", "unbindStatus", "lastConditionState"},
+				Required: []string{"conditions", "asyncOpInProgress", "reconciledGeneration", "observedGeneration", "orphanMitigationInProgress", "unbindStatus", "lastConditionState"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingCondition", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingCondition", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingPropertiesState", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingVolumeMount", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingVolumeDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceBindingVolumeDevice is a device returned in a volume mount.",
+				Properties: map[string]spec.Schema{
+					"volumeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeID is the ID of the shared volume to mount.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MountConfig is the configuration object passed to the volume driver when mounting the volume.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"volumeID"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_ServiceBindingVolumeMount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceBindingVolumeMount is a volume mount returned by a broker for a ServiceBinding, as defined by the Open Service Broker API.",
+				Properties: map[string]spec.Schema{
+					"driver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Driver is the name of the volume driver plugin which manages the device.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"containerDir": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerDir is the directory to mount inside the application container.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode describes whether the volume is mounted read-only or read-write.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deviceType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceType is the type of the device. Only \"shared\" is currently defined by the Open Service Broker API.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"device": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Device is the device object to mount.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingVolumeDevice"),
+						},
+					},
+				},
+				Required: []string{"driver", "containerDir", "mode", "deviceType", "device"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceBindingVolumeDevice"},
 	}
 }
 
@@ -2259,11 +2766,17 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerAuthInfo(ref common.Ref
 							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig"),
 						},
 					},
+					"bearerTokenFromServiceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerTokenFromServiceAccountAuthConfig provides configuration to send a token of a service account, obtained with the TokenRequest API, as a bearer token.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenFromServiceAccountAuthConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BasicAuthConfig", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BasicAuthConfig", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenAuthConfig", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.BearerTokenFromServiceAccountAuthConfig"},
 	}
 }
 
@@ -2389,6 +2902,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerSpec(ref common.Referen
 							Format:      "byte",
 						},
 					},
+					"osbApiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSBAPIVersion is the version of the Open Service Broker API used to communicate with the Broker, sent in the X-Broker-API-Version header. One of \"2.11\", \"2.12\" or \"2.13\". Defaults to the version preferred by the controller.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"relistBehavior": {
 						SchemaProps: spec.SchemaProps{
 							Description: "RelistBehavior specifies the type of relist behavior the catalog should exhibit when relisting ServiceClasses available from a broker.",
@@ -2474,6 +2994,41 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceBrokerStatus(ref common.Refer
 							Format:      "",
 						},
 					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Features is the list of optional Open Service Broker API features advertised in the broker's catalog when it was last fetched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"lastCatalogClassCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCatalogClassCount is the number of classes in the broker's catalog that passed its catalog restrictions when it was last fetched.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastCatalogPlanCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCatalogPlanCount is the number of plans in the broker's catalog that passed its catalog restrictions when it was last fetched.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastSuccessfulEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSuccessfulEndpoint is the URL of the broker endpoint which served the last successful catalog fetch. It lags behind the spec when the broker's URL is changed to an endpoint that cannot be reached.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"conditions", "reconciledGeneration", "lastConditionState"},
 			},
@@ -2791,6 +3346,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceCondition(ref common.
 							Format:      "",
 						},
 					},
+					"errorCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorCode is a machine readable classification of the failed request to the broker the condition reports, one of the ErrorCode constants. It is empty when the condition does not report a failed request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"type", "status", "lastTransitionTime", "reason", "message"},
 			},
@@ -2893,6 +3455,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstancePropertiesState(ref c
 							Format:      "",
 						},
 					},
+					"contextChecksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContextChecksum is the checksum of the context that was sent.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"userInfo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "UserInfo is information about the user that made the request.",
@@ -2998,6 +3567,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref common.Refer
 							Format:      "",
 						},
 					},
+					"clusterServiceBrokerName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterServiceBrokerName is the name of the ClusterServiceBroker whose ClusterServiceClass and ClusterServicePlan should be resolved. It is only needed when more than one broker offers a matching class.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"clusterServiceClassRef": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ClusterServiceClassRef is a reference to the ClusterServiceClass that the user selected. This is set by the controller based on the cluster-scoped values specified in the PlanReference.",
@@ -3041,6 +3617,12 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref common.Refer
 							},
 						},
 					},
+					"generatedParameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GeneratedParameters are parameters whose values are generated by the controller, for example passwords, instead of being supplied by the user. The values are stored in a Secret and are only generated once. A top-level parameter name existing in another source is considered to be a user error in the specification.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.GeneratedParameters"),
+						},
+					},
 					"externalID": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExternalID is the identity of this object for use with the OSB SB API.\n\nImmutable.",
@@ -3061,11 +3643,25 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceSpec(ref common.Refer
 							Format:      "int64",
 						},
 					},
+					"ttlSecondsAfterFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSecondsAfterFailure limits the lifetime of an instance that failed to provision. If set, the instance is deleted once it has been in the terminally failed state for this many seconds. It only applies to instances that were never provisioned and that do not require deprovisioning on the broker side.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ttlSecondsAfterReady": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSecondsAfterReady limits the lifetime of a provisioned instance, for instance in ephemeral test environments. If set, the instance and its bindings are deleted once the instance has been ready for this many seconds. The bindings are unbound and the instance deprovisioned as when they are deleted by the user.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ClusterObjectReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.GeneratedParameters", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.LocalObjectReference", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ParametersFromSource", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.UserInfo", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -3102,6 +3698,13 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref common.Ref
 							Format:      "",
 						},
 					},
+					"orphanMitigationAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OrphanMitigationAttempts is the number of deprovision requests sent to the broker by the ongoing orphan mitigation.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LastOperation is the string that the broker may have returned when an async operation started, it should be sent back to the broker on poll requests as a query param.",
@@ -3116,6 +3719,19 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref common.Ref
 							Format:      "",
 						},
 					},
+					"extensionAPIs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExtensionAPIs are the APIs, beyond the Open Service Broker API, that the broker offers for the service instance, as returned in its provision response.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ExtensionAPI"),
+									},
+								},
+							},
+						},
+					},
 					"currentOperation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CurrentOperation is the operation the Controller is currently performing on the ServiceInstance.",
@@ -3175,6 +3791,25 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref common.Ref
 							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
 						},
 					},
+					"bindingCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BindingCount is the number of ServiceBindings that refer to the ServiceInstance, including those being deleted. It is maintained by the controller and may briefly lag behind the bindings that exist.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastProvisionDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastProvisionDuration is how long the last successful provision of the ServiceInstance took, from the start of the provision operation until the broker reported its completion, including any polling of an asynchronous operation.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"lastUpdateDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateDuration is how long the last successful update of the ServiceInstance took, measured in the same way as LastProvisionDuration.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 					"lastConditionState": {
 						SchemaProps: spec.SchemaProps{
 							Description: "LastConditionState aggregates state from the Conditions array It is used for printing in a kubectl output via additionalPrinterColumns",
@@ -3196,12 +3831,26 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServiceInstanceStatus(ref common.Ref
 							Format:      "",
 						},
 					},
+					"resolvedClassExternalName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolvedClassExternalName is the external name of the class the instance refers to, whichever form of reference is used in the spec.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolvedPlanExternalName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResolvedPlanExternalName is the external name of the plan the instance refers to, whichever form of reference is used in the spec.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"conditions", "asyncOpInProgress", "orphanMitigationInProgress", "reconciledGeneration", "observedGeneration", "provisionStatus", "deprovisionStatus", "lastConditionState", "userSpecifiedPlanName", "userSpecifiedClassName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ExtensionAPI", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstanceCondition", "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServiceInstancePropertiesState", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -3256,6 +3905,41 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServicePlan(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanCost(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServicePlanCost is a cost of a plan, as described by the Open Service Broker API metadata conventions.",
+				Properties: map[string]spec.Schema{
+					"amount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Amount maps lowercase currency codes, such as \"usd\", to the amount charged in that currency.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unit is what the amount is charged for, such as \"MONTHLY\" or \"PER 1GB\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"amount", "unit"},
+			},
+		},
+		Dependencies: []string{},
+	}
+}
+
 func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3414,11 +4098,53 @@ func schema_pkg_apis_servicecatalog_v1beta1_ServicePlanStatus(ref common.Referen
 							Format:      "",
 						},
 					},
+					"costs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Costs are the costs of the plan, as reported by the broker in the \"costs\" field of the plan's external metadata.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCost"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"removedFromBrokerCatalog"},
 			},
 		},
-		Dependencies: []string{},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.ServicePlanCost"},
+	}
+}
+
+func schema_pkg_apis_servicecatalog_v1beta1_URLReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "URLReference references a document served over HTTP(S).",
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the http or https URL of the document.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bearerTokenSecretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerTokenSecretKeyRef references the key of a Secret, in the namespace of the resource, holding a token sent as the bearer token of the request.",
+							Ref:         ref("github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"),
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1.SecretKeyReference"},
 	}
 }
 
@@ -110,6 +110,7 @@ func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
 // resources
 func NewStorage(opts server.Options) (rest.Storage, rest.Storage, error) {
 	prefix := "/" + opts.ResourcePrefix()
+	strategy := newRESTStrategy(opts.ParametersLimits)
 
 	storageInterface, dFunc := opts.GetStorage(
 		&servicecatalog.ServiceBinding{},
@@ -135,9 +136,9 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, error) {
 		// DefaultQualifiedResource should always be plural
 		DefaultQualifiedResource: servicecatalog.Resource("servicebindings"),
 
-		CreateStrategy:          bindingRESTStrategies,
-		UpdateStrategy:          bindingRESTStrategies,
-		DeleteStrategy:          bindingRESTStrategies,
+		CreateStrategy:          strategy,
+		UpdateStrategy:          strategy,
+		DeleteStrategy:          strategy,
 		EnableGarbageCollection: true,
 
 		TableConvertor: tableconvertor.NewTableConvertor(
@@ -38,6 +38,14 @@ import (
 	"k8s.io/klog"
 )
 
+// newRESTStrategy returns the strategy of bindings checking their parameters
+// against the given limits.
+func newRESTStrategy(parametersLimits scv.ParametersLimits) bindingRESTStrategy {
+	strategy := bindingRESTStrategies
+	strategy.parametersLimits = parametersLimits
+	return strategy
+}
+
 // NewScopeStrategy returns a new NamespaceScopedStrategy for bindings
 func NewScopeStrategy() rest.NamespaceScopedStrategy {
 	return bindingRESTStrategies
@@ -48,6 +56,10 @@ func NewScopeStrategy() rest.NamespaceScopedStrategy {
 type bindingRESTStrategy struct {
 	runtime.ObjectTyper // inherit ObjectKinds method
 	names.NameGenerator // GenerateName method for CreateStrategy
+
+	// parametersLimits are the limits the parameters of created and
+	// updated bindings are checked against.
+	parametersLimits scv.ParametersLimits
 }
 
 // implements interface RESTUpdateStrategy
@@ -63,6 +75,8 @@ var (
 		// use the generator from upstream k8s, or implement method
 		// `GenerateName(base string) string`
 		NameGenerator: names.SimpleNameGenerator,
+
+		parametersLimits: scv.DefaultParametersLimits,
 	}
 	_ rest.RESTCreateStrategy         = bindingRESTStrategies
 	_ rest.RESTUpdateStrategy         = bindingRESTStrategies
@@ -117,8 +131,10 @@ func (bindingRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Obj
 	binding.Generation = 1
 }
 
-func (bindingRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return scv.ValidateServiceBinding(obj.(*sc.ServiceBinding))
+func (s bindingRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	binding := obj.(*sc.ServiceBinding)
+	allErrs := scv.ValidateServiceBinding(binding)
+	return append(allErrs, scv.ValidateServiceBindingParameters(binding, nil, s.parametersLimits)...)
 }
 
 func (bindingRESTStrategy) AllowCreateOnUpdate() bool {
@@ -160,7 +176,7 @@ func (bindingRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runtim
 	}
 }
 
-func (bindingRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
+func (s bindingRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
 	newServiceBinding, ok := new.(*sc.ServiceBinding)
 	if !ok {
 		klog.Fatal("received a non-binding object to validate to")
@@ -170,7 +186,8 @@ func (bindingRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.
 		klog.Fatal("received a non-binding object to validate from")
 	}
 
-	return scv.ValidateServiceBindingUpdate(newServiceBinding, oldServiceBinding)
+	allErrs := scv.ValidateServiceBindingUpdate(newServiceBinding, oldServiceBinding)
+	return append(allErrs, scv.ValidateServiceBindingParameters(newServiceBinding, oldServiceBinding, s.parametersLimits)...)
 }
 
 // CheckGracefulDelete sets the UserInfo on the resource to that of the user that
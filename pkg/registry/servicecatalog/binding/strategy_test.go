@@ -18,14 +18,19 @@ package binding
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	sctestutil "github.com/kubernetes-incubator/service-catalog/test/util"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 
 	servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 func getTestInstanceCredential() *servicecatalog.ServiceBinding {
@@ -157,3 +162,104 @@ func TestExternalIDUserProvided(t *testing.T) {
 		t.Errorf("Modified user provided ExternalID to %q", createdInstanceCredential.Spec.ExternalID)
 	}
 }
+
+// TestInstanceCredentialStatusUpdate tests that a status update cannot change
+// the spec of a ServiceBinding.
+func TestInstanceCredentialStatusUpdate(t *testing.T) {
+	older := getTestInstanceCredential()
+	newer := getTestInstanceCredential()
+	newer.Spec.SecretName = "other-secret"
+	newer.Status.Conditions[0].Status = servicecatalog.ConditionFalse
+
+	bindingStatusUpdateStrategy.PrepareForUpdate(sctestutil.ContextWithUserName("updater"), newer, older)
+
+	if e, a := older.Spec, newer.Spec; !reflect.DeepEqual(e, a) {
+		t.Errorf("spec changed by status update: expected %+v, got %+v", e, a)
+	}
+	if e, a := servicecatalog.ConditionFalse, newer.Status.Conditions[0].Status; e != a {
+		t.Errorf("status not updated: expected %v, got %v", e, a)
+	}
+}
+
+// hasParametersError returns whether errs reports invalid parameters.
+func hasParametersError(errs field.ErrorList) bool {
+	for _, err := range errs {
+		if strings.HasPrefix(err.Field, "spec.parameters") {
+			return true
+		}
+	}
+	return false
+}
+
+// TestParametersLimits tests that the parameters of bindings are checked
+// against the limits the strategy is configured with.
+func TestParametersLimits(t *testing.T) {
+	newObject := func() *servicecatalog.ServiceBinding {
+		obj := getTestInstanceCredential()
+		obj.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"size":"0123456789"}`)}
+		return obj
+	}
+	ctx := sctestutil.ContextWithUserName("creator")
+
+	if errs := bindingRESTStrategies.Validate(ctx, newObject()); hasParametersError(errs) {
+		t.Errorf("unexpected error with the default limits: %v", errs)
+	}
+
+	strategy := newRESTStrategy(scv.ParametersLimits{MaxSize: 10})
+	if errs := strategy.Validate(ctx, newObject()); !hasParametersError(errs) {
+		t.Errorf("expected parameters exceeding the configured size to be rejected on create, got %v", errs)
+	}
+	old := getTestInstanceCredential()
+	if errs := strategy.ValidateUpdate(ctx, newObject(), old); !hasParametersError(errs) {
+		t.Errorf("expected parameters exceeding the configured size to be rejected on update, got %v", errs)
+	}
+}
+
+// TestParametersValidatedOnChange tests that the parameters of bindings are
+// only checked against the limits when they change, so that bindings created
+// before the limits were tightened can still be updated.
+func TestParametersValidatedOnChange(t *testing.T) {
+	withParameters := func(parameters string) *servicecatalog.ServiceBinding {
+		obj := getTestInstanceCredential()
+		obj.Spec.Parameters = &runtime.RawExtension{Raw: []byte(parameters)}
+		return obj
+	}
+	ctx := sctestutil.ContextWithUserName("updater")
+	strategy := newRESTStrategy(scv.ParametersLimits{MaxSize: 20, ReservedKeys: []string{"platform"}})
+
+	cases := []struct {
+		name          string
+		old           string
+		new           string
+		expectInvalid bool
+	}{
+		{
+			name: "unchanged parameters exceeding the size",
+			old:  `{"size":"0123456789abcdef"}`,
+			new:  `{"size":"0123456789abcdef"}`,
+		},
+		{
+			name: "unchanged reserved parameter",
+			old:  `{"platform":"x"}`,
+			new:  `{"platform":"x"}`,
+		},
+		{
+			name:          "changed parameters exceeding the size",
+			old:           `{"size":"small"}`,
+			new:           `{"size":"0123456789abcdef"}`,
+			expectInvalid: true,
+		},
+		{
+			name:          "added reserved parameter",
+			old:           `{"size":"small"}`,
+			new:           `{"platform":"x"}`,
+			expectInvalid: true,
+		},
+	}
+	for _, tc := range cases {
+		errs := strategy.ValidateUpdate(ctx, withParameters(tc.new), withParameters(tc.old))
+		if e, a := tc.expectInvalid, hasParametersError(errs); e != a {
+			t.Errorf("%v: expected invalid parameters %v, got errors %v", tc.name, e, errs)
+		}
+	}
+}
@@ -116,6 +116,7 @@ func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, bool, error) {
 // resources
 func NewStorage(opts server.Options) (rest.Storage, rest.Storage, rest.Storage) {
 	prefix := "/" + opts.ResourcePrefix()
+	strategy := newRESTStrategy(opts.ParametersLimits)
 
 	storageInterface, dFunc := opts.GetStorage(
 		&servicecatalog.ServiceInstance{},
@@ -140,9 +141,9 @@ func NewStorage(opts server.Options) (rest.Storage, rest.Storage, rest.Storage)
 		// DefaultQualifiedResource should always be plural
 		DefaultQualifiedResource: servicecatalog.Resource("serviceinstances"),
 
-		CreateStrategy:          instanceRESTStrategies,
-		UpdateStrategy:          instanceRESTStrategies,
-		DeleteStrategy:          instanceRESTStrategies,
+		CreateStrategy:          strategy,
+		UpdateStrategy:          strategy,
+		DeleteStrategy:          strategy,
 		EnableGarbageCollection: true,
 
 		TableConvertor: tableconvertor.NewTableConvertor(
@@ -38,6 +38,14 @@ import (
 	"k8s.io/klog"
 )
 
+// newRESTStrategy returns the strategy of instances checking their parameters
+// against the given limits.
+func newRESTStrategy(parametersLimits scv.ParametersLimits) instanceRESTStrategy {
+	strategy := instanceRESTStrategies
+	strategy.parametersLimits = parametersLimits
+	return strategy
+}
+
 // NewScopeStrategy returns a new NamespaceScopedStrategy for instances
 func NewScopeStrategy() rest.NamespaceScopedStrategy {
 	return instanceRESTStrategies
@@ -49,6 +57,10 @@ func NewScopeStrategy() rest.NamespaceScopedStrategy {
 type instanceRESTStrategy struct {
 	runtime.ObjectTyper // inherit ObjectKinds method
 	names.NameGenerator // GenerateName method for CreateStrategy
+
+	// parametersLimits are the limits the parameters of created and
+	// updated instances are checked against.
+	parametersLimits scv.ParametersLimits
 }
 
 // implements interface RESTUpdateStrategy. This implementation validates updates to
@@ -72,6 +84,8 @@ var (
 		// use the generator from upstream k8s, or implement method
 		// `GenerateName(base string) string`
 		NameGenerator: names.SimpleNameGenerator,
+
+		parametersLimits: scv.DefaultParametersLimits,
 	}
 	_ rest.RESTCreateStrategy         = instanceRESTStrategies
 	_ rest.RESTUpdateStrategy         = instanceRESTStrategies
@@ -104,7 +118,8 @@ func (instanceRESTStrategy) NamespaceScoped() bool {
 
 // PrepareForCreate receives a the incoming ServiceInstance and clears it's
 // Status and Service[Class|Plan]Ref fields. These are not user settable fields.
-// It also creates a UUID if the user hasn't specified one.
+// It also creates a UUID if the user hasn't specified one, derived from the
+// namespace and name of instances asking for a stable external ID.
 func (instanceRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	instance, ok := obj.(*sc.ServiceInstance)
 	if !ok {
@@ -112,7 +127,11 @@ func (instanceRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Ob
 	}
 
 	if instance.Spec.ExternalID == "" {
-		instance.Spec.ExternalID = string(uuid.NewUUID())
+		if instance.HasStableExternalID() && instance.Name != "" {
+			instance.Spec.ExternalID = sc.StableExternalID(instance.Namespace, instance.Name)
+		} else {
+			instance.Spec.ExternalID = string(uuid.NewUUID())
+		}
 	}
 
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
@@ -134,8 +153,10 @@ func (instanceRESTStrategy) PrepareForCreate(ctx context.Context, obj runtime.Ob
 	instance.Generation = 1
 }
 
-func (instanceRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return scv.ValidateServiceInstance(obj.(*sc.ServiceInstance))
+func (s instanceRESTStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	instance := obj.(*sc.ServiceInstance)
+	allErrs := scv.ValidateServiceInstance(instance)
+	return append(allErrs, scv.ValidateServiceInstanceParameters(instance, nil, s.parametersLimits)...)
 }
 
 func (instanceRESTStrategy) AllowCreateOnUpdate() bool {
@@ -186,7 +207,7 @@ func (instanceRESTStrategy) PrepareForUpdate(ctx context.Context, new, old runti
 	}
 }
 
-func (instanceRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
+func (s instanceRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime.Object) field.ErrorList {
 	newServiceInstance, ok := new.(*sc.ServiceInstance)
 	if !ok {
 		klog.Fatal("received a non-instance object to validate to")
@@ -196,7 +217,8 @@ func (instanceRESTStrategy) ValidateUpdate(ctx context.Context, new, old runtime
 		klog.Fatal("received a non-instance object to validate from")
 	}
 
-	return scv.ValidateServiceInstanceUpdate(newServiceInstance, oldServiceInstance)
+	allErrs := scv.ValidateServiceInstanceUpdate(newServiceInstance, oldServiceInstance)
+	return append(allErrs, scv.ValidateServiceInstanceParameters(newServiceInstance, oldServiceInstance, s.parametersLimits)...)
 }
 
 // CheckGracefulDelete sets the UserInfo on the resource to that of the user that
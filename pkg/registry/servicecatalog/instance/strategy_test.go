@@ -18,13 +18,18 @@ package instance
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	servicecatalog "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
 	sctestutil "github.com/kubernetes-incubator/service-catalog/test/util"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 )
 
@@ -275,3 +280,136 @@ func TestExternalIDUserProvided(t *testing.T) {
 	}
 
 }
+
+// TestStableExternalID checks that an instance asking for a stable ExternalID
+// gets the same one when it is recreated, and that other instances do not.
+func TestStableExternalID(t *testing.T) {
+	create := func(namespace, name string, annotations map[string]string) string {
+		instance := getTestInstance()
+		instance.Namespace = namespace
+		instance.Name = name
+		instance.Annotations = annotations
+		instanceRESTStrategies.PrepareForCreate(sctestutil.ContextWithUserName("creator"), instance)
+		return instance.Spec.ExternalID
+	}
+	stable := map[string]string{servicecatalog.ServiceInstanceStableExternalIDAnnotation: "true"}
+
+	first := create("test-ns", "test-instance", stable)
+	if e, a := servicecatalog.StableExternalID("test-ns", "test-instance"), first; e != a {
+		t.Fatalf("unexpected ExternalID; expected %v, got %v", e, a)
+	}
+	if recreated := create("test-ns", "test-instance", stable); recreated != first {
+		t.Errorf("expected the recreated instance to get ExternalID %q, got %q", first, recreated)
+	}
+	if other := create("other-ns", "test-instance", stable); other == first {
+		t.Errorf("expected an instance in another namespace to get another ExternalID than %q", first)
+	}
+	if random := create("test-ns", "test-instance", nil); random == first {
+		t.Errorf("expected an instance without %s to get a random ExternalID", servicecatalog.ServiceInstanceStableExternalIDAnnotation)
+	}
+	if generated := create("test-ns", "", stable); generated == "" || generated == servicecatalog.StableExternalID("test-ns", "") {
+		t.Errorf("expected an instance with a generated name to get a random ExternalID, got %q", generated)
+	}
+}
+
+// TestInstanceStatusUpdate tests that a status update cannot change the spec
+// of an Instance.
+func TestInstanceStatusUpdate(t *testing.T) {
+	older := getTestInstance()
+	newer := getTestInstance()
+	newer.Spec.UpdateRequests = 1
+	newer.Spec.ClusterServicePlanExternalName = "other-clusterserviceplan"
+	newer.Status.Conditions[0].Status = servicecatalog.ConditionFalse
+
+	instanceStatusUpdateStrategy.PrepareForUpdate(sctestutil.ContextWithUserName("updater"), newer, older)
+
+	if e, a := older.Spec, newer.Spec; !reflect.DeepEqual(e, a) {
+		t.Errorf("spec changed by status update: expected %+v, got %+v", e, a)
+	}
+	if e, a := servicecatalog.ConditionFalse, newer.Status.Conditions[0].Status; e != a {
+		t.Errorf("status not updated: expected %v, got %v", e, a)
+	}
+}
+
+// hasParametersError returns whether errs reports invalid parameters.
+func hasParametersError(errs field.ErrorList) bool {
+	for _, err := range errs {
+		if strings.HasPrefix(err.Field, "spec.parameters") {
+			return true
+		}
+	}
+	return false
+}
+
+// TestParametersLimits tests that the parameters of instances are checked
+// against the limits the strategy is configured with.
+func TestParametersLimits(t *testing.T) {
+	newObject := func() *servicecatalog.ServiceInstance {
+		obj := getTestInstance()
+		obj.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"size":"0123456789"}`)}
+		return obj
+	}
+	ctx := sctestutil.ContextWithUserName("creator")
+
+	if errs := instanceRESTStrategies.Validate(ctx, newObject()); hasParametersError(errs) {
+		t.Errorf("unexpected error with the default limits: %v", errs)
+	}
+
+	strategy := newRESTStrategy(scv.ParametersLimits{MaxSize: 10})
+	if errs := strategy.Validate(ctx, newObject()); !hasParametersError(errs) {
+		t.Errorf("expected parameters exceeding the configured size to be rejected on create, got %v", errs)
+	}
+	old := getTestInstance()
+	if errs := strategy.ValidateUpdate(ctx, newObject(), old); !hasParametersError(errs) {
+		t.Errorf("expected parameters exceeding the configured size to be rejected on update, got %v", errs)
+	}
+}
+
+// TestParametersValidatedOnChange tests that the parameters of instances are
+// only checked against the limits when they change, so that instances created
+// before the limits were tightened can still be updated.
+func TestParametersValidatedOnChange(t *testing.T) {
+	withParameters := func(parameters string) *servicecatalog.ServiceInstance {
+		obj := getTestInstance()
+		obj.Spec.Parameters = &runtime.RawExtension{Raw: []byte(parameters)}
+		return obj
+	}
+	ctx := sctestutil.ContextWithUserName("updater")
+	strategy := newRESTStrategy(scv.ParametersLimits{MaxSize: 20, ReservedKeys: []string{"platform"}})
+
+	cases := []struct {
+		name          string
+		old           string
+		new           string
+		expectInvalid bool
+	}{
+		{
+			name: "unchanged parameters exceeding the size",
+			old:  `{"size":"0123456789abcdef"}`,
+			new:  `{"size":"0123456789abcdef"}`,
+		},
+		{
+			name: "unchanged reserved parameter",
+			old:  `{"platform":"x"}`,
+			new:  `{"platform":"x"}`,
+		},
+		{
+			name:          "changed parameters exceeding the size",
+			old:           `{"size":"small"}`,
+			new:           `{"size":"0123456789abcdef"}`,
+			expectInvalid: true,
+		},
+		{
+			name:          "added reserved parameter",
+			old:           `{"size":"small"}`,
+			new:           `{"platform":"x"}`,
+			expectInvalid: true,
+		},
+	}
+	for _, tc := range cases {
+		errs := strategy.ValidateUpdate(ctx, withParameters(tc.new), withParameters(tc.old))
+		if e, a := tc.expectInvalid, hasParametersError(errs); e != a {
+			t.Errorf("%v: expected invalid parameters %v, got errors %v", tc.name, e, errs)
+		}
+	}
+}
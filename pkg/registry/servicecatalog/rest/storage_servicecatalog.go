@@ -20,6 +20,7 @@ import (
 	"github.com/kubernetes-incubator/service-catalog/pkg/api"
 	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
 	servicecatalogv1beta1 "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/binding"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/clusterservicebroker"
 	"github.com/kubernetes-incubator/service-catalog/pkg/registry/servicecatalog/clusterserviceclass"
@@ -46,6 +47,9 @@ import (
 type StorageProvider struct {
 	DefaultNamespace string
 	RESTClient       restclient.Interface
+	// ParametersLimits are the limits on the parameters of
+	// ServiceInstances and ServiceBindings.
+	ParametersLimits scv.ParametersLimits
 }
 
 // NewRESTStorage is a factory method to make a new APIGroupInfo for the
@@ -153,6 +157,9 @@ func (p StorageProvider) v1beta1Storage(
 		},
 	)
 
+	instanceOpts.ParametersLimits = p.ParametersLimits
+	bindingsOpts.ParametersLimits = p.ParametersLimits
+
 	clusterServiceBrokerStorage, clusterServiceBrokerStatusStorage := clusterservicebroker.NewStorage(*clusterServiceBrokerOpts)
 	clusterServiceClassStorage, clusterServiceClassStatusStorage := clusterserviceclass.NewStorage(*clusterServiceClassOpts)
 	clusterServicePlanStorage, clusterServicePlanStatusStorage := clusterserviceplan.NewStorage(*clusterServicePlanOpts)
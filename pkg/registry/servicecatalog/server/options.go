@@ -19,6 +19,7 @@ package server
 import (
 	"context"
 
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	"github.com/kubernetes-incubator/service-catalog/pkg/storage/etcd"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
@@ -31,6 +32,9 @@ import (
 // specific things
 type Options struct {
 	EtcdOptions etcd.Options
+	// ParametersLimits are the limits on the parameters of the
+	// ServiceInstances and ServiceBindings stored.
+	ParametersLimits scv.ParametersLimits
 }
 
 // NewOptions returns a new Options with the given parameters
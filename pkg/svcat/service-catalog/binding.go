@@ -31,14 +31,31 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
-// RetrieveBindings lists all bindings in a namespace.
-func (sdk *SDK) RetrieveBindings(ns string) (*v1beta1.ServiceBindingList, error) {
+// RetrieveBindings lists all bindings in a namespace, optionally filtered by
+// the name of the secret they write credentials to.
+func (sdk *SDK) RetrieveBindings(ns, secretFilter string) (*v1beta1.ServiceBindingList, error) {
 	bindings, err := sdk.ServiceCatalog().ServiceBindings(ns).List(v1.ListOptions{})
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to list bindings in %s", ns)
 	}
 
-	return bindings, nil
+	if secretFilter == "" {
+		return bindings, nil
+	}
+
+	filtered := v1beta1.ServiceBindingList{
+		Items: []v1beta1.ServiceBinding{},
+	}
+
+	for _, binding := range bindings.Items {
+		if binding.Spec.SecretName != secretFilter {
+			continue
+		}
+
+		filtered.Items = append(filtered.Items, binding)
+	}
+
+	return &filtered, nil
 }
 
 // RetrieveBinding gets a binding by its name.
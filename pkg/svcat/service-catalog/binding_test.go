@@ -75,12 +75,24 @@ var _ = Describe("Binding", func() {
 
 	Describe("RetrieveBindings", func() {
 		It("Calls the generated v1beta1 List method with the specified namespace", func() {
-			bindings, err := sdk.RetrieveBindings(sb.Namespace)
+			bindings, err := sdk.RetrieveBindings(sb.Namespace, "")
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bindings.Items).Should(ConsistOf(*sb, *sb2))
 			Expect(svcCatClient.Actions()[0].Matches("list", "servicebindings")).To(BeTrue())
 		})
+		It("Filters the bindings by secret name", func() {
+			sb.Spec.SecretName = "foobar-secret"
+			sb2.Spec.SecretName = "barbaz-secret"
+			svcCatClient = fake.NewSimpleClientset(sb, sb2)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			bindings, err := sdk.RetrieveBindings(sb.Namespace, "barbaz-secret")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bindings.Items).Should(ConsistOf(*sb2))
+			Expect(svcCatClient.Actions()[0].Matches("list", "servicebindings")).To(BeTrue())
+		})
 		It("Bubbles up errors", func() {
 			badClient := &fake.Clientset{}
 			errorMessage := "error retrieving list"
@@ -89,7 +101,7 @@ var _ = Describe("Binding", func() {
 			})
 			sdk.ServiceCatalogClient = badClient
 
-			bindings, err := sdk.RetrieveBindings(sb.Namespace)
+			bindings, err := sdk.RetrieveBindings(sb.Namespace, "")
 
 			Expect(bindings).To(BeNil())
 			Expect(err).To(HaveOccurred())
@@ -0,0 +1,235 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// InstanceBundle is a portable copy of an instance and its bindings. It keeps
+// the external IDs known to the broker, so that importing the bundle into
+// another namespace or cluster refers to the same broker-side resources.
+type InstanceBundle struct {
+	Instance v1beta1.ServiceInstance
+	Bindings []v1beta1.ServiceBinding
+}
+
+// bundleList is the serialized form of an InstanceBundle: a List, in the same
+// shape as the one printed by kubectl, with the instance as its first item.
+type bundleList struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Items      []json.RawMessage `json:"items"`
+}
+
+// NewInstanceBundle builds a bundle from an instance and its bindings as read
+// from the API. Status, server-populated metadata and the namespace are
+// dropped; the specs, including the external IDs, are kept.
+func NewInstanceBundle(instance *v1beta1.ServiceInstance, bindings []v1beta1.ServiceBinding) *InstanceBundle {
+	bundle := &InstanceBundle{
+		Instance: v1beta1.ServiceInstance{
+			TypeMeta:   v1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "ServiceInstance"},
+			ObjectMeta: portableObjectMeta(instance.ObjectMeta),
+			Spec:       *instance.Spec.DeepCopy(),
+		},
+	}
+	// The resolved references and the user info are set by the server and
+	// ignored on create.
+	bundle.Instance.Spec.ClusterServiceClassRef = nil
+	bundle.Instance.Spec.ClusterServicePlanRef = nil
+	bundle.Instance.Spec.ServiceClassRef = nil
+	bundle.Instance.Spec.ServicePlanRef = nil
+	bundle.Instance.Spec.UserInfo = nil
+
+	for _, binding := range bindings {
+		b := v1beta1.ServiceBinding{
+			TypeMeta:   v1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "ServiceBinding"},
+			ObjectMeta: portableObjectMeta(binding.ObjectMeta),
+			Spec:       *binding.Spec.DeepCopy(),
+		}
+		b.Spec.UserInfo = nil
+		bundle.Bindings = append(bundle.Bindings, b)
+	}
+
+	return bundle
+}
+
+// portableObjectMeta returns the name, labels and annotations of an object.
+func portableObjectMeta(meta v1.ObjectMeta) v1.ObjectMeta {
+	portable := v1.ObjectMeta{Name: meta.Name}
+	if len(meta.Labels) > 0 {
+		portable.Labels = make(map[string]string, len(meta.Labels))
+		for k, v := range meta.Labels {
+			portable.Labels[k] = v
+		}
+	}
+	if len(meta.Annotations) > 0 {
+		portable.Annotations = make(map[string]string, len(meta.Annotations))
+		for k, v := range meta.Annotations {
+			portable.Annotations[k] = v
+		}
+	}
+	return portable
+}
+
+// EncodeInstanceBundle serializes a bundle as a YAML List.
+func EncodeInstanceBundle(bundle *InstanceBundle) ([]byte, error) {
+	list := bundleList{
+		APIVersion: "v1",
+		Kind:       "List",
+	}
+	objs := []runtime.Object{&bundle.Instance}
+	for i := range bundle.Bindings {
+		objs = append(objs, &bundle.Bindings[i])
+	}
+	for _, obj := range objs {
+		raw, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, raw)
+	}
+	return yaml.Marshal(list)
+}
+
+// DecodeInstanceBundle parses a bundle written by EncodeInstanceBundle. The
+// List must hold exactly one instance, and only bindings to that instance.
+func DecodeInstanceBundle(data []byte) (*InstanceBundle, error) {
+	var list bundleList
+	if err := yaml.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("unable to parse bundle (%s)", err)
+	}
+
+	bundle := &InstanceBundle{}
+	instances := 0
+	for i, raw := range list.Items {
+		var typeMeta v1.TypeMeta
+		if err := json.Unmarshal(raw, &typeMeta); err != nil {
+			return nil, fmt.Errorf("unable to parse item %d of the bundle (%s)", i, err)
+		}
+		if typeMeta.APIVersion != v1beta1.SchemeGroupVersion.String() {
+			return nil, fmt.Errorf("item %d of the bundle has unsupported apiVersion %q", i, typeMeta.APIVersion)
+		}
+		switch typeMeta.Kind {
+		case "ServiceInstance":
+			instances++
+			if err := json.Unmarshal(raw, &bundle.Instance); err != nil {
+				return nil, fmt.Errorf("unable to parse item %d of the bundle (%s)", i, err)
+			}
+		case "ServiceBinding":
+			var binding v1beta1.ServiceBinding
+			if err := json.Unmarshal(raw, &binding); err != nil {
+				return nil, fmt.Errorf("unable to parse item %d of the bundle (%s)", i, err)
+			}
+			bundle.Bindings = append(bundle.Bindings, binding)
+		default:
+			return nil, fmt.Errorf("item %d of the bundle has unsupported kind %q", i, typeMeta.Kind)
+		}
+	}
+
+	if instances != 1 {
+		return nil, fmt.Errorf("bundle must contain exactly one ServiceInstance, found %d", instances)
+	}
+	for _, binding := range bundle.Bindings {
+		if binding.Spec.InstanceRef.Name != bundle.Instance.Name {
+			return nil, fmt.Errorf("binding %q refers to instance %q, not to the instance %q of the bundle",
+				binding.Name, binding.Spec.InstanceRef.Name, bundle.Instance.Name)
+		}
+	}
+
+	return bundle, nil
+}
+
+// ExportInstance retrieves an instance and its bindings as a bundle. When the
+// class and plan of the instance have been resolved, the plan reference is
+// replaced by their external IDs, together with the name of the broker of a
+// cluster-scoped class, so that the bundle selects the same broker-side
+// service and plan wherever it is imported.
+func (sdk *SDK) ExportInstance(ns, name string) (*InstanceBundle, error) {
+	instance, err := sdk.RetrieveInstance(ns, name)
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := sdk.RetrieveBindingsByInstance(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := NewInstanceBundle(instance, bindings)
+
+	spec := instance.Spec
+	switch {
+	case spec.ClusterServiceClassRef != nil && spec.ClusterServicePlanRef != nil:
+		class, plan, err := sdk.InstanceToServiceClassAndPlan(instance)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Instance.Spec.PlanReference = v1beta1.PlanReference{
+			ClusterServiceClassExternalID: class.Spec.ExternalID,
+			ClusterServicePlanExternalID:  plan.Spec.ExternalID,
+		}
+		bundle.Instance.Spec.ClusterServiceBrokerName = class.Spec.ClusterServiceBrokerName
+	case spec.ServiceClassRef != nil && spec.ServicePlanRef != nil:
+		class, err := sdk.ServiceCatalog().ServiceClasses(ns).Get(spec.ServiceClassRef.Name, v1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		plan, err := sdk.ServiceCatalog().ServicePlans(ns).Get(spec.ServicePlanRef.Name, v1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		bundle.Instance.Spec.PlanReference = v1beta1.PlanReference{
+			ServiceClassExternalID: class.Spec.ExternalID,
+			ServicePlanExternalID:  plan.Spec.ExternalID,
+		}
+	}
+
+	return bundle, nil
+}
+
+// ImportInstance creates the instance and bindings of a bundle in the given
+// namespace. The controller sends the broker provision and bind requests with
+// the external IDs of the bundle, so a broker that treats repeated requests
+// for an existing instance or binding as idempotent hands back the existing
+// resources.
+func (sdk *SDK) ImportInstance(ns string, bundle *InstanceBundle) (*InstanceBundle, error) {
+	instance := bundle.Instance.DeepCopy()
+	instance.Namespace = ns
+	createdInstance, err := sdk.ServiceCatalog().ServiceInstances(ns).Create(instance)
+	if err != nil {
+		return nil, fmt.Errorf("import request failed (%s)", err)
+	}
+
+	result := &InstanceBundle{Instance: *createdInstance}
+	for _, b := range bundle.Bindings {
+		binding := b.DeepCopy()
+		binding.Namespace = ns
+		createdBinding, err := sdk.ServiceCatalog().ServiceBindings(ns).Create(binding)
+		if err != nil {
+			return nil, fmt.Errorf("import request failed (%s)", err)
+		}
+		result.Bindings = append(result.Bindings, *createdBinding)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicecatalog_test
+
+import (
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/kubernetes-incubator/service-catalog/pkg/svcat/service-catalog"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Instance bundles", func() {
+	var (
+		sdk          *SDK
+		svcCatClient *fake.Clientset
+		class        *v1beta1.ClusterServiceClass
+		plan         *v1beta1.ClusterServicePlan
+		si           *v1beta1.ServiceInstance
+		sb           *v1beta1.ServiceBinding
+		otherSb      *v1beta1.ServiceBinding
+	)
+
+	BeforeEach(func() {
+		class = &v1beta1.ClusterServiceClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "class-k8s-name"},
+			Spec: v1beta1.ClusterServiceClassSpec{
+				CommonServiceClassSpec: v1beta1.CommonServiceClassSpec{
+					ExternalName: "mysql",
+					ExternalID:   "class-external-id",
+				},
+				ClusterServiceBrokerName: "mysql-broker",
+			},
+		}
+		plan = &v1beta1.ClusterServicePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: "plan-k8s-name"},
+			Spec: v1beta1.ClusterServicePlanSpec{
+				CommonServicePlanSpec: v1beta1.CommonServicePlanSpec{
+					ExternalName: "small",
+					ExternalID:   "plan-external-id",
+				},
+			},
+		}
+		si = &v1beta1.ServiceInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "wordpress-mysql",
+				Namespace:       "foobar_namespace",
+				UID:             "instance-uid",
+				ResourceVersion: "42",
+				Generation:      3,
+				Finalizers:      []string{"kubernetes-incubator/service-catalog"},
+				Labels:          map[string]string{"app": "wordpress"},
+			},
+			Spec: v1beta1.ServiceInstanceSpec{
+				PlanReference: v1beta1.PlanReference{
+					ClusterServiceClassExternalName: "mysql",
+					ClusterServicePlanExternalName:  "small",
+				},
+				ClusterServiceClassRef: &v1beta1.ClusterObjectReference{Name: "class-k8s-name"},
+				ClusterServicePlanRef:  &v1beta1.ClusterObjectReference{Name: "plan-k8s-name"},
+				ExternalID:             "instance-external-id",
+				Parameters:             &runtime.RawExtension{Raw: []byte(`{"size":"10Gi"}`)},
+				UserInfo:               &v1beta1.UserInfo{Username: "admin"},
+			},
+			Status: v1beta1.ServiceInstanceStatus{
+				ProvisionStatus: v1beta1.ServiceInstanceProvisionStatusProvisioned,
+			},
+		}
+		sb = &v1beta1.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "wordpress-mysql-binding",
+				Namespace:       "foobar_namespace",
+				UID:             "binding-uid",
+				ResourceVersion: "43",
+			},
+			Spec: v1beta1.ServiceBindingSpec{
+				InstanceRef: v1beta1.LocalObjectReference{Name: "wordpress-mysql"},
+				ExternalID:  "binding-external-id",
+				SecretName:  "wordpress-mysql-secret",
+			},
+			Status: v1beta1.ServiceBindingStatus{
+				AsyncOpInProgress: true,
+			},
+		}
+		otherSb = &v1beta1.ServiceBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-binding", Namespace: "foobar_namespace"},
+			Spec: v1beta1.ServiceBindingSpec{
+				InstanceRef: v1beta1.LocalObjectReference{Name: "other-instance"},
+			},
+		}
+		svcCatClient = fake.NewSimpleClientset(class, plan, si, sb, otherSb)
+		sdk = &SDK{
+			ServiceCatalogClient: svcCatClient,
+		}
+	})
+
+	Describe("NewInstanceBundle", func() {
+		It("keeps the specs and external IDs and drops server-populated fields", func() {
+			bundle := NewInstanceBundle(si, []v1beta1.ServiceBinding{*sb})
+
+			instance := bundle.Instance
+			Expect(instance.Kind).To(Equal("ServiceInstance"))
+			Expect(instance.APIVersion).To(Equal("servicecatalog.k8s.io/v1beta1"))
+			Expect(instance.ObjectMeta).To(Equal(metav1.ObjectMeta{
+				Name:   "wordpress-mysql",
+				Labels: map[string]string{"app": "wordpress"},
+			}))
+			Expect(instance.Spec.ExternalID).To(Equal("instance-external-id"))
+			Expect(instance.Spec.PlanReference).To(Equal(si.Spec.PlanReference))
+			Expect(instance.Spec.Parameters).To(Equal(si.Spec.Parameters))
+			Expect(instance.Spec.ClusterServiceClassRef).To(BeNil())
+			Expect(instance.Spec.ClusterServicePlanRef).To(BeNil())
+			Expect(instance.Spec.UserInfo).To(BeNil())
+			Expect(instance.Status).To(Equal(v1beta1.ServiceInstanceStatus{}))
+
+			Expect(bundle.Bindings).To(HaveLen(1))
+			binding := bundle.Bindings[0]
+			Expect(binding.Kind).To(Equal("ServiceBinding"))
+			Expect(binding.ObjectMeta).To(Equal(metav1.ObjectMeta{Name: "wordpress-mysql-binding"}))
+			Expect(binding.Spec).To(Equal(sb.Spec))
+			Expect(binding.Status).To(Equal(v1beta1.ServiceBindingStatus{}))
+		})
+	})
+
+	Describe("EncodeInstanceBundle and DecodeInstanceBundle", func() {
+		It("round-trips a bundle", func() {
+			bundle := NewInstanceBundle(si, []v1beta1.ServiceBinding{*sb})
+
+			data, err := EncodeInstanceBundle(bundle)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring("kind: List"))
+			Expect(string(data)).To(ContainSubstring("externalID: instance-external-id"))
+			Expect(string(data)).To(ContainSubstring("externalID: binding-external-id"))
+
+			decoded, err := DecodeInstanceBundle(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(Equal(bundle))
+		})
+		It("round-trips a bundle without bindings", func() {
+			bundle := NewInstanceBundle(si, nil)
+
+			data, err := EncodeInstanceBundle(bundle)
+			Expect(err).NotTo(HaveOccurred())
+
+			decoded, err := DecodeInstanceBundle(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(Equal(bundle))
+		})
+		It("rejects a bundle without an instance", func() {
+			data := []byte(`{"apiVersion": "v1", "kind": "List", "items": []}`)
+
+			_, err := DecodeInstanceBundle(data)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exactly one ServiceInstance, found 0"))
+		})
+		It("rejects bindings to another instance", func() {
+			bundle := NewInstanceBundle(si, []v1beta1.ServiceBinding{*otherSb})
+			data, err := EncodeInstanceBundle(bundle)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = DecodeInstanceBundle(data)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`binding "other-binding" refers to instance "other-instance"`))
+		})
+		It("rejects unsupported kinds", func() {
+			data := []byte(`
+apiVersion: v1
+kind: List
+items:
+- apiVersion: servicecatalog.k8s.io/v1beta1
+  kind: ClusterServiceBroker
+  metadata:
+    name: broker
+`)
+
+			_, err := DecodeInstanceBundle(data)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`unsupported kind "ClusterServiceBroker"`))
+		})
+	})
+
+	Describe("ExportInstance", func() {
+		It("exports the instance with its resolved class and plan and its bindings", func() {
+			bundle, err := sdk.ExportInstance(si.Namespace, si.Name)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bundle.Instance.Spec.PlanReference).To(Equal(v1beta1.PlanReference{
+				ClusterServiceClassExternalID: "class-external-id",
+				ClusterServicePlanExternalID:  "plan-external-id",
+			}))
+			Expect(bundle.Instance.Spec.ClusterServiceBrokerName).To(Equal("mysql-broker"))
+			Expect(bundle.Instance.Spec.ExternalID).To(Equal("instance-external-id"))
+			Expect(bundle.Bindings).To(HaveLen(1))
+			Expect(bundle.Bindings[0].Name).To(Equal(sb.Name))
+		})
+		It("keeps the plan reference of an unresolved instance", func() {
+			si.Spec.ClusterServiceClassRef = nil
+			si.Spec.ClusterServicePlanRef = nil
+			svcCatClient = fake.NewSimpleClientset(si)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			bundle, err := sdk.ExportInstance(si.Namespace, si.Name)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bundle.Instance.Spec.PlanReference).To(Equal(si.Spec.PlanReference))
+			Expect(bundle.Instance.Spec.ClusterServiceBrokerName).To(BeEmpty())
+			Expect(bundle.Bindings).To(BeEmpty())
+		})
+	})
+
+	Describe("ImportInstance", func() {
+		It("creates the instance and bindings of an exported bundle in the namespace", func() {
+			bundle, err := sdk.ExportInstance(si.Namespace, si.Name)
+			Expect(err).NotTo(HaveOccurred())
+			data, err := EncodeInstanceBundle(bundle)
+			Expect(err).NotTo(HaveOccurred())
+			decoded, err := DecodeInstanceBundle(data)
+			Expect(err).NotTo(HaveOccurred())
+
+			imported, err := sdk.ImportInstance("restored", decoded)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(imported.Instance.Namespace).To(Equal("restored"))
+			Expect(imported.Instance.Spec.ExternalID).To(Equal("instance-external-id"))
+			Expect(imported.Bindings).To(HaveLen(1))
+			Expect(imported.Bindings[0].Namespace).To(Equal("restored"))
+			Expect(imported.Bindings[0].Spec.ExternalID).To(Equal("binding-external-id"))
+
+			created, err := svcCatClient.ServicecatalogV1beta1().ServiceInstances("restored").Get(si.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.Spec.PlanReference.ClusterServicePlanExternalID).To(Equal("plan-external-id"))
+			_, err = svcCatClient.ServicecatalogV1beta1().ServiceBindings("restored").Get(sb.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("bubbles up errors", func() {
+			bundle := NewInstanceBundle(si, nil)
+
+			_, err := sdk.ImportInstance(si.Namespace, bundle)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("import request failed"))
+		})
+	})
+})
@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 )
 
 // RetrieveInstances lists all instances in a namespace.
@@ -220,6 +221,80 @@ func (sdk *SDK) TouchInstance(ns, name string, retries int) error {
 	return fmt.Errorf("could not sync service broker after %d tries", retries)
 }
 
+// RetrieveInstancesOnPlan lists the instances in a namespace, or in all
+// namespaces if ns is empty, which specify the given class and plan in their
+// plan reference. The class is required as plan names are only unique within
+// a class. A non-empty label selector further restricts the instances.
+func (sdk *SDK) RetrieveInstancesOnPlan(ns, class, plan, selector string) ([]v1beta1.ServiceInstance, error) {
+	instances, err := sdk.ServiceCatalog().ServiceInstances(ns).List(v1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list instances in %s", ns)
+	}
+
+	var matching []v1beta1.ServiceInstance
+	for _, instance := range instances.Items {
+		if isOnPlan(instance.Spec.PlanReference, class, plan) {
+			matching = append(matching, instance)
+		}
+	}
+	return matching, nil
+}
+
+// MigrateInstance changes the plan of an instance of a class from fromPlan to
+// toPlan. The new plan is set in the field of the plan reference which holds
+// the current plan, so it must be given in the same form, such as its
+// external name. The instance is checked to still be on fromPlan before each
+// update attempt, so that an instance changed since it was listed is not
+// migrated. The controller then sends the broker an update request for the
+// plan change.
+func (sdk *SDK) MigrateInstance(ns, name, class, fromPlan, toPlan string) (*v1beta1.ServiceInstance, error) {
+	var migrated *v1beta1.ServiceInstance
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		instance, err := sdk.ServiceCatalog().ServiceInstances(ns).Get(name, v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !isOnPlan(instance.Spec.PlanReference, class, fromPlan) {
+			return fmt.Errorf("the instance is no longer on plan %q of class %q", fromPlan, class)
+		}
+		if !setSpecifiedPlan(&instance.Spec.PlanReference, toPlan) {
+			return fmt.Errorf("the instance does not specify a plan")
+		}
+		migrated, err = sdk.ServiceCatalog().ServiceInstances(ns).Update(instance)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not migrate instance '%s.%s' (%s)", ns, name, err)
+	}
+	return migrated, nil
+}
+
+// isOnPlan returns whether a plan reference specifies the given class and
+// plan, in the form it specifies them.
+func isOnPlan(pr v1beta1.PlanReference, class, plan string) bool {
+	return (pr.GetSpecifiedClusterServiceClass() == class && pr.GetSpecifiedClusterServicePlan() == plan) ||
+		(pr.GetSpecifiedServiceClass() == class && pr.GetSpecifiedServicePlan() == plan)
+}
+
+// setSpecifiedPlan replaces the plan of a plan reference in the field which
+// specifies it, and returns false if no plan is specified.
+func setSpecifiedPlan(pr *v1beta1.PlanReference, plan string) bool {
+	for _, field := range []*string{
+		&pr.ClusterServicePlanExternalName,
+		&pr.ClusterServicePlanExternalID,
+		&pr.ClusterServicePlanName,
+		&pr.ServicePlanExternalName,
+		&pr.ServicePlanExternalID,
+		&pr.ServicePlanName,
+	} {
+		if *field != "" {
+			*field = plan
+			return true
+		}
+	}
+	return false
+}
+
 // WaitForInstanceToNotExist waits for the specified instance to no longer exist.
 func (sdk *SDK) WaitForInstanceToNotExist(ns, name string, interval time.Duration, timeout *time.Duration) (instance *v1beta1.ServiceInstance, err error) {
 	if timeout == nil {
@@ -227,6 +227,127 @@ var _ = Describe("Instances", func() {
 			Expect(actions[0].(testing.ListActionImpl).GetListRestrictions().Fields.Matches(opts)).To(BeTrue())
 		})
 	})
+	Describe("RetrieveInstancesOnPlan", func() {
+		It("Returns the instances which specify the passed in class and plan", func() {
+			si.Labels = map[string]string{"app": "wordpress"}
+			si.Spec.PlanReference = v1beta1.PlanReference{
+				ClusterServiceClassExternalName: "mysql",
+				ClusterServicePlanExternalName:  "default",
+			}
+			si2.Labels = map[string]string{"app": "wordpress"}
+			si2.Spec.PlanReference = v1beta1.PlanReference{
+				ClusterServiceClassExternalName: "mysql",
+				ClusterServicePlanExternalName:  "premium",
+			}
+			otherClass := si2.DeepCopy()
+			otherClass.Name = "other-class-instance"
+			otherClass.Spec.PlanReference = v1beta1.PlanReference{
+				ClusterServiceClassExternalName: "redis",
+				ClusterServicePlanExternalName:  "default",
+			}
+			svcCatClient = fake.NewSimpleClientset(si, si2, otherClass)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			instances, err := sdk.RetrieveInstancesOnPlan(si.Namespace, "mysql", "default", "app=wordpress")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances).Should(ConsistOf(*si))
+			actions := svcCatClient.Actions()
+			Expect(actions[0].Matches("list", "serviceinstances")).To(BeTrue())
+			Expect(actions[0].(testing.ListActionImpl).GetListRestrictions().Labels.String()).To(Equal("app=wordpress"))
+		})
+		It("Bubbles up errors", func() {
+			badClient := &fake.Clientset{}
+			errorMessage := "error retrieving list"
+			badClient.AddReactor("list", "serviceinstances", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New(errorMessage)
+			})
+			sdk.ServiceCatalogClient = badClient
+
+			_, err := sdk.RetrieveInstancesOnPlan(si.Namespace, "mysql", "default", "")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(errorMessage))
+		})
+	})
+	Describe("MigrateInstance", func() {
+		It("Replaces the plan in the field which specifies it", func() {
+			si.Spec.PlanReference = v1beta1.PlanReference{
+				ClusterServiceClassExternalName: "mysql",
+				ClusterServicePlanExternalName:  "default",
+			}
+			svcCatClient = fake.NewSimpleClientset(si)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			instance, err := sdk.MigrateInstance(si.Namespace, si.Name, "mysql", "default", "premium")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instance.Spec.ClusterServiceClassExternalName).To(Equal("mysql"))
+			Expect(instance.Spec.ClusterServicePlanExternalName).To(Equal("premium"))
+			actions := svcCatClient.Actions()
+			Expect(actions[0].Matches("get", "serviceinstances")).To(BeTrue())
+			Expect(actions[1].Matches("update", "serviceinstances")).To(BeTrue())
+		})
+		It("Errors if the instance is no longer on the plan to migrate from", func() {
+			si.Spec.PlanReference = v1beta1.PlanReference{
+				ClusterServiceClassExternalName: "mysql",
+				ClusterServicePlanExternalName:  "premium",
+			}
+			svcCatClient = fake.NewSimpleClientset(si)
+			sdk.ServiceCatalogClient = svcCatClient
+
+			_, err := sdk.MigrateInstance(si.Namespace, si.Name, "mysql", "default", "premium")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(`no longer on plan "default" of class "mysql"`))
+			Expect(len(svcCatClient.Actions())).To(Equal(1))
+		})
+		It("Retries on conflicts", func() {
+			si.Spec.PlanReference = v1beta1.PlanReference{ServiceClassName: "mysql-id", ServicePlanName: "default-id"}
+			svcCatClient = fake.NewSimpleClientset(si)
+			conflicts := 1
+			svcCatClient.PrependReactor("update", "serviceinstances", func(action testing.Action) (bool, runtime.Object, error) {
+				if conflicts > 0 {
+					conflicts--
+					return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "serviceinstances"}, si.Name, errors.New("conflict"))
+				}
+				return false, nil, nil
+			})
+			sdk.ServiceCatalogClient = svcCatClient
+
+			instance, err := sdk.MigrateInstance(si.Namespace, si.Name, "mysql-id", "default-id", "premium-id")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instance.Spec.ServicePlanName).To(Equal("premium-id"))
+			Expect(len(svcCatClient.Actions())).To(Equal(4))
+		})
+		It("Does not migrate an instance moved off the plan while retrying on conflicts", func() {
+			si.Spec.PlanReference = v1beta1.PlanReference{ServiceClassName: "mysql-id", ServicePlanName: "default-id"}
+			svcCatClient = fake.NewSimpleClientset(si)
+			// another client moves the instance to a plan of its own before
+			// the first update attempt
+			moved := si.DeepCopy()
+			moved.Spec.ServicePlanName = "custom-id"
+			gets := 0
+			svcCatClient.PrependReactor("get", "serviceinstances", func(action testing.Action) (bool, runtime.Object, error) {
+				gets++
+				if gets > 1 {
+					return true, moved, nil
+				}
+				return false, nil, nil
+			})
+			svcCatClient.PrependReactor("update", "serviceinstances", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "serviceinstances"}, si.Name, errors.New("conflict"))
+			})
+			sdk.ServiceCatalogClient = svcCatClient
+
+			_, err := sdk.MigrateInstance(si.Namespace, si.Name, "mysql-id", "default-id", "premium-id")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(`no longer on plan "default-id"`))
+			Expect(len(svcCatClient.Actions())).To(Equal(3))
+		})
+	})
 	Describe("UpdateInstance", func() {
 		It("Properly increments the update requests field", func() {
 			namespace := "cherry_namespace"
@@ -50,6 +50,9 @@ type Plan interface {
 	// GetFree returns if the plan is free.
 	GetFree() bool
 
+	// GetCosts returns the costs of the plan reported by the broker.
+	GetCosts() []v1beta1.ServicePlanCost
+
 	// GetClassID returns the plan's class name.
 	GetClassID() string
 
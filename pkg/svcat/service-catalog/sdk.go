@@ -39,7 +39,7 @@ type SvcatClient interface {
 	IsBindingFailed(*apiv1beta1.ServiceBinding) bool
 	IsBindingReady(*apiv1beta1.ServiceBinding) bool
 	RetrieveBinding(string, string) (*apiv1beta1.ServiceBinding, error)
-	RetrieveBindings(string) (*apiv1beta1.ServiceBindingList, error)
+	RetrieveBindings(string, string) (*apiv1beta1.ServiceBindingList, error)
 	RetrieveBindingsByInstance(*apiv1beta1.ServiceInstance) ([]apiv1beta1.ServiceBinding, error)
 	Unbind(string, string) ([]types.NamespacedName, error)
 	WaitForBinding(string, string, time.Duration, *time.Duration) (*apiv1beta1.ServiceBinding, error)
@@ -59,15 +59,19 @@ type SvcatClient interface {
 	CreateClassFrom(CreateClassFromOptions) (Class, error)
 
 	Deprovision(string, string) error
+	ExportInstance(string, string) (*InstanceBundle, error)
+	ImportInstance(string, *InstanceBundle) (*InstanceBundle, error)
 	InstanceParentHierarchy(*apiv1beta1.ServiceInstance) (*apiv1beta1.ClusterServiceClass, *apiv1beta1.ClusterServicePlan, *apiv1beta1.ClusterServiceBroker, error)
 	InstanceToServiceClassAndPlan(*apiv1beta1.ServiceInstance) (*apiv1beta1.ClusterServiceClass, *apiv1beta1.ClusterServicePlan, error)
 	IsInstanceFailed(*apiv1beta1.ServiceInstance) bool
 	IsInstanceReady(*apiv1beta1.ServiceInstance) bool
+	MigrateInstance(string, string, string, string, string) (*apiv1beta1.ServiceInstance, error)
 	Provision(string, string, string, *ProvisionOptions) (*apiv1beta1.ServiceInstance, error)
 	RetrieveInstance(string, string) (*apiv1beta1.ServiceInstance, error)
 	RetrieveInstanceByBinding(*apiv1beta1.ServiceBinding) (*apiv1beta1.ServiceInstance, error)
 	RetrieveInstances(string, string, string) (*apiv1beta1.ServiceInstanceList, error)
 	RetrieveInstancesByPlan(Plan) ([]apiv1beta1.ServiceInstance, error)
+	RetrieveInstancesOnPlan(string, string, string, string) ([]apiv1beta1.ServiceInstance, error)
 	TouchInstance(string, string, int) error
 	WaitForInstance(string, string, time.Duration, *time.Duration) (*apiv1beta1.ServiceInstance, error)
 	WaitForInstanceToNotExist(string, string, time.Duration, *time.Duration) (*apiv1beta1.ServiceInstance, error)
@@ -128,10 +128,11 @@ type FakeSvcatClient struct {
 		result1 *apiv1beta1.ServiceBinding
 		result2 error
 	}
-	RetrieveBindingsStub        func(string) (*apiv1beta1.ServiceBindingList, error)
+	RetrieveBindingsStub        func(string, string) (*apiv1beta1.ServiceBindingList, error)
 	retrieveBindingsMutex       sync.RWMutex
 	retrieveBindingsArgsForCall []struct {
 		arg1 string
+		arg2 string
 	}
 	retrieveBindingsReturns struct {
 		result1 *apiv1beta1.ServiceBindingList
@@ -357,6 +358,34 @@ type FakeSvcatClient struct {
 	deprovisionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExportInstanceStub        func(string, string) (*servicecatalog.InstanceBundle, error)
+	exportInstanceMutex       sync.RWMutex
+	exportInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	exportInstanceReturns struct {
+		result1 *servicecatalog.InstanceBundle
+		result2 error
+	}
+	exportInstanceReturnsOnCall map[int]struct {
+		result1 *servicecatalog.InstanceBundle
+		result2 error
+	}
+	ImportInstanceStub        func(string, *servicecatalog.InstanceBundle) (*servicecatalog.InstanceBundle, error)
+	importInstanceMutex       sync.RWMutex
+	importInstanceArgsForCall []struct {
+		arg1 string
+		arg2 *servicecatalog.InstanceBundle
+	}
+	importInstanceReturns struct {
+		result1 *servicecatalog.InstanceBundle
+		result2 error
+	}
+	importInstanceReturnsOnCall map[int]struct {
+		result1 *servicecatalog.InstanceBundle
+		result2 error
+	}
 	InstanceParentHierarchyStub        func(*apiv1beta1.ServiceInstance) (*apiv1beta1.ClusterServiceClass, *apiv1beta1.ClusterServicePlan, *apiv1beta1.ClusterServiceBroker, error)
 	instanceParentHierarchyMutex       sync.RWMutex
 	instanceParentHierarchyArgsForCall []struct {
@@ -411,6 +440,23 @@ type FakeSvcatClient struct {
 	isInstanceReadyReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	MigrateInstanceStub        func(string, string, string, string, string) (*apiv1beta1.ServiceInstance, error)
+	migrateInstanceMutex       sync.RWMutex
+	migrateInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}
+	migrateInstanceReturns struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
+	migrateInstanceReturnsOnCall map[int]struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}
 	ProvisionStub        func(string, string, string, *servicecatalog.ProvisionOptions) (*apiv1beta1.ServiceInstance, error)
 	provisionMutex       sync.RWMutex
 	provisionArgsForCall []struct {
@@ -482,6 +528,22 @@ type FakeSvcatClient struct {
 		result1 []apiv1beta1.ServiceInstance
 		result2 error
 	}
+	RetrieveInstancesOnPlanStub        func(string, string, string, string) ([]apiv1beta1.ServiceInstance, error)
+	retrieveInstancesOnPlanMutex       sync.RWMutex
+	retrieveInstancesOnPlanArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	retrieveInstancesOnPlanReturns struct {
+		result1 []apiv1beta1.ServiceInstance
+		result2 error
+	}
+	retrieveInstancesOnPlanReturnsOnCall map[int]struct {
+		result1 []apiv1beta1.ServiceInstance
+		result2 error
+	}
 	TouchInstanceStub        func(string, string, int) error
 	touchInstanceMutex       sync.RWMutex
 	touchInstanceArgsForCall []struct {
@@ -997,16 +1059,17 @@ func (fake *FakeSvcatClient) RetrieveBindingReturnsOnCall(i int, result1 *apiv1b
 	}{result1, result2}
 }
 
-func (fake *FakeSvcatClient) RetrieveBindings(arg1 string) (*apiv1beta1.ServiceBindingList, error) {
+func (fake *FakeSvcatClient) RetrieveBindings(arg1 string, arg2 string) (*apiv1beta1.ServiceBindingList, error) {
 	fake.retrieveBindingsMutex.Lock()
 	ret, specificReturn := fake.retrieveBindingsReturnsOnCall[len(fake.retrieveBindingsArgsForCall)]
 	fake.retrieveBindingsArgsForCall = append(fake.retrieveBindingsArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	fake.recordInvocation("RetrieveBindings", []interface{}{arg1})
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RetrieveBindings", []interface{}{arg1, arg2})
 	fake.retrieveBindingsMutex.Unlock()
 	if fake.RetrieveBindingsStub != nil {
-		return fake.RetrieveBindingsStub(arg1)
+		return fake.RetrieveBindingsStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1020,10 +1083,10 @@ func (fake *FakeSvcatClient) RetrieveBindingsCallCount() int {
 	return len(fake.retrieveBindingsArgsForCall)
 }
 
-func (fake *FakeSvcatClient) RetrieveBindingsArgsForCall(i int) string {
+func (fake *FakeSvcatClient) RetrieveBindingsArgsForCall(i int) (string, string) {
 	fake.retrieveBindingsMutex.RLock()
 	defer fake.retrieveBindingsMutex.RUnlock()
-	return fake.retrieveBindingsArgsForCall[i].arg1
+	return fake.retrieveBindingsArgsForCall[i].arg1, fake.retrieveBindingsArgsForCall[i].arg2
 }
 
 func (fake *FakeSvcatClient) RetrieveBindingsReturns(result1 *apiv1beta1.ServiceBindingList, result2 error) {
@@ -1869,6 +1932,110 @@ func (fake *FakeSvcatClient) DeprovisionReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSvcatClient) ExportInstance(arg1 string, arg2 string) (*servicecatalog.InstanceBundle, error) {
+	fake.exportInstanceMutex.Lock()
+	ret, specificReturn := fake.exportInstanceReturnsOnCall[len(fake.exportInstanceArgsForCall)]
+	fake.exportInstanceArgsForCall = append(fake.exportInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ExportInstance", []interface{}{arg1, arg2})
+	fake.exportInstanceMutex.Unlock()
+	if fake.ExportInstanceStub != nil {
+		return fake.ExportInstanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.exportInstanceReturns.result1, fake.exportInstanceReturns.result2
+}
+
+func (fake *FakeSvcatClient) ExportInstanceCallCount() int {
+	fake.exportInstanceMutex.RLock()
+	defer fake.exportInstanceMutex.RUnlock()
+	return len(fake.exportInstanceArgsForCall)
+}
+
+func (fake *FakeSvcatClient) ExportInstanceArgsForCall(i int) (string, string) {
+	fake.exportInstanceMutex.RLock()
+	defer fake.exportInstanceMutex.RUnlock()
+	return fake.exportInstanceArgsForCall[i].arg1, fake.exportInstanceArgsForCall[i].arg2
+}
+
+func (fake *FakeSvcatClient) ExportInstanceReturns(result1 *servicecatalog.InstanceBundle, result2 error) {
+	fake.ExportInstanceStub = nil
+	fake.exportInstanceReturns = struct {
+		result1 *servicecatalog.InstanceBundle
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) ExportInstanceReturnsOnCall(i int, result1 *servicecatalog.InstanceBundle, result2 error) {
+	fake.ExportInstanceStub = nil
+	if fake.exportInstanceReturnsOnCall == nil {
+		fake.exportInstanceReturnsOnCall = make(map[int]struct {
+			result1 *servicecatalog.InstanceBundle
+			result2 error
+		})
+	}
+	fake.exportInstanceReturnsOnCall[i] = struct {
+		result1 *servicecatalog.InstanceBundle
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) ImportInstance(arg1 string, arg2 *servicecatalog.InstanceBundle) (*servicecatalog.InstanceBundle, error) {
+	fake.importInstanceMutex.Lock()
+	ret, specificReturn := fake.importInstanceReturnsOnCall[len(fake.importInstanceArgsForCall)]
+	fake.importInstanceArgsForCall = append(fake.importInstanceArgsForCall, struct {
+		arg1 string
+		arg2 *servicecatalog.InstanceBundle
+	}{arg1, arg2})
+	fake.recordInvocation("ImportInstance", []interface{}{arg1, arg2})
+	fake.importInstanceMutex.Unlock()
+	if fake.ImportInstanceStub != nil {
+		return fake.ImportInstanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.importInstanceReturns.result1, fake.importInstanceReturns.result2
+}
+
+func (fake *FakeSvcatClient) ImportInstanceCallCount() int {
+	fake.importInstanceMutex.RLock()
+	defer fake.importInstanceMutex.RUnlock()
+	return len(fake.importInstanceArgsForCall)
+}
+
+func (fake *FakeSvcatClient) ImportInstanceArgsForCall(i int) (string, *servicecatalog.InstanceBundle) {
+	fake.importInstanceMutex.RLock()
+	defer fake.importInstanceMutex.RUnlock()
+	return fake.importInstanceArgsForCall[i].arg1, fake.importInstanceArgsForCall[i].arg2
+}
+
+func (fake *FakeSvcatClient) ImportInstanceReturns(result1 *servicecatalog.InstanceBundle, result2 error) {
+	fake.ImportInstanceStub = nil
+	fake.importInstanceReturns = struct {
+		result1 *servicecatalog.InstanceBundle
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) ImportInstanceReturnsOnCall(i int, result1 *servicecatalog.InstanceBundle, result2 error) {
+	fake.ImportInstanceStub = nil
+	if fake.importInstanceReturnsOnCall == nil {
+		fake.importInstanceReturnsOnCall = make(map[int]struct {
+			result1 *servicecatalog.InstanceBundle
+			result2 error
+		})
+	}
+	fake.importInstanceReturnsOnCall[i] = struct {
+		result1 *servicecatalog.InstanceBundle
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) InstanceParentHierarchy(arg1 *apiv1beta1.ServiceInstance) (*apiv1beta1.ClusterServiceClass, *apiv1beta1.ClusterServicePlan, *apiv1beta1.ClusterServiceBroker, error) {
 	fake.instanceParentHierarchyMutex.Lock()
 	ret, specificReturn := fake.instanceParentHierarchyReturnsOnCall[len(fake.instanceParentHierarchyArgsForCall)]
@@ -2076,6 +2243,61 @@ func (fake *FakeSvcatClient) IsInstanceReadyReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeSvcatClient) MigrateInstance(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string) (*apiv1beta1.ServiceInstance, error) {
+	fake.migrateInstanceMutex.Lock()
+	ret, specificReturn := fake.migrateInstanceReturnsOnCall[len(fake.migrateInstanceArgsForCall)]
+	fake.migrateInstanceArgsForCall = append(fake.migrateInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("MigrateInstance", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.migrateInstanceMutex.Unlock()
+	if fake.MigrateInstanceStub != nil {
+		return fake.MigrateInstanceStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.migrateInstanceReturns.result1, fake.migrateInstanceReturns.result2
+}
+
+func (fake *FakeSvcatClient) MigrateInstanceCallCount() int {
+	fake.migrateInstanceMutex.RLock()
+	defer fake.migrateInstanceMutex.RUnlock()
+	return len(fake.migrateInstanceArgsForCall)
+}
+
+func (fake *FakeSvcatClient) MigrateInstanceArgsForCall(i int) (string, string, string, string, string) {
+	fake.migrateInstanceMutex.RLock()
+	defer fake.migrateInstanceMutex.RUnlock()
+	return fake.migrateInstanceArgsForCall[i].arg1, fake.migrateInstanceArgsForCall[i].arg2, fake.migrateInstanceArgsForCall[i].arg3, fake.migrateInstanceArgsForCall[i].arg4, fake.migrateInstanceArgsForCall[i].arg5
+}
+
+func (fake *FakeSvcatClient) MigrateInstanceReturns(result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.MigrateInstanceStub = nil
+	fake.migrateInstanceReturns = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) MigrateInstanceReturnsOnCall(i int, result1 *apiv1beta1.ServiceInstance, result2 error) {
+	fake.MigrateInstanceStub = nil
+	if fake.migrateInstanceReturnsOnCall == nil {
+		fake.migrateInstanceReturnsOnCall = make(map[int]struct {
+			result1 *apiv1beta1.ServiceInstance
+			result2 error
+		})
+	}
+	fake.migrateInstanceReturnsOnCall[i] = struct {
+		result1 *apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) Provision(arg1 string, arg2 string, arg3 string, arg4 *servicecatalog.ProvisionOptions) (*apiv1beta1.ServiceInstance, error) {
 	fake.provisionMutex.Lock()
 	ret, specificReturn := fake.provisionReturnsOnCall[len(fake.provisionArgsForCall)]
@@ -2337,6 +2559,60 @@ func (fake *FakeSvcatClient) RetrieveInstancesByPlanReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeSvcatClient) RetrieveInstancesOnPlan(arg1 string, arg2 string, arg3 string, arg4 string) ([]apiv1beta1.ServiceInstance, error) {
+	fake.retrieveInstancesOnPlanMutex.Lock()
+	ret, specificReturn := fake.retrieveInstancesOnPlanReturnsOnCall[len(fake.retrieveInstancesOnPlanArgsForCall)]
+	fake.retrieveInstancesOnPlanArgsForCall = append(fake.retrieveInstancesOnPlanArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RetrieveInstancesOnPlan", []interface{}{arg1, arg2, arg3, arg4})
+	fake.retrieveInstancesOnPlanMutex.Unlock()
+	if fake.RetrieveInstancesOnPlanStub != nil {
+		return fake.RetrieveInstancesOnPlanStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.retrieveInstancesOnPlanReturns.result1, fake.retrieveInstancesOnPlanReturns.result2
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesOnPlanCallCount() int {
+	fake.retrieveInstancesOnPlanMutex.RLock()
+	defer fake.retrieveInstancesOnPlanMutex.RUnlock()
+	return len(fake.retrieveInstancesOnPlanArgsForCall)
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesOnPlanArgsForCall(i int) (string, string, string, string) {
+	fake.retrieveInstancesOnPlanMutex.RLock()
+	defer fake.retrieveInstancesOnPlanMutex.RUnlock()
+	return fake.retrieveInstancesOnPlanArgsForCall[i].arg1, fake.retrieveInstancesOnPlanArgsForCall[i].arg2, fake.retrieveInstancesOnPlanArgsForCall[i].arg3, fake.retrieveInstancesOnPlanArgsForCall[i].arg4
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesOnPlanReturns(result1 []apiv1beta1.ServiceInstance, result2 error) {
+	fake.RetrieveInstancesOnPlanStub = nil
+	fake.retrieveInstancesOnPlanReturns = struct {
+		result1 []apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSvcatClient) RetrieveInstancesOnPlanReturnsOnCall(i int, result1 []apiv1beta1.ServiceInstance, result2 error) {
+	fake.RetrieveInstancesOnPlanStub = nil
+	if fake.retrieveInstancesOnPlanReturnsOnCall == nil {
+		fake.retrieveInstancesOnPlanReturnsOnCall = make(map[int]struct {
+			result1 []apiv1beta1.ServiceInstance
+			result2 error
+		})
+	}
+	fake.retrieveInstancesOnPlanReturnsOnCall[i] = struct {
+		result1 []apiv1beta1.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSvcatClient) TouchInstance(arg1 string, arg2 string, arg3 int) error {
 	fake.touchInstanceMutex.Lock()
 	ret, specificReturn := fake.touchInstanceReturnsOnCall[len(fake.touchInstanceArgsForCall)]
@@ -2902,6 +3178,10 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.createClassFromMutex.RUnlock()
 	fake.deprovisionMutex.RLock()
 	defer fake.deprovisionMutex.RUnlock()
+	fake.exportInstanceMutex.RLock()
+	defer fake.exportInstanceMutex.RUnlock()
+	fake.importInstanceMutex.RLock()
+	defer fake.importInstanceMutex.RUnlock()
 	fake.instanceParentHierarchyMutex.RLock()
 	defer fake.instanceParentHierarchyMutex.RUnlock()
 	fake.instanceToServiceClassAndPlanMutex.RLock()
@@ -2910,6 +3190,8 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.isInstanceFailedMutex.RUnlock()
 	fake.isInstanceReadyMutex.RLock()
 	defer fake.isInstanceReadyMutex.RUnlock()
+	fake.migrateInstanceMutex.RLock()
+	defer fake.migrateInstanceMutex.RUnlock()
 	fake.provisionMutex.RLock()
 	defer fake.provisionMutex.RUnlock()
 	fake.retrieveInstanceMutex.RLock()
@@ -2920,6 +3202,8 @@ func (fake *FakeSvcatClient) Invocations() map[string][][]interface{} {
 	defer fake.retrieveInstancesMutex.RUnlock()
 	fake.retrieveInstancesByPlanMutex.RLock()
 	defer fake.retrieveInstancesByPlanMutex.RUnlock()
+	fake.retrieveInstancesOnPlanMutex.RLock()
+	defer fake.retrieveInstancesOnPlanMutex.RUnlock()
 	fake.touchInstanceMutex.RLock()
 	defer fake.touchInstanceMutex.RUnlock()
 	fake.waitForInstanceMutex.RLock()
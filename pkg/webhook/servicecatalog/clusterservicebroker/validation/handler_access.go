@@ -60,33 +60,88 @@ func (h *AccessToBroker) Validate(ctx context.Context, req admission.Request, cs
 		return nil
 	}
 
-	var secretRef *sc.ObjectReference
-	if csb.Spec.AuthInfo.Basic != nil {
-		secretRef = csb.Spec.AuthInfo.Basic.SecretRef
-	} else if csb.Spec.AuthInfo.Bearer != nil {
-		secretRef = csb.Spec.AuthInfo.Bearer.SecretRef
+	var attributes *authorizationapi.ResourceAttributes
+	var forbiddenMsg string
+	if saAuth := csb.Spec.AuthInfo.BearerTokenFromServiceAccount; saAuth != nil && saAuth.ServiceAccountRef != nil {
+		// the controller requests tokens of the service account on behalf of
+		// the broker, so the user must be allowed to request them as well
+		saRef := saAuth.ServiceAccountRef
+		attributes = &authorizationapi.ResourceAttributes{
+			Namespace:   saRef.Namespace,
+			Verb:        "create",
+			Group:       corev1.SchemeGroupVersion.Group,
+			Version:     corev1.SchemeGroupVersion.Version,
+			Resource:    "serviceaccounts",
+			Subresource: "token",
+			Name:        saRef.Name,
+		}
+		forbiddenMsg = fmt.Sprintf("broker forbidden access to service account token (%s)", saRef.Name)
+	} else {
+		var secretRef *sc.ObjectReference
+		if csb.Spec.AuthInfo.Basic != nil {
+			secretRef = csb.Spec.AuthInfo.Basic.SecretRef
+		} else if csb.Spec.AuthInfo.Bearer != nil {
+			secretRef = csb.Spec.AuthInfo.Bearer.SecretRef
+		}
+
+		if secretRef == nil {
+			traced.Infof("%s %q has no SecretRef neither in Basic nor Bearer auth. Operation completed", csb.Kind, csb.Name)
+			return nil
+		}
+
+		attributes = &authorizationapi.ResourceAttributes{
+			Namespace: secretRef.Namespace,
+			Verb:      "get",
+			Group:     corev1.SchemeGroupVersion.Group,
+			Version:   corev1.SchemeGroupVersion.Version,
+			Resource:  corev1.ResourceSecrets.String(),
+			Name:      secretRef.Name,
+		}
+		forbiddenMsg = fmt.Sprintf("broker forbidden access to auth secret (%s)", secretRef.Name)
 	}
 
-	if secretRef == nil {
-		traced.Infof("%s %q has no SecretRef neither in Basic nor Bearer auth. Operation completed", csb.Kind, csb.Name)
-		return nil
+	if err := h.checkAccess(ctx, req, csb, attributes, forbiddenMsg, traced); err != nil {
+		return err
 	}
 
-	user := req.UserInfo
-	sar := &authorizationapi.SubjectAccessReview{
-		Spec: authorizationapi.SubjectAccessReviewSpec{
-			ResourceAttributes: &authorizationapi.ResourceAttributes{
+	if fallbackAuth := csb.Spec.FallbackAuthInfo; fallbackAuth != nil {
+		var secretRef *sc.ObjectReference
+		if fallbackAuth.Basic != nil {
+			secretRef = fallbackAuth.Basic.SecretRef
+		} else if fallbackAuth.Bearer != nil {
+			secretRef = fallbackAuth.Bearer.SecretRef
+		}
+
+		if secretRef != nil {
+			// the fallback credentials are read by the controller as well
+			attributes = &authorizationapi.ResourceAttributes{
 				Namespace: secretRef.Namespace,
 				Verb:      "get",
 				Group:     corev1.SchemeGroupVersion.Group,
 				Version:   corev1.SchemeGroupVersion.Version,
 				Resource:  corev1.ResourceSecrets.String(),
 				Name:      secretRef.Name,
-			},
-			User:   user.Username,
-			Groups: user.Groups,
-			Extra:  convertToSARExtra(user.Extra),
-			UID:    user.UID,
+			}
+			forbiddenMsg = fmt.Sprintf("broker forbidden access to fallback auth secret (%s)", secretRef.Name)
+			if err := h.checkAccess(ctx, req, csb, attributes, forbiddenMsg, traced); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkAccess returns an error if the client is not allowed to access the given resource
+func (h *AccessToBroker) checkAccess(ctx context.Context, req admission.Request, csb *sc.ClusterServiceBroker, attributes *authorizationapi.ResourceAttributes, forbiddenMsg string, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	user := req.UserInfo
+	sar := &authorizationapi.SubjectAccessReview{
+		Spec: authorizationapi.SubjectAccessReviewSpec{
+			ResourceAttributes: attributes,
+			User:               user.Username,
+			Groups:             user.Groups,
+			Extra:              convertToSARExtra(user.Extra),
+			UID:                user.UID,
 		},
 	}
 
@@ -98,8 +153,8 @@ func (h *AccessToBroker) Validate(ctx context.Context, req admission.Request, cs
 
 	if !sar.Status.Allowed {
 		msg := fmt.Sprintf(
-			"broker forbidden access to auth secret (%s): Reason: %s, EvaluationError: %s",
-			secretRef.Name,
+			"%s: Reason: %s, EvaluationError: %s",
+			forbiddenMsg,
 			sar.Status.Reason,
 			sar.Status.EvaluationError)
 		traced.Info(msg)
@@ -19,9 +19,11 @@ package validation
 import (
 	"context"
 	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil"
 	admissionTypes "k8s.io/api/admission/v1beta1"
 	"net/http"
+	"regexp"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -45,10 +47,10 @@ var _ admission.DecoderInjector = &AdmissionHandler{}
 var _ inject.Client = &AdmissionHandler{}
 
 // NewAdmissionHandler creates new AdmissionHandler and initializes validators list
-func NewAdmissionHandler() *AdmissionHandler {
+func NewAdmissionHandler(parametersLimits scv.ParametersLimits, parameterKeyPattern *regexp.Regexp) *AdmissionHandler {
 	return &AdmissionHandler{
-		CreateValidators: []Validator{&ReferenceDeletion{}, &StaticCreate{}},
-		UpdateValidators: []Validator{&StaticUpdate{}},
+		CreateValidators: []Validator{&ReferenceDeletion{}, &StaticCreate{}, &LimitParameters{Limits: parametersLimits}, &AccessToSecretNamespaces{}, &BindablePlan{}, &ParameterKeyFormat{Pattern: parameterKeyPattern}},
+		UpdateValidators: []Validator{&StaticUpdate{}, &LimitParameters{Limits: parametersLimits}, &AccessToSecretNamespaces{}, &ParameterKeyFormat{Pattern: parameterKeyPattern}},
 	}
 }
 
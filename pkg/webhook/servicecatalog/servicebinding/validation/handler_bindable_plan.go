@@ -0,0 +1,123 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// BindablePlan rejects the creation of ServiceBindings to ServiceInstances
+// whose plan is not bindable, which the broker would refuse. A plan is
+// bindable if its own bindable flag says so or, when the plan does not set
+// it, if the flag of its class does.
+type BindablePlan struct {
+	client client.Client
+}
+
+var _ Validator = &BindablePlan{}
+var _ inject.Client = &BindablePlan{}
+
+// InjectClient injects the client
+func (h *BindablePlan) InjectClient(c client.Client) error {
+	h.client = c
+	return nil
+}
+
+// Validate checks if the plan of the referenced ServiceInstance is bindable
+// This feature was copied from Service Catalog admission plugin https://github.com/kubernetes-incubator/service-catalog/blob/master/plugin/pkg/admission/servicebindings/bindableplan/admission.go
+// If you want to track previous changes please check there.
+func (h *BindablePlan) Validate(ctx context.Context, req admission.Request, sb *sc.ServiceBinding, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	instance := &sc.ServiceInstance{}
+	err := h.client.Get(ctx, types.NamespacedName{Namespace: sb.Namespace, Name: sb.Spec.InstanceRef.Name}, instance)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// The controller reports bindings to missing instances
+			return nil
+		}
+		traced.Errorf("Could not get ServiceInstance by name %q: %v", sb.Spec.InstanceRef.Name, err)
+		return webhookutil.NewWebhookError(err.Error(), http.StatusForbidden)
+	}
+
+	planName, bindable, err := h.isInstancePlanBindable(ctx, instance)
+	if err != nil {
+		traced.Error(err)
+		return webhookutil.NewWebhookError(err.Error(), http.StatusForbidden)
+	}
+	if !bindable {
+		msg := fmt.Sprintf("ServiceInstance %q is of plan %q, which is not bindable", instance.Name, planName)
+		traced.Infof("ServiceBinding %s/%s: %s", sb.Namespace, sb.Name, msg)
+		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	}
+
+	return nil
+}
+
+// isInstancePlanBindable returns the external name of the plan of the
+// instance and whether it is bindable. Instances whose class and plan are not
+// resolved yet, or no longer exist, are considered bindable and left to the
+// controller.
+func (h *BindablePlan) isInstancePlanBindable(ctx context.Context, instance *sc.ServiceInstance) (string, bool, error) {
+	var class *sc.CommonServiceClassSpec
+	var plan *sc.CommonServicePlanSpec
+
+	switch {
+	case instance.Spec.ClusterServiceClassRef != nil && instance.Spec.ClusterServicePlanRef != nil:
+		csc := &sc.ClusterServiceClass{}
+		if err := h.client.Get(ctx, types.NamespacedName{Name: instance.Spec.ClusterServiceClassRef.Name}, csc); err != nil {
+			return "", apierrors.IsNotFound(err), ignoreNotFound(err)
+		}
+		csp := &sc.ClusterServicePlan{}
+		if err := h.client.Get(ctx, types.NamespacedName{Name: instance.Spec.ClusterServicePlanRef.Name}, csp); err != nil {
+			return "", apierrors.IsNotFound(err), ignoreNotFound(err)
+		}
+		class, plan = &csc.Spec.CommonServiceClassSpec, &csp.Spec.CommonServicePlanSpec
+	case instance.Spec.ServiceClassRef != nil && instance.Spec.ServicePlanRef != nil:
+		serviceClass := &sc.ServiceClass{}
+		if err := h.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.ServiceClassRef.Name}, serviceClass); err != nil {
+			return "", apierrors.IsNotFound(err), ignoreNotFound(err)
+		}
+		servicePlan := &sc.ServicePlan{}
+		if err := h.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.ServicePlanRef.Name}, servicePlan); err != nil {
+			return "", apierrors.IsNotFound(err), ignoreNotFound(err)
+		}
+		class, plan = &serviceClass.Spec.CommonServiceClassSpec, &servicePlan.Spec.CommonServicePlanSpec
+	default:
+		return "", true, nil
+	}
+
+	if plan.Bindable != nil {
+		return plan.ExternalName, *plan.Bindable, nil
+	}
+	return plan.ExternalName, class.Bindable, nil
+}
+
+func ignoreNotFound(err error) error {
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
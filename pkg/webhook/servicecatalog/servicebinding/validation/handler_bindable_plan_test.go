@@ -0,0 +1,159 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/servicebinding/validation"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestAdmissionHandlerBindablePlan(t *testing.T) {
+	tester.DiscardLoggedMsg()
+
+	// given
+	namespace := "test-handler"
+	err := sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+
+	decoder, err := admission.NewDecoder(sch)
+	require.NoError(t, err)
+
+	truePtr, falsePtr := true, false
+	instance := func(name, plan string) runtime.Object {
+		return &sc.ServiceInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: sc.ServiceInstanceSpec{
+				ClusterServiceClassRef: &sc.ClusterObjectReference{Name: "test-class"},
+				ClusterServicePlanRef:  &sc.ClusterObjectReference{Name: plan},
+			},
+		}
+	}
+	plan := func(name string, bindable *bool) runtime.Object {
+		return &sc.ClusterServicePlan{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: sc.ClusterServicePlanSpec{
+				CommonServicePlanSpec: sc.CommonServicePlanSpec{ExternalName: name, Bindable: bindable},
+			},
+		}
+	}
+	objects := []runtime.Object{
+		&sc.ClusterServiceClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-class"},
+			Spec: sc.ClusterServiceClassSpec{
+				CommonServiceClassSpec: sc.CommonServiceClassSpec{Bindable: true},
+			},
+		},
+		plan("bindable-plan", &truePtr),
+		plan("non-bindable-plan", &falsePtr),
+		plan("class-default-plan", nil),
+		instance("bindable-instance", "bindable-plan"),
+		instance("non-bindable-instance", "non-bindable-plan"),
+		instance("class-default-instance", "class-default-plan"),
+		&sc.ServiceInstance{ObjectMeta: metav1.ObjectMeta{Name: "unresolved-instance", Namespace: namespace}},
+	}
+
+	tests := map[string]struct {
+		instanceName    string
+		responseAllowed bool
+		responseReason  string
+	}{
+		"Instance of a bindable plan": {
+			instanceName:    "bindable-instance",
+			responseAllowed: true,
+		},
+		"Instance of a plan which is not bindable": {
+			instanceName:    "non-bindable-instance",
+			responseAllowed: false,
+			responseReason:  `ServiceInstance "non-bindable-instance" is of plan "non-bindable-plan", which is not bindable`,
+		},
+		"Instance of a plan using the bindable flag of its class": {
+			instanceName:    "class-default-instance",
+			responseAllowed: true,
+		},
+		"Instance whose plan is not resolved yet": {
+			instanceName:    "unresolved-instance",
+			responseAllowed: true,
+		},
+		"Instance which does not exist": {
+			instanceName:    "missing-instance",
+			responseAllowed: true,
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			handler := validation.AdmissionHandler{}
+			handler.CreateValidators = []validation.Validator{&validation.BindablePlan{}}
+			err := handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+			err = handler.InjectClient(fake.NewFakeClientWithScheme(sch, objects...))
+			require.NoError(t, err)
+
+			request := admission.Request{
+				AdmissionRequest: admissionv1beta1.AdmissionRequest{
+					UID:       "1111-aaaa",
+					Name:      "test-binding",
+					Namespace: namespace,
+					Operation: admissionv1beta1.Create,
+					Kind: metav1.GroupVersionKind{
+						Kind:    "ServiceBinding",
+						Version: "v1beta1",
+						Group:   "servicecatalog.k8s.io",
+					},
+					Object: runtime.RawExtension{Raw: []byte(`{
+						"metadata": {
+						  "name": "test-binding",
+						  "namespace": "` + namespace + `"
+						},
+						"spec": {
+						  "instanceRef": {
+							"name": "` + test.instanceName + `"
+						  },
+						  "externalID": "123-abc",
+						  "secretName": "test-binding"
+						}
+					}`)},
+				},
+			}
+
+			// when
+			response := handler.Handle(context.Background(), request)
+
+			// then
+			assert.Equal(t, test.responseAllowed, response.AdmissionResponse.Allowed)
+			if !test.responseAllowed {
+				assert.Contains(t, response.AdmissionResponse.Result.Reason, test.responseReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil"
+	admissionTypes "k8s.io/api/admission/v1beta1"
+	authenticationapi "k8s.io/api/authentication/v1"
+	authorizationapi "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"net/http"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// AccessToSecretNamespaces handles ServiceBinding validation
+type AccessToSecretNamespaces struct {
+	decoder *admission.Decoder
+	client  client.Client
+}
+
+var _ admission.DecoderInjector = &AccessToSecretNamespaces{}
+var _ inject.Client = &AccessToSecretNamespaces{}
+
+// InjectDecoder injects the decoder
+func (h *AccessToSecretNamespaces) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
+
+// InjectClient injects the client
+func (h *AccessToSecretNamespaces) InjectClient(c client.Client) error {
+	h.client = c
+	return nil
+}
+
+// Validate checks if the user is allowed to create Secrets in each of the
+// additional secret namespaces of the ServiceBinding, since the controller
+// writes the copies of the binding's Secret there on their behalf. On update
+// only the added namespaces are checked.
+// This feature was copied from Service Catalog admission plugin plugin/pkg/admission/servicebindings/secretcopies/admission.go
+// If you want to track previous changes please check there.
+func (h *AccessToSecretNamespaces) Validate(ctx context.Context, req admission.Request, sb *sc.ServiceBinding, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	namespaces := sets.NewString(sb.Spec.AdditionalSecretNamespaces...)
+	if req.Operation == admissionTypes.Update {
+		oldSb := &sc.ServiceBinding{}
+		if err := h.decoder.DecodeRaw(req.OldObject, oldSb); err != nil {
+			return webhookutil.NewWebhookError(err.Error(), http.StatusBadRequest)
+		}
+		namespaces = namespaces.Difference(sets.NewString(oldSb.Spec.AdditionalSecretNamespaces...))
+	}
+
+	user := req.UserInfo
+	for _, namespace := range namespaces.List() {
+		sar := &authorizationapi.SubjectAccessReview{
+			Spec: authorizationapi.SubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationapi.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      "create",
+					Group:     corev1.SchemeGroupVersion.Group,
+					Version:   corev1.SchemeGroupVersion.Version,
+					Resource:  corev1.ResourceSecrets.String(),
+					Name:      sb.Spec.SecretName,
+				},
+				User:   user.Username,
+				Groups: user.Groups,
+				Extra:  convertToSARExtra(user.Extra),
+				UID:    user.UID,
+			},
+		}
+
+		err := h.client.Create(ctx, sar)
+		if err != nil {
+			traced.Errorf("Could not create SubjectAccessReview for %s %q: %v", sb.Kind, sb.Name, err)
+			return webhookutil.NewWebhookError(err.Error(), http.StatusForbidden)
+		}
+
+		if !sar.Status.Allowed {
+			msg := fmt.Sprintf(
+				"binding forbidden to create secrets in additional secret namespace (%s): Reason: %s, EvaluationError: %s",
+				namespace,
+				sar.Status.Reason,
+				sar.Status.EvaluationError)
+			traced.Info(msg)
+			return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+		}
+	}
+
+	return nil
+}
+
+func convertToSARExtra(extra map[string]authenticationapi.ExtraValue) map[string]authorizationapi.ExtraValue {
+	if extra == nil {
+		return nil
+	}
+
+	ret := map[string]authorizationapi.ExtraValue{}
+	for k, v := range extra {
+		ret[k] = authorizationapi.ExtraValue(v)
+	}
+
+	return ret
+}
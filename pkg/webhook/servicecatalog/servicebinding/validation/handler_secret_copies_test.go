@@ -0,0 +1,163 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"errors"
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/servicebinding/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	"k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"testing"
+)
+
+const ForbiddenSecretNamespace = "forbidden-ns"
+
+// Reactors are not implemented in 'sigs.k8s.io/controller-runtime/pkg/client/fake' package
+// https://github.com/kubernetes-sigs/controller-runtime/issues/72
+// instead it is used custom client with override Create method
+type secretNamespacesClient struct {
+	client.Client
+	reviewed []string
+}
+
+// Create overrides real client Create method for the test
+func (m *secretNamespacesClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOptionFunc) error {
+	sar, ok := obj.(*v1.SubjectAccessReview)
+	if !ok {
+		return errors.New("Input object is not SubjectAccessReview type")
+	}
+
+	attributes := sar.Spec.ResourceAttributes
+	m.reviewed = append(m.reviewed, attributes.Namespace)
+	sar.Status.Allowed = attributes.Verb == "create" && attributes.Resource == "secrets" && attributes.Namespace != ForbiddenSecretNamespace
+
+	return nil
+}
+
+func TestAdmissionHandlerAccessToSecretNamespaces(t *testing.T) {
+	// given
+	err := sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
+	decoder, err := admission.NewDecoder(scheme.Scheme)
+	require.NoError(t, err)
+
+	binding := func(namespaces string) []byte {
+		return []byte(`{
+  			"apiVersion": "servicecatalog.k8s.io/v1beta1",
+  			"kind": "ServiceBinding",
+  			"metadata": {
+  			  "creationTimestamp": null,
+  			  "name": "test-binding"
+  			},
+  			"spec": {
+			  "instanceRef": {
+				"name": "test-instance"
+			  },
+			  "secretName": "test-binding",
+			  "additionalSecretNamespaces": [` + namespaces + `]
+  			}
+		}`)
+	}
+
+	tests := map[string]struct {
+		operation admissionv1beta1.Operation
+		object    []byte
+		oldObject []byte
+		reviewed  []string
+		allowed   bool
+	}{
+		"Request for Create ServiceBinding without additional secret namespaces should be allowed": {
+			operation: admissionv1beta1.Create,
+			object:    binding(``),
+			allowed:   true,
+		},
+		"Request for Create ServiceBinding with allowed namespaces should be allowed": {
+			operation: admissionv1beta1.Create,
+			object:    binding(`"other-ns", "another-ns"`),
+			reviewed:  []string{"another-ns", "other-ns"},
+			allowed:   true,
+		},
+		"Request for Create ServiceBinding with a forbidden namespace should be denied": {
+			operation: admissionv1beta1.Create,
+			object:    binding(`"other-ns", "` + ForbiddenSecretNamespace + `"`),
+			reviewed:  []string{ForbiddenSecretNamespace},
+			allowed:   false,
+		},
+		"Request for Update ServiceBinding keeping a forbidden namespace should be allowed": {
+			operation: admissionv1beta1.Update,
+			object:    binding(`"` + ForbiddenSecretNamespace + `", "other-ns"`),
+			oldObject: binding(`"` + ForbiddenSecretNamespace + `"`),
+			reviewed:  []string{"other-ns"},
+			allowed:   true,
+		},
+		"Request for Update ServiceBinding adding a forbidden namespace should be denied": {
+			operation: admissionv1beta1.Update,
+			object:    binding(`"other-ns", "` + ForbiddenSecretNamespace + `"`),
+			oldObject: binding(`"other-ns"`),
+			reviewed:  []string{ForbiddenSecretNamespace},
+			allowed:   false,
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			handler := validation.AdmissionHandler{}
+			handler.CreateValidators = []validation.Validator{&validation.AccessToSecretNamespaces{}}
+			handler.UpdateValidators = []validation.Validator{&validation.AccessToSecretNamespaces{}}
+
+			fakeClient := &secretNamespacesClient{}
+			err := handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+			err = handler.InjectClient(fakeClient)
+			require.NoError(t, err)
+
+			request := admission.Request{
+				AdmissionRequest: admissionv1beta1.AdmissionRequest{
+					UID:       "7777-gggg",
+					Name:      "test-binding",
+					Namespace: "test-handler",
+					Operation: test.operation,
+					Kind: metav1.GroupVersionKind{
+						Kind:    "ServiceBinding",
+						Version: "v1beta1",
+						Group:   "servicecatalog.k8s.io",
+					},
+					Object:    runtime.RawExtension{Raw: test.object},
+					OldObject: runtime.RawExtension{Raw: test.oldObject},
+				},
+			}
+
+			// when
+			response := handler.Handle(context.Background(), request)
+
+			// then
+			assert.Equal(t, test.allowed, response.AdmissionResponse.Allowed)
+			assert.Equal(t, test.reviewed, fakeClient.reviewed)
+		})
+	}
+}
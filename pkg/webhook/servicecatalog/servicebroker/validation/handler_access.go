@@ -60,33 +60,54 @@ func (h *AccessToBroker) Validate(ctx context.Context, req admission.Request, sb
 		return nil
 	}
 
-	var secretRef *sc.LocalObjectReference
-	if sb.Spec.AuthInfo.Basic != nil {
-		secretRef = sb.Spec.AuthInfo.Basic.SecretRef
-	} else if sb.Spec.AuthInfo.Bearer != nil {
-		secretRef = sb.Spec.AuthInfo.Bearer.SecretRef
-	}
-
-	if secretRef == nil {
-		traced.Infof("%s %q has no SecretRef neither in Basic nor Bearer auth. Operation completed", sb.Kind, sb.Name)
-		return nil
+	var attributes *authorizationapi.ResourceAttributes
+	var forbiddenMsg string
+	if saAuth := sb.Spec.AuthInfo.BearerTokenFromServiceAccount; saAuth != nil && saAuth.ServiceAccountRef != nil {
+		// the controller requests tokens of the service account on behalf of
+		// the broker, so the user must be allowed to request them as well
+		saRef := saAuth.ServiceAccountRef
+		attributes = &authorizationapi.ResourceAttributes{
+			Namespace:   sb.Namespace,
+			Verb:        "create",
+			Group:       corev1.SchemeGroupVersion.Group,
+			Version:     corev1.SchemeGroupVersion.Version,
+			Resource:    "serviceaccounts",
+			Subresource: "token",
+			Name:        saRef.Name,
+		}
+		forbiddenMsg = fmt.Sprintf("broker forbidden access to service account token (%s)", saRef.Name)
+	} else {
+		var secretRef *sc.LocalObjectReference
+		if sb.Spec.AuthInfo.Basic != nil {
+			secretRef = sb.Spec.AuthInfo.Basic.SecretRef
+		} else if sb.Spec.AuthInfo.Bearer != nil {
+			secretRef = sb.Spec.AuthInfo.Bearer.SecretRef
+		}
+
+		if secretRef == nil {
+			traced.Infof("%s %q has no SecretRef neither in Basic nor Bearer auth. Operation completed", sb.Kind, sb.Name)
+			return nil
+		}
+
+		attributes = &authorizationapi.ResourceAttributes{
+			Namespace: sb.Namespace,
+			Verb:      "get",
+			Group:     corev1.SchemeGroupVersion.Group,
+			Version:   corev1.SchemeGroupVersion.Version,
+			Resource:  corev1.ResourceSecrets.String(),
+			Name:      secretRef.Name,
+		}
+		forbiddenMsg = fmt.Sprintf("broker forbidden access to auth secret (%s)", secretRef.Name)
 	}
 
 	user := req.UserInfo
 	sar := &authorizationapi.SubjectAccessReview{
 		Spec: authorizationapi.SubjectAccessReviewSpec{
-			ResourceAttributes: &authorizationapi.ResourceAttributes{
-				Namespace: sb.Namespace,
-				Verb:      "get",
-				Group:     corev1.SchemeGroupVersion.Group,
-				Version:   corev1.SchemeGroupVersion.Version,
-				Resource:  corev1.ResourceSecrets.String(),
-				Name:      secretRef.Name,
-			},
-			User:   user.Username,
-			Groups: user.Groups,
-			Extra:  convertToSARExtra(user.Extra),
-			UID:    user.UID,
+			ResourceAttributes: attributes,
+			User:               user.Username,
+			Groups:             user.Groups,
+			Extra:              convertToSARExtra(user.Extra),
+			UID:                user.UID,
 		},
 	}
 
@@ -98,8 +119,8 @@ func (h *AccessToBroker) Validate(ctx context.Context, req admission.Request, sb
 
 	if !sar.Status.Allowed {
 		msg := fmt.Sprintf(
-			"broker forbidden access to auth secret (%s): Reason: %s, EvaluationError: %s",
-			secretRef.Name,
+			"%s: Reason: %s, EvaluationError: %s",
+			forbiddenMsg,
 			sar.Status.Reason,
 			sar.Status.EvaluationError)
 		traced.Info(msg)
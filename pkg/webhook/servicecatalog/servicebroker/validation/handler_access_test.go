@@ -35,8 +35,9 @@ import (
 )
 
 const (
-	AllowedSecretName = "csb-secret-name"
-	DeniedSecretName  = "denied-csb-secret-name"
+	AllowedSecretName         = "csb-secret-name"
+	DeniedSecretName          = "denied-csb-secret-name"
+	AllowedServiceAccountName = "csb-sa-name"
 )
 
 // Reactors are not implemented in 'sigs.k8s.io/controller-runtime/pkg/client/fake' package
@@ -52,7 +53,9 @@ func (m *fakedClient) Create(ctx context.Context, obj runtime.Object, opts ...cl
 		return errors.New("Input object is not SubjectAccessReview type")
 	}
 
-	if obj.(*v1.SubjectAccessReview).Spec.ResourceAttributes.Name == AllowedSecretName {
+	attributes := obj.(*v1.SubjectAccessReview).Spec.ResourceAttributes
+	if attributes.Name == AllowedSecretName ||
+		(attributes.Resource == "serviceaccounts" && attributes.Subresource == "token" && attributes.Name == AllowedServiceAccountName) {
 		obj.(*v1.SubjectAccessReview).Status.Allowed = true
 	}
 
@@ -161,6 +164,30 @@ func TestAdmissionHandlerAccessToBrokerAllowed(t *testing.T) {
 				}
 			}`),
 		},
+		"Request for Create ServiceBroker with service account token AuthInfo should be allowed": {
+			admissionv1beta1.Create,
+			[]byte(`{
+  				"apiVersion": "servicecatalog.k8s.io/v1beta1",
+  				"kind": "ServiceBroker",
+  				"metadata": {
+				  "finalizers": ["kubernetes-incubator/service-catalog"],
+  				  "creationTimestamp": null,
+  				  "name": "test-broker"
+  				},
+  				"spec": {
+				  "url": "http://test-broker.local",
+				  "authInfo": {
+				    "bearerTokenFromServiceAccount": {
+				      "serviceAccountRef": {
+				        "namespace": "test-handler",
+				        "name": "` + AllowedServiceAccountName + `"
+				      },
+				      "audience": "test-audience"
+				    }
+				  }
+  				}
+			}`),
+		},
 	}
 
 	for desc, test := range tests {
@@ -261,6 +288,30 @@ func TestAdmissionHandlerAccessToBrokerDenied(t *testing.T) {
   				}
 			}`),
 		},
+		"Request for Create ServiceBroker with service account token AuthInfo should be denied": {
+			admissionv1beta1.Create,
+			[]byte(`{
+  				"apiVersion": "servicecatalog.k8s.io/v1beta1",
+  				"kind": "ServiceBroker",
+  				"metadata": {
+				  "finalizers": ["kubernetes-incubator/service-catalog"],
+  				  "creationTimestamp": null,
+  				  "name": "test-broker"
+  				},
+  				"spec": {
+				  "url": "http://test-broker.local",
+				  "authInfo": {
+				    "bearerTokenFromServiceAccount": {
+				      "serviceAccountRef": {
+				        "namespace": "test-handler",
+				        "name": "` + AllowedSecretName + `"
+				      },
+				      "audience": "test-audience"
+				    }
+				  }
+  				}
+			}`),
+		},
 	}
 
 	for desc, test := range tests {
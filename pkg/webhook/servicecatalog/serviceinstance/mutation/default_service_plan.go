@@ -39,7 +39,8 @@ type DefaultServicePlan struct {
 	client client.Client
 }
 
-// SetDefaultPlan sets the default service plan if it's not specified and if only one plan exists
+// SetDefaultPlan sets the default service plan if it's not specified and if
+// only one plan exists or the class designates a default plan
 func (d *DefaultServicePlan) SetDefaultPlan(ctx context.Context, instance *sc.ServiceInstance, log *webhookutil.TracedLogger) *webhookutil.WebhookError {
 	if instance.Spec.ClusterServicePlanSpecified() || instance.Spec.ServicePlanSpecified() {
 		return nil
@@ -89,15 +90,33 @@ func (d *DefaultServicePlan) handleDefaultClusterServicePlan(ctx context.Context
 		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
 	}
 
-	// check if more than one service plan was found and error
-	if len(plans) > 1 {
-		msg := fmt.Sprintf("ClusterServiceClass (K8S: %v ExternalName: %v) has more than one plan, PlanName must be specified", clusterServiceClass.Name, clusterServiceClass.Spec.ExternalName)
-		log.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
-		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	var p sc.ClusterServicePlan
+	if defaultPlanName, ok := clusterServiceClass.Annotations[sc.ServiceClassDefaultPlanAnnotation]; ok {
+		// pick the plan designated as the default of the service class
+		found := false
+		for _, plan := range plans {
+			if plan.Spec.ExternalName == defaultPlanName {
+				p = plan
+				found = true
+				break
+			}
+		}
+		if !found {
+			msg := fmt.Sprintf("default ClusterServicePlan %q of ClusterServiceClass (K8S: %v ExternalName: %v) does not exist, PlanName must be specified", defaultPlanName, clusterServiceClass.Name, clusterServiceClass.Spec.ExternalName)
+			log.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+			return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+		}
+	} else {
+		// check if more than one service plan was found and error
+		if len(plans) > 1 {
+			msg := fmt.Sprintf("ClusterServiceClass (K8S: %v ExternalName: %v) has more than one plan, PlanName must be specified", clusterServiceClass.Name, clusterServiceClass.Spec.ExternalName)
+			log.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+			return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+		}
+		// otherwise, by default, pick the only plan that exists for the service class
+		p = plans[0]
 	}
-	// otherwise, by default, pick the only plan that exists for the service class
 
-	p := plans[0]
 	log.V(4).Infof(`ServiceInstance "%s/%s": Using default plan %q (K8S: %q) for Service Class %q`,
 		instance.Namespace, instance.Name, p.Spec.ExternalName, p.Name, clusterServiceClass.Spec.ExternalName)
 	if instance.Spec.ClusterServiceClassExternalName != "" {
@@ -145,15 +164,33 @@ func (d *DefaultServicePlan) handleDefaultServicePlan(ctx context.Context, insta
 		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
 	}
 
-	// check if more than one service plan was found and error
-	if len(plans) > 1 {
-		msg := fmt.Sprintf("ServiceClass (K8S: %v ExternalName: %v) has more than one plan, PlanName must be specified", serviceClass.Name, serviceClass.Spec.ExternalName)
-		log.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
-		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	var p sc.ServicePlan
+	if defaultPlanName, ok := serviceClass.Annotations[sc.ServiceClassDefaultPlanAnnotation]; ok {
+		// pick the plan designated as the default of the service class
+		found := false
+		for _, plan := range plans {
+			if plan.Spec.ExternalName == defaultPlanName {
+				p = plan
+				found = true
+				break
+			}
+		}
+		if !found {
+			msg := fmt.Sprintf("default ServicePlan %q of ServiceClass (K8S: %v ExternalName: %v) does not exist, PlanName must be specified", defaultPlanName, serviceClass.Name, serviceClass.Spec.ExternalName)
+			log.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+			return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+		}
+	} else {
+		// check if more than one service plan was found and error
+		if len(plans) > 1 {
+			msg := fmt.Sprintf("ServiceClass (K8S: %v ExternalName: %v) has more than one plan, PlanName must be specified", serviceClass.Name, serviceClass.Spec.ExternalName)
+			log.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+			return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+		}
+		// otherwise, by default, pick the only plan that exists for the service class
+		p = plans[0]
 	}
-	// otherwise, by default, pick the only plan that exists for the service class
 
-	p := plans[0]
 	log.V(4).Infof(`ServiceInstance "%s/%s": Using default plan %q (K8S: %q) for Service Class %q`,
 		instance.Namespace, instance.Name, p.Spec.ExternalName, p.Name, serviceClass.Spec.ExternalName)
 	if instance.Spec.ServiceClassExternalName != "" {
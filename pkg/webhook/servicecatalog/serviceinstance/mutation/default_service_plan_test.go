@@ -47,9 +47,10 @@ func TestClusterServiceClassSpecified(t *testing.T) {
 	const className = "csc"
 
 	for tn, tc := range map[string]struct {
-		instance *sc.ServiceInstance
-		objects  []runtime.Object
-		err      *webhookutil.WebhookError
+		instance     *sc.ServiceInstance
+		objects      []runtime.Object
+		err          *webhookutil.WebhookError
+		expectedPlan string
 	}{
 		"SuccessWithClusterServiceClassName": {
 			instance: &sc.ServiceInstance{
@@ -124,6 +125,37 @@ func TestClusterServiceClassSpecified(t *testing.T) {
 			},
 			err: webhookutil.NewWebhookError(fmt.Sprintf("ClusterServiceClass (K8S: %v ExternalName: %v) has more than one plan, PlanName must be specified", className, className), http.StatusForbidden),
 		},
+		"SuccessWithDefaultPlanAnnotation": {
+			instance: &sc.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "dummy"},
+				Spec: sc.ServiceInstanceSpec{
+					PlanReference: sc.PlanReference{
+						ClusterServiceClassExternalName: className,
+					},
+				},
+			},
+			objects: []runtime.Object{
+				withDefaultPlan(newClusterServiceClass(className, className), "baz"),
+				newClusterServicePlans(className, 2, false)[0],
+				newClusterServicePlans(className, 2, false)[1],
+			},
+			expectedPlan: "baz",
+		},
+		"ErrorWhenDefaultPlanDoesNotExist": {
+			instance: &sc.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "dummy"},
+				Spec: sc.ServiceInstanceSpec{
+					PlanReference: sc.PlanReference{
+						ClusterServiceClassExternalName: className,
+					},
+				},
+			},
+			objects: []runtime.Object{
+				withDefaultPlan(newClusterServiceClass(className, className), "missing"),
+				newClusterServicePlans(className, 1, false)[0],
+			},
+			err: webhookutil.NewWebhookError(fmt.Sprintf("default ClusterServicePlan %q of ClusterServiceClass (K8S: %v ExternalName: %v) does not exist, PlanName must be specified", "missing", className, className), http.StatusForbidden),
+		},
 	} {
 		t.Run(tn, func(t *testing.T) {
 			fakeClient := fake.NewFakeClientWithScheme(newTestScheme(t), tc.objects...)
@@ -139,6 +171,9 @@ func TestClusterServiceClassSpecified(t *testing.T) {
 			} else {
 				assert.Nil(t, mutateErr)
 			}
+			if tc.expectedPlan != "" {
+				assert.Equal(t, tc.expectedPlan, tc.instance.Spec.ClusterServicePlanExternalName)
+			}
 		})
 	}
 }
@@ -148,9 +183,10 @@ func TestServiceClassSpecified(t *testing.T) {
 	const namespace = "dummy"
 
 	for tn, tc := range map[string]struct {
-		instance *sc.ServiceInstance
-		objects  []runtime.Object
-		err      *webhookutil.WebhookError
+		instance     *sc.ServiceInstance
+		objects      []runtime.Object
+		err          *webhookutil.WebhookError
+		expectedPlan string
 	}{
 		"SuccessWithServiceClassName": {
 			instance: &sc.ServiceInstance{
@@ -225,6 +261,37 @@ func TestServiceClassSpecified(t *testing.T) {
 			},
 			err: webhookutil.NewWebhookError(fmt.Sprintf("ServiceClass (K8S: %v ExternalName: %v) has more than one plan, PlanName must be specified", className, className), http.StatusForbidden),
 		},
+		"SuccessWithDefaultPlanAnnotation": {
+			instance: &sc.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "dummy"},
+				Spec: sc.ServiceInstanceSpec{
+					PlanReference: sc.PlanReference{
+						ServiceClassExternalName: className,
+					},
+				},
+			},
+			objects: []runtime.Object{
+				withDefaultPlan(newServiceClass(className, className, namespace), "baz"),
+				newServicePlans(className, namespace, 2, false)[0],
+				newServicePlans(className, namespace, 2, false)[1],
+			},
+			expectedPlan: "baz",
+		},
+		"ErrorWhenDefaultPlanDoesNotExist": {
+			instance: &sc.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "dummy"},
+				Spec: sc.ServiceInstanceSpec{
+					PlanReference: sc.PlanReference{
+						ServiceClassExternalName: className,
+					},
+				},
+			},
+			objects: []runtime.Object{
+				withDefaultPlan(newServiceClass(className, className, namespace), "missing"),
+				newServicePlans(className, namespace, 1, false)[0],
+			},
+			err: webhookutil.NewWebhookError(fmt.Sprintf("default ServicePlan %q of ServiceClass (K8S: %v ExternalName: %v) does not exist, PlanName must be specified", "missing", className, className), http.StatusForbidden),
+		},
 	} {
 		t.Run(tn, func(t *testing.T) {
 			fakeClient := fake.NewFakeClientWithScheme(newTestScheme(t), tc.objects...)
@@ -240,6 +307,9 @@ func TestServiceClassSpecified(t *testing.T) {
 			} else {
 				assert.Nil(t, mutateErr)
 			}
+			if tc.expectedPlan != "" {
+				assert.Equal(t, tc.expectedPlan, tc.instance.Spec.ServicePlanExternalName)
+			}
 		})
 	}
 }
@@ -300,6 +370,13 @@ func newServiceClass(id string, name string, namespace string) *sc.ServiceClass
 	return sc
 }
 
+// withDefaultPlan designates the plan with the given external name as the
+// default plan of the class.
+func withDefaultPlan(class metav1.Object, planName string) runtime.Object {
+	class.SetAnnotations(map[string]string{sc.ServiceClassDefaultPlanAnnotation: planName})
+	return class.(runtime.Object)
+}
+
 // newClusterServicePlans returns new serviceplans.
 func newClusterServicePlans(classname string, count uint, useDifferentClasses bool) []*sc.ClusterServicePlan {
 	sp1 := &sc.ClusterServicePlan{
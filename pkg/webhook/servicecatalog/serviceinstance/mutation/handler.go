@@ -113,8 +113,14 @@ func (h *CreateUpdateHandler) mutateOnCreate(ctx context.Context, req admission.
 	// This feature was copied from Service Catalog registry: https://github.com/kubernetes-incubator/service-catalog/blob/master/pkg/registry/servicecatalog/instance/strategy.go
 	// If you want to track previous changes please check there.
 
+	sc.SetDefaults_PlanReference(&instance.Spec.PlanReference)
+
 	if instance.Spec.ExternalID == "" {
-		instance.Spec.ExternalID = string(h.UUID.New())
+		if instance.HasStableExternalID() && instance.Name != "" {
+			instance.Spec.ExternalID = sc.StableExternalID(instance.Namespace, instance.Name)
+		} else {
+			instance.Spec.ExternalID = string(h.UUID.New())
+		}
 	}
 
 	if utilfeature.DefaultFeatureGate.Enabled(scfeatures.OriginatingIdentity) {
@@ -127,6 +133,7 @@ func (h *CreateUpdateHandler) mutateOnCreate(ctx context.Context, req admission.
 }
 
 func (h *CreateUpdateHandler) mutateOnUpdate(ctx context.Context, req admission.Request, oldServiceInstance, newServiceInstance *sc.ServiceInstance) {
+	sc.SetDefaults_PlanReference(&newServiceInstance.Spec.PlanReference)
 
 	// Clear out the ClusterServicePlanRef so that it is resolved during reconciliation
 	planUpdated := newServiceInstance.Spec.ClusterServicePlanExternalName != oldServiceInstance.Spec.ClusterServicePlanExternalName ||
@@ -73,6 +73,74 @@ func TestCreateUpdateHandlerHandleCreateSuccess(t *testing.T) {
 				},
 			},
 		},
+		"Should trim whitespace from plan reference": {
+			givenRawObj: []byte(`{
+  				"apiVersion": "servicecatalog.k8s.io/v1beta1",
+  				"kind": "ServiceInstance",
+  				"metadata": {
+  				  "creationTimestamp": null,
+  				  "name": "test-instance"
+  				},
+  				"spec": {
+				  "updateRequests": 1,
+				  "clusterServiceClassExternalName": " some-class",
+				  "clusterServicePlanExternalName": "some-plan ",
+				  "externalID": "my-external-id-123"
+  				}
+			}`),
+			expPatches: []jsonpatch.Operation{
+				{
+					Operation: "add",
+					Path:      "/metadata/finalizers",
+					Value: []interface{}{
+						"kubernetes-incubator/service-catalog",
+					},
+				},
+				{
+					Operation: "replace",
+					Path:      "/spec/clusterServiceClassExternalName",
+					Value:     "some-class",
+				},
+				{
+					Operation: "replace",
+					Path:      "/spec/clusterServicePlanExternalName",
+					Value:     "some-plan",
+				},
+			},
+		},
+		"Should derive externalID from namespace and name when stable external ID is requested": {
+			givenRawObj: []byte(`{
+				"apiVersion": "servicecatalog.k8s.io/v1beta1",
+  				"kind": "ServiceInstance",
+  				"metadata": {
+  				  "creationTimestamp": null,
+  				  "name": "test-instance",
+  				  "namespace": "system",
+  				  "annotations": {
+  				    "servicecatalog.k8s.io/stable-external-id": "true"
+  				  }
+  				},
+  				"spec": {
+				  "updateRequests": 1,
+				  "clusterServiceClassExternalName": "some-class",
+				  "clusterServicePlanExternalName": "some-plan"
+  				}
+			}`),
+			expPatches: []jsonpatch.Operation{
+				{
+					Operation: "add",
+					Path:      "/metadata/finalizers",
+					Value: []interface{}{
+						"kubernetes-incubator/service-catalog",
+					},
+				},
+				{
+					Operation: "add",
+					Path:      "/spec/externalID",
+					Value:     sc.StableExternalID("system", "test-instance"),
+				},
+			},
+		},
 		"Should omit externalID and secretName if they are already set": {
 			givenRawObj: []byte(`{
 				"apiVersion": "servicecatalog.k8s.io/v1beta1",
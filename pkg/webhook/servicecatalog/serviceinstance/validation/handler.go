@@ -19,8 +19,10 @@ package validation
 import (
 	"context"
 	"net/http"
+	"regexp"
 
 	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
 	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil"
 
 	admissionTypes "k8s.io/api/admission/v1beta1"
@@ -46,11 +48,13 @@ var _ admission.Handler = &AdmissionHandler{}
 var _ admission.DecoderInjector = &AdmissionHandler{}
 var _ inject.Client = &AdmissionHandler{}
 
-// NewAdmissionHandler creates new AdmissionHandler and initializes validators list
-func NewAdmissionHandler() *AdmissionHandler {
+// NewAdmissionHandler creates new AdmissionHandler and initializes validators list.
+// allowedBrokers maps a namespace to the brokers its instances may use and
+// parameterKeyPattern, if not nil, is the format required of parameter keys.
+func NewAdmissionHandler(parametersLimits scv.ParametersLimits, allowedBrokers map[string][]string, parameterKeyPattern *regexp.Regexp) *AdmissionHandler {
 	return &AdmissionHandler{
-		UpdateValidators: []Validator{&StaticUpdate{}, &DenyPlanChangeIfNotUpdatable{}},
-		CreateValidators: []Validator{&StaticCreate{}},
+		UpdateValidators: []Validator{&StaticUpdate{}, &DenyPlanChangeIfNotUpdatable{}, &LimitParameters{Limits: parametersLimits}, &AllowedBrokers{Allowed: allowedBrokers}, &RequiredParameters{}, &ParameterKeyFormat{Pattern: parameterKeyPattern}},
+		CreateValidators: []Validator{&StaticCreate{}, &LimitParameters{Limits: parametersLimits}, &AllowedBrokers{Allowed: allowedBrokers}, &RequiredParameters{}, &ParameterKeyFormat{Pattern: parameterKeyPattern}},
 	}
 }
 
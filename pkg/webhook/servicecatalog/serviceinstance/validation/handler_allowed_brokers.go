@@ -0,0 +1,131 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil"
+	admissionTypes "k8s.io/api/admission/v1beta1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// AllowedBrokers rejects ServiceInstances in restricted namespaces whose
+// service class is offered by a broker that is not allowed for that
+// namespace. Namespaces which are not listed in Allowed are not restricted.
+//
+// This feature was copied from Service Catalog admission plugin https://github.com/kubernetes-incubator/service-catalog/blob/master/plugin/pkg/admission/serviceinstances/allowedbrokers
+// If you want to track previous changes please check there.
+type AllowedBrokers struct {
+	decoder *admission.Decoder
+	client  client.Client
+
+	// Allowed maps a namespace to the names of the brokers that instances
+	// in that namespace may be provisioned from.
+	Allowed map[string][]string
+}
+
+var _ Validator = &AllowedBrokers{}
+var _ admission.DecoderInjector = &AllowedBrokers{}
+var _ inject.Client = &AllowedBrokers{}
+
+// InjectDecoder injects the decoder
+func (v *AllowedBrokers) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// InjectClient injects the client
+func (v *AllowedBrokers) InjectClient(c client.Client) error {
+	v.client = c
+	return nil
+}
+
+// Validate checks if the broker offering the class of the ServiceInstance is
+// allowed in its namespace
+func (v *AllowedBrokers) Validate(ctx context.Context, req admission.Request, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	brokers, restricted := v.Allowed[si.Namespace]
+	if !restricted {
+		return nil
+	}
+	allowed := sets.NewString(brokers...)
+
+	// Updates which do not change the plan are let through, so that
+	// restricting a namespace does not block updates of its existing
+	// instances.
+	if req.Operation == admissionTypes.Update {
+		originalObj := &sc.ServiceInstance{}
+		if err := v.decoder.DecodeRaw(req.OldObject, originalObj); err != nil {
+			traced.Errorf("Could not decode oldObject: %v", err)
+			return webhookutil.NewWebhookError(err.Error(), http.StatusBadRequest)
+		}
+		if apiequality.Semantic.DeepEqual(originalObj.Spec.PlanReference, si.Spec.PlanReference) {
+			return nil
+		}
+	}
+
+	used, err := v.getBrokerNames(ctx, si)
+	if err != nil {
+		traced.Error(err)
+		return webhookutil.NewWebhookError(err.Error(), http.StatusForbidden)
+	}
+	// Fail closed: an instance whose class cannot be found yet could later
+	// be resolved to a class of any broker.
+	if used.Len() == 0 {
+		msg := fmt.Sprintf("Unable to determine the broker offering %c; namespace %q only allows brokers %v", si.Spec.PlanReference, si.Namespace, allowed.List())
+		traced.Infof("ServiceInstance %s/%s: %s", si.Namespace, si.Name, msg)
+		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	}
+	if disallowed := used.Difference(allowed); disallowed.Len() > 0 {
+		msg := fmt.Sprintf("Namespace %q is not allowed to provision from brokers %v", si.Namespace, disallowed.List())
+		traced.Infof("ServiceInstance %s/%s: %s", si.Namespace, si.Name, msg)
+		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	}
+
+	return nil
+}
+
+// getBrokerNames returns the names of the brokers offering a class the
+// instance could be resolved to.
+func (v *AllowedBrokers) getBrokerNames(ctx context.Context, si *sc.ServiceInstance) (sets.String, error) {
+	brokers := sets.NewString()
+
+	clusterClasses, err := getClusterServiceClasses(ctx, v.client, si)
+	if err != nil {
+		return nil, err
+	}
+	for _, class := range clusterClasses {
+		brokers.Insert(class.Spec.ClusterServiceBrokerName)
+	}
+
+	classes, err := getServiceClasses(ctx, v.client, si)
+	if err != nil {
+		return nil, err
+	}
+	for _, class := range classes {
+		brokers.Insert(class.Spec.ServiceBrokerName)
+	}
+
+	return brokers, nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestAdmissionHandlerAllowedBrokers(t *testing.T) {
+	tester.DiscardLoggedMsg()
+
+	// given
+	instanceInNamespace := func(namespace, class, plan string) []byte {
+		return []byte(`{
+			"metadata": {
+			  "name": "test-serviceinstance",
+			  "namespace": "` + namespace + `"
+			},
+			"spec": {
+			  "clusterServiceClassExternalName": "` + class + `",
+			  "clusterServicePlanExternalName": "` + plan + `"
+			}
+		}`)
+	}
+
+	err := sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+
+	decoder, err := admission.NewDecoder(sch)
+	require.NoError(t, err)
+
+	classes := []runtime.Object{
+		&sc.ClusterServiceClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "shared-class-id",
+				Labels: map[string]string{
+					sc.GroupName + "/" + sc.FilterSpecExternalName:             "shared-class",
+					sc.GroupName + "/" + sc.FilterSpecClusterServiceBrokerName: "shared-broker",
+				},
+			},
+			Spec: sc.ClusterServiceClassSpec{
+				ClusterServiceBrokerName: "shared-broker",
+				CommonServiceClassSpec:   sc.CommonServiceClassSpec{ExternalName: "shared-class"},
+			},
+		},
+		&sc.ClusterServiceClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "private-class-id",
+				Labels: map[string]string{
+					sc.GroupName + "/" + sc.FilterSpecExternalName:             "private-class",
+					sc.GroupName + "/" + sc.FilterSpecClusterServiceBrokerName: "private-broker",
+				},
+			},
+			Spec: sc.ClusterServiceClassSpec{
+				ClusterServiceBrokerName: "private-broker",
+				CommonServiceClassSpec:   sc.CommonServiceClassSpec{ExternalName: "private-class"},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		operation       admissionv1beta1.Operation
+		object          []byte
+		oldObject       []byte
+		responseAllowed bool
+		responseReason  string
+	}{
+		"Create in a namespace which is not restricted": {
+			operation:       admissionv1beta1.Create,
+			object:          instanceInNamespace("ns-free", "private-class", "default"),
+			responseAllowed: true,
+		},
+		"Create from an allowed broker": {
+			operation:       admissionv1beta1.Create,
+			object:          instanceInNamespace("ns-restricted", "shared-class", "default"),
+			responseAllowed: true,
+		},
+		"Create from a broker which is not allowed": {
+			operation:       admissionv1beta1.Create,
+			object:          instanceInNamespace("ns-restricted", "private-class", "default"),
+			responseAllowed: false,
+			responseReason:  `Namespace "ns-restricted" is not allowed to provision from brokers [private-broker]`,
+		},
+		"Create of a class which does not exist": {
+			operation:       admissionv1beta1.Create,
+			object:          instanceInNamespace("ns-restricted", "unknown-class", "default"),
+			responseAllowed: false,
+			responseReason:  "Unable to determine the broker offering",
+		},
+		"Update which does not change the plan": {
+			operation:       admissionv1beta1.Update,
+			object:          instanceInNamespace("ns-restricted", "private-class", "default"),
+			oldObject:       instanceInNamespace("ns-restricted", "private-class", "default"),
+			responseAllowed: true,
+		},
+		"Update changing the plan": {
+			operation:       admissionv1beta1.Update,
+			object:          instanceInNamespace("ns-restricted", "private-class", "premium"),
+			oldObject:       instanceInNamespace("ns-restricted", "private-class", "default"),
+			responseAllowed: false,
+			responseReason:  `Namespace "ns-restricted" is not allowed to provision from brokers [private-broker]`,
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			allowedBrokers := &validation.AllowedBrokers{Allowed: map[string][]string{
+				"ns-restricted": {"shared-broker"},
+			}}
+			handler := validation.AdmissionHandler{}
+			handler.CreateValidators = []validation.Validator{allowedBrokers}
+			handler.UpdateValidators = []validation.Validator{allowedBrokers}
+			err := handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+			err = handler.InjectClient(fake.NewFakeClientWithScheme(sch, classes...))
+			require.NoError(t, err)
+
+			request := admission.Request{
+				AdmissionRequest: admissionv1beta1.AdmissionRequest{
+					UID:       "uuid",
+					Name:      "test-serviceinstance",
+					Operation: test.operation,
+					Kind: metav1.GroupVersionKind{
+						Kind:    "ServiceInstance",
+						Version: "v1beta1",
+						Group:   "servicecatalog.k8s.io",
+					},
+					Object:    runtime.RawExtension{Raw: test.object},
+					OldObject: runtime.RawExtension{Raw: test.oldObject},
+				},
+			}
+
+			// when
+			response := handler.Handle(context.Background(), request)
+
+			// then
+			assert.Equal(t, test.responseAllowed, response.AdmissionResponse.Allowed)
+			if !test.responseAllowed {
+				assert.Contains(t, response.AdmissionResponse.Result.Reason, test.responseReason)
+			}
+		})
+	}
+}
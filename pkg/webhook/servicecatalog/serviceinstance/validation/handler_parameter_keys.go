@@ -0,0 +1,73 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil"
+	admissionTypes "k8s.io/api/admission/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ParameterKeyFormat rejects ServiceInstances whose parameter keys do not match
+// the pattern configured by the operator. A nil pattern admits every key.
+type ParameterKeyFormat struct {
+	decoder *admission.Decoder
+
+	Pattern *regexp.Regexp
+}
+
+var _ Validator = &ParameterKeyFormat{}
+var _ admission.DecoderInjector = &ParameterKeyFormat{}
+
+// InjectDecoder injects the decoder
+func (v *ParameterKeyFormat) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// Validate checks the parameter keys of the ServiceInstance against the pattern
+func (v *ParameterKeyFormat) Validate(ctx context.Context, req admission.Request, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	if v.Pattern == nil {
+		return nil
+	}
+
+	var old *webhookutil.ParameterKeys
+	if req.Operation == admissionTypes.Update {
+		originalObj := &sc.ServiceInstance{}
+		if err := v.decoder.DecodeRaw(req.OldObject, originalObj); err != nil {
+			traced.Errorf("Could not decode oldObject: %v", err)
+			return webhookutil.NewWebhookError(err.Error(), http.StatusBadRequest)
+		}
+		old = &webhookutil.ParameterKeys{Parameters: originalObj.Spec.Parameters, ParametersFrom: originalObj.Spec.ParametersFrom}
+	}
+
+	invalid := webhookutil.FindInvalidParameterKeys(v.Pattern, webhookutil.ParameterKeys{Parameters: si.Spec.Parameters, ParametersFrom: si.Spec.ParametersFrom}, old)
+	if len(invalid) > 0 {
+		msg := fmt.Sprintf("parameter keys must match %q; invalid keys: %s", v.Pattern, strings.Join(invalid, ", "))
+		traced.Infof("ServiceInstance %s/%s: %s", si.Namespace, si.Name, msg)
+		return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+	}
+
+	return nil
+}
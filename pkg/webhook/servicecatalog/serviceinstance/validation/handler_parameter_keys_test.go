@@ -0,0 +1,147 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestAdmissionHandlerParameterKeyFormat(t *testing.T) {
+	tester.DiscardLoggedMsg()
+
+	// given
+	withSpec := func(spec string) []byte {
+		return []byte(`{
+			"metadata": {
+			  "name": "test-object"
+			},
+			"spec": {
+			  "clusterServiceClassExternalName": "test-class",
+			  ` + spec + `
+			}
+		}`)
+	}
+
+	err := sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+
+	decoder, err := admission.NewDecoder(sch)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		operation       admissionv1beta1.Operation
+		pattern         *regexp.Regexp
+		object          []byte
+		oldObject       []byte
+		responseAllowed bool
+		responseReason  string
+	}{
+		"Create with matching keys": {
+			operation:       admissionv1beta1.Create,
+			pattern:         regexp.MustCompile("^[a-z][a-zA-Z0-9]*$"),
+			object:          withSpec(`"parameters": {"region":"eu","nested":{"diskSize":10}}`),
+			responseAllowed: true,
+		},
+		"Create with a nested key which does not match": {
+			operation:       admissionv1beta1.Create,
+			pattern:         regexp.MustCompile("^[a-z][a-zA-Z0-9]*$"),
+			object:          withSpec(`"parameters": {"region":"eu","nested":[{"disk_size":10}]}`),
+			responseAllowed: false,
+			responseReason:  "invalid keys: nested[0].disk_size",
+		},
+		"Create assigning a parametersFrom source to a key which does not match": {
+			operation:       admissionv1beta1.Create,
+			pattern:         regexp.MustCompile("^[a-z][a-zA-Z0-9]*$"),
+			object:          withSpec(`"parametersFrom": [{"parameterName":"Password","secretKeyRef":{"name":"s","key":"k"}}]`),
+			responseAllowed: false,
+			responseReason:  "invalid keys: Password",
+		},
+		"Create without a pattern": {
+			operation:       admissionv1beta1.Create,
+			object:          withSpec(`"parameters": {"disk_size":10}`),
+			responseAllowed: true,
+		},
+		"Update keeping keys which do not match": {
+			operation:       admissionv1beta1.Update,
+			pattern:         regexp.MustCompile("^[a-z][a-zA-Z0-9]*$"),
+			object:          withSpec(`"parameters": {"disk_size":10}`),
+			oldObject:       withSpec(`"parameters": {"disk_size":10}`),
+			responseAllowed: true,
+		},
+		"Update changing parameters with keys which do not match": {
+			operation:       admissionv1beta1.Update,
+			pattern:         regexp.MustCompile("^[a-z][a-zA-Z0-9]*$"),
+			object:          withSpec(`"parameters": {"disk_size":20}`),
+			oldObject:       withSpec(`"parameters": {"disk_size":10}`),
+			responseAllowed: false,
+			responseReason:  "invalid keys: disk_size",
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			parameterKeyFormat := &validation.ParameterKeyFormat{Pattern: test.pattern}
+			handler := validation.AdmissionHandler{}
+			handler.CreateValidators = []validation.Validator{parameterKeyFormat}
+			handler.UpdateValidators = []validation.Validator{parameterKeyFormat}
+			err := handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+
+			request := admission.Request{
+				AdmissionRequest: admissionv1beta1.AdmissionRequest{
+					UID:       "uuid",
+					Name:      "test-object",
+					Namespace: "ns-test",
+					Operation: test.operation,
+					Kind: metav1.GroupVersionKind{
+						Kind:    "ServiceInstance",
+						Version: "v1beta1",
+						Group:   "servicecatalog.k8s.io",
+					},
+					Object:    runtime.RawExtension{Raw: test.object},
+					OldObject: runtime.RawExtension{Raw: test.oldObject},
+				},
+			}
+
+			// when
+			response := handler.Handle(context.Background(), request)
+
+			// then
+			assert.Equal(t, test.responseAllowed, response.AdmissionResponse.Allowed)
+			if !test.responseAllowed {
+				assert.Contains(t, response.AdmissionResponse.Result.Reason, test.responseReason)
+			}
+		})
+	}
+}
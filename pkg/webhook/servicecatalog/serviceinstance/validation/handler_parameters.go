@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"net/http"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil"
+	admissionTypes "k8s.io/api/admission/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// LimitParameters rejects ServiceInstances whose parameters exceed the limits
+// configured by the operator. The parameters are only checked on create and
+// when an update changes them.
+type LimitParameters struct {
+	decoder *admission.Decoder
+
+	Limits scv.ParametersLimits
+}
+
+var _ Validator = &LimitParameters{}
+var _ admission.DecoderInjector = &LimitParameters{}
+
+// InjectDecoder injects the decoder
+func (v *LimitParameters) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// Validate checks the parameters of the ServiceInstance against the limits
+func (v *LimitParameters) Validate(ctx context.Context, req admission.Request, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	var originalObj *sc.ServiceInstance
+	if req.Operation == admissionTypes.Update {
+		originalObj = &sc.ServiceInstance{}
+		if err := v.decoder.DecodeRaw(req.OldObject, originalObj); err != nil {
+			return webhookutil.NewWebhookError(err.Error(), http.StatusBadRequest)
+		}
+	}
+	err := scv.ValidateServiceInstanceParameters(si, originalObj, v.Limits).ToAggregate()
+	if err != nil {
+		return webhookutil.NewWebhookError(err.Error(), http.StatusForbidden)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestAdmissionHandlerLimitParameters(t *testing.T) {
+	tester.DiscardLoggedMsg()
+
+	// given
+	instanceWithParameters := func(parameters string) []byte {
+		return []byte(`{
+			"metadata": {
+			  "name": "test-serviceinstance"
+			},
+			"spec": {
+			  "parameters": ` + parameters + `
+			}
+		}`)
+	}
+
+	err := sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+
+	decoder, err := admission.NewDecoder(sch)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		operation       admissionv1beta1.Operation
+		object          []byte
+		oldObject       []byte
+		responseAllowed bool
+	}{
+		"Create with a reserved key": {
+			operation:       admissionv1beta1.Create,
+			object:          instanceWithParameters(`{"platform":"kubernetes"}`),
+			responseAllowed: false,
+		},
+		"Create without a reserved key": {
+			operation:       admissionv1beta1.Create,
+			object:          instanceWithParameters(`{"name":"value"}`),
+			responseAllowed: true,
+		},
+		"Update adding a reserved key": {
+			operation:       admissionv1beta1.Update,
+			object:          instanceWithParameters(`{"platform":"kubernetes"}`),
+			oldObject:       instanceWithParameters(`{"name":"value"}`),
+			responseAllowed: false,
+		},
+		"Update keeping a reserved key": {
+			operation:       admissionv1beta1.Update,
+			object:          instanceWithParameters(`{"platform":"kubernetes"}`),
+			oldObject:       instanceWithParameters(`{"platform":"kubernetes"}`),
+			responseAllowed: true,
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			limitParameters := &validation.LimitParameters{Limits: scv.ParametersLimits{ReservedKeys: []string{"platform"}}}
+			handler := validation.AdmissionHandler{}
+			handler.CreateValidators = []validation.Validator{limitParameters}
+			handler.UpdateValidators = []validation.Validator{limitParameters}
+			err := handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+
+			request := admission.Request{
+				AdmissionRequest: admissionv1beta1.AdmissionRequest{
+					UID:       "uuid",
+					Name:      "test-serviceinstance",
+					Namespace: "ns-test",
+					Operation: test.operation,
+					Kind: metav1.GroupVersionKind{
+						Kind:    "ServiceInstance",
+						Version: "v1beta1",
+						Group:   "servicecatalog.k8s.io",
+					},
+					Object:    runtime.RawExtension{Raw: test.object},
+					OldObject: runtime.RawExtension{Raw: test.oldObject},
+				},
+			}
+
+			// when
+			response := handler.Handle(context.Background(), request)
+
+			// then
+			assert.Equal(t, test.responseAllowed, response.AdmissionResponse.Allowed)
+		})
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil"
+	admissionTypes "k8s.io/api/admission/v1beta1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// RequiredParametersMetadataKey is the key of the plan metadata listing the
+// names of the parameters an instance of the plan must set, e.g.
+//
+//	"metadata": {"requiredParameters": ["region", "size"]}
+const RequiredParametersMetadataKey = "requiredParameters"
+
+// RequiredParameters rejects ServiceInstances which do not set all the
+// parameters listed as required in the catalog metadata of their plan.
+//
+// This feature was copied from Service Catalog admission plugin https://github.com/kubernetes-incubator/service-catalog/blob/master/plugin/pkg/admission/serviceinstances/requiredparameters
+// If you want to track previous changes please check there.
+type RequiredParameters struct {
+	decoder *admission.Decoder
+	client  client.Client
+}
+
+var _ Validator = &RequiredParameters{}
+var _ admission.DecoderInjector = &RequiredParameters{}
+var _ inject.Client = &RequiredParameters{}
+
+// InjectDecoder injects the decoder
+func (v *RequiredParameters) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// InjectClient injects the client
+func (v *RequiredParameters) InjectClient(c client.Client) error {
+	v.client = c
+	return nil
+}
+
+// Validate checks if the ServiceInstance sets the parameters required by its plan
+func (v *RequiredParameters) Validate(ctx context.Context, req admission.Request, si *sc.ServiceInstance, traced *webhookutil.TracedLogger) *webhookutil.WebhookError {
+	// Updates which change neither the plan nor the parameters are let
+	// through, so that instances created before a plan started requiring
+	// parameters can still be updated otherwise.
+	if req.Operation == admissionTypes.Update {
+		originalObj := &sc.ServiceInstance{}
+		if err := v.decoder.DecodeRaw(req.OldObject, originalObj); err != nil {
+			traced.Errorf("Could not decode oldObject: %v", err)
+			return webhookutil.NewWebhookError(err.Error(), http.StatusBadRequest)
+		}
+		if !isPlanOrParametersChanged(originalObj, si) {
+			return nil
+		}
+	}
+
+	provided, known := getProvidedParameters(si)
+	if !known {
+		// Some parameters come from sources only read by the controller,
+		// so whether the required parameters are set cannot be told yet.
+		return nil
+	}
+
+	plans, err := v.getPlans(ctx, si)
+	if err != nil {
+		traced.Error(err)
+		return webhookutil.NewWebhookError(err.Error(), http.StatusForbidden)
+	}
+
+	for _, plan := range plans {
+		planProvided := provided
+		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServicePlanDefaults) {
+			planProvided = provided.Union(getParameterNames(plan.DefaultProvisionParameters))
+		}
+		missing := sets.NewString(getRequiredParameters(plan, traced)...).Difference(planProvided)
+		if missing.Len() > 0 {
+			msg := fmt.Sprintf("Parameters %v are required by plan %q", missing.List(), plan.ExternalName)
+			traced.Infof("ServiceInstance %s/%s: %s", si.Namespace, si.Name, msg)
+			return webhookutil.NewWebhookError(msg, http.StatusForbidden)
+		}
+	}
+
+	return nil
+}
+
+// getPlans returns the plans the instance could be resolved to. An instance
+// whose plan cannot be found yet is left to the controller, which reports it.
+func (v *RequiredParameters) getPlans(ctx context.Context, si *sc.ServiceInstance) ([]sc.CommonServicePlanSpec, error) {
+	var plans []sc.CommonServicePlanSpec
+
+	clusterPlans, err := getClusterServicePlans(ctx, v.client, si)
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range clusterPlans {
+		plans = append(plans, plan.Spec.CommonServicePlanSpec)
+	}
+
+	namespacedPlans, err := getServicePlans(ctx, v.client, si)
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range namespacedPlans {
+		plans = append(plans, plan.Spec.CommonServicePlanSpec)
+	}
+
+	return plans, nil
+}
+
+// isPlanOrParametersChanged returns whether an update of an instance changes
+// its plan or any of its parameters.
+func isPlanOrParametersChanged(old, new *sc.ServiceInstance) bool {
+	return !apiequality.Semantic.DeepEqual(old.Spec.PlanReference, new.Spec.PlanReference) ||
+		!apiequality.Semantic.DeepEqual(old.Spec.Parameters, new.Spec.Parameters) ||
+		!apiequality.Semantic.DeepEqual(old.Spec.ParametersFrom, new.Spec.ParametersFrom) ||
+		!apiequality.Semantic.DeepEqual(old.Spec.GeneratedParameters, new.Spec.GeneratedParameters)
+}
+
+// getProvidedParameters returns the names of the top-level parameters an
+// instance sets. It returns false if the instance sets parameters whose names
+// are not known at admission, from a parametersFrom source that is not
+// assigned to a named parameter.
+func getProvidedParameters(si *sc.ServiceInstance) (sets.String, bool) {
+	provided := getParameterNames(si.Spec.Parameters)
+	for _, source := range si.Spec.ParametersFrom {
+		if source.ParameterName == "" {
+			return nil, false
+		}
+		provided.Insert(source.ParameterName)
+	}
+	if si.Spec.GeneratedParameters != nil {
+		for _, parameter := range si.Spec.GeneratedParameters.Parameters {
+			provided.Insert(parameter.Name)
+		}
+	}
+	return provided, true
+}
+
+// getParameterNames returns the top-level keys of a JSON object of
+// parameters. Parameters which are not a JSON object have no names.
+func getParameterNames(params *runtime.RawExtension) sets.String {
+	names := sets.NewString()
+	if params == nil || len(params.Raw) == 0 {
+		return names
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(params.Raw, &m); err != nil {
+		return names
+	}
+	for name := range m {
+		names.Insert(name)
+	}
+	return names
+}
+
+// getRequiredParameters returns the names of the parameters listed under
+// RequiredParametersMetadataKey in the metadata of a plan. Metadata which is
+// not a JSON object or lists no required parameters requires none.
+func getRequiredParameters(plan sc.CommonServicePlanSpec, traced *webhookutil.TracedLogger) []string {
+	if plan.ExternalMetadata == nil || len(plan.ExternalMetadata.Raw) == 0 {
+		return nil
+	}
+	var metadata map[string]json.RawMessage
+	if err := json.Unmarshal(plan.ExternalMetadata.Raw, &metadata); err != nil {
+		return nil
+	}
+	raw, ok := metadata[RequiredParametersMetadataKey]
+	if !ok {
+		return nil
+	}
+	var required []string
+	if err := json.Unmarshal(raw, &required); err != nil {
+		traced.V(4).Infof("Ignoring %q of plan %q, which is not a list of parameter names: %v", RequiredParametersMetadataKey, plan.ExternalName, err)
+		return nil
+	}
+	return required
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhook/servicecatalog/serviceinstance/validation"
+	"github.com/kubernetes-incubator/service-catalog/pkg/webhookutil/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestAdmissionHandlerRequiredParameters(t *testing.T) {
+	tester.DiscardLoggedMsg()
+
+	// given
+	instance := func(plan, spec string) []byte {
+		return []byte(`{
+			"metadata": {
+			  "name": "test-serviceinstance",
+			  "namespace": "ns-test"
+			},
+			"spec": {
+			  "clusterServiceClassExternalName": "test-class",
+			  "clusterServicePlanExternalName": "` + plan + `"` + spec + `
+			}
+		}`)
+	}
+
+	err := sc.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
+	sch, err := sc.SchemeBuilderRuntime.Build()
+	require.NoError(t, err)
+
+	decoder, err := admission.NewDecoder(sch)
+	require.NoError(t, err)
+
+	plan := func(name, metadata string) runtime.Object {
+		return &sc.ClusterServicePlan{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name + "-id",
+				Labels: map[string]string{
+					sc.GroupName + "/" + sc.FilterSpecExternalName:               name,
+					sc.GroupName + "/" + sc.FilterSpecClusterServiceClassRefName: "test-class-id",
+				},
+			},
+			Spec: sc.ClusterServicePlanSpec{
+				CommonServicePlanSpec: sc.CommonServicePlanSpec{
+					ExternalName:     name,
+					ExternalMetadata: &runtime.RawExtension{Raw: []byte(metadata)},
+				},
+				ClusterServiceClassRef: sc.ClusterObjectReference{Name: "test-class-id"},
+			},
+		}
+	}
+	objects := []runtime.Object{
+		&sc.ClusterServiceClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-class-id",
+				Labels: map[string]string{
+					sc.GroupName + "/" + sc.FilterSpecExternalName: "test-class",
+				},
+			},
+			Spec: sc.ClusterServiceClassSpec{
+				CommonServiceClassSpec: sc.CommonServiceClassSpec{ExternalName: "test-class"},
+			},
+		},
+		plan("strict", `{"requiredParameters":["region","size"]}`),
+		plan("relaxed", `{"displayName":"Relaxed"}`),
+	}
+
+	tests := map[string]struct {
+		operation       admissionv1beta1.Operation
+		object          []byte
+		oldObject       []byte
+		responseAllowed bool
+		responseReason  string
+	}{
+		"Create setting all required parameters": {
+			operation:       admissionv1beta1.Create,
+			object:          instance("strict", `, "parameters": {"region":"eu","size":"xl"}`),
+			responseAllowed: true,
+		},
+		"Create setting a required parameter from a Secret": {
+			operation:       admissionv1beta1.Create,
+			object:          instance("strict", `, "parameters": {"region":"eu"}, "parametersFrom": [{"parameterName":"size","secretKeyRef":{"name":"s","key":"k"}}]`),
+			responseAllowed: true,
+		},
+		"Create missing a required parameter": {
+			operation:       admissionv1beta1.Create,
+			object:          instance("strict", `, "parameters": {"region":"eu"}`),
+			responseAllowed: false,
+			responseReason:  `Parameters [size] are required by plan "strict"`,
+		},
+		"Create with parameters whose names are not known": {
+			operation:       admissionv1beta1.Create,
+			object:          instance("strict", `, "parametersFrom": [{"secretKeyRef":{"name":"s","key":"k"}}]`),
+			responseAllowed: true,
+		},
+		"Create of a plan without required parameters": {
+			operation:       admissionv1beta1.Create,
+			object:          instance("relaxed", ``),
+			responseAllowed: true,
+		},
+		"Update changing neither the plan nor the parameters": {
+			operation:       admissionv1beta1.Update,
+			object:          instance("strict", ``),
+			oldObject:       instance("strict", ``),
+			responseAllowed: true,
+		},
+		"Update changing the plan": {
+			operation:       admissionv1beta1.Update,
+			object:          instance("strict", ``),
+			oldObject:       instance("relaxed", ``),
+			responseAllowed: false,
+			responseReason:  `Parameters [region size] are required by plan "strict"`,
+		},
+	}
+
+	for desc, test := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// given
+			requiredParameters := &validation.RequiredParameters{}
+			handler := validation.AdmissionHandler{}
+			handler.CreateValidators = []validation.Validator{requiredParameters}
+			handler.UpdateValidators = []validation.Validator{requiredParameters}
+			err := handler.InjectDecoder(decoder)
+			require.NoError(t, err)
+			err = handler.InjectClient(fake.NewFakeClientWithScheme(sch, objects...))
+			require.NoError(t, err)
+
+			request := admission.Request{
+				AdmissionRequest: admissionv1beta1.AdmissionRequest{
+					UID:       "uuid",
+					Name:      "test-serviceinstance",
+					Namespace: "ns-test",
+					Operation: test.operation,
+					Kind: metav1.GroupVersionKind{
+						Kind:    "ServiceInstance",
+						Version: "v1beta1",
+						Group:   "servicecatalog.k8s.io",
+					},
+					Object:    runtime.RawExtension{Raw: test.object},
+					OldObject: runtime.RawExtension{Raw: test.oldObject},
+				},
+			}
+
+			// when
+			response := handler.Handle(context.Background(), request)
+
+			// then
+			assert.Equal(t, test.responseAllowed, response.AdmissionResponse.Allowed)
+			if !test.responseAllowed {
+				assert.Contains(t, response.AdmissionResponse.Result.Reason, test.responseReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"net/http"
+
+	admissionTypes "k8s.io/api/admission/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	scv "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/validation"
+	webhookutil "github.com/kubernetes-incubator/service-catalog/pkg/webhookutil"
+)
+
+// StatusUpdateValidationHandler provides status resource validation
+type StatusUpdateValidationHandler struct {
+	decoder *admission.Decoder
+}
+
+// Handle handles admission requests.
+func (h *StatusUpdateValidationHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	traced := webhookutil.NewTracedLogger(req.UID)
+	traced.Infof("Start handling validation operation: %s for %s/%s: %q", req.Operation, req.Kind.Kind, req.SubResource, req.Name)
+
+	if req.Operation != admissionTypes.Update {
+		traced.Infof("Operation %s is not validated", req.Operation)
+		return admission.Allowed("status operation allowed")
+	}
+
+	newSi := &sc.ServiceInstance{}
+	if err := h.decoder.Decode(req, newSi); err != nil {
+		traced.Errorf("Could not decode request object: %v", err)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	oldSi := &sc.ServiceInstance{}
+	if err := h.decoder.DecodeRaw(req.OldObject, oldSi); err != nil {
+		traced.Errorf("Could not decode request object: %v", err)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	eList := scv.ValidateServiceInstanceStatusUpdate(newSi, oldSi)
+
+	if err := eList.ToAggregate(); err != nil {
+		traced.Infof("%s/%s update not allowed: %s", req.Kind.Kind, req.SubResource, err.Error())
+		return admission.Denied(err.Error())
+	}
+
+	traced.Infof("Completed successfully validation operation: %s for %s/%s: %q", req.Operation, req.Kind.Kind, req.SubResource, req.Name)
+	return admission.Allowed("status update allowed")
+}
+
+// InjectDecoder injects the decoder into the handlers
+func (h *StatusUpdateValidationHandler) InjectDecoder(d *admission.Decoder) error {
+	h.decoder = d
+	return nil
+}
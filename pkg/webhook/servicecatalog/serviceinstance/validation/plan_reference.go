@@ -0,0 +1,185 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// This feature was copied from Service Catalog admission plugin https://github.com/kubernetes-incubator/service-catalog/blob/master/pkg/apiserver/admission/instance.go
+// If you want to track previous changes please check there.
+
+// getClusterServiceClasses returns the ClusterServiceClasses the
+// ServiceInstance could be resolved to. A class referred to by name which
+// does not exist yet is left out.
+func getClusterServiceClasses(ctx context.Context, c client.Client, si *sc.ServiceInstance) ([]sc.ClusterServiceClass, error) {
+	ref := si.Spec.PlanReference
+
+	if si.Spec.ClusterServiceClassRef != nil || ref.ClusterServiceClassName != "" {
+		name := ref.ClusterServiceClassName
+		if si.Spec.ClusterServiceClassRef != nil {
+			name = si.Spec.ClusterServiceClassRef.Name
+		}
+		class := sc.ClusterServiceClass{}
+		if err := c.Get(ctx, client.ObjectKey{Name: name}, &class); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []sc.ClusterServiceClass{class}, nil
+	}
+	if !ref.ClusterServiceClassSpecified() {
+		return nil, nil
+	}
+
+	filter := map[string]string{
+		ref.GetClusterServiceClassFilterLabelName(): ref.GetSpecifiedClusterServiceClass(),
+	}
+	if si.Spec.ClusterServiceBrokerName != "" {
+		filter[sc.GroupName+"/"+sc.FilterSpecClusterServiceBrokerName] = si.Spec.ClusterServiceBrokerName
+	}
+	classes := &sc.ClusterServiceClassList{}
+	if err := c.List(ctx, classes, client.MatchingLabels(filter)); err != nil {
+		return nil, err
+	}
+	return classes.Items, nil
+}
+
+// getServiceClasses returns the ServiceClasses the ServiceInstance could be
+// resolved to. A class referred to by name which does not exist yet is left
+// out.
+func getServiceClasses(ctx context.Context, c client.Client, si *sc.ServiceInstance) ([]sc.ServiceClass, error) {
+	ref := si.Spec.PlanReference
+
+	if si.Spec.ServiceClassRef != nil || ref.ServiceClassName != "" {
+		name := ref.ServiceClassName
+		if si.Spec.ServiceClassRef != nil {
+			name = si.Spec.ServiceClassRef.Name
+		}
+		class := sc.ServiceClass{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: si.Namespace, Name: name}, &class); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []sc.ServiceClass{class}, nil
+	}
+	if !ref.ServiceClassSpecified() {
+		return nil, nil
+	}
+
+	classes := &sc.ServiceClassList{}
+	err := c.List(ctx, classes, client.InNamespace(si.Namespace), client.MatchingLabels(map[string]string{
+		ref.GetServiceClassFilterLabelName(): ref.GetSpecifiedServiceClass(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return classes.Items, nil
+}
+
+// getClusterServicePlans returns the ClusterServicePlans the ServiceInstance
+// could be resolved to. A plan referred to by name which does not exist yet
+// is left out.
+func getClusterServicePlans(ctx context.Context, c client.Client, si *sc.ServiceInstance) ([]sc.ClusterServicePlan, error) {
+	ref := si.Spec.PlanReference
+
+	if si.Spec.ClusterServicePlanRef != nil || ref.ClusterServicePlanName != "" {
+		name := ref.ClusterServicePlanName
+		if si.Spec.ClusterServicePlanRef != nil {
+			name = si.Spec.ClusterServicePlanRef.Name
+		}
+		plan := sc.ClusterServicePlan{}
+		if err := c.Get(ctx, client.ObjectKey{Name: name}, &plan); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []sc.ClusterServicePlan{plan}, nil
+	}
+	if !ref.ClusterServicePlanSpecified() {
+		return nil, nil
+	}
+
+	classes, err := getClusterServiceClasses(ctx, c, si)
+	if err != nil {
+		return nil, err
+	}
+	var matching []sc.ClusterServicePlan
+	for _, class := range classes {
+		plans := &sc.ClusterServicePlanList{}
+		err := c.List(ctx, plans, client.MatchingLabels(map[string]string{
+			ref.GetClusterServicePlanFilterLabelName():                   ref.GetSpecifiedClusterServicePlan(),
+			sc.GroupName + "/" + sc.FilterSpecClusterServiceClassRefName: class.Name,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		matching = append(matching, plans.Items...)
+	}
+	return matching, nil
+}
+
+// getServicePlans returns the ServicePlans the ServiceInstance could be
+// resolved to. A plan referred to by name which does not exist yet is left
+// out.
+func getServicePlans(ctx context.Context, c client.Client, si *sc.ServiceInstance) ([]sc.ServicePlan, error) {
+	ref := si.Spec.PlanReference
+
+	if si.Spec.ServicePlanRef != nil || ref.ServicePlanName != "" {
+		name := ref.ServicePlanName
+		if si.Spec.ServicePlanRef != nil {
+			name = si.Spec.ServicePlanRef.Name
+		}
+		plan := sc.ServicePlan{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: si.Namespace, Name: name}, &plan); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []sc.ServicePlan{plan}, nil
+	}
+	if !ref.ServicePlanSpecified() {
+		return nil, nil
+	}
+
+	classes, err := getServiceClasses(ctx, c, si)
+	if err != nil {
+		return nil, err
+	}
+	var matching []sc.ServicePlan
+	for _, class := range classes {
+		plans := &sc.ServicePlanList{}
+		err := c.List(ctx, plans, client.InNamespace(si.Namespace), client.MatchingLabels(map[string]string{
+			ref.GetServicePlanFilterLabelName():                   ref.GetSpecifiedServicePlan(),
+			sc.GroupName + "/" + sc.FilterSpecServiceClassRefName: class.Name,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		matching = append(matching, plans.Items...)
+	}
+	return matching, nil
+}
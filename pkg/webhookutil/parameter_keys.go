@@ -0,0 +1,88 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhookutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	sc "github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ParameterKeys holds the parameters of a ServiceInstance or ServiceBinding
+// whose keys are checked by FindInvalidParameterKeys.
+type ParameterKeys struct {
+	Parameters     *runtime.RawExtension
+	ParametersFrom []sc.ParametersFromSource
+}
+
+// FindInvalidParameterKeys returns the sorted paths of the keys of the
+// parameters, including the keys of nested objects, and the names assigned
+// to parametersFrom sources which do not match pattern. On update, old holds
+// the previous parameters, and the ones which did not change are left alone
+// so that tightening the pattern does not block updates of existing objects.
+//
+// This feature was copied from Service Catalog admission plugin https://github.com/kubernetes-incubator/service-catalog/blob/master/plugin/pkg/admission/parameterkeys
+// If you want to track previous changes please check there.
+func FindInvalidParameterKeys(pattern *regexp.Regexp, new ParameterKeys, old *ParameterKeys) []string {
+	var invalid []string
+	if new.Parameters != nil && len(new.Parameters.Raw) > 0 &&
+		(old == nil || old.Parameters == nil || !bytes.Equal(new.Parameters.Raw, old.Parameters.Raw)) {
+		var values map[string]interface{}
+		// Malformed parameters are rejected by validation
+		if err := json.Unmarshal(new.Parameters.Raw, &values); err == nil {
+			invalid = findInvalidKeys(pattern, "", values, invalid)
+		}
+	}
+	if old == nil || !apiequality.Semantic.DeepEqual(new.ParametersFrom, old.ParametersFrom) {
+		for _, source := range new.ParametersFrom {
+			if source.ParameterName != "" && !pattern.MatchString(source.ParameterName) {
+				invalid = append(invalid, source.ParameterName)
+			}
+		}
+	}
+	sort.Strings(invalid)
+	return invalid
+}
+
+// findInvalidKeys appends to invalid the paths of the keys of value, and of
+// the objects nested in it, which do not match the pattern.
+func findInvalidKeys(pattern *regexp.Regexp, path string, value interface{}, invalid []string) []string {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range value {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			if !pattern.MatchString(key) {
+				invalid = append(invalid, keyPath)
+			}
+			invalid = findInvalidKeys(pattern, keyPath, nested, invalid)
+		}
+	case []interface{}:
+		for i, nested := range value {
+			invalid = findInvalidKeys(pattern, fmt.Sprintf("%s[%d]", path, i), nested, invalid)
+		}
+	}
+	return invalid
+}
@@ -78,6 +78,8 @@ func (s *sarcheck) Admit(a admission.Attributes) error {
 
 	var namespace string
 	var secretName string
+	var serviceAccountName string
+	var fallbackSecretRef *servicecatalog.ObjectReference
 	// only care about brokers and namespace brokers
 	if a.GetResource().GroupResource() == servicecatalog.Resource("clusterservicebrokers") {
 		clusterServiceBroker, ok := a.GetObject().(*servicecatalog.ClusterServiceBroker)
@@ -90,19 +92,39 @@ func (s *sarcheck) Admit(a admission.Attributes) error {
 			return nil
 		}
 
-		var secretRef *servicecatalog.ObjectReference
-		if clusterServiceBroker.Spec.AuthInfo.Basic != nil {
-			secretRef = clusterServiceBroker.Spec.AuthInfo.Basic.SecretRef
-		} else if clusterServiceBroker.Spec.AuthInfo.Bearer != nil {
-			secretRef = clusterServiceBroker.Spec.AuthInfo.Bearer.SecretRef
+		if saAuth := clusterServiceBroker.Spec.AuthInfo.BearerTokenFromServiceAccount; saAuth != nil && saAuth.ServiceAccountRef != nil {
+			klog.V(5).Infof("ClusterServiceBroker %+v: evaluating auth service account ref %q", clusterServiceBroker, saAuth.ServiceAccountRef.Name)
+			namespace = saAuth.ServiceAccountRef.Namespace
+			serviceAccountName = saAuth.ServiceAccountRef.Name
+		} else {
+			var secretRef *servicecatalog.ObjectReference
+			if clusterServiceBroker.Spec.AuthInfo.Basic != nil {
+				secretRef = clusterServiceBroker.Spec.AuthInfo.Basic.SecretRef
+			} else if clusterServiceBroker.Spec.AuthInfo.Bearer != nil {
+				secretRef = clusterServiceBroker.Spec.AuthInfo.Bearer.SecretRef
+			}
+
+			if secretRef == nil {
+				return nil
+			}
+			klog.V(5).Infof("ClusterServiceBroker %+v: evaluating auth secret ref, with authInfo %q", clusterServiceBroker, secretRef)
+			namespace = secretRef.Namespace
+			secretName = secretRef.Name
 		}
 
-		if secretRef == nil {
-			return nil
+		if fallbackAuth := clusterServiceBroker.Spec.FallbackAuthInfo; fallbackAuth != nil {
+			var secretRef *servicecatalog.ObjectReference
+			if fallbackAuth.Basic != nil {
+				secretRef = fallbackAuth.Basic.SecretRef
+			} else if fallbackAuth.Bearer != nil {
+				secretRef = fallbackAuth.Bearer.SecretRef
+			}
+
+			if secretRef != nil {
+				klog.V(5).Infof("ClusterServiceBroker %+v: evaluating fallback auth secret ref %q", clusterServiceBroker, secretRef)
+				fallbackSecretRef = secretRef
+			}
 		}
-		klog.V(5).Infof("ClusterServiceBroker %+v: evaluating auth secret ref, with authInfo %q", clusterServiceBroker, secretRef)
-		namespace = secretRef.Namespace
-		secretName = secretRef.Name
 	} else if a.GetResource().GroupResource() == servicecatalog.Resource("servicebrokers") {
 		serviceBroker, ok := a.GetObject().(*servicecatalog.ServiceBroker)
 		if !ok {
@@ -114,40 +136,88 @@ func (s *sarcheck) Admit(a admission.Attributes) error {
 			return nil
 		}
 
-		var secretRef *servicecatalog.LocalObjectReference
-		if serviceBroker.Spec.AuthInfo.Basic != nil {
-			secretRef = serviceBroker.Spec.AuthInfo.Basic.SecretRef
-		} else if serviceBroker.Spec.AuthInfo.Bearer != nil {
-			secretRef = serviceBroker.Spec.AuthInfo.Bearer.SecretRef
+		if saAuth := serviceBroker.Spec.AuthInfo.BearerTokenFromServiceAccount; saAuth != nil && saAuth.ServiceAccountRef != nil {
+			klog.V(5).Infof("ServiceBroker %+v: evaluating auth service account ref %q", serviceBroker, saAuth.ServiceAccountRef.Name)
+			namespace = serviceBroker.Namespace
+			serviceAccountName = saAuth.ServiceAccountRef.Name
+		} else {
+			var secretRef *servicecatalog.LocalObjectReference
+			if serviceBroker.Spec.AuthInfo.Basic != nil {
+				secretRef = serviceBroker.Spec.AuthInfo.Basic.SecretRef
+			} else if serviceBroker.Spec.AuthInfo.Bearer != nil {
+				secretRef = serviceBroker.Spec.AuthInfo.Bearer.SecretRef
+			}
+
+			if secretRef == nil {
+				return nil
+			}
+			klog.V(5).Infof("ServiceBroker %+v: evaluating auth secret ref, with authInfo %q", serviceBroker, secretRef)
+			namespace = serviceBroker.Namespace
+			secretName = secretRef.Name
 		}
-
-		if secretRef == nil {
-			return nil
-		}
-		klog.V(5).Infof("ServiceBroker %+v: evaluating auth secret ref, with authInfo %q", serviceBroker, secretRef)
-		namespace = serviceBroker.Namespace
-		secretName = secretRef.Name
 	}
 	// if we didn't get a namespace and name, it wasn't a clusterservicebroker or broker
-	if namespace == "" || secretName == "" {
+	if namespace == "" || (secretName == "" && serviceAccountName == "") {
 		return nil
 	}
-	userInfo := a.GetUserInfo()
 
+	resourceAttributes := &authorizationapi.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "get",
+		Group:     corev1.SchemeGroupVersion.Group,
+		Version:   corev1.SchemeGroupVersion.Version,
+		Resource:  corev1.ResourceSecrets.String(),
+		Name:      secretName,
+	}
+	forbiddenMsg := fmt.Sprintf("broker forbidden access to auth secret (%s)", secretName)
+	if serviceAccountName != "" {
+		// the controller requests tokens of the service account on behalf of
+		// the broker, so the user must be allowed to request them as well
+		resourceAttributes = &authorizationapi.ResourceAttributes{
+			Namespace:   namespace,
+			Verb:        "create",
+			Group:       corev1.SchemeGroupVersion.Group,
+			Version:     corev1.SchemeGroupVersion.Version,
+			Resource:    "serviceaccounts",
+			Subresource: "token",
+			Name:        serviceAccountName,
+		}
+		forbiddenMsg = fmt.Sprintf("broker forbidden access to service account token (%s)", serviceAccountName)
+	}
+
+	if err := s.checkAccess(a, resourceAttributes, forbiddenMsg); err != nil {
+		return err
+	}
+
+	if fallbackSecretRef != nil {
+		// the fallback credentials are read by the controller as well
+		resourceAttributes = &authorizationapi.ResourceAttributes{
+			Namespace: fallbackSecretRef.Namespace,
+			Verb:      "get",
+			Group:     corev1.SchemeGroupVersion.Group,
+			Version:   corev1.SchemeGroupVersion.Version,
+			Resource:  corev1.ResourceSecrets.String(),
+			Name:      fallbackSecretRef.Name,
+		}
+		forbiddenMsg = fmt.Sprintf("broker forbidden access to fallback auth secret (%s)", fallbackSecretRef.Name)
+		if err := s.checkAccess(a, resourceAttributes, forbiddenMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkAccess returns a forbidden error if the user making the request is not
+// allowed to access the given resource.
+func (s *sarcheck) checkAccess(a admission.Attributes, resourceAttributes *authorizationapi.ResourceAttributes, forbiddenMsg string) error {
+	userInfo := a.GetUserInfo()
 	sar := &authorizationapi.SubjectAccessReview{
 		Spec: authorizationapi.SubjectAccessReviewSpec{
-			ResourceAttributes: &authorizationapi.ResourceAttributes{
-				Namespace: namespace,
-				Verb:      "get",
-				Group:     corev1.SchemeGroupVersion.Group,
-				Version:   corev1.SchemeGroupVersion.Version,
-				Resource:  corev1.ResourceSecrets.String(),
-				Name:      secretName,
-			},
-			User:   userInfo.GetName(),
-			Groups: userInfo.GetGroups(),
-			Extra:  convertToSARExtra(userInfo.GetExtra()),
-			UID:    userInfo.GetUID(),
+			ResourceAttributes: resourceAttributes,
+			User:               userInfo.GetName(),
+			Groups:             userInfo.GetGroups(),
+			Extra:              convertToSARExtra(userInfo.GetExtra()),
+			UID:                userInfo.GetUID(),
 		},
 	}
 	sar, err := s.client.AuthorizationV1().SubjectAccessReviews().Create(sar)
@@ -156,7 +226,7 @@ func (s *sarcheck) Admit(a admission.Attributes) error {
 	}
 
 	if !sar.Status.Allowed {
-		return admission.NewForbidden(a, fmt.Errorf("broker forbidden access to auth secret (%s): Reason: %s, EvaluationError: %s", secretName, sar.Status.Reason, sar.Status.EvaluationError))
+		return admission.NewForbidden(a, fmt.Errorf("%s: Reason: %s, EvaluationError: %s", forbiddenMsg, sar.Status.Reason, sar.Status.EvaluationError))
 	}
 	return nil
 }
@@ -50,14 +50,14 @@ func newHandlerForTest(kubeClient kubeclientset.Interface) (admission.Interface,
 }
 
 // newMockKubeClientForTest creates a mock kubernetes client that is configured
-// to allow any SAR creations.
+// to allow any SAR creations, except those of the forbidden user and those
+// for the forbidden secret.
 func newMockKubeClientForTest(userInfo *user.DefaultInfo) *kubefake.Clientset {
 	mockClient := &kubefake.Clientset{}
-	allowed := true
-	if userInfo.GetName() == "system:serviceaccount:test-ns:forbidden" {
-		allowed = false
-	}
 	mockClient.AddReactor("create", "subjectaccessreviews", func(action core.Action) (bool, runtime.Object, error) {
+		sar := action.(core.CreateAction).GetObject().(*authorizationapi.SubjectAccessReview)
+		allowed := userInfo.GetName() != "system:serviceaccount:test-ns:forbidden" &&
+			sar.Spec.ResourceAttributes.Name != "forbidden-secret"
 		mysar := &authorizationapi.SubjectAccessReview{
 			Status: authorizationapi.SubjectAccessReviewStatus{
 				Allowed: allowed,
@@ -183,6 +183,76 @@ func TestAdmissionBroker(t *testing.T) {
 			},
 			allowed: false,
 		},
+		{
+			name: "broker with basic auth and fallback auth, user authenticated",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-broker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ClusterServiceBrokerAuthInfo{
+						Basic: &servicecatalog.ClusterBasicAuthConfig{
+							SecretRef: &servicecatalog.ObjectReference{
+								Namespace: "test-ns",
+								Name:      "test-secret",
+							},
+						},
+					},
+					FallbackAuthInfo: &servicecatalog.ClusterServiceBrokerAuthInfo{
+						Basic: &servicecatalog.ClusterBasicAuthConfig{
+							SecretRef: &servicecatalog.ObjectReference{
+								Namespace: "test-ns",
+								Name:      "test-old-secret",
+							},
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: "Manual",
+					},
+				},
+			},
+			userInfo: &user.DefaultInfo{
+				Name:   "system:serviceaccount:test-ns:catalog",
+				Groups: []string{"system:serviceaccount", "system:serviceaccounts:test-ns"},
+			},
+			allowed: true,
+		},
+		{
+			name: "broker with basic auth and fallback auth, user forbidden access to fallback secret",
+			broker: &servicecatalog.ClusterServiceBroker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-broker",
+				},
+				Spec: servicecatalog.ClusterServiceBrokerSpec{
+					AuthInfo: &servicecatalog.ClusterServiceBrokerAuthInfo{
+						Basic: &servicecatalog.ClusterBasicAuthConfig{
+							SecretRef: &servicecatalog.ObjectReference{
+								Namespace: "test-ns",
+								Name:      "test-secret",
+							},
+						},
+					},
+					FallbackAuthInfo: &servicecatalog.ClusterServiceBrokerAuthInfo{
+						Basic: &servicecatalog.ClusterBasicAuthConfig{
+							SecretRef: &servicecatalog.ObjectReference{
+								Namespace: "test-ns",
+								Name:      "forbidden-secret",
+							},
+						},
+					},
+					CommonServiceBrokerSpec: servicecatalog.CommonServiceBrokerSpec{
+						URL:            "http://example.com",
+						RelistBehavior: "Manual",
+					},
+				},
+			},
+			userInfo: &user.DefaultInfo{
+				Name:   "system:serviceaccount:test-ns:catalog",
+				Groups: []string{"system:serviceaccount", "system:serviceaccounts:test-ns"},
+			},
+			allowed: false,
+		},
 		{
 			name: "broker with empty authInfo",
 			broker: &servicecatalog.ClusterServiceBroker{
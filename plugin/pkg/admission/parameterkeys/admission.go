@@ -0,0 +1,179 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parameterkeys
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/klog"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ParameterKeyFormat"
+)
+
+// Policy is the configuration of the plugin. Pattern is the regular
+// expression every key of the parameters of instances and bindings must
+// match, including the keys of nested objects. An empty pattern admits every
+// key.
+//
+// For example, to enforce lowerCamelCase keys:
+//
+//	pattern: "^[a-z][a-zA-Z0-9]*$"
+type Policy struct {
+	Pattern string `json:"pattern"`
+}
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		policy := &Policy{}
+		if err := scadmission.ReadPolicy(PluginName, config, policy); err != nil {
+			return nil, err
+		}
+		return NewParameterKeyFormat(policy)
+	})
+}
+
+// parameterKeyFormat is an implementation of admission.Interface.
+// It rejects Service Instances and Service Bindings whose parameters have
+// keys not matching the configured pattern, as well as those assigning a
+// spec.parametersFrom source to a parameter whose name does not match it.
+// The keys of the parameters taken from spec.parametersFrom sources without
+// a parameter name are not readable at admission time.
+type parameterKeyFormat struct {
+	*admission.Handler
+	pattern *regexp.Regexp
+}
+
+func (p *parameterKeyFormat) Admit(a admission.Attributes) error {
+	if p.pattern == nil || a.GetResource().Group != servicecatalog.GroupName || a.GetSubresource() != "" {
+		return nil
+	}
+
+	var (
+		parameters, oldParameters         *runtime.RawExtension
+		parametersFrom, oldParametersFrom []servicecatalog.ParametersFromSource
+		isUpdate                          bool
+	)
+	switch a.GetResource().GroupResource() {
+	case servicecatalog.Resource("serviceinstances"):
+		instance, ok := a.GetObject().(*servicecatalog.ServiceInstance)
+		if !ok {
+			return apierrors.NewBadRequest("Resource was marked with kind ServiceInstance but was unable to be converted")
+		}
+		parameters, parametersFrom = instance.Spec.Parameters, instance.Spec.ParametersFrom
+		if oldInstance, ok := a.GetOldObject().(*servicecatalog.ServiceInstance); ok {
+			oldParameters, oldParametersFrom, isUpdate = oldInstance.Spec.Parameters, oldInstance.Spec.ParametersFrom, true
+		}
+	case servicecatalog.Resource("servicebindings"):
+		binding, ok := a.GetObject().(*servicecatalog.ServiceBinding)
+		if !ok {
+			return apierrors.NewBadRequest("Resource was marked with kind ServiceBinding but was unable to be converted")
+		}
+		parameters, parametersFrom = binding.Spec.Parameters, binding.Spec.ParametersFrom
+		if oldBinding, ok := a.GetOldObject().(*servicecatalog.ServiceBinding); ok {
+			oldParameters, oldParametersFrom, isUpdate = oldBinding.Spec.Parameters, oldBinding.Spec.ParametersFrom, true
+		}
+	default:
+		return nil
+	}
+
+	// Leave the parameters which did not change alone, so that tightening
+	// the pattern does not block updates of existing objects.
+	var invalid []string
+	if parameters != nil && len(parameters.Raw) > 0 &&
+		(oldParameters == nil || !bytes.Equal(parameters.Raw, oldParameters.Raw)) {
+		var values map[string]interface{}
+		// Malformed parameters are rejected by validation
+		if err := json.Unmarshal(parameters.Raw, &values); err == nil {
+			invalid = p.findInvalidKeys("", values, invalid)
+		}
+	}
+	if !isUpdate || !apiequality.Semantic.DeepEqual(parametersFrom, oldParametersFrom) {
+		for _, source := range parametersFrom {
+			if source.ParameterName != "" && !p.pattern.MatchString(source.ParameterName) {
+				invalid = append(invalid, source.ParameterName)
+			}
+		}
+	}
+
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		msg := fmt.Sprintf("parameter keys must match %q; invalid keys: %s", p.pattern, strings.Join(invalid, ", "))
+		klog.V(4).Infof(`%s "%s/%s": %s`, a.GetKind().Kind, a.GetNamespace(), a.GetName(), msg)
+		return admission.NewForbidden(a, errors.New(msg))
+	}
+
+	return nil
+}
+
+// findInvalidKeys appends to invalid the paths of the keys of value, and of
+// the objects nested in it, which do not match the pattern.
+func (p *parameterKeyFormat) findInvalidKeys(path string, value interface{}, invalid []string) []string {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range value {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			if !p.pattern.MatchString(key) {
+				invalid = append(invalid, keyPath)
+			}
+			invalid = p.findInvalidKeys(keyPath, nested, invalid)
+		}
+	case []interface{}:
+		for i, nested := range value {
+			invalid = p.findInvalidKeys(fmt.Sprintf("%s[%d]", path, i), nested, invalid)
+		}
+	}
+	return invalid
+}
+
+// NewParameterKeyFormat creates a new admission control handler that rejects
+// Service Instances and Service Bindings whose parameter keys do not match
+// the pattern of the given policy
+func NewParameterKeyFormat(policy *Policy) (admission.Interface, error) {
+	var pattern *regexp.Regexp
+	if policy.Pattern != "" {
+		var err error
+		if pattern, err = regexp.Compile(policy.Pattern); err != nil {
+			return nil, fmt.Errorf("invalid %s pattern %q: %v", PluginName, policy.Pattern, err)
+		}
+	}
+	return &parameterKeyFormat{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+		pattern: pattern,
+	}, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parameterkeys
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+)
+
+const testPolicy = `
+pattern: "^[a-z][a-zA-Z0-9]*$"
+`
+
+// newHandlerForTest returns a handler configured with the given policy.
+func newHandlerForTest(config string) (admission.Interface, error) {
+	policy := &Policy{}
+	if err := scadmission.ReadPolicy(PluginName, strings.NewReader(config), policy); err != nil {
+		return nil, err
+	}
+	return NewParameterKeyFormat(policy)
+}
+
+func newInstance(parameters string) *servicecatalog.ServiceInstance {
+	instance := &servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "test-ns"},
+	}
+	if parameters != "" {
+		instance.Spec.Parameters = &runtime.RawExtension{Raw: []byte(parameters)}
+	}
+	return instance
+}
+
+func newBinding(parameters string) *servicecatalog.ServiceBinding {
+	binding := &servicecatalog.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "test-ns"},
+	}
+	if parameters != "" {
+		binding.Spec.Parameters = &runtime.RawExtension{Raw: []byte(parameters)}
+	}
+	return binding
+}
+
+// newParametersFrom returns a secret source assigned to each of the given
+// parameter names.
+func newParametersFrom(parameterNames []string) []servicecatalog.ParametersFromSource {
+	var parametersFrom []servicecatalog.ParametersFromSource
+	for _, name := range parameterNames {
+		parametersFrom = append(parametersFrom, servicecatalog.ParametersFromSource{
+			SecretKeyRef:  &servicecatalog.SecretKeyReference{Name: "secret", Key: name},
+			ParameterName: name,
+		})
+	}
+	return parametersFrom
+}
+
+func TestParameterKeyFormat(t *testing.T) {
+	cases := []struct {
+		name              string
+		policy            string
+		parameters        string
+		oldParameters     string
+		parameterNames    []string
+		oldParameterNames []string
+		binding           bool
+		operation         admission.Operation
+		errMsg            string
+	}{
+		{
+			name:       "conforming keys",
+			policy:     testPolicy,
+			parameters: `{"maxConnections": 10, "tls": {"minVersion": "1.2"}}`,
+			operation:  admission.Create,
+		},
+		{
+			name:       "non-conforming keys",
+			policy:     testPolicy,
+			parameters: `{"max_connections": 10, "Region": "eu"}`,
+			operation:  admission.Create,
+			errMsg:     `parameter keys must match "^[a-z][a-zA-Z0-9]*$"; invalid keys: Region, max_connections`,
+		},
+		{
+			name:       "non-conforming nested keys",
+			policy:     testPolicy,
+			parameters: `{"tls": {"min-version": "1.2"}, "users": [{"name": "a"}, {"User_Name": "b"}]}`,
+			operation:  admission.Create,
+			errMsg:     "invalid keys: tls.min-version, users[1].User_Name",
+		},
+		{
+			name:       "non-conforming binding keys",
+			policy:     testPolicy,
+			parameters: `{"read_only": true}`,
+			binding:    true,
+			operation:  admission.Create,
+			errMsg:     "invalid keys: read_only",
+		},
+		{
+			name:      "no parameters",
+			policy:    testPolicy,
+			operation: admission.Create,
+		},
+		{
+			name:       "no pattern",
+			policy:     "",
+			parameters: `{"max_connections": 10}`,
+			operation:  admission.Create,
+		},
+		{
+			name:          "update with unchanged parameters",
+			policy:        testPolicy,
+			parameters:    `{"max_connections": 10}`,
+			oldParameters: `{"max_connections": 10}`,
+			operation:     admission.Update,
+		},
+		{
+			name:          "update with changed parameters",
+			policy:        testPolicy,
+			parameters:    `{"max_connections": 20}`,
+			oldParameters: `{"max_connections": 10}`,
+			operation:     admission.Update,
+			errMsg:        "invalid keys: max_connections",
+		},
+		{
+			name:           "conforming parametersFrom parameter names",
+			policy:         testPolicy,
+			parameterNames: []string{"password"},
+			operation:      admission.Create,
+		},
+		{
+			name:           "non-conforming parametersFrom parameter names",
+			policy:         testPolicy,
+			parameters:     `{"region": "eu"}`,
+			parameterNames: []string{"db_password", "password"},
+			operation:      admission.Create,
+			errMsg:         "invalid keys: db_password",
+		},
+		{
+			name:           "non-conforming binding parametersFrom parameter names",
+			policy:         testPolicy,
+			parameterNames: []string{"api-key"},
+			binding:        true,
+			operation:      admission.Create,
+			errMsg:         "invalid keys: api-key",
+		},
+		{
+			name:              "update with unchanged parametersFrom",
+			policy:            testPolicy,
+			parameters:        `{"region": "us"}`,
+			oldParameters:     `{"region": "eu"}`,
+			parameterNames:    []string{"db_password"},
+			oldParameterNames: []string{"db_password"},
+			operation:         admission.Update,
+		},
+		{
+			name:              "update with changed parametersFrom",
+			policy:            testPolicy,
+			parameterNames:    []string{"db_password", "db_user"},
+			oldParameterNames: []string{"db_password"},
+			operation:         admission.Update,
+			errMsg:            "invalid keys: db_password, db_user",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, err := newHandlerForTest(tc.policy)
+			if err != nil {
+				t.Fatalf("unexpected error initializing handler: %v", err)
+			}
+
+			var attributes admission.Attributes
+			if tc.binding {
+				binding := newBinding(tc.parameters)
+				binding.Spec.ParametersFrom = newParametersFrom(tc.parameterNames)
+				attributes = admission.NewAttributesRecord(binding, nil, servicecatalog.Kind("ServiceBinding").WithVersion("version"), binding.Namespace, binding.Name, servicecatalog.Resource("servicebindings").WithVersion("version"), "", tc.operation, false, nil)
+			} else {
+				instance := newInstance(tc.parameters)
+				instance.Spec.ParametersFrom = newParametersFrom(tc.parameterNames)
+				var oldInstance runtime.Object
+				if tc.operation == admission.Update {
+					old := newInstance(tc.oldParameters)
+					old.Spec.ParametersFrom = newParametersFrom(tc.oldParameterNames)
+					oldInstance = old
+				}
+				attributes = admission.NewAttributesRecord(instance, oldInstance, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", tc.operation, false, nil)
+			}
+
+			err = handler.(admission.MutationInterface).Admit(attributes)
+			if tc.errMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", tc.errMsg)
+			}
+			if !strings.Contains(err.Error(), tc.errMsg) {
+				t.Fatalf("expected error containing %q, got %q", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestParameterKeyFormatInvalidPattern(t *testing.T) {
+	if _, err := newHandlerForTest(`pattern: "^[a-z"`); err == nil {
+		t.Fatal("expected an error for an invalid pattern, got none")
+	}
+}
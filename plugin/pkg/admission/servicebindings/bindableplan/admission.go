@@ -0,0 +1,198 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindableplan
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"k8s.io/klog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ServiceBindingsBindablePlan"
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewBindablePlan()
+	})
+}
+
+// bindablePlan is an implementation of admission.Interface.
+// It rejects the creation of Service Bindings to Service Instances whose plan
+// is not bindable, which the broker would refuse. A plan is bindable if its
+// own bindable flag says so or, when the plan does not set it, if the flag of
+// its class does.
+type bindablePlan struct {
+	*admission.Handler
+	instanceLister internalversion.ServiceInstanceLister
+	cscLister      internalversion.ClusterServiceClassLister
+	cspLister      internalversion.ClusterServicePlanLister
+	scLister       internalversion.ServiceClassLister
+	spLister       internalversion.ServicePlanLister
+}
+
+var _ = scadmission.WantsInternalServiceCatalogInformerFactory(&bindablePlan{})
+
+func (b *bindablePlan) Admit(a admission.Attributes) error {
+	// We only care about service Bindings
+	if a.GetResource().Group != servicecatalog.GroupName || a.GetResource().GroupResource() != servicecatalog.Resource("servicebindings") {
+		return nil
+	}
+	if a.GetSubresource() != "" {
+		return nil
+	}
+	binding, ok := a.GetObject().(*servicecatalog.ServiceBinding)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind ServiceBinding but was unable to be converted")
+	}
+
+	// we need to wait for our caches to warm
+	if !b.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	instance, err := b.instanceLister.ServiceInstances(binding.Namespace).Get(binding.Spec.InstanceRef.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// The controller reports bindings to missing instances
+			return nil
+		}
+		klog.Error(err)
+		return admission.NewForbidden(a, err)
+	}
+
+	planName, bindable, err := b.isInstancePlanBindable(instance)
+	if err != nil {
+		klog.Error(err)
+		return admission.NewForbidden(a, err)
+	}
+	if !bindable {
+		msg := fmt.Sprintf("ServiceInstance %q is of plan %q, which is not bindable", instance.Name, planName)
+		klog.V(4).Infof(`ServiceBinding "%s/%s": %s`, binding.Namespace, binding.Name, msg)
+		return admission.NewForbidden(a, errors.New(msg))
+	}
+
+	return nil
+}
+
+// isInstancePlanBindable returns the external name of the plan of the
+// instance and whether it is bindable. Instances whose class and plan are not
+// resolved yet, or no longer exist, are considered bindable and left to the
+// controller.
+func (b *bindablePlan) isInstancePlanBindable(instance *servicecatalog.ServiceInstance) (string, bool, error) {
+	var class *servicecatalog.CommonServiceClassSpec
+	var plan *servicecatalog.CommonServicePlanSpec
+
+	switch {
+	case instance.Spec.ClusterServiceClassRef != nil && instance.Spec.ClusterServicePlanRef != nil:
+		csc, err := b.cscLister.Get(instance.Spec.ClusterServiceClassRef.Name)
+		if err != nil {
+			return "", apierrors.IsNotFound(err), ignoreNotFound(err)
+		}
+		csp, err := b.cspLister.Get(instance.Spec.ClusterServicePlanRef.Name)
+		if err != nil {
+			return "", apierrors.IsNotFound(err), ignoreNotFound(err)
+		}
+		class, plan = &csc.Spec.CommonServiceClassSpec, &csp.Spec.CommonServicePlanSpec
+	case instance.Spec.ServiceClassRef != nil && instance.Spec.ServicePlanRef != nil:
+		sc, err := b.scLister.ServiceClasses(instance.Namespace).Get(instance.Spec.ServiceClassRef.Name)
+		if err != nil {
+			return "", apierrors.IsNotFound(err), ignoreNotFound(err)
+		}
+		sp, err := b.spLister.ServicePlans(instance.Namespace).Get(instance.Spec.ServicePlanRef.Name)
+		if err != nil {
+			return "", apierrors.IsNotFound(err), ignoreNotFound(err)
+		}
+		class, plan = &sc.Spec.CommonServiceClassSpec, &sp.Spec.CommonServicePlanSpec
+	default:
+		return "", true, nil
+	}
+
+	if plan.Bindable != nil {
+		return plan.ExternalName, *plan.Bindable, nil
+	}
+	return plan.ExternalName, class.Bindable, nil
+}
+
+func ignoreNotFound(err error) error {
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// NewBindablePlan creates a new admission control handler that rejects the
+// creation of Service Bindings to Service Instances of non-bindable plans
+func NewBindablePlan() (admission.Interface, error) {
+	return &bindablePlan{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+func (b *bindablePlan) SetInternalServiceCatalogInformerFactory(f informers.SharedInformerFactory) {
+	instanceInformer := f.Servicecatalog().InternalVersion().ServiceInstances()
+	b.instanceLister = instanceInformer.Lister()
+	cscInformer := f.Servicecatalog().InternalVersion().ClusterServiceClasses()
+	b.cscLister = cscInformer.Lister()
+	cspInformer := f.Servicecatalog().InternalVersion().ClusterServicePlans()
+	b.cspLister = cspInformer.Lister()
+	scInformer := f.Servicecatalog().InternalVersion().ServiceClasses()
+	b.scLister = scInformer.Lister()
+	spInformer := f.Servicecatalog().InternalVersion().ServicePlans()
+	b.spLister = spInformer.Lister()
+
+	readyFunc := func() bool {
+		return instanceInformer.Informer().HasSynced() &&
+			cscInformer.Informer().HasSynced() && cspInformer.Informer().HasSynced() &&
+			scInformer.Informer().HasSynced() && spInformer.Informer().HasSynced()
+	}
+
+	b.SetReadyFunc(readyFunc)
+}
+
+func (b *bindablePlan) ValidateInitialization() error {
+	if b.instanceLister == nil {
+		return errors.New("missing service instance lister")
+	}
+	if b.cscLister == nil {
+		return errors.New("missing cluster service class lister")
+	}
+	if b.cspLister == nil {
+		return errors.New("missing cluster service plan lister")
+	}
+	if b.scLister == nil {
+		return errors.New("missing service class lister")
+	}
+	if b.spLister == nil {
+		return errors.New("missing service plan lister")
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindableplan
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/admission"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+)
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(internalClient internalclientset.Interface) (admission.Interface, informers.SharedInformerFactory, error) {
+	f := informers.NewSharedInformerFactory(internalClient, 5*time.Minute)
+	handler, err := NewBindablePlan()
+	if err != nil {
+		return nil, f, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(internalClient, f, nil, nil)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, f, err
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// newFakeServiceCatalogClientForTest creates a fake clientset that lists a
+// bindable "db" ClusterServiceClass with a "shared" plan inheriting its
+// bindable flag and a "backup" plan which is not bindable, a non-bindable
+// "queue" ServiceClass with a "standard" plan overriding it, and one instance
+// of each of the plans.
+func newFakeServiceCatalogClientForTest() *fake.Clientset {
+	fakeClient := &fake.Clientset{}
+
+	cscList := &servicecatalog.ClusterServiceClassList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items: []servicecatalog.ClusterServiceClass{{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-class"},
+			Spec: servicecatalog.ClusterServiceClassSpec{
+				CommonServiceClassSpec: servicecatalog.CommonServiceClassSpec{
+					ExternalName: "db",
+					Bindable:     true,
+				},
+			},
+		}},
+	}
+	cspList := &servicecatalog.ClusterServicePlanList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items: []servicecatalog.ClusterServicePlan{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "shared-plan"},
+				Spec: servicecatalog.ClusterServicePlanSpec{
+					CommonServicePlanSpec: servicecatalog.CommonServicePlanSpec{
+						ExternalName: "shared",
+					},
+					ClusterServiceClassRef: servicecatalog.ClusterObjectReference{Name: "db-class"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup-plan"},
+				Spec: servicecatalog.ClusterServicePlanSpec{
+					CommonServicePlanSpec: servicecatalog.CommonServicePlanSpec{
+						ExternalName: "backup",
+						Bindable:     boolPtr(false),
+					},
+					ClusterServiceClassRef: servicecatalog.ClusterObjectReference{Name: "db-class"},
+				},
+			},
+		},
+	}
+	scList := &servicecatalog.ServiceClassList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items: []servicecatalog.ServiceClass{{
+			ObjectMeta: metav1.ObjectMeta{Name: "queue-class", Namespace: "test-ns"},
+			Spec: servicecatalog.ServiceClassSpec{
+				CommonServiceClassSpec: servicecatalog.CommonServiceClassSpec{
+					ExternalName: "queue",
+					Bindable:     false,
+				},
+			},
+		}},
+	}
+	spList := &servicecatalog.ServicePlanList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items: []servicecatalog.ServicePlan{{
+			ObjectMeta: metav1.ObjectMeta{Name: "standard-plan", Namespace: "test-ns"},
+			Spec: servicecatalog.ServicePlanSpec{
+				CommonServicePlanSpec: servicecatalog.CommonServicePlanSpec{
+					ExternalName: "standard",
+					Bindable:     boolPtr(true),
+				},
+				ServiceClassRef: servicecatalog.LocalObjectReference{Name: "queue-class"},
+			},
+		}},
+	}
+	instanceList := &servicecatalog.ServiceInstanceList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items: []servicecatalog.ServiceInstance{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "shared-instance", Namespace: "test-ns"},
+				Spec: servicecatalog.ServiceInstanceSpec{
+					ClusterServiceClassRef: &servicecatalog.ClusterObjectReference{Name: "db-class"},
+					ClusterServicePlanRef:  &servicecatalog.ClusterObjectReference{Name: "shared-plan"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup-instance", Namespace: "test-ns"},
+				Spec: servicecatalog.ServiceInstanceSpec{
+					ClusterServiceClassRef: &servicecatalog.ClusterObjectReference{Name: "db-class"},
+					ClusterServicePlanRef:  &servicecatalog.ClusterObjectReference{Name: "backup-plan"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "standard-instance", Namespace: "test-ns"},
+				Spec: servicecatalog.ServiceInstanceSpec{
+					ServiceClassRef: &servicecatalog.LocalObjectReference{Name: "queue-class"},
+					ServicePlanRef:  &servicecatalog.LocalObjectReference{Name: "standard-plan"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "unresolved-instance", Namespace: "test-ns"},
+				Spec: servicecatalog.ServiceInstanceSpec{
+					PlanReference: servicecatalog.PlanReference{
+						ClusterServiceClassExternalName: "db",
+						ClusterServicePlanExternalName:  "backup",
+					},
+				},
+			},
+		},
+	}
+
+	fakeClient.AddReactor("list", "clusterserviceclasses", func(action core.Action) (bool, runtime.Object, error) {
+		return true, cscList, nil
+	})
+	fakeClient.AddReactor("list", "clusterserviceplans", func(action core.Action) (bool, runtime.Object, error) {
+		return true, cspList, nil
+	})
+	fakeClient.AddReactor("list", "serviceclasses", func(action core.Action) (bool, runtime.Object, error) {
+		return true, scList, nil
+	})
+	fakeClient.AddReactor("list", "serviceplans", func(action core.Action) (bool, runtime.Object, error) {
+		return true, spList, nil
+	})
+	fakeClient.AddReactor("list", "serviceinstances", func(action core.Action) (bool, runtime.Object, error) {
+		return true, instanceList, nil
+	})
+	return fakeClient
+}
+
+func TestBindablePlan(t *testing.T) {
+	cases := []struct {
+		name     string
+		instance string
+		errMsg   string
+	}{
+		{
+			name:     "plan inheriting a bindable class",
+			instance: "shared-instance",
+		},
+		{
+			name:     "non-bindable plan",
+			instance: "backup-instance",
+			errMsg:   `ServiceInstance "backup-instance" is of plan "backup", which is not bindable`,
+		},
+		{
+			name:     "bindable plan of a non-bindable namespaced class",
+			instance: "standard-instance",
+		},
+		{
+			name:     "instance with unresolved references",
+			instance: "unresolved-instance",
+		},
+		{
+			name:     "missing instance",
+			instance: "missing-instance",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, informerFactory, err := newHandlerForTest(newFakeServiceCatalogClientForTest())
+			if err != nil {
+				t.Fatalf("unexpected error initializing handler: %v", err)
+			}
+			informerFactory.Start(wait.NeverStop)
+
+			binding := &servicecatalog.ServiceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "test-ns"},
+				Spec: servicecatalog.ServiceBindingSpec{
+					InstanceRef: servicecatalog.LocalObjectReference{Name: tc.instance},
+				},
+			}
+			err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(binding, nil, servicecatalog.Kind("ServiceBinding").WithVersion("version"), binding.Namespace, binding.Name, servicecatalog.Resource("servicebindings").WithVersion("version"), "", admission.Create, false, nil))
+			if tc.errMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", tc.errMsg)
+			}
+			if !strings.Contains(err.Error(), tc.errMsg) {
+				t.Fatalf("expected error containing %q, got %q", tc.errMsg, err)
+			}
+		})
+	}
+}
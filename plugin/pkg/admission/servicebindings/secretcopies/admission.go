@@ -0,0 +1,161 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretcopies
+
+import (
+	"fmt"
+	"io"
+
+	"k8s.io/klog"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	authorizationapi "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+	kubeclientset "k8s.io/client-go/kubernetes"
+
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ServiceBindingsSecretCopies"
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewSecretCopiesCheck()
+	})
+}
+
+// secretCopiesCheck is an implementation of admission.Interface.
+// It enforces that the user creating or updating a ServiceBinding is allowed
+// to create Secrets in each of its additional secret namespaces, since the
+// controller writes the copies of the binding's Secret there on their behalf.
+type secretCopiesCheck struct {
+	*admission.Handler
+	client kubeclientset.Interface
+}
+
+var _ = scadmission.WantsKubeClientSet(&secretCopiesCheck{})
+
+func convertToSARExtra(extra map[string][]string) map[string]authorizationapi.ExtraValue {
+	if extra == nil {
+		return nil
+	}
+
+	ret := map[string]authorizationapi.ExtraValue{}
+	for k, v := range extra {
+		ret[k] = authorizationapi.ExtraValue(v)
+	}
+
+	return ret
+}
+
+func (s *secretCopiesCheck) Admit(a admission.Attributes) error {
+	// need to wait for our caches to warm
+	if !s.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+	// only care about bindings
+	if a.GetResource().Group != servicecatalog.GroupName || a.GetResource().GroupResource() != servicecatalog.Resource("servicebindings") {
+		return nil
+	}
+	// the status of the binding does not change where the copies are written
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	binding, ok := a.GetObject().(*servicecatalog.ServiceBinding)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind ServiceBinding but was unable to be converted")
+	}
+
+	namespaces := sets.NewString(binding.Spec.AdditionalSecretNamespaces...)
+	// only the namespaces added by an update are checked
+	if a.GetOperation() == admission.Update {
+		oldBinding, ok := a.GetOldObject().(*servicecatalog.ServiceBinding)
+		if !ok {
+			return apierrors.NewBadRequest("Resource was marked with kind ServiceBinding but was unable to be converted")
+		}
+		namespaces = namespaces.Difference(sets.NewString(oldBinding.Spec.AdditionalSecretNamespaces...))
+	}
+
+	for _, namespace := range namespaces.List() {
+		klog.V(5).Infof("ServiceBinding %s/%s: evaluating access to secrets in additional secret namespace %q", binding.Namespace, binding.Name, namespace)
+		resourceAttributes := &authorizationapi.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      "create",
+			Group:     corev1.SchemeGroupVersion.Group,
+			Version:   corev1.SchemeGroupVersion.Version,
+			Resource:  corev1.ResourceSecrets.String(),
+			Name:      binding.Spec.SecretName,
+		}
+		forbiddenMsg := fmt.Sprintf("binding forbidden to create secrets in additional secret namespace (%s)", namespace)
+		if err := s.checkAccess(a, resourceAttributes, forbiddenMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkAccess returns a forbidden error if the user making the request is not
+// allowed to access the given resource.
+func (s *secretCopiesCheck) checkAccess(a admission.Attributes, resourceAttributes *authorizationapi.ResourceAttributes, forbiddenMsg string) error {
+	userInfo := a.GetUserInfo()
+	sar := &authorizationapi.SubjectAccessReview{
+		Spec: authorizationapi.SubjectAccessReviewSpec{
+			ResourceAttributes: resourceAttributes,
+			User:               userInfo.GetName(),
+			Groups:             userInfo.GetGroups(),
+			Extra:              convertToSARExtra(userInfo.GetExtra()),
+			UID:                userInfo.GetUID(),
+		},
+	}
+	sar, err := s.client.AuthorizationV1().SubjectAccessReviews().Create(sar)
+	if err != nil {
+		return err
+	}
+
+	if !sar.Status.Allowed {
+		return admission.NewForbidden(a, fmt.Errorf("%s: Reason: %s, EvaluationError: %s", forbiddenMsg, sar.Status.Reason, sar.Status.EvaluationError))
+	}
+	return nil
+}
+
+// NewSecretCopiesCheck creates a new admission control handler that checks
+// that the user may create Secrets in the additional secret namespaces of a
+// ServiceBinding
+func NewSecretCopiesCheck() (admission.Interface, error) {
+	return &secretCopiesCheck{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+func (s *secretCopiesCheck) SetKubeClientSet(client kubeclientset.Interface) {
+	s.client = client
+}
+
+func (s *secretCopiesCheck) ValidateInitialization() error {
+	if s.client == nil {
+		return fmt.Errorf("missing client")
+	}
+	return nil
+}
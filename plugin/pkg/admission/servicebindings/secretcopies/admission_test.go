@@ -0,0 +1,162 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretcopies
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	authorizationapi "k8s.io/api/authorization/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	kubeclientset "k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+)
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(kubeClient kubeclientset.Interface) (admission.Interface, error) {
+	kf := kubeinformers.NewSharedInformerFactory(kubeClient, 5*time.Minute)
+	handler, err := NewSecretCopiesCheck()
+	if err != nil {
+		return nil, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(nil, nil, kubeClient, kf)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, err
+}
+
+// newMockKubeClientForTest creates a mock kubernetes client that is configured
+// to allow the creation of Secrets in any namespace but the forbidden one,
+// and records the namespaces reviewed.
+func newMockKubeClientForTest(reviewed *[]string) *kubefake.Clientset {
+	mockClient := &kubefake.Clientset{}
+	mockClient.AddReactor("create", "subjectaccessreviews", func(action core.Action) (bool, runtime.Object, error) {
+		sar := action.(core.CreateAction).GetObject().(*authorizationapi.SubjectAccessReview)
+		*reviewed = append(*reviewed, sar.Spec.ResourceAttributes.Namespace)
+		allowed := sar.Spec.ResourceAttributes.Verb == "create" &&
+			sar.Spec.ResourceAttributes.Resource == "secrets" &&
+			sar.Spec.ResourceAttributes.Namespace != "forbidden-ns"
+		return true, &authorizationapi.SubjectAccessReview{
+			Status: authorizationapi.SubjectAccessReviewStatus{
+				Allowed: allowed,
+			},
+		}, nil
+	})
+	return mockClient
+}
+
+func newServiceBinding(namespaces ...string) *servicecatalog.ServiceBinding {
+	return &servicecatalog.ServiceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-binding",
+			Namespace: "test-ns",
+		},
+		Spec: servicecatalog.ServiceBindingSpec{
+			InstanceRef:                servicecatalog.LocalObjectReference{Name: "test-instance"},
+			SecretName:                 "test-secret",
+			AdditionalSecretNamespaces: namespaces,
+		},
+	}
+}
+
+// TestAdmissionSecretCopies tests that the user is required to be allowed to
+// create Secrets in the additional secret namespaces of a ServiceBinding,
+// and that only the namespaces added by an update are reviewed.
+func TestAdmissionSecretCopies(t *testing.T) {
+	cases := []struct {
+		name       string
+		operation  admission.Operation
+		binding    *servicecatalog.ServiceBinding
+		oldBinding *servicecatalog.ServiceBinding
+		reviewed   []string
+		allowed    bool
+	}{
+		{
+			name:      "no additional secret namespaces",
+			operation: admission.Create,
+			binding:   newServiceBinding(),
+			allowed:   true,
+		},
+		{
+			name:      "allowed namespaces",
+			operation: admission.Create,
+			binding:   newServiceBinding("other-ns", "another-ns"),
+			reviewed:  []string{"another-ns", "other-ns"},
+			allowed:   true,
+		},
+		{
+			name:      "forbidden namespace",
+			operation: admission.Create,
+			binding:   newServiceBinding("other-ns", "forbidden-ns"),
+			reviewed:  []string{"forbidden-ns"},
+			allowed:   false,
+		},
+		{
+			name:       "update keeping a forbidden namespace",
+			operation:  admission.Update,
+			binding:    newServiceBinding("forbidden-ns", "other-ns"),
+			oldBinding: newServiceBinding("forbidden-ns"),
+			reviewed:   []string{"other-ns"},
+			allowed:    true,
+		},
+		{
+			name:       "update adding a forbidden namespace",
+			operation:  admission.Update,
+			binding:    newServiceBinding("other-ns", "forbidden-ns"),
+			oldBinding: newServiceBinding("other-ns"),
+			reviewed:   []string{"forbidden-ns"},
+			allowed:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var reviewed []string
+			handler, err := newHandlerForTest(newMockKubeClientForTest(&reviewed))
+			if err != nil {
+				t.Fatalf("unexpected error initializing handler: %v", err)
+			}
+
+			var oldObj runtime.Object
+			if tc.oldBinding != nil {
+				oldObj = tc.oldBinding
+			}
+			userInfo := &user.DefaultInfo{Name: "test-user"}
+			err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(tc.binding, oldObj, servicecatalog.Kind("ServiceBinding").WithVersion("version"), tc.binding.Namespace, tc.binding.Name, servicecatalog.Resource("servicebindings").WithVersion("version"), "", tc.operation, false, userInfo))
+			if err != nil && tc.allowed || err == nil && !tc.allowed {
+				t.Fatalf("Unexpected error returned from admission handler: %v", err)
+			}
+			if e, a := len(tc.reviewed), len(reviewed); e != a {
+				t.Fatalf("Unexpected number of reviewed namespaces: expected %v, got %v (%v)", e, a, reviewed)
+			}
+			for i := range tc.reviewed {
+				if e, a := tc.reviewed[i], reviewed[i]; e != a {
+					t.Fatalf("Unexpected reviewed namespace: expected %q, got %q", e, a)
+				}
+			}
+		})
+	}
+}
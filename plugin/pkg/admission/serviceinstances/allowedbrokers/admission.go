@@ -0,0 +1,191 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowedbrokers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"k8s.io/klog"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ServiceInstanceAllowedBrokers"
+)
+
+// Policy is the configuration of the plugin. It maps a namespace to the
+// names of the brokers that instances in that namespace may be provisioned
+// from. Namespaces that are not listed are not restricted.
+//
+// For example:
+//
+//	namespaces:
+//	  team-a: ["shared-broker", "team-a-broker"]
+//	  team-b: ["shared-broker"]
+type Policy struct {
+	Namespaces map[string][]string `json:"namespaces"`
+}
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		policy := &Policy{}
+		if err := scadmission.ReadPolicy(PluginName, config, policy); err != nil {
+			return nil, err
+		}
+		return NewAllowedBrokers(policy)
+	})
+}
+
+// allowedBrokers is an implementation of admission.Interface.
+// It rejects Service Instances in restricted namespaces whose Service Class
+// is offered by a broker that is not allowed for that namespace.
+type allowedBrokers struct {
+	*admission.Handler
+	allowed   map[string]sets.String
+	cscLister internalversion.ClusterServiceClassLister
+	scLister  internalversion.ServiceClassLister
+}
+
+var _ = scadmission.WantsInternalServiceCatalogInformerFactory(&allowedBrokers{})
+
+func (b *allowedBrokers) Admit(a admission.Attributes) error {
+	// We only care about service Instances
+	if a.GetResource().Group != servicecatalog.GroupName || a.GetResource().GroupResource() != servicecatalog.Resource("serviceinstances") {
+		return nil
+	}
+	if a.GetSubresource() != "" {
+		return nil
+	}
+	instance, ok := a.GetObject().(*servicecatalog.ServiceInstance)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind ServiceInstance but was unable to be converted")
+	}
+
+	// Updates which do not change the plan are let through, so that
+	// restricting a namespace does not block updates of its existing
+	// instances.
+	if a.GetOperation() == admission.Update {
+		if old, ok := a.GetOldObject().(*servicecatalog.ServiceInstance); ok && apiequality.Semantic.DeepEqual(old.Spec.PlanReference, instance.Spec.PlanReference) {
+			return nil
+		}
+	}
+
+	allowed, restricted := b.allowed[instance.Namespace]
+	if !restricted {
+		return nil
+	}
+
+	// we need to wait for our caches to warm
+	if !b.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	brokers, err := b.getBrokerNames(instance)
+	if err != nil {
+		klog.Error(err)
+		return admission.NewForbidden(a, err)
+	}
+	// Fail closed: an instance whose class cannot be found yet could later
+	// be resolved to a class of any broker.
+	if len(brokers) == 0 {
+		msg := fmt.Sprintf("Unable to determine the broker offering %c; namespace %q only allows brokers %v", instance.Spec.PlanReference, instance.Namespace, allowed.List())
+		klog.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+		return admission.NewForbidden(a, errors.New(msg))
+	}
+	if disallowed := brokers.Difference(allowed); disallowed.Len() > 0 {
+		msg := fmt.Sprintf("Namespace %q is not allowed to provision from brokers %v", instance.Namespace, disallowed.List())
+		klog.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+		return admission.NewForbidden(a, errors.New(msg))
+	}
+
+	return nil
+}
+
+// getBrokerNames returns the names of the brokers offering a class the
+// instance could be resolved to.
+func (b *allowedBrokers) getBrokerNames(instance *servicecatalog.ServiceInstance) (sets.String, error) {
+	brokers := sets.NewString()
+
+	clusterClasses, err := scadmission.GetClusterServiceClasses(b.cscLister, instance)
+	if err != nil {
+		return nil, err
+	}
+	for _, class := range clusterClasses {
+		brokers.Insert(class.Spec.ClusterServiceBrokerName)
+	}
+
+	classes, err := scadmission.GetServiceClasses(b.scLister, instance)
+	if err != nil {
+		return nil, err
+	}
+	for _, class := range classes {
+		brokers.Insert(class.Spec.ServiceBrokerName)
+	}
+
+	return brokers, nil
+}
+
+// NewAllowedBrokers creates a new admission control handler that rejects
+// Service Instances referring to a broker that is not allowed in their
+// namespace by the given policy
+func NewAllowedBrokers(policy *Policy) (admission.Interface, error) {
+	allowed := make(map[string]sets.String, len(policy.Namespaces))
+	for namespace, brokers := range policy.Namespaces {
+		allowed[namespace] = sets.NewString(brokers...)
+	}
+	return &allowedBrokers{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+		allowed: allowed,
+	}, nil
+}
+
+func (b *allowedBrokers) SetInternalServiceCatalogInformerFactory(f informers.SharedInformerFactory) {
+	cscInformer := f.Servicecatalog().InternalVersion().ClusterServiceClasses()
+	b.cscLister = cscInformer.Lister()
+	scInformer := f.Servicecatalog().InternalVersion().ServiceClasses()
+	b.scLister = scInformer.Lister()
+
+	readyFunc := func() bool {
+		return cscInformer.Informer().HasSynced() && scInformer.Informer().HasSynced()
+	}
+
+	b.SetReadyFunc(readyFunc)
+}
+
+func (b *allowedBrokers) ValidateInitialization() error {
+	if b.cscLister == nil {
+		return errors.New("missing cluster service class lister")
+	}
+	if b.scLister == nil {
+		return errors.New("missing service class lister")
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package allowedbrokers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/admission"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+)
+
+const testPolicy = `
+namespaces:
+  restricted: ["allowed-broker"]
+`
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(internalClient internalclientset.Interface) (admission.Interface, informers.SharedInformerFactory, error) {
+	f := informers.NewSharedInformerFactory(internalClient, 5*time.Minute)
+	policy := &Policy{}
+	if err := scadmission.ReadPolicy(PluginName, strings.NewReader(testPolicy), policy); err != nil {
+		return nil, f, err
+	}
+	handler, err := NewAllowedBrokers(policy)
+	if err != nil {
+		return nil, f, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(internalClient, f, nil, nil)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, f, err
+}
+
+// newFakeServiceCatalogClientForTest creates a fake clientset that lists a
+// ClusterServiceClass and a ServiceClass in the "restricted" namespace for
+// each of the given brokers.
+func newFakeServiceCatalogClientForTest(brokers ...string) *fake.Clientset {
+	fakeClient := &fake.Clientset{}
+
+	cscList := &servicecatalog.ClusterServiceClassList{
+		ListMeta: metav1.ListMeta{
+			ResourceVersion: "1",
+		}}
+	scList := &servicecatalog.ServiceClassList{
+		ListMeta: metav1.ListMeta{
+			ResourceVersion: "1",
+		}}
+	for _, broker := range brokers {
+		cscList.Items = append(cscList.Items, servicecatalog.ClusterServiceClass{
+			ObjectMeta: metav1.ObjectMeta{Name: broker + "-class"},
+			Spec: servicecatalog.ClusterServiceClassSpec{
+				CommonServiceClassSpec: servicecatalog.CommonServiceClassSpec{
+					ExternalName: "db",
+					ExternalID:   broker + "-db",
+				},
+				ClusterServiceBrokerName: broker,
+			},
+		})
+		scList.Items = append(scList.Items, servicecatalog.ServiceClass{
+			ObjectMeta: metav1.ObjectMeta{Name: broker + "-class", Namespace: "restricted"},
+			Spec: servicecatalog.ServiceClassSpec{
+				CommonServiceClassSpec: servicecatalog.CommonServiceClassSpec{
+					ExternalName: broker + "-db",
+					ExternalID:   broker + "-db",
+				},
+				ServiceBrokerName: broker,
+			},
+		})
+	}
+
+	fakeClient.AddReactor("list", "clusterserviceclasses", func(action core.Action) (bool, runtime.Object, error) {
+		return true, cscList, nil
+	})
+	fakeClient.AddReactor("list", "serviceclasses", func(action core.Action) (bool, runtime.Object, error) {
+		return true, scList, nil
+	})
+	return fakeClient
+}
+
+func TestAllowedBrokers(t *testing.T) {
+	cases := []struct {
+		name      string
+		namespace string
+		ref       servicecatalog.PlanReference
+		brokerRef string
+		errMsg    string
+	}{
+		{
+			name:      "unrestricted namespace",
+			namespace: "other",
+			ref:       servicecatalog.PlanReference{ClusterServiceClassName: "disallowed-broker-class"},
+		},
+		{
+			name:      "allowed cluster class by k8s name",
+			namespace: "restricted",
+			ref:       servicecatalog.PlanReference{ClusterServiceClassName: "allowed-broker-class"},
+		},
+		{
+			name:      "disallowed cluster class by k8s name",
+			namespace: "restricted",
+			ref:       servicecatalog.PlanReference{ClusterServiceClassName: "disallowed-broker-class"},
+			errMsg:    `Namespace "restricted" is not allowed to provision from brokers [disallowed-broker]`,
+		},
+		{
+			name:      "allowed cluster class by external ID",
+			namespace: "restricted",
+			ref:       servicecatalog.PlanReference{ClusterServiceClassExternalID: "allowed-broker-db"},
+		},
+		{
+			name:      "cluster class by external name offered by a disallowed broker too",
+			namespace: "restricted",
+			ref:       servicecatalog.PlanReference{ClusterServiceClassExternalName: "db"},
+			errMsg:    `Namespace "restricted" is not allowed to provision from brokers [disallowed-broker]`,
+		},
+		{
+			name:      "cluster class by external name with allowed broker selected",
+			namespace: "restricted",
+			ref:       servicecatalog.PlanReference{ClusterServiceClassExternalName: "db"},
+			brokerRef: "allowed-broker",
+		},
+		{
+			name:      "allowed namespaced class by external name",
+			namespace: "restricted",
+			ref:       servicecatalog.PlanReference{ServiceClassExternalName: "allowed-broker-db"},
+		},
+		{
+			name:      "disallowed namespaced class by external name",
+			namespace: "restricted",
+			ref:       servicecatalog.PlanReference{ServiceClassExternalName: "disallowed-broker-db"},
+			errMsg:    `Namespace "restricted" is not allowed to provision from brokers [disallowed-broker]`,
+		},
+		{
+			name:      "unknown class",
+			namespace: "restricted",
+			ref:       servicecatalog.PlanReference{ClusterServiceClassExternalName: "unknown"},
+			errMsg:    "Unable to determine the broker offering",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := newFakeServiceCatalogClientForTest("allowed-broker", "disallowed-broker")
+			handler, informerFactory, err := newHandlerForTest(fakeClient)
+			if err != nil {
+				t.Fatalf("unexpected error initializing handler: %v", err)
+			}
+			informerFactory.Start(wait.NeverStop)
+
+			instance := &servicecatalog.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: tc.namespace},
+				Spec: servicecatalog.ServiceInstanceSpec{
+					PlanReference:            tc.ref,
+					ClusterServiceBrokerName: tc.brokerRef,
+				},
+			}
+			err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(instance, nil, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Create, false, nil))
+			if tc.errMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", tc.errMsg)
+			}
+			if !strings.Contains(err.Error(), tc.errMsg) {
+				t.Fatalf("expected error containing %q, got %q", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestAllowedBrokersUpdate(t *testing.T) {
+	handler, informerFactory, err := newHandlerForTest(newFakeServiceCatalogClientForTest("allowed-broker", "disallowed-broker"))
+	if err != nil {
+		t.Fatalf("unexpected error initializing handler: %v", err)
+	}
+	informerFactory.Start(wait.NeverStop)
+
+	oldInstance := &servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "restricted"},
+		Spec: servicecatalog.ServiceInstanceSpec{
+			PlanReference: servicecatalog.PlanReference{ClusterServiceClassName: "disallowed-broker-class"},
+		},
+	}
+	admit := func(instance *servicecatalog.ServiceInstance, subresource string) error {
+		return handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(instance, oldInstance, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), subresource, admission.Update, false, nil))
+	}
+
+	// An update which does not change the plan is let through, even though
+	// the instance was provisioned from a broker no longer allowed
+	instance := oldInstance.DeepCopy()
+	instance.Spec.UpdateRequests = 1
+	if err := admit(instance, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Status updates are not checked
+	instance = oldInstance.DeepCopy()
+	instance.Spec.PlanReference = servicecatalog.PlanReference{ClusterServiceClassExternalName: "db"}
+	if err := admit(instance, "status"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Changing the plan checks the broker again
+	if err := admit(instance, ""); err == nil || !strings.Contains(err.Error(), `Namespace "restricted" is not allowed to provision from brokers [disallowed-broker]`) {
+		t.Fatalf("expected the disallowed broker to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requiredparameters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"k8s.io/klog"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+	internalversion "github.com/kubernetes-incubator/service-catalog/pkg/client/listers_generated/servicecatalog/internalversion"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	scfeatures "github.com/kubernetes-incubator/service-catalog/pkg/features"
+)
+
+const (
+	// PluginName is name of admission plug-in
+	PluginName = "ServiceInstanceRequiredParameters"
+
+	// RequiredParametersMetadataKey is the key of the plan metadata listing
+	// the names of the parameters an instance of the plan must set, e.g.
+	//
+	//	"metadata": {"requiredParameters": ["region", "size"]}
+	RequiredParametersMetadataKey = "requiredParameters"
+)
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewRequiredParameters()
+	})
+}
+
+// requiredParameters is an implementation of admission.Interface.
+// It rejects Service Instances which do not set all the parameters listed
+// as required in the catalog metadata of their Service Plan. Unlike a
+// parameter schema, the list only names the top-level parameters which must
+// be present.
+type requiredParameters struct {
+	*admission.Handler
+	cscLister internalversion.ClusterServiceClassLister
+	cspLister internalversion.ClusterServicePlanLister
+	scLister  internalversion.ServiceClassLister
+	spLister  internalversion.ServicePlanLister
+}
+
+var _ = scadmission.WantsInternalServiceCatalogInformerFactory(&requiredParameters{})
+
+func (r *requiredParameters) Admit(a admission.Attributes) error {
+	// We only care about service Instances
+	if a.GetResource().Group != servicecatalog.GroupName || a.GetResource().GroupResource() != servicecatalog.Resource("serviceinstances") {
+		return nil
+	}
+	if a.GetSubresource() != "" {
+		return nil
+	}
+	instance, ok := a.GetObject().(*servicecatalog.ServiceInstance)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind ServiceInstance but was unable to be converted")
+	}
+
+	// Updates which change neither the plan nor the parameters are let
+	// through, so that instances created before a plan started requiring
+	// parameters can still be updated otherwise.
+	if a.GetOperation() == admission.Update {
+		if old, ok := a.GetOldObject().(*servicecatalog.ServiceInstance); ok && !isPlanOrParametersChanged(old, instance) {
+			return nil
+		}
+	}
+
+	provided, known := getProvidedParameters(instance)
+	if !known {
+		// Some parameters come from sources only read by the controller,
+		// so whether the required parameters are set cannot be told yet.
+		return nil
+	}
+
+	// we need to wait for our caches to warm
+	if !r.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+
+	plans, err := r.getPlans(instance)
+	if err != nil {
+		klog.Error(err)
+		return admission.NewForbidden(a, err)
+	}
+
+	for _, plan := range plans {
+		planProvided := provided
+		if utilfeature.DefaultFeatureGate.Enabled(scfeatures.ServicePlanDefaults) {
+			planProvided = provided.Union(getParameterNames(plan.DefaultProvisionParameters))
+		}
+		missing := sets.NewString(getRequiredParameters(plan)...).Difference(planProvided)
+		if missing.Len() > 0 {
+			msg := fmt.Sprintf("Parameters %v are required by plan %q", missing.List(), plan.ExternalName)
+			klog.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+			return admission.NewForbidden(a, errors.New(msg))
+		}
+	}
+
+	return nil
+}
+
+// isPlanOrParametersChanged returns whether an update of an instance changes
+// its plan or any of its parameters.
+func isPlanOrParametersChanged(old, new *servicecatalog.ServiceInstance) bool {
+	return !apiequality.Semantic.DeepEqual(old.Spec.PlanReference, new.Spec.PlanReference) ||
+		!apiequality.Semantic.DeepEqual(old.Spec.Parameters, new.Spec.Parameters) ||
+		!apiequality.Semantic.DeepEqual(old.Spec.ParametersFrom, new.Spec.ParametersFrom) ||
+		!apiequality.Semantic.DeepEqual(old.Spec.GeneratedParameters, new.Spec.GeneratedParameters)
+}
+
+// getProvidedParameters returns the names of the top-level parameters an
+// instance sets. It returns false if the instance sets parameters whose names
+// are not known at admission, from a parametersFrom source that is not
+// assigned to a named parameter.
+func getProvidedParameters(instance *servicecatalog.ServiceInstance) (sets.String, bool) {
+	provided := getParameterNames(instance.Spec.Parameters)
+	for _, source := range instance.Spec.ParametersFrom {
+		if source.ParameterName == "" {
+			return nil, false
+		}
+		provided.Insert(source.ParameterName)
+	}
+	if instance.Spec.GeneratedParameters != nil {
+		for _, parameter := range instance.Spec.GeneratedParameters.Parameters {
+			provided.Insert(parameter.Name)
+		}
+	}
+	return provided, true
+}
+
+// getParameterNames returns the top-level keys of a JSON object of
+// parameters. Parameters which are not a JSON object have no names.
+func getParameterNames(params *runtime.RawExtension) sets.String {
+	names := sets.NewString()
+	if params == nil || len(params.Raw) == 0 {
+		return names
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(params.Raw, &m); err != nil {
+		return names
+	}
+	for name := range m {
+		names.Insert(name)
+	}
+	return names
+}
+
+// getRequiredParameters returns the names of the parameters listed under
+// RequiredParametersMetadataKey in the metadata of a plan. Metadata which is
+// not a JSON object or lists no required parameters requires none.
+func getRequiredParameters(plan *servicecatalog.CommonServicePlanSpec) []string {
+	if plan.ExternalMetadata == nil || len(plan.ExternalMetadata.Raw) == 0 {
+		return nil
+	}
+	var metadata map[string]json.RawMessage
+	if err := json.Unmarshal(plan.ExternalMetadata.Raw, &metadata); err != nil {
+		return nil
+	}
+	raw, ok := metadata[RequiredParametersMetadataKey]
+	if !ok {
+		return nil
+	}
+	var required []string
+	if err := json.Unmarshal(raw, &required); err != nil {
+		klog.V(4).Infof("Ignoring %q of plan %q, which is not a list of parameter names: %v", RequiredParametersMetadataKey, plan.ExternalName, err)
+		return nil
+	}
+	return required
+}
+
+// getPlans returns the plans the instance could be resolved to. An instance
+// whose plan cannot be found yet is left to the controller, which reports it.
+func (r *requiredParameters) getPlans(instance *servicecatalog.ServiceInstance) ([]*servicecatalog.CommonServicePlanSpec, error) {
+	var plans []*servicecatalog.CommonServicePlanSpec
+
+	clusterPlans, err := scadmission.GetClusterServicePlans(r.cscLister, r.cspLister, instance)
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range clusterPlans {
+		plans = append(plans, &plan.Spec.CommonServicePlanSpec)
+	}
+
+	namespacedPlans, err := scadmission.GetServicePlans(r.scLister, r.spLister, instance)
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range namespacedPlans {
+		plans = append(plans, &plan.Spec.CommonServicePlanSpec)
+	}
+
+	return plans, nil
+}
+
+// NewRequiredParameters creates a new admission control handler that rejects
+// Service Instances missing parameters required by the metadata of their
+// Service Plan
+func NewRequiredParameters() (admission.Interface, error) {
+	return &requiredParameters{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+func (r *requiredParameters) SetInternalServiceCatalogInformerFactory(f informers.SharedInformerFactory) {
+	cscInformer := f.Servicecatalog().InternalVersion().ClusterServiceClasses()
+	r.cscLister = cscInformer.Lister()
+	cspInformer := f.Servicecatalog().InternalVersion().ClusterServicePlans()
+	r.cspLister = cspInformer.Lister()
+	scInformer := f.Servicecatalog().InternalVersion().ServiceClasses()
+	r.scLister = scInformer.Lister()
+	spInformer := f.Servicecatalog().InternalVersion().ServicePlans()
+	r.spLister = spInformer.Lister()
+
+	readyFunc := func() bool {
+		return cscInformer.Informer().HasSynced() && cspInformer.Informer().HasSynced() &&
+			scInformer.Informer().HasSynced() && spInformer.Informer().HasSynced()
+	}
+
+	r.SetReadyFunc(readyFunc)
+}
+
+func (r *requiredParameters) ValidateInitialization() error {
+	if r.cscLister == nil {
+		return errors.New("missing cluster service class lister")
+	}
+	if r.cspLister == nil {
+		return errors.New("missing cluster service plan lister")
+	}
+	if r.scLister == nil {
+		return errors.New("missing service class lister")
+	}
+	if r.spLister == nil {
+		return errors.New("missing service plan lister")
+	}
+	return nil
+}
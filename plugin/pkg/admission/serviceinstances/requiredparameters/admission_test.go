@@ -0,0 +1,289 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requiredparameters
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/admission"
+	core "k8s.io/client-go/testing"
+
+	"github.com/kubernetes-incubator/service-catalog/pkg/apis/servicecatalog"
+	scadmission "github.com/kubernetes-incubator/service-catalog/pkg/apiserver/admission"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset"
+	"github.com/kubernetes-incubator/service-catalog/pkg/client/clientset_generated/internalclientset/fake"
+	informers "github.com/kubernetes-incubator/service-catalog/pkg/client/informers_generated/internalversion"
+)
+
+const testMetadata = `{"displayName": "Large", "requiredParameters": ["region", "size"]}`
+
+// newHandlerForTest returns a configured handler for testing.
+func newHandlerForTest(internalClient internalclientset.Interface) (admission.Interface, informers.SharedInformerFactory, error) {
+	f := informers.NewSharedInformerFactory(internalClient, 5*time.Minute)
+	handler, err := NewRequiredParameters()
+	if err != nil {
+		return nil, f, err
+	}
+	pluginInitializer := scadmission.NewPluginInitializer(internalClient, f, nil, nil)
+	pluginInitializer.Initialize(handler)
+	err = admission.ValidateInitialization(handler)
+	return handler, f, err
+}
+
+// newFakeServiceCatalogClientForTest creates a fake clientset that lists a
+// "db" ClusterServiceClass and ServiceClass, each with a "large" plan
+// requiring the "region" and "size" parameters and a "small" plan requiring
+// none.
+func newFakeServiceCatalogClientForTest() *fake.Clientset {
+	fakeClient := &fake.Clientset{}
+
+	cscList := &servicecatalog.ClusterServiceClassList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items: []servicecatalog.ClusterServiceClass{{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-class"},
+			Spec: servicecatalog.ClusterServiceClassSpec{
+				CommonServiceClassSpec: servicecatalog.CommonServiceClassSpec{
+					ExternalName: "db",
+					ExternalID:   "db-id",
+				},
+				ClusterServiceBrokerName: "broker",
+			},
+		}},
+	}
+	cspList := &servicecatalog.ClusterServicePlanList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items: []servicecatalog.ClusterServicePlan{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "large-plan"},
+				Spec: servicecatalog.ClusterServicePlanSpec{
+					CommonServicePlanSpec: servicecatalog.CommonServicePlanSpec{
+						ExternalName:     "large",
+						ExternalID:       "large-id",
+						ExternalMetadata: &runtime.RawExtension{Raw: []byte(testMetadata)},
+					},
+					ClusterServiceBrokerName: "broker",
+					ClusterServiceClassRef:   servicecatalog.ClusterObjectReference{Name: "db-class"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "small-plan"},
+				Spec: servicecatalog.ClusterServicePlanSpec{
+					CommonServicePlanSpec: servicecatalog.CommonServicePlanSpec{
+						ExternalName: "small",
+						ExternalID:   "small-id",
+					},
+					ClusterServiceBrokerName: "broker",
+					ClusterServiceClassRef:   servicecatalog.ClusterObjectReference{Name: "db-class"},
+				},
+			},
+		},
+	}
+	scList := &servicecatalog.ServiceClassList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items: []servicecatalog.ServiceClass{{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-class", Namespace: "test-ns"},
+			Spec: servicecatalog.ServiceClassSpec{
+				CommonServiceClassSpec: servicecatalog.CommonServiceClassSpec{
+					ExternalName: "db",
+					ExternalID:   "db-id",
+				},
+				ServiceBrokerName: "broker",
+			},
+		}},
+	}
+	spList := &servicecatalog.ServicePlanList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+		Items: []servicecatalog.ServicePlan{{
+			ObjectMeta: metav1.ObjectMeta{Name: "large-plan", Namespace: "test-ns"},
+			Spec: servicecatalog.ServicePlanSpec{
+				CommonServicePlanSpec: servicecatalog.CommonServicePlanSpec{
+					ExternalName:     "large",
+					ExternalID:       "large-id",
+					ExternalMetadata: &runtime.RawExtension{Raw: []byte(testMetadata)},
+				},
+				ServiceBrokerName: "broker",
+				ServiceClassRef:   servicecatalog.LocalObjectReference{Name: "db-class"},
+			},
+		}},
+	}
+
+	fakeClient.AddReactor("list", "clusterserviceclasses", func(action core.Action) (bool, runtime.Object, error) {
+		return true, cscList, nil
+	})
+	fakeClient.AddReactor("list", "clusterserviceplans", func(action core.Action) (bool, runtime.Object, error) {
+		return true, cspList, nil
+	})
+	fakeClient.AddReactor("list", "serviceclasses", func(action core.Action) (bool, runtime.Object, error) {
+		return true, scList, nil
+	})
+	fakeClient.AddReactor("list", "serviceplans", func(action core.Action) (bool, runtime.Object, error) {
+		return true, spList, nil
+	})
+	return fakeClient
+}
+
+func TestRequiredParameters(t *testing.T) {
+	cases := []struct {
+		name                string
+		ref                 servicecatalog.PlanReference
+		parameters          string
+		parametersFrom      []servicecatalog.ParametersFromSource
+		generatedParameters *servicecatalog.GeneratedParameters
+		errMsg              string
+	}{
+		{
+			name:       "required parameters present",
+			ref:        servicecatalog.PlanReference{ClusterServicePlanName: "large-plan"},
+			parameters: `{"region": "eu", "size": 3, "tier": "gold"}`,
+		},
+		{
+			name:       "required parameter missing",
+			ref:        servicecatalog.PlanReference{ClusterServicePlanName: "large-plan"},
+			parameters: `{"region": "eu"}`,
+			errMsg:     `Parameters [size] are required by plan "large"`,
+		},
+		{
+			name:   "required parameters missing without parameters",
+			ref:    servicecatalog.PlanReference{ClusterServicePlanName: "large-plan"},
+			errMsg: `Parameters [region size] are required by plan "large"`,
+		},
+		{
+			name:       "required parameter missing with plan by external name",
+			ref:        servicecatalog.PlanReference{ClusterServiceClassExternalName: "db", ClusterServicePlanExternalName: "large"},
+			parameters: `{"size": 3}`,
+			errMsg:     `Parameters [region] are required by plan "large"`,
+		},
+		{
+			name:       "required parameters present with plan by external ID",
+			ref:        servicecatalog.PlanReference{ClusterServiceClassExternalID: "db-id", ClusterServicePlanExternalID: "large-id"},
+			parameters: `{"region": "eu", "size": 3}`,
+		},
+		{
+			name: "plan without required parameters",
+			ref:  servicecatalog.PlanReference{ClusterServiceClassExternalName: "db", ClusterServicePlanExternalName: "small"},
+		},
+		{
+			name: "unknown plan",
+			ref:  servicecatalog.PlanReference{ClusterServiceClassExternalName: "db", ClusterServicePlanExternalName: "unknown"},
+		},
+		{
+			name:       "required parameter missing with namespaced plan",
+			ref:        servicecatalog.PlanReference{ServiceClassExternalName: "db", ServicePlanExternalName: "large"},
+			parameters: `{"region": "eu"}`,
+			errMsg:     `Parameters [size] are required by plan "large"`,
+		},
+		{
+			name:       "required parameter from a named parametersFrom source",
+			ref:        servicecatalog.PlanReference{ClusterServicePlanName: "large-plan"},
+			parameters: `{"region": "eu"}`,
+			parametersFrom: []servicecatalog.ParametersFromSource{{
+				SecretKeyRef:  &servicecatalog.SecretKeyReference{Name: "secret", Key: "size"},
+				ParameterName: "size",
+			}},
+		},
+		{
+			name:       "required parameter from a generated parameter",
+			ref:        servicecatalog.PlanReference{ClusterServicePlanName: "large-plan"},
+			parameters: `{"region": "eu"}`,
+			generatedParameters: &servicecatalog.GeneratedParameters{
+				SecretName: "generated",
+				Parameters: []servicecatalog.GeneratedParameter{{Name: "size"}},
+			},
+		},
+		{
+			name: "parameters from a secret whose keys are unknown",
+			ref:  servicecatalog.PlanReference{ClusterServicePlanName: "large-plan"},
+			parametersFrom: []servicecatalog.ParametersFromSource{{
+				SecretKeyRef: &servicecatalog.SecretKeyReference{Name: "secret", Key: "parameters"},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, informerFactory, err := newHandlerForTest(newFakeServiceCatalogClientForTest())
+			if err != nil {
+				t.Fatalf("unexpected error initializing handler: %v", err)
+			}
+			informerFactory.Start(wait.NeverStop)
+
+			instance := &servicecatalog.ServiceInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "test-ns"},
+				Spec: servicecatalog.ServiceInstanceSpec{
+					PlanReference:       tc.ref,
+					ParametersFrom:      tc.parametersFrom,
+					GeneratedParameters: tc.generatedParameters,
+				},
+			}
+			if tc.parameters != "" {
+				instance.Spec.Parameters = &runtime.RawExtension{Raw: []byte(tc.parameters)}
+			}
+			err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(instance, nil, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Create, false, nil))
+			if tc.errMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", tc.errMsg)
+			}
+			if !strings.Contains(err.Error(), tc.errMsg) {
+				t.Fatalf("expected error containing %q, got %q", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestRequiredParametersUpdate(t *testing.T) {
+	handler, informerFactory, err := newHandlerForTest(newFakeServiceCatalogClientForTest())
+	if err != nil {
+		t.Fatalf("unexpected error initializing handler: %v", err)
+	}
+	informerFactory.Start(wait.NeverStop)
+
+	oldInstance := &servicecatalog.ServiceInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "instance", Namespace: "test-ns"},
+		Spec: servicecatalog.ServiceInstanceSpec{
+			PlanReference: servicecatalog.PlanReference{ClusterServicePlanName: "large-plan"},
+			Parameters:    &runtime.RawExtension{Raw: []byte(`{"region": "eu"}`)},
+		},
+	}
+	admit := func(instance *servicecatalog.ServiceInstance) error {
+		return handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(instance, oldInstance, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Update, false, nil))
+	}
+
+	// An update which changes neither the plan nor the parameters is let
+	// through, even though a required parameter is missing
+	instance := oldInstance.DeepCopy()
+	instance.Spec.UpdateRequests = 1
+	if err := admit(instance); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Changing the parameters checks the required parameters again
+	instance = oldInstance.DeepCopy()
+	instance.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"region": "us"}`)}
+	if err := admit(instance); err == nil || !strings.Contains(err.Error(), `Parameters [size] are required by plan "large"`) {
+		t.Fatalf("expected the missing required parameter to be rejected, got %v", err)
+	}
+}
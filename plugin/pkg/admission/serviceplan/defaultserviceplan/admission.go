@@ -51,7 +51,8 @@ func Register(plugins *admission.Plugins) {
 // defaultServicePlan is an implementation of admission.Interface.
 // It checks to see if Service Instance is being created without
 // a Service Plan if there is only one Service Plan for the
-// specified Service and defaults to that value.
+// specified Service, or if the Service designates a default
+// Service Plan, and defaults to that value.
 // that the cluster actually has support for it.
 type defaultServicePlan struct {
 	*admission.Handler
@@ -91,7 +92,7 @@ func (d *defaultServicePlan) Admit(a admission.Attributes) error {
 }
 
 func (d *defaultServicePlan) handleDefaultClusterServicePlan(a admission.Attributes, instance *servicecatalog.ServiceInstance) error {
-	sc, err := d.getClusterServiceClassByPlanReference(a, &instance.Spec.PlanReference)
+	sc, err := d.getClusterServiceClassByPlanReference(a, &instance.Spec.PlanReference, instance.Spec.ClusterServiceBrokerName)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
 			return admission.NewForbidden(a, err)
@@ -125,15 +126,33 @@ func (d *defaultServicePlan) handleDefaultClusterServicePlan(a admission.Attribu
 		return admission.NewForbidden(a, errors.New(msg))
 	}
 
-	// check if more than one service plan was found and error
-	if len(plans) > 1 {
-		msg := fmt.Sprintf("ClusterServiceClass (K8S: %v ExternalName: %v) has more than one plan, PlanName must be specified", sc.Name, sc.Spec.ExternalName)
-		klog.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
-		return admission.NewForbidden(a, errors.New(msg))
+	var p servicecatalog.ClusterServicePlan
+	if defaultPlanName, ok := sc.Annotations[v1beta1.ServiceClassDefaultPlanAnnotation]; ok {
+		// pick the plan designated as the default of the service class
+		found := false
+		for _, plan := range plans {
+			if plan.Spec.ExternalName == defaultPlanName {
+				p = plan
+				found = true
+				break
+			}
+		}
+		if !found {
+			msg := fmt.Sprintf("default ClusterServicePlan %q of ClusterServiceClass (K8S: %v ExternalName: %v) does not exist, PlanName must be specified", defaultPlanName, sc.Name, sc.Spec.ExternalName)
+			klog.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+			return admission.NewForbidden(a, errors.New(msg))
+		}
+	} else {
+		// check if more than one service plan was found and error
+		if len(plans) > 1 {
+			msg := fmt.Sprintf("ClusterServiceClass (K8S: %v ExternalName: %v) has more than one plan, PlanName must be specified", sc.Name, sc.Spec.ExternalName)
+			klog.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+			return admission.NewForbidden(a, errors.New(msg))
+		}
+		// otherwise, by default, pick the only plan that exists for the service class
+		p = plans[0]
 	}
-	// otherwise, by default, pick the only plan that exists for the service class
 
-	p := plans[0]
 	klog.V(4).Infof(`ServiceInstance "%s/%s": Using default plan %q (K8S: %q) for Service Class %q`,
 		instance.Namespace, instance.Name, p.Spec.ExternalName, p.Name, sc.Spec.ExternalName)
 	if instance.Spec.ClusterServiceClassExternalName != "" {
@@ -186,15 +205,33 @@ func (d *defaultServicePlan) handleDefaultServicePlan(a admission.Attributes, in
 		return admission.NewForbidden(a, errors.New(msg))
 	}
 
-	// check if more than one service plan was found and error
-	if len(plans) > 1 {
-		msg := fmt.Sprintf("ServiceClass (K8S: %v ExternalName: %v) has more than one plan, PlanName must be specified", sc.Name, sc.Spec.ExternalName)
-		klog.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
-		return admission.NewForbidden(a, errors.New(msg))
+	var p servicecatalog.ServicePlan
+	if defaultPlanName, ok := sc.Annotations[v1beta1.ServiceClassDefaultPlanAnnotation]; ok {
+		// pick the plan designated as the default of the service class
+		found := false
+		for _, plan := range plans {
+			if plan.Spec.ExternalName == defaultPlanName {
+				p = plan
+				found = true
+				break
+			}
+		}
+		if !found {
+			msg := fmt.Sprintf("default ServicePlan %q of ServiceClass (K8S: %v ExternalName: %v) does not exist, PlanName must be specified", defaultPlanName, sc.Name, sc.Spec.ExternalName)
+			klog.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+			return admission.NewForbidden(a, errors.New(msg))
+		}
+	} else {
+		// check if more than one service plan was found and error
+		if len(plans) > 1 {
+			msg := fmt.Sprintf("ServiceClass (K8S: %v ExternalName: %v) has more than one plan, PlanName must be specified", sc.Name, sc.Spec.ExternalName)
+			klog.V(4).Infof(`ServiceInstance "%s/%s": %s`, instance.Namespace, instance.Name, msg)
+			return admission.NewForbidden(a, errors.New(msg))
+		}
+		// otherwise, by default, pick the only plan that exists for the service class
+		p = plans[0]
 	}
-	// otherwise, by default, pick the only plan that exists for the service class
 
-	p := plans[0]
 	klog.V(4).Infof(`ServiceInstance "%s/%s": Using default plan %q (K8S: %q) for Service Class %q`,
 		instance.Namespace, instance.Name, p.Spec.ExternalName, p.Name, sc.Spec.ExternalName)
 	if instance.Spec.ServiceClassExternalName != "" {
@@ -211,7 +248,7 @@ func (d *defaultServicePlan) handleDefaultServicePlan(a admission.Attributes, in
 // NewDefaultClusterServicePlan creates a new admission control handler that
 // fills in a default Service Plan if omitted from Service Instance
 // creation request and if there exists only one plan in the
-// specified Service Class
+// specified Service Class, or if the Service Class designates a default plan
 func NewDefaultClusterServicePlan() (admission.Interface, error) {
 	return &defaultServicePlan{
 		Handler: admission.NewHandler(admission.Create, admission.Update),
@@ -234,12 +271,12 @@ func (d *defaultServicePlan) ValidateInitialization() error {
 	return nil
 }
 
-func (d *defaultServicePlan) getClusterServiceClassByPlanReference(a admission.Attributes, ref *servicecatalog.PlanReference) (*servicecatalog.ClusterServiceClass, error) {
+func (d *defaultServicePlan) getClusterServiceClassByPlanReference(a admission.Attributes, ref *servicecatalog.PlanReference, brokerName string) (*servicecatalog.ClusterServiceClass, error) {
 	if ref.ClusterServiceClassName != "" {
 		return d.getClusterServiceClassByK8SName(a, ref.ClusterServiceClassName)
 	}
 
-	return d.getClusterServiceClassByField(a, ref)
+	return d.getClusterServiceClassByField(a, ref, brokerName)
 }
 
 func (d *defaultServicePlan) getServiceClassByPlanReference(a admission.Attributes, ref *servicecatalog.PlanReference) (*servicecatalog.ServiceClass, error) {
@@ -260,14 +297,18 @@ func (d *defaultServicePlan) getServiceClassByK8SName(a admission.Attributes, sc
 	return d.scClient.Get(scK8SName, apimachineryv1.GetOptions{})
 }
 
-func (d *defaultServicePlan) getClusterServiceClassByField(a admission.Attributes, ref *servicecatalog.PlanReference) (*servicecatalog.ClusterServiceClass, error) {
+func (d *defaultServicePlan) getClusterServiceClassByField(a admission.Attributes, ref *servicecatalog.PlanReference, brokerName string) (*servicecatalog.ClusterServiceClass, error) {
 	filterLabel := ref.GetClusterServiceClassFilterLabelName()
 	filterValue := ref.GetSpecifiedClusterServiceClass()
 
 	klog.V(4).Infof("Fetching ClusterServiceClass filtered by %q = %q", filterLabel, filterValue)
-	labelSelector := labels.SelectorFromSet(labels.Set{
+	filterSet := labels.Set{
 		filterLabel: filterValue,
-	}).String()
+	}
+	if brokerName != "" {
+		filterSet[v1beta1.GroupName+"/"+v1beta1.FilterSpecClusterServiceBrokerName] = brokerName
+	}
+	labelSelector := labels.SelectorFromSet(filterSet).String()
 
 	listOpts := apimachineryv1.ListOptions{
 		LabelSelector: labelSelector,
@@ -281,6 +322,11 @@ func (d *defaultServicePlan) getClusterServiceClassByField(a admission.Attribute
 		klog.V(4).Infof("Found single ClusterServiceClass as %+v", serviceClasses.Items[0])
 		return &serviceClasses.Items[0], nil
 	}
+	if len(serviceClasses.Items) > 1 && brokerName == "" {
+		msg := fmt.Sprintf("Found %v ClusterServiceClasses with %q = %q, clusterServiceBrokerName must be specified", len(serviceClasses.Items), filterLabel, filterValue)
+		klog.V(4).Info(msg)
+		return nil, errors.New(msg)
+	}
 	msg := fmt.Sprintf("Could not find a single ClusterServiceClass with %q = %q, found %v", filterLabel, filterValue, len(serviceClasses.Items))
 	klog.V(4).Info(msg)
 	return nil, admission.NewNotFound(a)
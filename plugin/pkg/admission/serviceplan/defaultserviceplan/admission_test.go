@@ -525,6 +525,116 @@ func TestWithNoPlanFailsWithMultiplePlans(t *testing.T) {
 	}
 }
 
+// checks that defaulting picks the default plan designated by the class when
+// there are multiple plans to choose from.
+func TestWithNoPlanWorksWithDefaultPlan(t *testing.T) {
+	cases := []struct {
+		name          string
+		requestedPlan servicecatalog.PlanReference
+		resolvedPlan  servicecatalog.PlanReference
+		namespaced    bool
+	}{
+		{"cluster external name",
+			servicecatalog.PlanReference{ClusterServiceClassExternalName: "foo"},
+			servicecatalog.PlanReference{ClusterServiceClassExternalName: "foo", ClusterServicePlanExternalName: "baz"}, false},
+		{"cluster external id",
+			servicecatalog.PlanReference{ClusterServiceClassExternalID: "foo-id"},
+			servicecatalog.PlanReference{ClusterServiceClassExternalID: "foo-id", ClusterServicePlanExternalID: "23456"}, false},
+		{"cluster k8s", servicecatalog.PlanReference{ClusterServiceClassName: "foo-id"},
+			servicecatalog.PlanReference{ClusterServiceClassName: "foo-id", ClusterServicePlanName: "baz-id"}, false},
+		{"ns external name",
+			servicecatalog.PlanReference{ServiceClassExternalName: "foo"},
+			servicecatalog.PlanReference{ServiceClassExternalName: "foo", ServicePlanExternalName: "baz"}, true},
+		{"ns external id",
+			servicecatalog.PlanReference{ServiceClassExternalID: "foo-id"},
+			servicecatalog.PlanReference{ServiceClassExternalID: "foo-id", ServicePlanExternalID: "23456"}, true},
+		{"ns k8s", servicecatalog.PlanReference{ServiceClassName: "foo-id"},
+			servicecatalog.PlanReference{ServiceClassName: "foo-id", ServicePlanName: "baz-id"}, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var fakeClient *fake.Clientset
+			defaultPlanAnnotations := map[string]string{v1beta1.ServiceClassDefaultPlanAnnotation: "baz"}
+			if tc.namespaced {
+				sc := newServiceClass("foo-id", "foo")
+				sc.Annotations = defaultPlanAnnotations
+				sps := newServicePlans("foo-id", 2, false)
+				fakeClient = newFakeServiceCatalogClientForNamespacedTest(sc, sps, "" /* do not use get */)
+			} else {
+				csc := newClusterServiceClass("foo-id", "foo")
+				csc.Annotations = defaultPlanAnnotations
+				csps := newClusterServicePlans("foo-id", 2, false)
+				fakeClient = newFakeServiceCatalogClientForTest(csc, csps, "" /* do not use get */)
+			}
+
+			handler, informerFactory, err := newHandlerForTest(fakeClient)
+			if err != nil {
+				t.Errorf("unexpected error initializing handler: %v", err)
+			}
+			informerFactory.Start(wait.NeverStop)
+
+			instance := newServiceInstance("dummy")
+			instance.Spec.PlanReference = tc.requestedPlan
+
+			err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(&instance, nil, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Create, false, nil))
+			if err != nil {
+				t.Errorf("unexpected error %q returned from admission handler", err)
+			}
+			assertPlanReference(t,
+				tc.resolvedPlan,
+				instance.Spec.PlanReference)
+		})
+	}
+}
+
+// checks that defaulting fails when the default plan designated by the class
+// does not exist.
+func TestWithNoPlanFailsWithMissingDefaultPlan(t *testing.T) {
+	cases := []struct {
+		name          string
+		requestedPlan servicecatalog.PlanReference
+		namespaced    bool
+	}{
+		{"cluster external name", servicecatalog.PlanReference{ClusterServiceClassExternalName: "foo"}, false},
+		{"ns external name", servicecatalog.PlanReference{ServiceClassExternalName: "foo"}, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var fakeClient *fake.Clientset
+			defaultPlanAnnotations := map[string]string{v1beta1.ServiceClassDefaultPlanAnnotation: "missing"}
+			if tc.namespaced {
+				sc := newServiceClass("foo-id", "foo")
+				sc.Annotations = defaultPlanAnnotations
+				sps := newServicePlans("foo-id", 2, false)
+				fakeClient = newFakeServiceCatalogClientForNamespacedTest(sc, sps, "" /* do not use get */)
+			} else {
+				csc := newClusterServiceClass("foo-id", "foo")
+				csc.Annotations = defaultPlanAnnotations
+				csps := newClusterServicePlans("foo-id", 2, false)
+				fakeClient = newFakeServiceCatalogClientForTest(csc, csps, "" /* do not use get */)
+			}
+			handler, informerFactory, err := newHandlerForTest(fakeClient)
+			if err != nil {
+				t.Errorf("unexpected error initializing handler: %v", err)
+			}
+			informerFactory.Start(wait.NeverStop)
+
+			instance := newServiceInstance("dummy")
+			instance.Spec.PlanReference = tc.requestedPlan
+
+			err = handler.(admission.MutationInterface).Admit(admission.NewAttributesRecord(&instance, nil, servicecatalog.Kind("ServiceInstance").WithVersion("version"), instance.Namespace, instance.Name, servicecatalog.Resource("serviceinstances").WithVersion("version"), "", admission.Create, false, nil))
+			if err == nil {
+				t.Errorf("unexpected success with no plan specified and a missing default plan")
+				return
+			} else if !strings.Contains(err.Error(), `"missing"`) || !strings.Contains(err.Error(), "does not exist, PlanName must be specified") {
+				t.Errorf("did not find expected error, got %q", err)
+			}
+		})
+	}
+}
+
 // checks that defaulting succeeds when there are multiple plans but only a
 // single plan for the specified Service Class
 func TestWithNoPlanSucceedsWithMultiplePlansFromDifferentClasses(t *testing.T) {
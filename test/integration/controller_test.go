@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 	restclient "k8s.io/client-go/rest"
 	clientgotesting "k8s.io/client-go/testing"
@@ -778,6 +779,8 @@ func newControllerTestTestController(ct *controllerTest) (
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0).Core().V1().Secrets(),
+		kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0).Core().V1().Namespaces(),
 		brokerClFunc,
 		24*time.Hour,
 		osb.LatestAPIVersion().HeaderValue(),
@@ -786,6 +789,10 @@ func newControllerTestTestController(ct *controllerTest) (
 		7*24*time.Hour,
 		controller.DefaultClusterIDConfigMapName,
 		controller.DefaultClusterIDConfigMapNamespace,
+		controller.Options{
+			OperationPollingInitialInterval: time.Second,
+			BrokerContextPlatform:           controller.ContextProfilePlatformKubernetes,
+		},
 	)
 	t.Log("controller start")
 	if err != nil {
@@ -816,7 +823,7 @@ func newControllerTestTestController(ct *controllerTest) (
 	controllerStopped := make(chan struct{})
 
 	go func() {
-		testController.Run(1, stopCh)
+		testController.Run(controller.Workers{Brokers: 1, Instances: 1, Bindings: 1}, stopCh)
 		controllerStopped <- struct{}{}
 	}()
 
@@ -938,6 +945,8 @@ func newTestController(t *testing.T) (
 		serviceCatalogSharedInformers.ServiceBindings(),
 		serviceCatalogSharedInformers.ClusterServicePlans(),
 		serviceCatalogSharedInformers.ServicePlans(),
+		kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0).Core().V1().Secrets(),
+		kubeinformers.NewSharedInformerFactory(fakeKubeClient, 0).Core().V1().Namespaces(),
 		brokerClFunc,
 		24*time.Hour,
 		osb.LatestAPIVersion().HeaderValue(),
@@ -946,6 +955,10 @@ func newTestController(t *testing.T) (
 		7*24*time.Hour,
 		controller.DefaultClusterIDConfigMapName,
 		controller.DefaultClusterIDConfigMapNamespace,
+		controller.Options{
+			OperationPollingInitialInterval: time.Second,
+			BrokerContextPlatform:           controller.ContextProfilePlatformKubernetes,
+		},
 	)
 	t.Log("controller start")
 	if err != nil {
@@ -955,7 +968,7 @@ func newTestController(t *testing.T) (
 	stopCh := make(chan struct{})
 	controllerStopped := make(chan struct{})
 	go func() {
-		testController.Run(1, stopCh)
+		testController.Run(controller.Workers{Brokers: 1, Instances: 1, Bindings: 1}, stopCh)
 		controllerStopped <- struct{}{}
 	}()
 	informerFactory.Start(stopCh)